@@ -15,6 +15,7 @@ package main
 
 import (
 	"flag"
+	"os"
 
 	"github.com/shaders/protoc-gen-go-mcp/pkg/generator"
 	"google.golang.org/protobuf/compiler/protogen"
@@ -27,6 +28,16 @@ func main() {
 		"mcp",
 		"Generate files into a sub-package of the package containing the base .pb.go files using the given suffix. An empty suffix denotes to generate into the same package as the base pb.go files.",
 	)
+	packageName := flagSet.String(
+		"package_name",
+		"",
+		"When set, generate files into a sub-package with this name instead of the base package name plus package_suffix",
+	)
+	fileSuffix := flagSet.String(
+		"file_suffix",
+		generator.GeneratedFilenameExtension,
+		"Suffix of the generated file names, replacing the .proto extension; it must end in .go",
+	)
 	optionalKeywordSupport := flagSet.Bool(
 		"optional_keyword_support",
 		false,
@@ -37,6 +48,156 @@ func main() {
 		false,
 		"When enabled, every generated method must carry a valid (mcp.options.tool) name annotation; a missing, malformed or duplicate name fails generation with no autogenerated-name fallback",
 	)
+	inlineMessages := flagSet.Bool(
+		"inline_messages",
+		false,
+		"When enabled, message schemas are inlined instead of referenced from $defs; enums are still deduplicated into $defs and recursive messages keep using $ref",
+	)
+	skipDeprecated := flagSet.Bool(
+		"skip_deprecated",
+		false,
+		"When enabled, methods marked [deprecated = true] get no tool; otherwise their tool description starts with \"(deprecated)\" and their input schema is marked deprecated",
+	)
+	flatArgs := flagSet.Bool(
+		"flat_args",
+		false,
+		"When enabled, the fields of single-level message fields of a request are hoisted to the top level of the tool input schema when their names are unambiguous, and nested again by the generated handler",
+	)
+	markFieldBehavior := flagSet.Bool(
+		"mark_field_behavior",
+		false,
+		"When enabled, INPUT_ONLY and OUTPUT_ONLY fields are kept in every schema and marked writeOnly/readOnly instead of being dropped",
+	)
+	uiHints := flagSet.Bool(
+		"ui_hints",
+		false,
+		"When enabled, every property gets an x-order vendor extension with its declaration position in the message, and properties of deprecated fields are marked deprecated, for clients that render forms",
+	)
+	schemaTitle := flagSet.Bool(
+		"schema_title",
+		false,
+		"When enabled, the root of every input schema gets the method name as its title and the tool annotation description or method comment as its description, for schemas exported on their own",
+	)
+	fieldPathComments := flagSet.Bool(
+		"field_path_comments",
+		false,
+		"When enabled, every property of the generated schemas gets a $comment with the fully-qualified name and number of its proto field, for debugging",
+	)
+	toolMeta := flagSet.Bool(
+		"tool_meta",
+		false,
+		"When enabled, every tool carries the proto package and version of its file in its _meta; files with an (mcp.options.file) version are stamped either way",
+	)
+	toolVersion := flagSet.String(
+		"tool_version",
+		"",
+		"Version stamped into the _meta of the tools of files without an (mcp.options.file) version, e.g. a release tag; implies tool_meta",
+	)
+	grpcMethod := flagSet.Bool(
+		"grpc_method",
+		false,
+		"When enabled, every tool carries its gRPC method path, e.g. /pkg.Service/Method, as x-grpc-method in its input schema and in its _meta",
+	)
+	validateTool := flagSet.Bool(
+		"validate_tool",
+		false,
+		"When enabled, every service gets a <package>_<Service>_validate tool that checks arguments for its other tools without calling the backend",
+	)
+	rawTools := flagSet.Bool(
+		"raw_tools",
+		false,
+		"When enabled, every tool gets a <name>_raw companion whose only argument is a string holding its arguments as JSON, for clients that only pass a single string argument",
+	)
+	int64Note := flagSet.String(
+		"int64_note",
+		"",
+		"Description note added to 64-bit integer fields, replacing the default note that explains their string encoding",
+	)
+	suppressInt64Note := flagSet.Bool(
+		"suppress_int64_note",
+		false,
+		"When enabled, 64-bit integer fields get no description note",
+	)
+	timestampFormat := flagSet.String(
+		"timestamp_format",
+		generator.TimestampFormatRFC3339,
+		"Encoding of google.protobuf.Timestamp fields in tool schemas: rfc3339 strings, or unix_seconds integers that the generated handler converts before forwarding",
+	)
+	optionalFields := flagSet.String(
+		"optional_fields",
+		generator.OptionalFieldsNullable,
+		"Representation of singular well-known-type fields that may be unset: nullable adds \"null\" to their type, omit leaves them out of required without a null type, for clients that dislike explicit nulls",
+	)
+	nullableStyle := flagSet.String(
+		"nullable_style",
+		generator.NullableStyleTypeArray,
+		"How schemas that accept null say so: type_array adds \"null\" to the type array as in JSON Schema, keyword sets the OpenAPI 3.0 \"nullable\": true next to a single type, for consumers that do not understand type arrays",
+	)
+	enumAliases := flagSet.String(
+		"enum_aliases",
+		generator.EnumAliasesAll,
+		"Listing of the names of enums with allow_alias: all lists every name with a note grouping the names of the same value, canonical lists only the first name of each value; the generated handler accepts every name either way",
+	)
+	enumOrder := flagSet.String(
+		"enum_order",
+		generator.EnumOrderDeclaration,
+		"Order of the names listed in enum schemas: declaration keeps the proto order, alphabetical sorts them by name and numeric by number, for schemas that stay stable when values are reordered",
+	)
+	enumAsInt := flagSet.Bool(
+		"enum_as_int",
+		false,
+		"When enabled, input enum fields are integers: a minimum and maximum when the numbers of the enum are contiguous, an enum list of the numbers otherwise, with a note naming each number; responses keep the names",
+	)
+	oneOfKey := flagSet.String(
+		"oneof_key",
+		generator.OneOfKeyTypeSuffix,
+		"Naming of the property wrapping the variants of a oneof: type_suffix for <oneof>OneOfType, camel for <oneofInLowerCamel>OneOf, union for <oneof>__union; the generated handler recognizes the same naming",
+	)
+	descriptionPrefix := flagSet.String(
+		"description_prefix",
+		"",
+		"Prefix for every tool description, e.g. \"[{service}] \"; {service} and {method} are replaced by the simple service and method names",
+	)
+	generateHandlers := flagSet.Bool(
+		"generate_handlers",
+		false,
+		"When enabled, also generate Unimplemented<Service>Handler and Mock<Service>Handler implementations of the generated client interface, for tests and prototypes",
+	)
+	generateForward := flagSet.Bool(
+		"generate_forward",
+		true,
+		"When disabled, leave out ForwardTo<Service>Client, for services only registered in-process with RegisterInProcess<Service>Server",
+	)
+	generateRegister := flagSet.Bool(
+		"generate_register",
+		true,
+		"When disabled, leave out RegisterInProcess<Service>Server and <Service>InProcessServer, for services only reached through ForwardTo<Service>Client; generate_forward and generate_register cannot both be disabled",
+	)
+	generateSmokeTest := flagSet.Bool(
+		"generate_smoke_test",
+		false,
+		"When enabled, also generate SmokeTest<Service>(t, client) helpers in a <package>test package next to the generated code, which call every tool with the minimal arguments its schema accepts and fail on panics, rejected arguments and results that do not encode; needs generate_forward",
+	)
+	schemaOut := flagSet.String(
+		"schema_out",
+		"",
+		"When set, also write each tool's input and output JSON schema to <schema_out>/<proto package path>/<Service>/<Method>.json, relative to the plugin output directory",
+	)
+	refBaseURI := flagSet.String(
+		"ref_base_uri",
+		"",
+		"When set, every tool schema gets an absolute $id under this base URI, <ref_base_uri>/<package>.<Service>/<Method>, and its $refs become absolute against it, for schemas hosted and referenced across documents; by default $refs are local",
+	)
+	report := flagSet.String(
+		"report",
+		"",
+		"When set, also report the tools generated per service, the methods skipped and why, and the well-known types without a dedicated schema: as text on stderr for \"stderr\", otherwise as JSON to the given file, relative to the plugin output directory",
+	)
+	openAPIOut := flagSet.String(
+		"openapi_out",
+		"",
+		"When set, also write an OpenAPI 3.1 document with the request and response message schemas of every tool under components/schemas to the given file, relative to the plugin output directory",
+	)
 
 	protogen.Options{
 		ParamFunc: flagSet.Set,
@@ -44,17 +205,65 @@ func main() {
 		// Shared across all files so tool-name uniqueness can be enforced
 		// globally (requires protoc to be invoked over all protos at once).
 		toolNames := generator.ToolNameRegistry{}
+		var summary *generator.Report
+		if *report != "" {
+			summary = &generator.Report{}
+		}
+		var openAPI *generator.OpenAPIDocument
+		if *openAPIOut != "" {
+			openAPI = &generator.OpenAPIDocument{}
+		}
 		for _, f := range gen.Files {
 			if !f.Generate {
 				continue
 			}
 			generator.NewFileGenerator(f, gen).GenerateWithConfig(generator.GenerateConfig{
 				PackageSuffix:          *packageSuffix,
+				PackageName:            *packageName,
+				FileSuffix:             *fileSuffix,
 				OptionalKeywordSupport: *optionalKeywordSupport,
 				RequireToolAnnotation:  *requireToolAnnotation,
+				InlineMessages:         *inlineMessages,
+				FlatArgs:               *flatArgs,
+				SkipDeprecated:         *skipDeprecated,
+				MarkFieldBehavior:      *markFieldBehavior,
+				UIHints:                *uiHints,
+				SchemaTitle:            *schemaTitle,
+				FieldPathComments:      *fieldPathComments,
+				ToolMeta:               *toolMeta,
+				ToolVersion:            *toolVersion,
+				GRPCMethod:             *grpcMethod,
+				ValidateTool:           *validateTool,
+				RawTools:               *rawTools,
+				Int64Note:              *int64Note,
+				SuppressInt64Note:      *suppressInt64Note,
+				TimestampFormat:        *timestampFormat,
+				OptionalFields:         *optionalFields,
+				NullableStyle:          *nullableStyle,
+				EnumAliases:            *enumAliases,
+				EnumOrder:              *enumOrder,
+				EnumAsInt:              *enumAsInt,
+				OneOfKey:               *oneOfKey,
+				DescriptionPrefix:      *descriptionPrefix,
+				GenerateHandlers:       *generateHandlers,
+				SkipForward:            !*generateForward,
+				SkipRegister:           !*generateRegister,
+				GenerateSmokeTest:      *generateSmokeTest,
+				SchemaOut:              *schemaOut,
+				RefBaseURI:             *refBaseURI,
+				Report:                 summary,
+				OpenAPI:                openAPI,
 				ToolNames:              toolNames,
 			})
 		}
+		if openAPI != nil {
+			if err := openAPI.Write(gen, *openAPIOut); err != nil {
+				return err
+			}
+		}
+		if summary != nil {
+			return summary.Write(gen, *report, os.Stderr)
+		}
 		return nil
 	})
 }
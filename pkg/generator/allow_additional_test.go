@@ -0,0 +1,73 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestAllowAdditional(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(testdatamcp.PluginService_ConfigurePluginTool.JSONSchema), &schema)).To(Succeed())
+	defs := schema["$defs"].(map[string]any)
+
+	// Only the annotated message allows extra properties explicitly.
+	g.Expect(defs["PluginSettings"]).To(HaveKeyWithValue("additionalProperties", true))
+	g.Expect(defs["PluginOwner"]).ToNot(HaveKey("additionalProperties"))
+	g.Expect(schema).ToNot(HaveKey("additionalProperties"))
+
+	// An annotated message at the root of a schema is open too.
+	fg := &FileGenerator{}
+	root := fg.messageSchemaWithDefs((&testdata.PluginSettings{}).ProtoReflect().Descriptor(), nil, directionInput)
+	g.Expect(root).To(HaveKeyWithValue("additionalProperties", true))
+	root = fg.messageSchemaWithDefs((&testdata.PluginOwner{}).ProtoReflect().Descriptor(), nil, directionInput)
+	g.Expect(root).ToNot(HaveKey("additionalProperties"))
+}
+
+// TestAllowAdditionalCall checks that extra properties of an annotated
+// message pass the schema but are dropped from the request.
+func TestAllowAdditionalCall(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(testdatamcp.PluginService_ConfigurePluginTool.JSONSchema), &schema)).To(Succeed())
+	compiled, err := compileSchema(schema)
+	g.Expect(err).ToNot(HaveOccurred())
+	args := map[string]any{"plugin_id": "p-1", "settings": map[string]any{"enabled": true, "theme": "dark"}}
+	g.Expect(compiled.Validate(args)).To(Succeed())
+
+	var got *testdata.ConfigurePluginRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToPluginServiceClient(s, &testdatamcp.MockPluginServiceHandler{
+		ConfigurePluginFunc: func(_ context.Context, req *testdata.ConfigurePluginRequest) (*testdata.ConfigurePluginResponse, error) {
+			got = req
+			return &testdata.ConfigurePluginResponse{Applied: true}, nil
+		},
+	})
+	resp := callTool(t, s, testdatamcp.PluginService_ConfigurePluginToolName, args)
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"applied":true}`))
+	g.Expect(got.GetSettings().GetEnabled()).To(BeTrue())
+	g.Expect(got.GetSettings().ProtoReflect().GetUnknown()).To(BeEmpty(), "theme has no proto field and is dropped")
+}
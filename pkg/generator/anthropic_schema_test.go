@@ -0,0 +1,128 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// expectAnthropicCompatible fails g unless schema has the shape of an
+// Anthropic tool input_schema.
+func expectAnthropicCompatible(g *WithT, schema map[string]any) {
+	g.Expect(schema).To(HaveKeyWithValue("type", "object"))
+	g.Expect(schema).To(HaveKey("properties"))
+	g.Expect(schema).ToNot(HaveKey("oneOf"))
+	g.Expect(schema).ToNot(HaveKey("anyOf"))
+	g.Expect(schema).ToNot(HaveKey("allOf"))
+
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			for key, value := range v {
+				g.Expect(key).ToNot(BeElementOf("$ref", "$defs", "$schema", "nullable", "contentEncoding"))
+				g.Expect(strings.HasPrefix(key, "x-")).To(BeFalse(), "vendor extension %q", key)
+				if key == "format" {
+					g.Expect(value).To(BeElementOf("date-time", "date", "duration"))
+				}
+				walk(value)
+			}
+		case []any:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	walk(schema)
+	_, err := compileSchema(schema)
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestToAnthropicToolSchemaWellKnownTypes(t *testing.T) {
+	msg := &testdata.WktTestMessage{
+		Timestamp:   timestamppb.New(timestamppb.Now().AsTime()),
+		Duration:    durationpb.New(90e9),
+		StructField: &structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewNumberValue(1)}},
+		ValueField:  structpb.NewStringValue("x"),
+		StringValue: wrapperspb.String("s"),
+		Int64Value:  wrapperspb.Int64(1 << 40),
+		BytesValue:  wrapperspb.Bytes([]byte("hi")),
+	}
+	instance := map[string]any{}
+	raw, err := protojson.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(raw, &instance); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, style := range []string{NullableStyleTypeArray, NullableStyleKeyword} {
+		t.Run(style, func(t *testing.T) {
+			g := NewWithT(t)
+
+			fg := &FileGenerator{nullableStyle: style}
+			generated, err := json.Marshal(fg.messageSchema((&testdata.WktTestMessage{}).ProtoReflect().Descriptor()))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			adapted, err := runtime.ToAnthropicToolSchema(generated)
+			g.Expect(err).ToNot(HaveOccurred())
+			var schema map[string]any
+			g.Expect(json.Unmarshal(adapted, &schema)).To(Succeed())
+			expectAnthropicCompatible(g, schema)
+
+			props := schema["properties"].(map[string]any)
+			g.Expect(props).To(HaveLen(12))
+			g.Expect(props["timestamp"]).To(HaveKeyWithValue("format", "date-time"))
+			g.Expect(props["int64_value"]).ToNot(HaveKey("format"), "int64 is not an Anthropic format")
+			g.Expect(props["int64_value"]).To(HaveKeyWithValue("type", ContainElement("null")))
+			g.Expect(validateAgainstSchema(schema, instance)).To(Succeed())
+		})
+	}
+}
+
+func TestToAnthropicToolSchemaInlinesRefs(t *testing.T) {
+	g := NewWithT(t)
+
+	generated := testdatamcp.TestService_CreateItemTool.JSONSchema
+	g.Expect(generated).To(ContainSubstring(`"$ref"`))
+
+	adapted, err := runtime.ToAnthropicToolSchema(json.RawMessage(generated))
+	g.Expect(err).ToNot(HaveOccurred())
+	var schema map[string]any
+	g.Expect(json.Unmarshal(adapted, &schema)).To(Succeed())
+	expectAnthropicCompatible(g, schema)
+
+	var original map[string]any
+	g.Expect(json.Unmarshal([]byte(generated), &original)).To(Succeed())
+	g.Expect(schema["required"]).To(Equal(original["required"]))
+
+	variants := schema["properties"].(map[string]any)["item_typeOneOfType"].(map[string]any)["oneOf"].([]any)
+	product := variants[0].(map[string]any)["properties"].(map[string]any)["product"]
+	g.Expect(product).To(HaveKeyWithValue("properties", HaveKey("price")))
+}
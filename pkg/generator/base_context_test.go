@@ -0,0 +1,53 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+type loggerKey struct{}
+
+func TestBaseContextReachesForwardedCall(t *testing.T) {
+	g := NewWithT(t)
+
+	var seen any
+	var callCtx context.Context
+	base := context.WithValue(context.Background(), loggerKey{}, "server logger")
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, &testdatamcp.MockTestServiceHandler{
+		GetItemFunc: func(ctx context.Context, req *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+			seen, callCtx = ctx.Value(loggerKey{}), ctx
+			return &testdata.GetItemResponse{Item: &testdata.Item{Id: req.GetId()}}, nil
+		},
+	}, runtime.WithBaseContext(base))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	resultText(g, callToolWithContext(t, ctx, s, testdatamcp.TestService_GetItemTool.Name, map[string]any{"id": "item-1"}))
+	g.Expect(seen).To(Equal("server logger"))
+
+	// The request context still governs cancellation.
+	g.Expect(callCtx.Err()).ToNot(HaveOccurred())
+	cancel()
+	g.Expect(callCtx.Err()).To(MatchError(context.Canceled))
+}
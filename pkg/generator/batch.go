@@ -0,0 +1,104 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+const (
+	// batchToolSuffix is appended to a tool name to name its batch tool.
+	batchToolSuffix = "_batch"
+
+	// batchMaxItems is the maxItems of a batch tool's requests array. It
+	// matches runtime.MaxBatchSize, which enforces it.
+	batchMaxItems = 100
+)
+
+// batchTool returns the companion batch tool of tool, the tool generated for
+// meth from inputSchema, or nil when (mcp.options.tool) batch is not set. Its
+// input is a "requests" array of tool inputs.
+func (g *FileGenerator) batchTool(meth *protogen.Method, opts *mcpoptions.ToolOptions, tool SimpleTool, inputSchema map[string]any) (*SimpleTool, error) {
+	if !opts.GetBatch() {
+		return nil, nil
+	}
+
+	annotated := opts.GetName() != ""
+	name := tool.Name + batchToolSuffix
+	if len(name) > MaxToolNameLength {
+		if annotated {
+			return nil, fmt.Errorf("mcpgen: %s batch tool name %q is longer than %d characters; shorten the (mcp.options.tool) name", meth.Desc.FullName(), name, MaxToolNameLength)
+		}
+		name = MangleHeadIfTooLong(strings.ReplaceAll(string(meth.Desc.FullName()), ".", "_")+batchToolSuffix, MaxToolNameLength)
+	}
+	if err := g.claimToolName(meth, name, annotated); err != nil {
+		return nil, err
+	}
+
+	// The requests share the $defs of the single-request schema, hoisted to
+	// the root so their $refs still resolve.
+	items := make(map[string]any, len(inputSchema))
+	for k, v := range inputSchema {
+		switch k {
+		case "$schema", "$defs", "examples", "x-grpc-method":
+		default:
+			items[k] = v
+		}
+	}
+	schema := map[string]any{
+		"$schema": inputSchema["$schema"],
+		"type":    "object",
+		"properties": map[string]any{
+			"requests": map[string]any{
+				"type":        "array",
+				"description": fmt.Sprintf("Requests to run, each as accepted by %s.", tool.Name),
+				"items":       items,
+				"minItems":    1,
+				"maxItems":    batchMaxItems,
+			},
+		},
+		"required": []string{"requests"},
+	}
+	if defs, ok := inputSchema["$defs"]; ok {
+		schema["$defs"] = defs
+	}
+	if method, ok := inputSchema["x-grpc-method"]; ok {
+		schema["x-grpc-method"] = method
+	}
+	marshaled, err := g.marshalSchema(schema, g.schemaID(meth, "batch"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal batch JSON schema for %s: %w", meth.Desc.FullName(), err)
+	}
+
+	description := fmt.Sprintf("Runs %s for each of up to %d requests. Results are returned in request order; a failed request reports its error without failing the others.", tool.Name, batchMaxItems)
+	if tool.Description != "" {
+		description += "\n\n" + tool.Description
+	}
+
+	batch := tool
+	batch.Name = name
+	batch.Description = description
+	batch.JSONSchema = string(marshaled)
+	if batch.Title != "" {
+		batch.Title += " (batch)"
+	}
+	batch.ZeroBasedPaginationPaths = nil
+	return &batch, nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestBatchToolGolden(t *testing.T) {
+	g := NewWithT(t)
+
+	batch := testdatamcp.BatchService_LookupWidgetBatchTool
+	g.Expect(batch.Name).To(Equal("lookup_widget_batch"))
+	g.Expect(batch.Title).To(Equal("Look up widget (batch)"))
+	g.Expect(*batch.ReadOnly).To(BeTrue())
+	g.Expect(batch.Description).To(HaveSuffix(testdatamcp.BatchService_LookupWidgetTool.Description))
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(batch.JSONSchema), &schema)).To(Succeed())
+	g.Expect(schema["required"]).To(ConsistOf("requests"))
+	g.Expect(schema["$defs"]).To(HaveKey("WidgetLookupOptions"), "$defs are hoisted to the root")
+	requests := schema["properties"].(map[string]any)["requests"].(map[string]any)
+	g.Expect(requests).To(HaveKeyWithValue("maxItems", float64(runtime.MaxBatchSize)))
+	g.Expect(requests["items"]).To(HaveKey("properties"))
+	g.Expect(requests["items"]).ToNot(HaveKey("$defs"))
+
+	g.Expect(validateAgainstSchema(schema, map[string]any{
+		"requests": []any{
+			map[string]any{"id": "a", "options": map[string]any{"include_deleted": true}},
+			map[string]any{"id": "b"},
+		},
+	})).To(Succeed())
+	g.Expect(validateAgainstSchema(schema, map[string]any{"requests": []any{}})).ToNot(Succeed())
+
+	// Methods without the option get no batch tool.
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToBatchServiceClient(s, &testdatamcp.MockBatchServiceHandler{})
+	g.Expect(listToolNames(t, s)).To(ConsistOf("lookup_widget", "lookup_widget_batch", testdatamcp.BatchService_RenameWidgetTool.Name))
+}
+
+func TestBatchToolPartialFailure(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToBatchServiceClient(s, &testdatamcp.MockBatchServiceHandler{
+		LookupWidgetFunc: func(_ context.Context, req *testdata.LookupWidgetRequest) (*testdata.LookupWidgetResponse, error) {
+			if req.GetId() == "missing" {
+				return nil, status.Error(codes.NotFound, "widget missing not found")
+			}
+			return &testdata.LookupWidgetResponse{Id: req.GetId(), Name: strings.ToUpper(req.GetId())}, nil
+		},
+	}, runtime.WithBatchConcurrency(2))
+
+	resp := callTool(t, s, "lookup_widget_batch", map[string]any{
+		"requests": []any{
+			map[string]any{"id": "a"},
+			map[string]any{"id": "missing"},
+			map[string]any{"id": "c"},
+		},
+	})
+	g.Expect(resp["result"]).ToNot(HaveKeyWithValue("isError", true), "a partial failure does not fail the batch")
+
+	var out struct {
+		Results []struct {
+			Index  int             `json:"index"`
+			Result json.RawMessage `json:"result"`
+			Error  json.RawMessage `json:"error"`
+		} `json:"results"`
+	}
+	g.Expect(json.Unmarshal([]byte(resultText(g, resp)), &out)).To(Succeed())
+	g.Expect(out.Results).To(HaveLen(3))
+
+	g.Expect(out.Results[0].Index).To(Equal(0))
+	g.Expect(out.Results[0].Result).To(MatchJSON(`{"id":"a","name":"A"}`))
+	g.Expect(out.Results[0].Error).To(BeEmpty())
+
+	g.Expect(out.Results[1].Index).To(Equal(1))
+	g.Expect(out.Results[1].Result).To(BeEmpty())
+	g.Expect(string(out.Results[1].Error)).To(ContainSubstring("widget missing not found"))
+	g.Expect(string(out.Results[1].Error)).To(ContainSubstring("NOT_FOUND"))
+
+	g.Expect(out.Results[2].Index).To(Equal(2))
+	g.Expect(out.Results[2].Result).To(MatchJSON(`{"id":"c","name":"C"}`))
+}
+
+func TestBatchToolName(t *testing.T) {
+	long := strings.Repeat("a", MaxToolNameLength-2)
+	methods := buildMethod(t, map[string]*mcpoptions.ToolOptions{
+		"Get":  {Name: "get_item", Batch: true},
+		"Long": {Name: long, Batch: true},
+		"Flat": {Batch: true},
+	})
+
+	t.Run("annotated", func(t *testing.T) {
+		g := NewWithT(t)
+		fg := &FileGenerator{seenToolNames: ToolNameRegistry{}}
+		m := methodNamed(methods, "Get")
+		batch, err := fg.batchTool(m, methodToolOptions(m), SimpleTool{Name: "get_item"}, fg.messageSchemaWithDefs(m.Input.Desc, nil, directionInput))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(batch.Name).To(Equal("get_item_batch"))
+		g.Expect(fg.seenToolNames).To(HaveKey("get_item_batch"))
+	})
+
+	t.Run("annotated name too long", func(t *testing.T) {
+		g := NewWithT(t)
+		fg := &FileGenerator{seenToolNames: ToolNameRegistry{}}
+		m := methodNamed(methods, "Long")
+		_, err := fg.batchTool(m, methodToolOptions(m), SimpleTool{Name: long}, fg.messageSchemaWithDefs(m.Input.Desc, nil, directionInput))
+		g.Expect(err).To(MatchError(ContainSubstring("test.pkg.Svc.Long batch tool name")))
+	})
+
+	t.Run("legacy name", func(t *testing.T) {
+		g := NewWithT(t)
+		fg := &FileGenerator{seenToolNames: ToolNameRegistry{}}
+		m := methodNamed(methods, "Flat")
+		batch, err := fg.batchTool(m, methodToolOptions(m), SimpleTool{Name: "test_pkg_Svc_Flat"}, fg.messageSchemaWithDefs(m.Input.Desc, nil, directionInput))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(batch.Name).To(Equal("test_pkg_Svc_Flat_batch"))
+	})
+
+	t.Run("collides with another tool", func(t *testing.T) {
+		g := NewWithT(t)
+		fg := &FileGenerator{seenToolNames: ToolNameRegistry{
+			"get_item_batch": {Method: "test.pkg.Svc.GetItemBatch", Annotated: true},
+		}}
+		m := methodNamed(methods, "Get")
+		_, err := fg.batchTool(m, methodToolOptions(m), SimpleTool{Name: "get_item"}, fg.messageSchemaWithDefs(m.Input.Desc, nil, directionInput))
+		g.Expect(err).To(MatchError(ContainSubstring(`duplicate MCP tool name "get_item_batch"`)))
+	})
+}
+
+func TestBatchMaxItemsMatchesRuntime(t *testing.T) {
+	NewWithT(t).Expect(batchMaxItems).To(Equal(runtime.MaxBatchSize))
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestBytesInlineLimit(t *testing.T) {
+	large := bytes.Repeat([]byte("x"), 4096)
+	newServer := func(opts ...runtime.Option) *mcpserver.MCPServer {
+		s := mcpserver.NewMCPServer("test-server", "1.0.0")
+		testdatamcp.ForwardToBlobServiceClient(s, &testdatamcp.MockBlobServiceHandler{
+			GetBlobFunc: func(context.Context, *testdata.GetBlobRequest) (*testdata.GetBlobResponse, error) {
+				return &testdata.GetBlobResponse{
+					Content:  large,
+					Checksum: []byte("ok"),
+					Parts:    []*testdata.BlobPart{{Name: "head", Data: large}},
+				}, nil
+			},
+		}, opts...)
+		return s
+	}
+
+	t.Run("summarized", func(t *testing.T) {
+		g := NewWithT(t)
+		text := resultText(g, callTool(t, newServer(runtime.WithBytesInlineLimit(1024)), testdatamcp.BlobService_GetBlobTool.Name, map[string]any{"id": "b"}))
+		g.Expect(text).To(ContainSubstring(`"content":"<4096 bytes omitted, sha256:`))
+		g.Expect(text).To(ContainSubstring(`"data":"<4096 bytes omitted, sha256:`))
+		g.Expect(text).To(ContainSubstring(`"checksum":"b2s="`))
+		g.Expect(len(text)).To(BeNumerically("<", 1024))
+	})
+
+	t.Run("inline by default", func(t *testing.T) {
+		g := NewWithT(t)
+		text := resultText(g, callTool(t, newServer(), testdatamcp.BlobService_GetBlobTool.Name, map[string]any{"id": "b"}))
+		g.Expect(text).ToNot(ContainSubstring("omitted"))
+		g.Expect(len(text)).To(BeNumerically(">", 2*4096))
+	})
+}
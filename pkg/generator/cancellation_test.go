@@ -0,0 +1,78 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// newBlockingAnnotatedServer serves AnnotatedService with a backend that
+// only returns once the call context is done, with the transport's error.
+func newBlockingAnnotatedServer() *mcpserver.MCPServer {
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToAnnotatedServiceClient(s, &testdatamcp.MockAnnotatedServiceHandler{
+		GetWidgetFunc: func(ctx context.Context, _ *testdata.GetWidgetRequest) (*testdata.GetWidgetResponse, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+		DeleteWidgetFunc: func(ctx context.Context, _ *testdata.DeleteWidgetRequest) (*testdata.DeleteWidgetResponse, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	})
+	return s
+}
+
+func TestCallCanceledByClient(t *testing.T) {
+	g := NewWithT(t)
+	s := newBlockingAnnotatedServer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	resp := callToolWithContext(t, ctx, s, "get_widget", map[string]any{})
+	g.Expect(resp["result"]).To(HaveKeyWithValue("isError", true))
+	text := resultText(g, resp)
+	g.Expect(text).To(ContainSubstring(`"code":"CANCELLED"`))
+	g.Expect(text).To(ContainSubstring("call canceled by client"))
+	g.Expect(text).ToNot(ContainSubstring("may still have been applied"), "get_widget is read-only")
+
+	// A destructive call may have gone through before the cancellation.
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	text = resultText(g, callToolWithContext(t, ctx, s, "delete_widget", map[string]any{}))
+	g.Expect(text).To(ContainSubstring("call canceled by client; the request may still have been applied"))
+}
+
+func TestCallDeadlineExceeded(t *testing.T) {
+	g := NewWithT(t)
+	s := newBlockingAnnotatedServer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	resp := callToolWithContext(t, ctx, s, "get_widget", map[string]any{})
+	g.Expect(resp["result"]).To(HaveKeyWithValue("isError", true))
+	text := resultText(g, resp)
+	g.Expect(text).To(ContainSubstring(`"code":"DEADLINE_EXCEEDED"`))
+	g.Expect(text).To(ContainSubstring("call deadline exceeded"))
+	g.Expect(text).ToNot(ContainSubstring("canceled"))
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// interceptedTestServer answers GetItem with the authorization metadata it
+// received as the item name.
+type interceptedTestServer struct {
+	testdata.UnimplementedTestServiceServer
+}
+
+func (interceptedTestServer) GetItem(ctx context.Context, req *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return &testdata.GetItemResponse{Item: &testdata.Item{Id: req.GetId(), Name: md.Get("authorization")[0]}}, nil
+}
+
+// TestClientInterceptors forwards a tool call to a client whose connection
+// has unary interceptors, as for auth or tracing, and checks that the call
+// goes through them rather than around them.
+func TestClientInterceptors(t *testing.T) {
+	g := NewWithT(t)
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	testdata.RegisterTestServiceServer(srv, interceptedTestServer{})
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	var methods []string
+	count := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		methods = append(methods, method)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	auth := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer t0k3n"), method, req, reply, cc, opts...)
+	}
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(count, auth),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	t.Cleanup(func() { _ = conn.Close() })
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, testdata.NewTestServiceClient(conn))
+
+	resp := callTool(t, s, testdatamcp.TestService_GetItemToolName, map[string]any{"id": "item-1"})
+	g.Expect(resultText(g, resp)).To(ContainSubstring(`"name":"Bearer t0k3n"`))
+	g.Expect(methods).To(Equal([]string{"/testdata.TestService/GetItem"}))
+}
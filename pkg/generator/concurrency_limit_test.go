@@ -0,0 +1,123 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// blockingItemBackend is a TestService backend whose GetItem blocks until
+// unblock is closed, recording how many calls were in flight at most.
+type blockingItemBackend struct {
+	unblock  chan struct{}
+	inFlight atomic.Int32
+	maxSeen  atomic.Int32
+	started  chan struct{}
+}
+
+func newBlockingItemBackend() *blockingItemBackend {
+	return &blockingItemBackend{unblock: make(chan struct{}), started: make(chan struct{}, 100)}
+}
+
+func (b *blockingItemBackend) register(s *mcpserver.MCPServer, opts ...runtime.Option) {
+	testdatamcp.ForwardToTestServiceClient(s, &testdatamcp.MockTestServiceHandler{
+		GetItemFunc: func(_ context.Context, req *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+			n := b.inFlight.Add(1)
+			defer b.inFlight.Add(-1)
+			for {
+				seen := b.maxSeen.Load()
+				if n <= seen || b.maxSeen.CompareAndSwap(seen, n) {
+					break
+				}
+			}
+			b.started <- struct{}{}
+			<-b.unblock
+			return &testdata.GetItemResponse{Item: &testdata.Item{Id: req.GetId()}}, nil
+		},
+	}, opts...)
+}
+
+func TestConcurrencyLimitBoundsInFlightCalls(t *testing.T) {
+	g := NewWithT(t)
+
+	const limit, calls = 3, 10
+	backend := newBlockingItemBackend()
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	backend.register(s, runtime.WithConcurrencyLimit(limit))
+
+	var wg sync.WaitGroup
+	texts := make([]string, calls)
+	for i := range calls {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			texts[i] = resultText(g, callGetItem(t, s, map[string]any{"id": "item-1"}))
+		}()
+	}
+
+	for range limit {
+		g.Eventually(backend.started).Should(Receive())
+	}
+	g.Consistently(backend.started).ShouldNot(Receive(), "calls beyond the limit wait")
+	close(backend.unblock)
+	wg.Wait()
+
+	g.Expect(backend.maxSeen.Load()).To(BeEquivalentTo(limit))
+	for _, text := range texts {
+		g.Expect(text).To(ContainSubstring("item-1"))
+	}
+}
+
+func TestConcurrencyLimitFailFast(t *testing.T) {
+	g := NewWithT(t)
+
+	backend := newBlockingItemBackend()
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	backend.register(s, runtime.WithConcurrencyLimit(1), runtime.WithConcurrencyLimitFailFast(true))
+
+	// A second registration has its own limit.
+	other := newBlockingItemBackend()
+	close(other.unblock)
+	otherServer := mcpserver.NewMCPServer("other-server", "1.0.0")
+	other.register(otherServer, runtime.WithConcurrencyLimit(1), runtime.WithConcurrencyLimitFailFast(true))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		callGetItem(t, s, map[string]any{"id": "item-1"})
+	}()
+	g.Eventually(backend.started).Should(Receive())
+
+	resp := callGetItem(t, s, map[string]any{"id": "item-2"})
+	g.Expect(resp).To(HaveKey("result"), "unexpected response: %v", resp)
+	result := resp["result"].(map[string]any)
+	g.Expect(result["isError"]).To(BeTrue())
+	g.Expect(result["content"].([]any)[0].(map[string]any)["text"]).To(ContainSubstring("RESOURCE_EXHAUSTED"))
+
+	g.Expect(resultText(g, callGetItem(t, otherServer, map[string]any{"id": "item-3"}))).To(ContainSubstring("item-3"))
+
+	close(backend.unblock)
+	<-done
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// deprecatedMarker leads the description of the tool of a deprecated method.
+const deprecatedMarker = "(deprecated)"
+
+// skippedDeprecated is the report reason of a deprecated method left out
+// under skip_deprecated.
+const skippedDeprecated = "deprecated"
+
+// isDeprecated reports whether meth is marked [deprecated = true].
+func isDeprecated(meth *protogen.Method) bool {
+	if meth.Desc == nil {
+		return false
+	}
+	opts, ok := meth.Desc.Options().(*descriptorpb.MethodOptions)
+	return ok && opts.GetDeprecated()
+}
+
+// isFieldDeprecated reports whether fd is marked [deprecated = true].
+func isFieldDeprecated(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	return ok && opts.GetDeprecated()
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestDeprecatedMethodGolden(t *testing.T) {
+	g := NewWithT(t)
+
+	tool := testdatamcp.InvoiceService_GetInvoiceV1Tool
+	g.Expect(tool.Deprecated).To(BeTrue())
+	g.Expect(tool.Description).To(Equal("(deprecated) GetInvoiceV1 returns an invoice by number. Use GetInvoice instead.\n"))
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(tool.JSONSchema), &schema)).To(Succeed())
+	g.Expect(schema).To(HaveKeyWithValue("deprecated", true))
+
+	tool = testdatamcp.InvoiceService_GetInvoiceTool
+	g.Expect(tool.Deprecated).To(BeFalse())
+	g.Expect(tool.Description).To(Equal("GetInvoice returns an invoice by number.\n"))
+	g.Expect(tool.JSONSchema).ToNot(ContainSubstring("deprecated"))
+}
+
+func TestSkipDeprecated(t *testing.T) {
+	g := NewWithT(t)
+
+	file := testdata.File_testdata_deprecated_test_proto
+	report := &Report{}
+	src := generatedGoFile(t, file, GenerateConfig{SkipDeprecated: true, Report: report})
+	g.Expect(src).To(ContainSubstring("InvoiceService_GetInvoiceTool"))
+	g.Expect(src).ToNot(ContainSubstring("GetInvoiceV1"))
+
+	g.Expect(report.Services).To(HaveLen(1))
+	g.Expect(report.Services[0].Tools).To(Equal([]string{"testdata_InvoiceService_GetInvoice"}))
+	g.Expect(report.Services[0].Skipped).To(Equal([]SkippedMethod{{Method: "testdata.InvoiceService.GetInvoiceV1", Reason: "deprecated"}}))
+}
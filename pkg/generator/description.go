@@ -0,0 +1,198 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DescriptionComposer builds the description of the property of fd from
+// comment, the cleaned field comment, and constraints, short summaries of the
+// protovalidate rules on fd that the schema does not express, such as
+// "at most 64 characters". Either may be empty; an empty result leaves the
+// property without a description.
+type DescriptionComposer func(fd protoreflect.FieldDescriptor, comment string, constraints []string) string
+
+// DefaultDescriptionComposer follows comment with a "Must be ..." sentence
+// listing constraints, e.g. "Item name. Must be 1–64 characters, matching
+// `^[a-z]+$`." A comment of several lines gets the sentence as a paragraph of
+// its own.
+func DefaultDescriptionComposer(_ protoreflect.FieldDescriptor, comment string, constraints []string) string {
+	if len(constraints) == 0 {
+		return comment
+	}
+	summary := "Must be " + strings.Join(constraints, ", ") + "."
+	switch {
+	case comment == "":
+		return summary
+	case strings.Contains(comment, "\n"):
+		return comment + "\n\n" + summary
+	}
+	if !strings.ContainsAny(comment[len(comment)-1:], ".!?:") {
+		comment += "."
+	}
+	return comment + " " + summary
+}
+
+// describeField returns the description of the property of fd with the
+// composer of the generator.
+func (g *FileGenerator) describeField(fd protoreflect.FieldDescriptor, comment string) string {
+	compose := g.descriptionComposer
+	if compose == nil {
+		compose = DefaultDescriptionComposer
+	}
+	return strings.TrimSpace(compose(fd, comment, protovalidateConstraints(fd)))
+}
+
+// protovalidateConstraints summarizes the protovalidate length, range,
+// pattern and item rules on fd, which have no JSON Schema translation in the
+// generated schema. Rules the schema already carries, such as formats, in
+// lists and consts, are left out.
+func protovalidateConstraints(fd protoreflect.FieldDescriptor) []string {
+	rules := protovalidateFieldRules(fd)
+	if rules == nil || fd.IsMap() {
+		return nil
+	}
+	if !fd.IsList() {
+		return scalarConstraints(fd, rules)
+	}
+	var out []string
+	repeated := subMessage(rules, "repeated")
+	if repeated == nil {
+		return nil
+	}
+	lo, hasMin := uintRule(repeated, "min_items")
+	hi, hasMax := uintRule(repeated, "max_items")
+	if s := rangePhrase(fmt.Sprint(lo), hasMin, fmt.Sprint(hi), hasMax, "items"); s != "" {
+		out = append(out, s)
+	}
+	if unique := repeated.Descriptor().Fields().ByName("unique"); unique != nil && repeated.Get(unique).Bool() {
+		out = append(out, "without duplicates")
+	}
+	if items := subMessage(repeated, "items"); items != nil {
+		for _, s := range scalarConstraints(fd, items) {
+			out = append(out, "each "+s)
+		}
+	}
+	return out
+}
+
+// scalarConstraints summarizes the rules for the scalar values of fd in
+// rules, a FieldRules message.
+func scalarConstraints(fd protoreflect.FieldDescriptor, rules protoreflect.Message) []string {
+	name, ok := protovalidateScalarRules[fd.Kind()]
+	if !ok || fd.Kind() == protoreflect.EnumKind || fd.Kind() == protoreflect.BoolKind {
+		return nil
+	}
+	kindRules := subMessage(rules, name)
+	if kindRules == nil {
+		return nil
+	}
+	if fd.Kind() == protoreflect.StringKind {
+		return stringConstraints(kindRules)
+	}
+	return numberConstraints(fd, kindRules)
+}
+
+// stringConstraints summarizes the length and content rules of a
+// StringRules message.
+func stringConstraints(rules protoreflect.Message) []string {
+	var out []string
+	if n, ok := uintRule(rules, "len"); ok {
+		out = append(out, rangePhrase(fmt.Sprint(n), true, fmt.Sprint(n), true, "characters"))
+	} else {
+		lo, hasMin := uintRule(rules, "min_len")
+		hi, hasMax := uintRule(rules, "max_len")
+		if s := rangePhrase(fmt.Sprint(lo), hasMin, fmt.Sprint(hi), hasMax, "characters"); s != "" {
+			out = append(out, s)
+		}
+	}
+	for _, rule := range []struct {
+		name   protoreflect.Name
+		phrase string
+	}{
+		{"pattern", "matching `%s`"},
+		{"prefix", "starting with `%s`"},
+		{"suffix", "ending with `%s`"},
+		{"contains", "containing `%s`"},
+		{"not_contains", "not containing `%s`"},
+	} {
+		if fd := rules.Descriptor().Fields().ByName(rule.name); fd != nil && rules.Has(fd) {
+			out = append(out, fmt.Sprintf(rule.phrase, rules.Get(fd).String()))
+		}
+	}
+	return out
+}
+
+// numberConstraints summarizes the gt, gte, lt and lte rules of the numeric
+// rules message of fd.
+func numberConstraints(fd protoreflect.FieldDescriptor, rules protoreflect.Message) []string {
+	bound := func(name protoreflect.Name) (string, bool) {
+		ruleField := rules.Descriptor().Fields().ByName(name)
+		if ruleField == nil || !rules.Has(ruleField) {
+			return "", false
+		}
+		v, ok := protovalidateRuleValue(fd, rules.Get(ruleField))
+		return fmt.Sprint(v), ok
+	}
+	gt, hasGT := bound("gt")
+	gte, hasGTE := bound("gte")
+	lt, hasLT := bound("lt")
+	lte, hasLTE := bound("lte")
+
+	if hasGTE && hasLTE {
+		return []string{"between " + gte + " and " + lte}
+	}
+	var out []string
+	switch {
+	case hasGT:
+		out = append(out, "greater than "+gt)
+	case hasGTE:
+		out = append(out, "at least "+gte)
+	}
+	switch {
+	case hasLT:
+		out = append(out, "less than "+lt)
+	case hasLTE:
+		out = append(out, "at most "+lte)
+	}
+	return out
+}
+
+// rangePhrase describes a count of unit between lo and hi, such as
+// "1–64 characters", "at least 1 item" or "at most 64 characters". It
+// returns "" when neither bound is set.
+func rangePhrase(lo string, hasMin bool, hi string, hasMax bool, unit string) string {
+	count := func(n string) string {
+		if n == "1" {
+			return n + " " + strings.TrimSuffix(unit, "s")
+		}
+		return n + " " + unit
+	}
+	switch {
+	case hasMin && hasMax && lo == hi:
+		return "exactly " + count(lo)
+	case hasMin && hasMax:
+		return lo + "–" + hi + " " + unit
+	case hasMin:
+		return "at least " + count(lo)
+	case hasMax:
+		return "at most " + count(hi)
+	}
+	return ""
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestDescriptionPrefix(t *testing.T) {
+	methods := buildServices(t, map[string]map[string]*mcpoptions.ToolOptions{
+		"Inventory": {
+			"ListItems":  {Description: "Lists items."},
+			"DeleteItem": nil,
+		},
+	})
+
+	t.Run("service name", func(t *testing.T) {
+		g := NewWithT(t)
+		fg := &FileGenerator{descriptionPrefix: "[{service}] "}
+		g.Expect(fg.toolDescription(methodNamed(methods, "ListItems"), &mcpoptions.ToolOptions{Description: "Lists items."})).To(Equal("[Inventory] Lists items."))
+		// A method without a description still says where it belongs.
+		g.Expect(fg.toolDescription(methodNamed(methods, "DeleteItem"), nil)).To(Equal("[Inventory]"))
+	})
+
+	t.Run("template", func(t *testing.T) {
+		g := NewWithT(t)
+		fg := &FileGenerator{descriptionPrefix: "{service}.{method}: "}
+		g.Expect(fg.toolDescription(methodNamed(methods, "ListItems"), &mcpoptions.ToolOptions{Description: "Lists items."})).To(Equal("Inventory.ListItems: Lists items."))
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect((&FileGenerator{}).toolDescription(methodNamed(methods, "ListItems"), &mcpoptions.ToolOptions{Description: "Lists items."})).To(Equal("Lists items."))
+	})
+}
+
+func TestDescriptionPrefixConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	file := (&testdata.ListWidgetsRequest{}).ProtoReflect().Descriptor().ParentFile()
+	content := generatedGoFile(t, file, GenerateConfig{DescriptionPrefix: "[{service}] "})
+	g.Expect(content).To(ContainSubstring(`Description: "[AnnotatedService] Lists every widget visible to the caller.`))
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestConstraintDescriptions(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema struct {
+		Properties map[string]map[string]any `json:"properties"`
+	}
+	g.Expect(json.Unmarshal([]byte(testdatamcp.ValidatedService_RegisterHostTool.JSONSchema), &schema)).To(Succeed())
+	for name, want := range map[string]string{
+		"slug":         "Host slug. Must be 1–64 characters, matching `^[a-z0-9-]+$`.",
+		"cores":        "Number of CPU cores. Must be between 1 and 256.",
+		"dns_servers":  "Must be 1–3 items, without duplicates, each at most 253 characters.",
+		"display_name": "Non-format rules do not add a format. Must be at most 64 characters.",
+		// Formats are in the schema already.
+		"owner_email": "Contact address for alerts.",
+	} {
+		g.Expect(schema.Properties[name]).To(HaveKeyWithValue("description", want), name)
+	}
+}
+
+func TestDefaultDescriptionComposer(t *testing.T) {
+	for name, tc := range map[string]struct {
+		comment     string
+		constraints []string
+		want        string
+	}{
+		"no constraints":  {comment: "Item name.", want: "Item name."},
+		"no comment":      {constraints: []string{"at most 64 characters"}, want: "Must be at most 64 characters."},
+		"period added":    {comment: "Item name", constraints: []string{"1–64 characters"}, want: "Item name. Must be 1–64 characters."},
+		"punctuation":     {comment: "Which item?", constraints: []string{"at least 1"}, want: "Which item? Must be at least 1."},
+		"several":         {comment: "Item name.", constraints: []string{"1–64 characters", "matching `^[a-z]+$`"}, want: "Item name. Must be 1–64 characters, matching `^[a-z]+$`."},
+		"multi-line":      {comment: "Item name.\n- lowercase", constraints: []string{"at most 64 characters"}, want: "Item name.\n- lowercase\n\nMust be at most 64 characters."},
+		"nothing at all":  {want: ""},
+		"markdown ending": {comment: "See `name`", constraints: []string{"at most 3 characters"}, want: "See `name`. Must be at most 3 characters."},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(DefaultDescriptionComposer(nil, tc.comment, tc.constraints)).To(Equal(tc.want))
+		})
+	}
+}
+
+func TestCustomDescriptionComposer(t *testing.T) {
+	g := NewWithT(t)
+
+	file := testdata.File_testdata_validate_test_proto
+	plugin, err := protogen.Options{}.New(codeGeneratorRequest(file))
+	g.Expect(err).ToNot(HaveOccurred())
+	fg := NewFileGenerator(plugin.FilesByPath[file.Path()], plugin)
+	fg.descriptionComposer = func(fd protoreflect.FieldDescriptor, comment string, constraints []string) string {
+		if len(constraints) == 0 {
+			return comment
+		}
+		return comment + " [" + string(fd.Name()) + ": " + strings.Join(constraints, "; ") + "]"
+	}
+
+	// The linked descriptors carry no comments, so only the constraints
+	// reach the composer.
+	props := fg.messageSchema(file.Messages().ByName("RegisterHostRequest"))["properties"].(map[string]any)
+	g.Expect(props["slug"]).To(HaveKeyWithValue("description", "[slug: 1–64 characters; matching `^[a-z0-9-]+$`]"))
+	g.Expect(props["owner_email"]).ToNot(HaveKey("description"))
+}
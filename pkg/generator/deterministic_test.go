@@ -0,0 +1,57 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// generateDeterministicProto runs a fresh plugin over deterministic_test.proto
+// and returns the generated files by name.
+func generateDeterministicProto(t *testing.T) map[string]string {
+	t.Helper()
+	file := (&testdata.ConfigureRequest{}).ProtoReflect().Descriptor().ParentFile()
+	return generateSchemaFiles(t, codeGeneratorRequest(file), "schemas")
+}
+
+func TestGenerationIsDeterministic(t *testing.T) {
+	g := NewWithT(t)
+
+	first := generateDeterministicProto(t)
+	g.Expect(first).To(HaveLen(2), "the Go file and the schema file")
+	// Map iteration order is randomized per range statement, so a few runs
+	// are enough to surface any leak into the output.
+	for i := 0; i < 10; i++ {
+		g.Expect(generateDeterministicProto(t)).To(Equal(first), "run %d differs", i)
+	}
+}
+
+func TestRequiredFollowsDeclarationOrder(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema struct {
+		Required []string `json:"required"`
+	}
+	g.Expect(json.Unmarshal([]byte(testdatamcp.DeterministicService_ConfigureTool.JSONSchema), &schema)).To(Succeed())
+	g.Expect(schema.Required).To(Equal([]string{
+		"sourceOneOfType", "targetOneOfType", "scheduleOneOfType", "modeOneOfType",
+	}))
+}
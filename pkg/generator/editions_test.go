@@ -0,0 +1,62 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestEditionsRequiredDetection(t *testing.T) {
+	md := (&testdata.UpdateProfileRequest{}).ProtoReflect().Descriptor()
+
+	t.Run("optional keyword support", func(t *testing.T) {
+		g := NewWithT(t)
+		schema := (&FileGenerator{optionalKeywordSupport: true}).messageSchemaWithDefs(md, nil, directionInput)
+
+		// Implicit presence is the editions spelling of a proto3 field
+		// without optional; explicit presence is the spelling of optional.
+		g.Expect(schema["required"]).To(ConsistOf("user_id", "version"))
+
+		settings := schema["$defs"].(map[string]any)["ProfileSettings"].(map[string]any)
+		g.Expect(settings["required"]).To(ConsistOf("locale"))
+	})
+
+	t.Run("default", func(t *testing.T) {
+		g := NewWithT(t)
+		schema := (&FileGenerator{}).messageSchemaWithDefs(md, nil, directionInput)
+
+		// LEGACY_REQUIRED fields are required in every mode.
+		g.Expect(schema["required"]).To(ConsistOf("version"))
+	})
+}
+
+func TestProto3RequiredDetectionUnchanged(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{optionalKeywordSupport: true}
+	md := (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor()
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if fd.ContainingOneof() != nil && !fd.ContainingOneof().IsSynthetic() {
+			continue
+		}
+		want := !fd.IsList() && !fd.IsMap() && !fd.HasOptionalKeyword()
+		g.Expect(fg.isFieldRequiredWithOptionalSupport(fd)).To(Equal(want), "field %s", fd.Name())
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// withoutDescriptions returns a copy of the JSON value v without its
+// "description" keys. Descriptions come from source comments, which the
+// linked-in descriptors do not carry.
+func withoutDescriptions(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, child := range v {
+			if k != "description" {
+				out[k] = withoutDescriptions(child)
+			}
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, child := range v {
+			out[i] = withoutDescriptions(child)
+		}
+		return out
+	}
+	return v
+}
+
+// reflectedSchemas builds the tool input schemas of file from its linked-in
+// descriptors, keyed by tool name.
+func reflectedSchemas(t *testing.T, file protoreflect.FileDescriptor) map[string]any {
+	t.Helper()
+	plugin, err := protogen.Options{}.New(codeGeneratorRequest(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fg := NewFileGenerator(plugin.FilesByPath[file.Path()], plugin)
+	fg.seenToolNames = ToolNameRegistry{}
+	schemas := map[string]any{}
+	for _, svc := range fg.f.Services {
+		for _, meth := range svc.Methods {
+			if streamingReason(meth) != "" {
+				continue
+			}
+			opts := methodToolOptions(meth)
+			name, err := fg.resolveToolName(meth, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			schema := fg.messageSchemaWithDefs(meth.Input.Desc, meth.Input, directionInput)
+			if err := fg.addExampleRequest(meth, opts, schema); err != nil {
+				t.Fatal(err)
+			}
+			raw, err := json.Marshal(schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var decoded any
+			if err := json.Unmarshal(raw, &decoded); err != nil {
+				t.Fatal(err)
+			}
+			schemas[name] = withoutDescriptions(decoded)
+		}
+	}
+	return schemas
+}
+
+// The generated files embed the final tool schemas as string literals, so
+// registering tools does not walk descriptors. They match the schemas built
+// from the descriptors at generation time.
+func TestEmbeddedSchemasMatchReflection(t *testing.T) {
+	for _, tc := range []struct {
+		file     protoreflect.FileDescriptor
+		register func(s *mcpserver.MCPServer)
+	}{
+		{testdata.File_testdata_test_service_proto, func(s *mcpserver.MCPServer) {
+			testdatamcp.ForwardToTestServiceClient(s, &testdatamcp.MockTestServiceHandler{})
+		}},
+		{testdata.File_testdata_field_behavior_test_proto, func(s *mcpserver.MCPServer) {
+			testdatamcp.ForwardToFieldBehaviorServiceClient(s, &testdatamcp.MockFieldBehaviorServiceHandler{})
+		}},
+		{testdata.File_testdata_optional_fields_test_proto, func(s *mcpserver.MCPServer) {
+			testdatamcp.ForwardToReminderServiceClient(s, &testdatamcp.MockReminderServiceHandler{})
+		}},
+		{testdata.File_testdata_multi_service_test_proto, func(s *mcpserver.MCPServer) {
+			testdatamcp.ForwardToOrderServiceClient(s, &testdatamcp.MockOrderServiceHandler{})
+			testdatamcp.ForwardToInventoryServiceClient(s, &testdatamcp.MockInventoryServiceHandler{})
+		}},
+	} {
+		t.Run(tc.file.Path(), func(t *testing.T) {
+			g := NewWithT(t)
+
+			want := reflectedSchemas(t, tc.file)
+			s := mcpserver.NewMCPServer("test-server", "1.0.0")
+			tc.register(s)
+			raw, err := json.Marshal(s.HandleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`)))
+			g.Expect(err).ToNot(HaveOccurred())
+			var list struct {
+				Result struct {
+					Tools []struct {
+						Name        string         `json:"name"`
+						InputSchema map[string]any `json:"inputSchema"`
+					} `json:"tools"`
+				} `json:"result"`
+			}
+			g.Expect(json.Unmarshal(raw, &list)).To(Succeed())
+			g.Expect(list.Result.Tools).To(HaveLen(len(want)))
+			for _, tool := range list.Result.Tools {
+				g.Expect(want).To(HaveKey(tool.Name))
+				// Registration adds the per-call format override.
+				delete(tool.InputSchema["properties"].(map[string]any), runtime.FormatArgument)
+				g.Expect(withoutDescriptions(tool.InputSchema)).To(Equal(want[tool.Name]), tool.Name)
+			}
+		})
+	}
+}
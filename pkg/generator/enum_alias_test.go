@@ -0,0 +1,100 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+const shipmentStateAliases = "Aliases, names of the same value:\n" +
+	"- SHIPMENT_STATE_IN_TRANSIT = SHIPMENT_STATE_SHIPPED\n" +
+	"- SHIPMENT_STATE_DELIVERED = SHIPMENT_STATE_RECEIVED = SHIPMENT_STATE_DONE"
+
+// shipmentStateSchema generates the schema of the state field of
+// UpdateShipmentRequest with the given enum_aliases.
+func shipmentStateSchema(t *testing.T, enumAliases string) map[string]any {
+	t.Helper()
+	file := testdata.File_testdata_enum_alias_test_proto
+	plugin, err := protogen.Options{}.New(codeGeneratorRequest(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fg := NewFileGenerator(plugin.FilesByPath[file.Path()], plugin)
+	fg.enumAliases = enumAliases
+	return fg.getEnumSchema(testdata.ShipmentState(0).Descriptor())
+}
+
+func TestEnumAliasesAll(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(testdatamcp.ShipmentService_UpdateShipmentTool.JSONSchema), &schema)).To(Succeed())
+	state := schema["properties"].(map[string]any)["state"]
+	g.Expect(state).To(HaveKeyWithValue("enum", []any{
+		"SHIPMENT_STATE_UNSPECIFIED",
+		"SHIPMENT_STATE_IN_TRANSIT",
+		"SHIPMENT_STATE_SHIPPED",
+		"SHIPMENT_STATE_DELIVERED",
+		"SHIPMENT_STATE_RECEIVED",
+		"SHIPMENT_STATE_DONE",
+	}))
+	g.Expect(state).To(HaveKeyWithValue("description", shipmentStateAliases))
+
+	// Enums without aliases get no note.
+	g.Expect(testdatamcp.TicketService_FileTicketTool.JSONSchema).ToNot(ContainSubstring("Aliases"))
+}
+
+func TestEnumAliasesCanonical(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(shipmentStateSchema(t, EnumAliasesCanonical)).To(Equal(map[string]any{
+		"type": "string",
+		"enum": []string{"SHIPMENT_STATE_UNSPECIFIED", "SHIPMENT_STATE_IN_TRANSIT", "SHIPMENT_STATE_DELIVERED"},
+	}))
+	g.Expect(shipmentStateSchema(t, EnumAliasesAll)).To(HaveKeyWithValue("description", shipmentStateAliases))
+}
+
+func TestEnumAliasesAcceptedByHandler(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToShipmentServiceClient(s, &testdatamcp.MockShipmentServiceHandler{
+		UpdateShipmentFunc: func(_ context.Context, req *testdata.UpdateShipmentRequest) (*testdata.UpdateShipmentResponse, error) {
+			return &testdata.UpdateShipmentResponse{State: req.State}, nil
+		},
+	})
+
+	// An alias left out under canonical is still accepted, and answered with
+	// the first name of its value.
+	resp := callTool(t, s, testdatamcp.ShipmentService_UpdateShipmentTool.Name, map[string]any{"state": "SHIPMENT_STATE_RECEIVED"})
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"state":"SHIPMENT_STATE_DELIVERED"}`))
+}
+
+func TestEnumAliasesInvalid(t *testing.T) {
+	g := NewWithT(t)
+
+	file := testdata.File_testdata_enum_alias_test_proto
+	plugin, _ := runPlugin(t, codeGeneratorRequest(file), GenerateConfig{EnumAliases: "first"})
+	g.Expect(plugin.Response().GetError()).To(Equal(`enum_aliases "first" must be "all" or "canonical"`))
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// enumAsIntIn reports whether enums are described by their numbers in
+// schemas of direction dir. Responses are written by protojson with the
+// names, so only input schemas use numbers.
+func (g *FileGenerator) enumAsIntIn(dir schemaDirection) bool {
+	return g.enumAsInt && dir == directionInput
+}
+
+// enumSchema returns the schema of ed in direction dir: the integer schema
+// under enum_as_int, the schema listing the names otherwise.
+func (g *FileGenerator) enumSchema(ed protoreflect.EnumDescriptor, dir schemaDirection) map[string]any {
+	if g.enumAsIntIn(dir) {
+		return g.enumIntSchema(ed)
+	}
+	return g.getEnumSchema(ed)
+}
+
+// enumIntSchema is the schema of ed under enum_as_int. Numbers forming a
+// contiguous range are bounded by minimum and maximum, which is shorter than
+// listing them; the numbers of a sparse enum are listed in an enum.
+func (g *FileGenerator) enumIntSchema(ed protoreflect.EnumDescriptor) map[string]any {
+	numbers := g.enumNumbers(ed)
+	lo, hi := numbers[0], numbers[0]
+	for _, n := range numbers {
+		lo, hi = min(lo, n), max(hi, n)
+	}
+	schema := map[string]any{"type": "integer"}
+	if int64(hi)-int64(lo)+1 == int64(len(numbers)) {
+		schema["minimum"] = int(lo)
+		schema["maximum"] = int(hi)
+	} else {
+		values := make([]any, len(numbers))
+		for i, n := range numbers {
+			values[i] = int(n)
+		}
+		schema["enum"] = values
+	}
+	schema["description"] = g.enumNumbersNote(ed)
+	return schema
+}
+
+// enumNumbers returns the distinct numbers of the values of ed listed in its
+// schema, in the order of their first name.
+func (g *FileGenerator) enumNumbers(ed protoreflect.EnumDescriptor) []protoreflect.EnumNumber {
+	var numbers []protoreflect.EnumNumber
+	seen := map[protoreflect.EnumNumber]bool{}
+	for _, v := range g.enumValues(ed) {
+		if !seen[v.Number()] {
+			seen[v.Number()] = true
+			numbers = append(numbers, v.Number())
+		}
+	}
+	return numbers
+}
+
+// enumNumbersNote names the numbers of ed, which an integer schema does not,
+// one "- NUMBER: NAME" line each, followed by the description of the value
+// when it has one, e.g. "- 4: TASK_PRIORITY_URGENT. Drop everything else."
+// The names of a number with aliases are joined with " = ".
+func (g *FileGenerator) enumNumbersNote(ed protoreflect.EnumDescriptor) string {
+	names := map[protoreflect.EnumNumber][]string{}
+	descs := map[protoreflect.EnumNumber]string{}
+	for _, v := range g.enumValues(ed) {
+		names[v.Number()] = append(names[v.Number()], string(v.Name()))
+		if desc := g.enumValueDescription(v); desc != "" && descs[v.Number()] == "" {
+			descs[v.Number()] = strings.ReplaceAll(desc, "\n", " ")
+		}
+	}
+	var lines []string
+	for _, n := range g.enumNumbers(ed) {
+		line := "- " + strconv.Itoa(int(n)) + ": " + strings.Join(names[n], " = ")
+		if desc := descs[n]; desc != "" {
+			line += ". " + desc
+		}
+		lines = append(lines, line)
+	}
+	return "Values:\n" + strings.Join(lines, "\n")
+}
+
+// enumNamesToNumbers replaces the value names of ed in the const and
+// examples of schema, taken from protovalidate rules, by their numbers.
+func enumNamesToNumbers(ed protoreflect.EnumDescriptor, schema map[string]any) {
+	number := func(v any) any {
+		if name, ok := v.(string); ok {
+			if ev := ed.Values().ByName(protoreflect.Name(name)); ev != nil {
+				return int(ev.Number())
+			}
+		}
+		return v
+	}
+	if v, ok := schema["const"]; ok {
+		schema["const"] = number(v)
+	}
+	if examples, ok := schema["examples"].([]any); ok {
+		for i, v := range examples {
+			examples[i] = number(v)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// enumAsIntProperties generates the schema of md in direction dir with
+// enum_as_int and the given inline_messages, and returns its properties and
+// $defs.
+func enumAsIntProperties(t *testing.T, md protoreflect.MessageDescriptor, dir schemaDirection, inline bool) (map[string]any, map[string]any) {
+	t.Helper()
+	file := md.ParentFile()
+	plugin, err := protogen.Options{}.New(codeGeneratorRequest(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fg := NewFileGenerator(plugin.FilesByPath[file.Path()], plugin)
+	fg.enumAsInt = true
+	fg.inlineMessages = inline
+	schema := fg.messageSchemaWithDefs(md, nil, dir)
+	defs, _ := schema["$defs"].(map[string]any)
+	return schema["properties"].(map[string]any), defs
+}
+
+func TestEnumAsIntContiguous(t *testing.T) {
+	g := NewWithT(t)
+
+	properties, _ := enumAsIntProperties(t, (&testdata.FileTicketRequest{}).ProtoReflect().Descriptor(), directionInput, false)
+	g.Expect(properties["severity"]).To(Equal(map[string]any{
+		"type":    "integer",
+		"minimum": 0,
+		"maximum": 3,
+		"description": "Values:\n" +
+			"- 0: TICKET_SEVERITY_UNSPECIFIED\n" +
+			"- 1: TICKET_SEVERITY_CRITICAL. Production is down for customers.\n" +
+			"- 2: TICKET_SEVERITY_MAJOR\n" +
+			"- 3: TICKET_SEVERITY_MINOR. Cosmetic issue.",
+	}))
+
+	// The range does not depend on the order the values are declared in.
+	properties, _ = enumAsIntProperties(t, (&testdata.ScheduleTaskRequest{}).ProtoReflect().Descriptor(), directionInput, false)
+	g.Expect(properties["priority"]).To(HaveKeyWithValue("minimum", 0))
+	g.Expect(properties["priority"]).To(HaveKeyWithValue("maximum", 4))
+	g.Expect(properties["priority"]).ToNot(HaveKey("enum"))
+}
+
+func TestEnumAsIntSparse(t *testing.T) {
+	g := NewWithT(t)
+
+	properties, _ := enumAsIntProperties(t, (&testdata.RaiseAlarmRequest{}).ProtoReflect().Descriptor(), directionInput, false)
+	g.Expect(properties["level"]).To(HaveKeyWithValue("type", "integer"))
+	g.Expect(properties["level"]).To(HaveKeyWithValue("enum", []any{0, 10, 20, 50}))
+	g.Expect(properties["level"]).ToNot(HaveKey("minimum"))
+	g.Expect(properties["level"]).To(HaveKeyWithValue("description", ContainSubstring("- 20: ALARM_LEVEL_WARNING\n")))
+	g.Expect(properties["escalate_to"]).To(HaveKeyWithValue("items", HaveKeyWithValue("enum", []any{0, 10, 20, 50})))
+
+	// Responses are written with the names.
+	properties, _ = enumAsIntProperties(t, (&testdata.RaiseAlarmResponse{}).ProtoReflect().Descriptor(), directionOutput, false)
+	g.Expect(properties["level"]).To(HaveKeyWithValue("type", "string"))
+	g.Expect(properties["level"]).To(HaveKeyWithValue("enum", []string{"ALARM_LEVEL_UNSPECIFIED", "ALARM_LEVEL_INFO", "ALARM_LEVEL_WARNING", "ALARM_LEVEL_CRITICAL"}))
+}
+
+func TestEnumAsIntInlineMessages(t *testing.T) {
+	g := NewWithT(t)
+
+	properties, defs := enumAsIntProperties(t, (&testdata.RaiseAlarmRequest{}).ProtoReflect().Descriptor(), directionInput, true)
+	g.Expect(properties["level"]).To(Equal(map[string]any{"$ref": "#/$defs/testdata.AlarmLevel", "type": "integer"}))
+	g.Expect(defs["testdata.AlarmLevel"]).To(HaveKeyWithValue("enum", []any{0, 10, 20, 50}))
+}
+
+func TestEnumAsIntAliases(t *testing.T) {
+	g := NewWithT(t)
+
+	properties, _ := enumAsIntProperties(t, (&testdata.UpdateShipmentRequest{}).ProtoReflect().Descriptor(), directionInput, false)
+	g.Expect(properties["state"]).To(Equal(map[string]any{
+		"type":    "integer",
+		"minimum": 0,
+		"maximum": 2,
+		"description": "Values:\n" +
+			"- 0: SHIPMENT_STATE_UNSPECIFIED\n" +
+			"- 1: SHIPMENT_STATE_IN_TRANSIT = SHIPMENT_STATE_SHIPPED\n" +
+			"- 2: SHIPMENT_STATE_DELIVERED = SHIPMENT_STATE_RECEIVED = SHIPMENT_STATE_DONE",
+	}))
+}
+
+func TestEnumAsIntConst(t *testing.T) {
+	g := NewWithT(t)
+
+	properties, _ := enumAsIntProperties(t, (&testdata.PublishEventRequest{}).ProtoReflect().Descriptor(), directionInput, false)
+	g.Expect(properties["kind"]).To(HaveKeyWithValue("const", 2))
+}
+
+func TestEnumAsIntCall(t *testing.T) {
+	g := NewWithT(t)
+
+	var got *testdata.RaiseAlarmRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToAlarmServiceClient(s, &testdatamcp.MockAlarmServiceHandler{
+		RaiseAlarmFunc: func(_ context.Context, req *testdata.RaiseAlarmRequest) (*testdata.RaiseAlarmResponse, error) {
+			got = req
+			return &testdata.RaiseAlarmResponse{Id: "a-1", Level: req.GetLevel()}, nil
+		},
+	})
+	// protojson reads enum numbers as well as names.
+	resp := callTool(t, s, testdatamcp.AlarmService_RaiseAlarmToolName, map[string]any{"text": "disk full", "level": 20, "escalate_to": []any{50}})
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"id":"a-1","level":"ALARM_LEVEL_WARNING"}`))
+	g.Expect(got.GetLevel()).To(Equal(testdata.AlarmLevel_ALARM_LEVEL_WARNING))
+	g.Expect(got.GetEscalateTo()).To(Equal([]testdata.AlarmLevel{testdata.AlarmLevel_ALARM_LEVEL_CRITICAL}))
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// enumOrderNames generates the schema of ed with the given enum_order and
+// enum_aliases and returns the names it lists.
+func enumOrderNames(t *testing.T, ed protoreflect.EnumDescriptor, enumOrder, enumAliases string) []string {
+	t.Helper()
+	file := ed.ParentFile()
+	plugin, err := protogen.Options{}.New(codeGeneratorRequest(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fg := NewFileGenerator(plugin.FilesByPath[file.Path()], plugin)
+	fg.enumOrder = enumOrder
+	fg.enumAliases = enumAliases
+	return fg.getEnumSchema(ed)["enum"].([]string)
+}
+
+func TestEnumOrder(t *testing.T) {
+	priority := testdata.TaskPriority(0).Descriptor()
+	for order, want := range map[string][]string{
+		EnumOrderDeclaration:  {"TASK_PRIORITY_UNSPECIFIED", "TASK_PRIORITY_URGENT", "TASK_PRIORITY_HIGH", "TASK_PRIORITY_LOW", "TASK_PRIORITY_MEDIUM"},
+		EnumOrderAlphabetical: {"TASK_PRIORITY_HIGH", "TASK_PRIORITY_LOW", "TASK_PRIORITY_MEDIUM", "TASK_PRIORITY_UNSPECIFIED", "TASK_PRIORITY_URGENT"},
+		EnumOrderNumeric:      {"TASK_PRIORITY_UNSPECIFIED", "TASK_PRIORITY_LOW", "TASK_PRIORITY_MEDIUM", "TASK_PRIORITY_HIGH", "TASK_PRIORITY_URGENT"},
+	} {
+		t.Run(order, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(enumOrderNames(t, priority, order, EnumAliasesAll)).To(Equal(want))
+		})
+	}
+}
+
+func TestEnumOrderAliases(t *testing.T) {
+	g := NewWithT(t)
+
+	state := testdata.ShipmentState(0).Descriptor()
+	// Names of the same number keep their declaration order.
+	g.Expect(enumOrderNames(t, state, EnumOrderNumeric, EnumAliasesAll)).To(Equal([]string{
+		"SHIPMENT_STATE_UNSPECIFIED",
+		"SHIPMENT_STATE_IN_TRANSIT",
+		"SHIPMENT_STATE_SHIPPED",
+		"SHIPMENT_STATE_DELIVERED",
+		"SHIPMENT_STATE_RECEIVED",
+		"SHIPMENT_STATE_DONE",
+	}))
+	g.Expect(enumOrderNames(t, state, EnumOrderAlphabetical, EnumAliasesCanonical)).To(Equal([]string{
+		"SHIPMENT_STATE_DELIVERED",
+		"SHIPMENT_STATE_IN_TRANSIT",
+		"SHIPMENT_STATE_UNSPECIFIED",
+	}))
+}
+
+func TestEnumOrderDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(testdatamcp.TaskService_ScheduleTaskTool.JSONSchema), &schema)).To(Succeed())
+	priority := schema["properties"].(map[string]any)["priority"]
+	g.Expect(priority).To(HaveKeyWithValue("enum", []any{
+		"TASK_PRIORITY_UNSPECIFIED", "TASK_PRIORITY_URGENT", "TASK_PRIORITY_HIGH", "TASK_PRIORITY_LOW", "TASK_PRIORITY_MEDIUM",
+	}))
+}
+
+func TestEnumOrderInvalid(t *testing.T) {
+	g := NewWithT(t)
+
+	file := testdata.File_testdata_enum_order_test_proto
+	plugin, _ := runPlugin(t, codeGeneratorRequest(file), GenerateConfig{EnumOrder: "random"})
+	g.Expect(plugin.Response().GetError()).To(Equal(`enum_order "random" must be "declaration", "alphabetical" or "numeric"`))
+}
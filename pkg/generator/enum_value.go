@@ -0,0 +1,112 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+// enumValueDescription returns the model-facing description of v: its
+// (mcp.options.enum_value).description when set, otherwise its leading
+// comment, which is only available for files with source info.
+func (g *FileGenerator) enumValueDescription(v protoreflect.EnumValueDescriptor) string {
+	opts, ok, err := getExtension[*mcpoptions.EnumValueOptions](v, mcpoptions.E_EnumValue)
+	if err != nil {
+		g.gen.Error(err)
+		return ""
+	}
+	if desc := strings.TrimSpace(opts.GetDescription()); ok && desc != "" {
+		return desc
+	}
+	loc := v.ParentFile().SourceLocations().ByDescriptor(v)
+	return strings.TrimSpace(cleanComment(loc.LeadingComments))
+}
+
+// enumValuesNote lists the values of ed that have a description, one
+// "- NAME: description" line each, or returns "" when none has one.
+func (g *FileGenerator) enumValuesNote(ed protoreflect.EnumDescriptor) string {
+	var lines []string
+	for _, v := range g.enumValues(ed) {
+		if desc := g.enumValueDescription(v); desc != "" {
+			lines = append(lines, "- "+string(v.Name())+": "+strings.ReplaceAll(desc, "\n", " "))
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "Values:\n" + strings.Join(lines, "\n")
+}
+
+// enumValues returns the values of ed listed in its schema, in the order of
+// the enum_order option. Aliases are left out when only canonical names are
+// listed.
+func (g *FileGenerator) enumValues(ed protoreflect.EnumDescriptor) []protoreflect.EnumValueDescriptor {
+	values := make([]protoreflect.EnumValueDescriptor, 0, ed.Values().Len())
+	for i := 0; i < ed.Values().Len(); i++ {
+		v := ed.Values().Get(i)
+		if g.enumAliases == EnumAliasesCanonical && isEnumAlias(v) {
+			continue
+		}
+		values = append(values, v)
+	}
+	switch g.enumOrder {
+	case EnumOrderAlphabetical:
+		sort.SliceStable(values, func(i, j int) bool { return values[i].Name() < values[j].Name() })
+	case EnumOrderNumeric:
+		sort.SliceStable(values, func(i, j int) bool { return values[i].Number() < values[j].Number() })
+	}
+	return values
+}
+
+// isEnumAlias reports whether v shares its number with a value declared
+// before it, which makes v an alias under allow_alias.
+func isEnumAlias(v protoreflect.EnumValueDescriptor) bool {
+	ed := v.Parent().(protoreflect.EnumDescriptor)
+	return ed.Values().ByNumber(v.Number()) != v
+}
+
+// enumAliasesNote groups the names of each number of ed that has several,
+// one "- FIRST = ALIAS" line each, or returns "" when ed has no aliases or
+// only canonical names are listed.
+func (g *FileGenerator) enumAliasesNote(ed protoreflect.EnumDescriptor) string {
+	if g.enumAliases == EnumAliasesCanonical {
+		return ""
+	}
+	var lines []string
+	for i := 0; i < ed.Values().Len(); i++ {
+		v := ed.Values().Get(i)
+		if isEnumAlias(v) {
+			continue
+		}
+		names := []string{string(v.Name())}
+		for j := i + 1; j < ed.Values().Len(); j++ {
+			if alias := ed.Values().Get(j); alias.Number() == v.Number() {
+				names = append(names, string(alias.Name()))
+			}
+		}
+		if len(names) > 1 {
+			lines = append(lines, "- "+strings.Join(names, " = "))
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "Aliases, names of the same value:\n" + strings.Join(lines, "\n")
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+const ticketSeverityValues = "Values:\n" +
+	"- TICKET_SEVERITY_CRITICAL: Production is down for customers.\n" +
+	"- TICKET_SEVERITY_MAJOR: Something is broken but there is a workaround.\n" +
+	"- TICKET_SEVERITY_MINOR: Cosmetic issue."
+
+// TestEnumValueDescriptions checks the generated schema, which was compiled
+// with source info: the annotation wins over the comment of CRITICAL, MAJOR
+// falls back to its comment and UNSPECIFIED, with neither, is not listed.
+func TestEnumValueDescriptions(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(testdatamcp.TicketService_FileTicketTool.JSONSchema), &schema)).To(Succeed())
+	props := schema["properties"].(map[string]any)
+
+	g.Expect(props["severity"]).To(HaveKeyWithValue("description", "How urgent the ticket is.\n\n"+ticketSeverityValues))
+	g.Expect(props["also_affects"].(map[string]any)["items"]).To(HaveKeyWithValue("description", ticketSeverityValues))
+	g.Expect(props["also_affects"]).ToNot(HaveKey("description"))
+}
+
+// TestEnumValueDescriptionsWithoutComments uses the linked descriptor, which
+// has no source info, so only the annotated values are described.
+func TestEnumValueDescriptionsWithoutComments(t *testing.T) {
+	g := NewWithT(t)
+
+	ed := testdata.TicketSeverity(0).Descriptor()
+	fg := &FileGenerator{}
+	g.Expect(fg.enumValueDescription(ed.Values().ByName("TICKET_SEVERITY_CRITICAL"))).To(Equal("Production is down for customers."))
+	g.Expect(fg.enumValueDescription(ed.Values().ByName("TICKET_SEVERITY_MAJOR"))).To(BeEmpty())
+	g.Expect(fg.enumValuesNote(ed)).To(Equal("Values:\n" +
+		"- TICKET_SEVERITY_CRITICAL: Production is down for customers.\n" +
+		"- TICKET_SEVERITY_MINOR: Cosmetic issue."))
+
+	g.Expect(fg.enumValuesNote(testdata.EventKind(0).Descriptor())).To(BeEmpty())
+}
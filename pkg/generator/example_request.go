@@ -0,0 +1,221 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+// addExampleRequest parses the (mcp.options.tool) example_request of meth, if
+// any, and stores it as the top-level "examples" entry of schema. The example
+// is converted to the shape the tool accepts and validated against schema, so
+// a stale example fails generation instead of misleading the model.
+func (g *FileGenerator) addExampleRequest(meth *protogen.Method, opts *mcpoptions.ToolOptions, schema map[string]any) error {
+	text := strings.TrimSpace(opts.GetExampleRequest())
+	if text == "" {
+		return nil
+	}
+
+	msg := dynamicpb.NewMessage(meth.Input.Desc)
+	var err error
+	if strings.HasPrefix(text, "{") {
+		err = protojson.Unmarshal([]byte(text), msg)
+	} else {
+		err = prototext.Unmarshal([]byte(text), msg)
+	}
+	if err != nil {
+		return fmt.Errorf("mcpgen: %s has an invalid (mcp.options.tool) example_request: %w", meth.Desc.FullName(), err)
+	}
+
+	example, err := g.exampleArguments(msg)
+	if err != nil {
+		return fmt.Errorf("mcpgen: %s has an invalid (mcp.options.tool) example_request: %w", meth.Desc.FullName(), err)
+	}
+	if err := validateAgainstSchema(schema, example); err != nil {
+		return fmt.Errorf("mcpgen: %s has a (mcp.options.tool) example_request that does not match the input schema: %w", meth.Desc.FullName(), err)
+	}
+
+	schema["examples"] = []any{example}
+	return nil
+}
+
+// exampleArguments returns msg as the JSON arguments an MCP client would
+// send for it. With optional keyword support, fields without presence are
+// required, so their zero values are spelled out too.
+func (g *FileGenerator) exampleArguments(msg protoreflect.Message) (map[string]any, error) {
+	raw, err := protojson.MarshalOptions{
+		UseProtoNames:     true,
+		EmitDefaultValues: g.optionalKeywordSupport,
+	}.Marshal(msg.Interface())
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var args map[string]any
+	if err := dec.Decode(&args); err != nil {
+		return nil, err
+	}
+	if err := g.toToolShape(msg.Descriptor(), args); err != nil {
+		return nil, err
+	}
+	return args, nil
+}
+
+// toToolShape rewrites obj, the protojson form of a message of type md, in
+// place to match the tool schema: oneof members are wrapped in their
+// <oneof>OneOfType discriminated union, 64-bit integers become numbers and
+// zero-based pagination fields are shifted to one-based. google.type.Date
+// values become date strings, in unix_seconds mode timestamps become integer
+// seconds, and under enum_as_int enum names become numbers.
+func (g *FileGenerator) toToolShape(md protoreflect.MessageDescriptor, obj map[string]any) error {
+	if _, ok := wellKnownTypeSchemas[string(md.FullName())]; ok {
+		return nil
+	}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := string(fd.Name())
+		v, ok := obj[name]
+		if !ok {
+			continue
+		}
+		v, err := g.toToolShapeValue(fd, v)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if oneOf := fd.ContainingOneof(); oneOf != nil && !oneOf.IsSynthetic() {
+			delete(obj, name)
+			obj[g.oneOfWrapperKeyOf(oneOf)] = map[string]any{
+				"object_type": oneofDiscriminator(fd),
+				name:          v,
+			}
+			continue
+		}
+		obj[name] = v
+	}
+	return nil
+}
+
+func (g *FileGenerator) toToolShapeValue(fd protoreflect.FieldDescriptor, v any) (any, error) {
+	switch {
+	case fd.IsMap():
+		m, _ := v.(map[string]any)
+		for k, elem := range m {
+			shaped, err := g.toToolShapeSingular(fd.MapValue(), elem)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = shaped
+		}
+		return v, nil
+	case fd.IsList():
+		list, _ := v.([]any)
+		for i, elem := range list {
+			shaped, err := g.toToolShapeSingular(fd, elem)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = shaped
+		}
+		return v, nil
+	}
+	v, err := g.toToolShapeSingular(fd, v)
+	if err != nil || !isZeroBasedPagination(fd) {
+		return v, err
+	}
+	n, err := strconv.ParseInt(string(v.(json.Number)), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return json.Number(strconv.FormatInt(n+1, 10)), nil
+}
+
+func (g *FileGenerator) toToolShapeSingular(fd protoreflect.FieldDescriptor, v any) (any, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if s, ok := v.(string); ok && fd.Message().FullName() == timestampFullName && g.unixSecondsIn(directionInput) {
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return nil, err
+			}
+			if t.Nanosecond() != 0 {
+				return nil, fmt.Errorf("timestamp %s has fractional seconds, which unix_seconds cannot express", s)
+			}
+			return json.Number(strconv.FormatInt(t.Unix(), 10)), nil
+		}
+		if obj, ok := v.(map[string]any); ok {
+			if fd.Message().FullName() == dateFullName {
+				return dateString(obj)
+			}
+			return v, g.toToolShape(fd.Message(), obj)
+		}
+	case protoreflect.EnumKind:
+		// protojson writes enum names; under enum_as_int the schema says integer.
+		if name, ok := v.(string); ok && g.enumAsIntIn(directionInput) {
+			if ev := fd.Enum().Values().ByName(protoreflect.Name(name)); ev != nil {
+				return json.Number(strconv.Itoa(int(ev.Number()))), nil
+			}
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// protojson writes 64-bit integers as strings; the schema says integer.
+		if s, ok := v.(string); ok {
+			return json.Number(s), nil
+		}
+	}
+	return v, nil
+}
+
+// validateAgainstSchema reports whether instance is valid against schema.
+func validateAgainstSchema(schema map[string]any, instance any) error {
+	compiled, err := compileSchema(schema)
+	if err != nil {
+		return err
+	}
+	return compiled.Validate(instance)
+}
+
+// compileSchema compiles schema as a JSON Schema 2020-12 document.
+func compileSchema(schema any) (*jsonschema.Schema, error) {
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	c := jsonschema.NewCompiler()
+	c.DefaultDraft(jsonschema.Draft2020)
+	if err := c.AddResource("schema.json", doc); err != nil {
+		return nil, err
+	}
+	return c.Compile("schema.json")
+}
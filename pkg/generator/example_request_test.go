@@ -0,0 +1,187 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// recordingExampleClient captures the SearchWidgets request it receives.
+type recordingExampleClient struct {
+	last *testdata.SearchWidgetsRequest
+}
+
+func (c *recordingExampleClient) SearchWidgets(_ context.Context, in *testdata.SearchWidgetsRequest, _ ...grpc.CallOption) (*testdata.SearchWidgetsResponse, error) {
+	c.last = in
+	return &testdata.SearchWidgetsResponse{}, nil
+}
+
+func (c *recordingExampleClient) CountWidgets(context.Context, *testdata.CountWidgetsRequest, ...grpc.CallOption) (*testdata.CountWidgetsResponse, error) {
+	return &testdata.CountWidgetsResponse{}, nil
+}
+
+// toolExamples returns the top-level "examples" of a generated tool schema.
+func toolExamples(g *WithT, schema string) []any {
+	var parsed map[string]any
+	g.Expect(json.Unmarshal([]byte(schema), &parsed)).To(Succeed())
+	examples, _ := parsed["examples"].([]any)
+	return examples
+}
+
+func TestExampleRequestGolden(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(toolExamples(g, testdatamcp.ExampleService_SearchWidgetsTool.JSONSchema)).To(Equal([]any{
+		map[string]any{
+			"query":          "blue",
+			"page":           2.0, // one-based
+			"max_size_bytes": 1048576.0,
+			"tags":           []any{"sale", "new"},
+			"filterOneOfType": map[string]any{
+				"object_type": "owner",
+				"owner":       map[string]any{"team": "platform"},
+			},
+		},
+	}))
+	g.Expect(toolExamples(g, testdatamcp.ExampleService_CountWidgetsTool.JSONSchema)).To(Equal([]any{
+		map[string]any{"query": "red", "since_id": 42.0},
+	}))
+
+	// Tools without the option carry no examples.
+	g.Expect(testdatamcp.TestService_GetItemTool.JSONSchema).ToNot(ContainSubstring(`"examples"`))
+}
+
+func TestExampleRequestIsAcceptedByTool(t *testing.T) {
+	g := NewWithT(t)
+
+	client := &recordingExampleClient{}
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToExampleServiceClient(s, client)
+
+	example := toolExamples(g, testdatamcp.ExampleService_SearchWidgetsTool.JSONSchema)[0].(map[string]any)
+	resp := callTool(t, s, testdatamcp.ExampleService_SearchWidgetsTool.Name, example)
+	g.Expect(resp).To(HaveKey("result"), "unexpected response: %v", resp)
+
+	want := &testdata.SearchWidgetsRequest{
+		Query:        "blue",
+		Page:         1,
+		Filter:       &testdata.SearchWidgetsRequest_Owner{Owner: &testdata.WidgetOwner{Team: "platform"}},
+		MaxSizeBytes: 1048576,
+		Tags:         []string{"sale", "new"},
+	}
+	g.Expect(proto.Equal(client.last, want)).To(BeTrue(), "got %v", client.last)
+}
+
+// searchWidgetsMethod returns the SearchWidgets method with the given example.
+func searchWidgetsMethod(example string) (*protogen.Method, *mcpoptions.ToolOptions) {
+	md := testdata.File_testdata_example_request_test_proto.Services().ByName("ExampleService").Methods().ByName("SearchWidgets")
+	meth := &protogen.Method{
+		Desc:  md,
+		Input: &protogen.Message{Desc: md.Input()},
+	}
+	return meth, &mcpoptions.ToolOptions{Name: "search_widgets", ExampleRequest: example}
+}
+
+func TestExampleRequestErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		example string
+		wantErr string
+	}{
+		{
+			name:    "invalid text format",
+			example: `query: blue`,
+			wantErr: "testdata.ExampleService.SearchWidgets has an invalid (mcp.options.tool) example_request",
+		},
+		{
+			name:    "invalid JSON",
+			example: `{"query": 1}`,
+			wantErr: "testdata.ExampleService.SearchWidgets has an invalid (mcp.options.tool) example_request",
+		},
+		{
+			name:    "unknown field",
+			example: `colour: WIDGET_COLOR_RED`,
+			wantErr: "testdata.ExampleService.SearchWidgets has an invalid (mcp.options.tool) example_request",
+		},
+		{
+			name:    "oneof is required by the schema",
+			example: `query: "blue"`,
+			wantErr: "testdata.ExampleService.SearchWidgets has a (mcp.options.tool) example_request that does not match the input schema",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fg := &FileGenerator{}
+			meth, opts := searchWidgetsMethod(tt.example)
+			schema := fg.messageSchemaWithDefs(meth.Input.Desc, nil, directionInput)
+			err := fg.addExampleRequest(meth, opts, schema)
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			g.Expect(schema).ToNot(HaveKey("examples"))
+		})
+	}
+}
+
+func TestExampleRequestSpellsOutRequiredZeroValues(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{optionalKeywordSupport: true}
+	meth, opts := searchWidgetsMethod(`color: WIDGET_COLOR_RED`)
+	schema := fg.messageSchemaWithDefs(meth.Input.Desc, nil, directionInput)
+	g.Expect(fg.addExampleRequest(meth, opts, schema)).To(Succeed())
+
+	raw, err := json.Marshal(schema["examples"])
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(raw).To(MatchJSON(`[{
+		"query": "",
+		"page": 1,
+		"max_size_bytes": 0,
+		"tags": [],
+		"filterOneOfType": {"object_type": "color", "color": "WIDGET_COLOR_RED"}
+	}]`))
+}
+
+func TestExampleRequestEnumAsInt(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{enumAsInt: true}
+	meth, opts := searchWidgetsMethod(`color: WIDGET_COLOR_RED`)
+	schema := fg.messageSchemaWithDefs(meth.Input.Desc, nil, directionInput)
+	g.Expect(fg.addExampleRequest(meth, opts, schema)).To(Succeed())
+
+	raw, err := json.Marshal(schema["examples"])
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(raw).To(MatchJSON(`[{"filterOneOfType": {"object_type": "color", "color": 1}}]`))
+}
+
+func TestExampleRequestEnumAsIntGenerate(t *testing.T) {
+	g := NewWithT(t)
+
+	file := testdata.File_testdata_example_request_test_proto
+	plugin, _ := runPlugin(t, codeGeneratorRequest(file), GenerateConfig{EnumAsInt: true})
+	g.Expect(plugin.Response().GetError()).To(BeEmpty())
+}
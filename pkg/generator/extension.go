@@ -0,0 +1,182 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// errExtensionNotDefined is returned when an extension is neither linked into
+// the generator nor declared by the file being read or its imports. A file
+// cannot set an option it does not import, so callers probing for an
+// optional extension can treat it as "not set".
+var errExtensionNotDefined = errors.New("extension is not defined")
+
+// extensionValue returns the value of the extension name set on the options
+// of desc, which may be any descriptor. It works whether or not the Go types
+// of the extension are linked into the generator: inside protoc an unlinked
+// extension is left in the unknown fields of the options, and is resolved
+// here against the descriptors imported by the file of desc. Message values
+// of unlinked extensions are dynamicpb messages.
+func extensionValue(desc protoreflect.Descriptor, name protoreflect.FullName) (protoreflect.Value, bool, error) {
+	optsMsg := descriptorOptions(desc)
+	if optsMsg == nil {
+		return protoreflect.Value{}, false, nil
+	}
+	if v, ok := rangeExtension(optsMsg, name, nil); ok {
+		return v, true, nil
+	}
+
+	xd := findExtension(desc.ParentFile(), name, map[string]bool{})
+	if xd == nil {
+		xt, err := protoregistry.GlobalTypes.FindExtensionByName(name)
+		if err != nil {
+			return protoreflect.Value{}, false, fmt.Errorf("mcpgen: reading %s from %s: %w", name, desc.FullName(), errExtensionNotDefined)
+		}
+		return resolveExtension(desc, optsMsg, xt)
+	}
+	return resolveExtension(desc, optsMsg, dynamicpb.NewExtensionType(xd))
+}
+
+// getExtension returns the Go value of the linked extension xt set on the
+// options of desc, such as *mcpoptions.ToolOptions for mcpoptions.E_Tool.
+// Unlike proto.GetExtension it also finds the value when it was left in the
+// unknown fields, or was resolved against a different copy of the
+// extension's descriptor.
+func getExtension[T any](desc protoreflect.Descriptor, xt protoreflect.ExtensionType) (T, bool, error) {
+	var zero T
+	optsMsg := descriptorOptions(desc)
+	if optsMsg == nil {
+		return zero, false, nil
+	}
+	v, ok, err := resolveExtension(desc, optsMsg, xt)
+	if err != nil || !ok {
+		return zero, false, err
+	}
+	t, ok := xt.InterfaceOf(v).(T)
+	if !ok {
+		return zero, false, fmt.Errorf("mcpgen: extension %s on %s is a %T, not a %T", xt.TypeDescriptor().FullName(), desc.FullName(), xt.InterfaceOf(v), zero)
+	}
+	return t, true, nil
+}
+
+// descriptorOptions returns the options message of desc, or nil when it has
+// none.
+func descriptorOptions(desc protoreflect.Descriptor) protoreflect.Message {
+	opts := desc.Options()
+	if opts == nil {
+		return nil
+	}
+	m, ok := opts.(proto.Message)
+	if !ok {
+		return nil
+	}
+	return m.ProtoReflect()
+}
+
+// rangeExtension returns the value of the already-resolved extension name in
+// optsMsg. When xt is non-nil the value must have been resolved with xt
+// itself, so that it has the Go type xt expects.
+func rangeExtension(optsMsg protoreflect.Message, name protoreflect.FullName, xt protoreflect.ExtensionType) (protoreflect.Value, bool) {
+	var value protoreflect.Value
+	var found bool
+	optsMsg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !fd.IsExtension() || fd.FullName() != name {
+			return true
+		}
+		if xt != nil {
+			xtd, ok := fd.(protoreflect.ExtensionTypeDescriptor)
+			if !ok || xtd.Type() != xt {
+				return false
+			}
+		}
+		value, found = v, true
+		return false
+	})
+	return value, found
+}
+
+// resolveExtension returns the value of xt in optsMsg, the options of desc,
+// re-parsing the options with xt when the value is in the unknown fields or
+// was resolved with another type.
+func resolveExtension(desc protoreflect.Descriptor, optsMsg protoreflect.Message, xt protoreflect.ExtensionType) (protoreflect.Value, bool, error) {
+	xd := xt.TypeDescriptor()
+	if got, want := xd.ContainingMessage().FullName(), optsMsg.Descriptor().FullName(); got != want {
+		return protoreflect.Value{}, false, fmt.Errorf("mcpgen: extension %s extends %s and cannot be set on %s (%s)", xd.FullName(), got, desc.FullName(), want)
+	}
+	if v, ok := rangeExtension(optsMsg, xd.FullName(), xt); ok {
+		return v, true, nil
+	}
+	if _, ok := rangeExtension(optsMsg, xd.FullName(), nil); !ok && len(optsMsg.GetUnknown()) == 0 {
+		return protoreflect.Value{}, false, nil
+	}
+
+	types := new(protoregistry.Types)
+	if err := types.RegisterExtension(xt); err != nil {
+		return protoreflect.Value{}, false, fmt.Errorf("mcpgen: registering extension %s: %w", xd.FullName(), err)
+	}
+	raw, err := proto.Marshal(optsMsg.Interface())
+	if err != nil {
+		return protoreflect.Value{}, false, fmt.Errorf("mcpgen: reading %s from %s: %w", xd.FullName(), desc.FullName(), err)
+	}
+	resolved := optsMsg.Type().New()
+	if err := (proto.UnmarshalOptions{Resolver: types}).Unmarshal(raw, resolved.Interface()); err != nil {
+		return protoreflect.Value{}, false, fmt.Errorf("mcpgen: reading %s from %s: %w", xd.FullName(), desc.FullName(), err)
+	}
+	if !resolved.Has(xd) {
+		return protoreflect.Value{}, false, nil
+	}
+	return resolved.Get(xd), true, nil
+}
+
+// findExtension looks up an extension by full name in file and its transitive
+// imports, whether it is declared at the top level or inside a message.
+func findExtension(file protoreflect.FileDescriptor, name protoreflect.FullName, seen map[string]bool) protoreflect.ExtensionDescriptor {
+	if file == nil || seen[file.Path()] {
+		return nil
+	}
+	seen[file.Path()] = true
+	if xd := scopedExtension(file.Extensions(), file.Messages(), name); xd != nil {
+		return xd
+	}
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		if xd := findExtension(imports.Get(i).FileDescriptor, name, seen); xd != nil {
+			return xd
+		}
+	}
+	return nil
+}
+
+// scopedExtension looks up the extension name among exts and the extensions
+// declared in msgs and the messages nested in them.
+func scopedExtension(exts protoreflect.ExtensionDescriptors, msgs protoreflect.MessageDescriptors, name protoreflect.FullName) protoreflect.ExtensionDescriptor {
+	if xd := exts.ByName(name.Name()); xd != nil && xd.FullName() == name {
+		return xd
+	}
+	for i := 0; i < msgs.Len(); i++ {
+		md := msgs.Get(i)
+		if xd := scopedExtension(md.Extensions(), md.Messages(), name); xd != nil {
+			return xd
+		}
+	}
+	return nil
+}
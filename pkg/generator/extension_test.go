@@ -0,0 +1,168 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func deleteRecordMethod() protoreflect.MethodDescriptor {
+	return testdata.File_testdata_custom_option_test_proto.Services().ByName("AuditedService").Methods().ByName("DeleteRecord")
+}
+
+func TestExtensionValueLinked(t *testing.T) {
+	g := NewWithT(t)
+
+	v, ok, err := extensionValue(deleteRecordMethod(), "testdata.audit")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+	audit := v.Message()
+	g.Expect(audit.Get(audit.Descriptor().Fields().ByName("category")).String()).To(Equal("records"))
+
+	fields := (&testdata.DeleteRecordRequest{}).ProtoReflect().Descriptor().Fields()
+	label, ok, err := getExtension[string](fields.ByName("record_id"), testdata.E_Label)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+	g.Expect(label).To(Equal("Record"))
+
+	_, ok, err = getExtension[string](fields.ByName("reason"), testdata.E_Label)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ok).To(BeFalse())
+}
+
+// TestExtensionValueUnlinked covers the protoc plugin path, where the options
+// arrive as unknown bytes and the extension is declared in an imported file.
+func TestExtensionValueUnlinked(t *testing.T) {
+	g := NewWithT(t)
+
+	file := unlinkedFile(t, testdata.File_testdata_custom_option_test_proto)
+	meth := file.Services[0].Methods[0].Desc
+	g.Expect(meth.Options().(proto.Message).ProtoReflect().GetUnknown()).ToNot(BeEmpty())
+
+	v, ok, err := extensionValue(meth, "testdata.audit")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+	audit := v.Message()
+	g.Expect(audit.Get(audit.Descriptor().Fields().ByName("sensitive")).Bool()).To(BeTrue())
+
+	typed, ok, err := getExtension[*testdata.AuditOptions](meth, testdata.E_Audit)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+	g.Expect(typed.GetCategory()).To(Equal("records"))
+	g.Expect(typed.GetSensitive()).To(BeTrue())
+}
+
+// unlinkedFile returns target as protoc hands it to the plugin, with every
+// option left in the unknown fields of the options messages.
+func unlinkedFile(t *testing.T, target protoreflect.FileDescriptor) *protogen.File {
+	t.Helper()
+	raw, err := proto.Marshal(codeGeneratorRequest(target))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &pluginpb.CodeGeneratorRequest{}
+	if err := (proto.UnmarshalOptions{Resolver: new(protoregistry.Types)}).Unmarshal(raw, req); err != nil {
+		t.Fatal(err)
+	}
+	plugin, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return plugin.FilesByPath[target.Path()]
+}
+
+// TestLinkedExtensionsUnlinked reads the options the generator links in from
+// unknown fields, as it does inside protoc.
+func TestLinkedExtensionsUnlinked(t *testing.T) {
+	g := NewWithT(t)
+
+	account := unlinkedFile(t, testdata.File_testdata_field_behavior_test_proto).Desc.Messages().ByName("Account")
+	g.Expect(hasFieldBehavior(account.Fields().ByName("name"), annotations.FieldBehavior_REQUIRED)).To(BeTrue())
+
+	list := unlinkedFile(t, testdata.File_testdata_pagination_test_proto).Desc.Messages().ByName("ListItemsRequest")
+	g.Expect(isZeroBasedPagination(list.Fields().ByName("page"))).To(BeTrue())
+
+	meth := unlinkedFile(t, testdata.File_testdata_tool_annotation_test_proto).Services[0].Methods[0]
+	g.Expect(methodToolOptions(meth).GetName()).To(Equal("get_widget"))
+}
+
+// TestExtensionValueMessageScoped resolves an unlinked extension declared
+// inside a message rather than at the top level of its file.
+func TestExtensionValueMessageScoped(t *testing.T) {
+	g := NewWithT(t)
+
+	opts := &descriptorpb.FieldOptions{}
+	opts.ProtoReflect().SetUnknown(protowire.AppendString(protowire.AppendTag(nil, 50001, protowire.BytesType), "Record"))
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("scoped/test.proto"),
+		Package:    proto.String("scoped"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/descriptor.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Hints"),
+			Extension: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("label"),
+				Number:   proto.Int32(50001),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				Extendee: proto.String(".google.protobuf.FieldOptions"),
+			}},
+		}, {
+			Name: proto.String("Record"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:    proto.String("id"),
+				Number:  proto.Int32(1),
+				Label:   descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:    descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				Options: opts,
+			}},
+		}},
+	}, protoregistry.GlobalFiles)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	v, ok, err := extensionValue(fd.Messages().ByName("Record").Fields().ByName("id"), "scoped.Hints.label")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+	g.Expect(v.String()).To(Equal("Record"))
+}
+
+func TestExtensionValueErrors(t *testing.T) {
+	g := NewWithT(t)
+
+	_, ok, err := extensionValue(deleteRecordMethod(), "testdata.not_declared")
+	g.Expect(ok).To(BeFalse())
+	g.Expect(err).To(MatchError(errExtensionNotDefined))
+	g.Expect(err).To(MatchError(ContainSubstring("testdata.not_declared")))
+	g.Expect(err).To(MatchError(ContainSubstring("testdata.AuditedService.DeleteRecord")))
+
+	_, _, err = getExtension[string](deleteRecordMethod(), testdata.E_Label)
+	g.Expect(err).To(MatchError(ContainSubstring("extends google.protobuf.FieldOptions")))
+
+	_, _, err = getExtension[int32](deleteRecordMethod(), testdata.E_Audit)
+	g.Expect(err).To(MatchError(ContainSubstring("not a int32")))
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestFieldBehaviorDirection(t *testing.T) {
+	md := (&testdata.Account{}).ProtoReflect().Descriptor()
+
+	t.Run("input", func(t *testing.T) {
+		g := NewWithT(t)
+		schema := (&FileGenerator{}).messageSchemaFromDescriptor(md, nil, directionInput)
+		g.Expect(schema["properties"]).To(HaveKey("name"))
+		g.Expect(schema["properties"]).To(HaveKey("password"))
+		g.Expect(schema["properties"]).To(HaveKey("contact"))
+		g.Expect(schema["properties"]).ToNot(HaveKey("uid"), "OUTPUT_ONLY is not part of a request")
+		g.Expect(schema["required"]).To(ConsistOf("name", "password"))
+	})
+
+	t.Run("output", func(t *testing.T) {
+		g := NewWithT(t)
+		schema := (&FileGenerator{}).messageSchemaFromDescriptor(md, nil, directionOutput)
+		g.Expect(schema["properties"]).To(HaveKey("name"))
+		g.Expect(schema["properties"]).To(HaveKey("uid"))
+		g.Expect(schema["properties"]).To(HaveKey("contact"))
+		g.Expect(schema["properties"]).ToNot(HaveKey("password"), "INPUT_ONLY is never returned")
+		g.Expect(schema["required"]).To(ConsistOf("name"), "a dropped field cannot be required")
+	})
+}
+
+func TestFieldBehaviorDirectionNestedDefs(t *testing.T) {
+	g := NewWithT(t)
+	md := (&testdata.Account{}).ProtoReflect().Descriptor()
+
+	for _, inline := range []bool{false, true} {
+		fg := &FileGenerator{inlineMessages: inline}
+
+		input := contactSchema(fg.messageSchemaWithDefs(md, nil, directionInput))
+		output := contactSchema(fg.messageSchemaWithDefs(md, nil, directionOutput))
+
+		g.Expect(input).To(HaveKey("email"))
+		g.Expect(input).To(HaveKey("verification_code"))
+		g.Expect(input).ToNot(HaveKey("verified"), "inline=%v", inline)
+
+		g.Expect(output).To(HaveKey("email"))
+		g.Expect(output).To(HaveKey("verified"))
+		g.Expect(output).ToNot(HaveKey("verification_code"), "inline=%v", inline)
+	}
+}
+
+// contactSchema returns the AccountContact properties of an Account schema,
+// whether it was inlined or factored into $defs.
+func contactSchema(schema map[string]any) map[string]any {
+	contact := schema["properties"].(map[string]any)["contact"].(map[string]any)
+	if _, isRef := contact["$ref"]; isRef {
+		contact = schema["$defs"].(map[string]any)["AccountContact"].(map[string]any)
+	}
+	return contact["properties"].(map[string]any)
+}
+
+func TestFieldBehaviorGeneratedToolIsInputSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	schema := testdatamcp.FieldBehaviorService_UpsertAccountTool.JSONSchema
+	g.Expect(schema).To(ContainSubstring(`"password"`))
+	g.Expect(schema).To(ContainSubstring(`"verification_code"`))
+	g.Expect(schema).ToNot(ContainSubstring(`"uid"`))
+	g.Expect(schema).ToNot(ContainSubstring(`"verified"`))
+}
+
+func TestFieldBehaviorMarkMode(t *testing.T) {
+	md := (&testdata.Account{}).ProtoReflect().Descriptor()
+	fg := &FileGenerator{markFieldBehavior: true}
+
+	for _, dir := range []schemaDirection{directionInput, directionOutput} {
+		g := NewWithT(t)
+		schema := fg.messageSchemaWithDefs(md, nil, dir)
+		props := schema["properties"].(map[string]any)
+
+		// Nothing is dropped; the restricted fields are marked instead.
+		g.Expect(props).To(HaveKey("name"))
+		g.Expect(props["uid"]).To(HaveKeyWithValue("readOnly", true))
+		g.Expect(props["password"]).To(HaveKeyWithValue("writeOnly", true))
+		g.Expect(props["name"]).ToNot(HaveKey("readOnly"))
+		g.Expect(props["name"]).ToNot(HaveKey("writeOnly"))
+
+		contact := contactSchema(schema)
+		g.Expect(contact["verified"]).To(HaveKeyWithValue("readOnly", true))
+		g.Expect(contact["verification_code"]).To(HaveKeyWithValue("writeOnly", true))
+
+		// A field the caller cannot send is never required in the input, and
+		// vice versa.
+		if dir == directionInput {
+			g.Expect(schema["required"]).To(ConsistOf("name", "password"))
+		} else {
+			g.Expect(schema["required"]).To(ConsistOf("name"))
+		}
+	}
+}
+
+func TestFieldBehaviorDropModeHasNoMarks(t *testing.T) {
+	g := NewWithT(t)
+
+	schema := (&FileGenerator{}).messageSchemaWithDefs((&testdata.Account{}).ProtoReflect().Descriptor(), nil, directionInput)
+	raw, err := json.Marshal(schema)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(raw)).ToNot(ContainSubstring("readOnly"))
+	g.Expect(string(raw)).ToNot(ContainSubstring("writeOnly"))
+}
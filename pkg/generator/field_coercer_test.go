@@ -0,0 +1,98 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// severityCoercer accepts TicketSeverity values sent as numbers, in JSON or
+// in a string, and names with surrounding whitespace.
+func severityCoercer(fieldPath string, raw any) (any, error) {
+	if fieldPath != "severity" && fieldPath != "also_affects" {
+		return raw, nil
+	}
+	var n int64
+	switch v := raw.(type) {
+	case float64:
+		n = int64(v)
+	case string:
+		v = strings.TrimSpace(v)
+		parsed, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return v, nil
+		}
+		n = parsed
+	default:
+		return raw, nil
+	}
+	name, ok := testdata.TicketSeverity_name[int32(n)]
+	if !ok {
+		return nil, fmt.Errorf("no severity %d", n)
+	}
+	return name, nil
+}
+
+func TestFieldCoercer(t *testing.T) {
+	var got *testdata.FileTicketRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTicketServiceClient(s, &testdatamcp.MockTicketServiceHandler{
+		FileTicketFunc: func(_ context.Context, req *testdata.FileTicketRequest) (*testdata.FileTicketResponse, error) {
+			got = req
+			return &testdata.FileTicketResponse{TicketId: "T-1"}, nil
+		},
+	}, runtime.WithFieldCoercer(severityCoercer))
+
+	t.Run("numbers become enum names", func(t *testing.T) {
+		g := NewWithT(t)
+
+		resp := callTool(t, s, testdatamcp.TicketService_FileTicketToolName, map[string]any{
+			"severity":     "2",
+			"also_affects": []any{1, " TICKET_SEVERITY_MINOR "},
+		})
+		g.Expect(resultText(g, resp)).To(MatchJSON(`{"ticket_id":"T-1"}`))
+		g.Expect(got.GetSeverity()).To(Equal(testdata.TicketSeverity_TICKET_SEVERITY_MAJOR))
+		g.Expect(got.GetAlsoAffects()).To(Equal([]testdata.TicketSeverity{
+			testdata.TicketSeverity_TICKET_SEVERITY_CRITICAL,
+			testdata.TicketSeverity_TICKET_SEVERITY_MINOR,
+		}))
+	})
+
+	t.Run("errors are invalid arguments", func(t *testing.T) {
+		g := NewWithT(t)
+
+		resp := callTool(t, s, testdatamcp.TicketService_FileTicketToolName, map[string]any{"severity": "9"})
+		g.Expect(resultText(g, resp)).To(MatchJSON(`{"code":"INVALID_ARGUMENT","message":"severity: no severity 9"}`))
+	})
+
+	t.Run("parse args", func(t *testing.T) {
+		g := NewWithT(t)
+
+		req, err := testdatamcp.ParseTicketServiceFileTicketArgs(map[string]any{"severity": "3"}, runtime.WithFieldCoercer(severityCoercer))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(req.GetSeverity()).To(Equal(testdata.TicketSeverity_TICKET_SEVERITY_MINOR))
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestFieldComment(t *testing.T) {
+	for name, tc := range map[string]struct {
+		leading, trailing protogen.Comments
+		want              string
+	}{
+		"none":          {want: ""},
+		"leading only":  {leading: " Title of the note.\n", want: "Title of the note."},
+		"trailing only": {trailing: " At most 10.\n", want: "At most 10."},
+		"both":          {leading: " Tags of the note.\n", trailing: " At most 10.\n", want: "Tags of the note.\n\nAt most 10."},
+		"same text":     {leading: " Tags.\n", trailing: " Tags.\n", want: "Tags."},
+		"multi-line leading": {
+			leading:  " First line.\n Second line.\n",
+			trailing: " Note.\n",
+			want:     "First line.\nSecond line.\n\nNote.",
+		},
+		"markdown": {
+			trailing: " Markdown, e.g. `**bold**` or a [link](https://example.com).\n",
+			want:     "Markdown, e.g. `**bold**` or a [link](https://example.com).",
+		},
+		"lint directive": {leading: " buf:lint:ignore FIELD_LOWER_SNAKE_CASE\n", trailing: " Kept.\n", want: "Kept."},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			field := &protogen.Field{Comments: protogen.CommentSet{Leading: tc.leading, Trailing: tc.trailing}}
+			g.Expect(fieldComment(field)).To(Equal(tc.want))
+		})
+	}
+}
+
+// TestFieldCommentsFromSource checks the descriptions the generator took from
+// the source locations of field_comments_test.proto.
+func TestFieldCommentsFromSource(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema struct {
+		Properties map[string]map[string]any `json:"properties"`
+	}
+	g.Expect(json.Unmarshal([]byte(testdatamcp.NoteService_CreateNoteTool.JSONSchema), &schema)).To(Succeed())
+	g.Expect(schema.Properties["title"]).To(HaveKeyWithValue("description", "Title of the note."))
+	g.Expect(schema.Properties["body"]).To(HaveKeyWithValue("description", "Markdown, e.g. `**bold**` or a [link](https://example.com)."))
+	g.Expect(schema.Properties["tags"]).To(HaveKeyWithValue("description", "Tags of the note.\n\nAt most 10."))
+	g.Expect(schema.Properties["author"]).ToNot(HaveKey("description"))
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestFieldPathComments(t *testing.T) {
+	g := NewWithT(t)
+
+	schema := schemaTitleInputSchema(t, testdata.File_testdata_test_service_proto, GenerateConfig{FieldPathComments: true}, "TestService", "CreateItem", "")
+	props := schema["properties"].(map[string]any)
+	g.Expect(props["name"]).To(HaveKeyWithValue("$comment", "testdata.CreateItemRequest.name = 1"))
+	g.Expect(props["labels"]).To(HaveKeyWithValue("$comment", "testdata.CreateItemRequest.labels = 3"))
+	g.Expect(props["item_typeOneOfType"]).To(HaveKeyWithValue("$comment", "oneof testdata.CreateItemRequest.item_type"))
+
+	// Oneof variants and the fields of messages in $defs carry their own path.
+	variant := props["item_typeOneOfType"].(map[string]any)["oneOf"].([]any)[0].(map[string]any)
+	g.Expect(variant["properties"].(map[string]any)["product"]).To(HaveKeyWithValue("$comment", "testdata.CreateItemRequest.product = 5"))
+	product := schema["$defs"].(map[string]any)["ProductDetails"].(map[string]any)
+	g.Expect(product["properties"].(map[string]any)["quantity"]).To(HaveKeyWithValue("$comment", "testdata.ProductDetails.quantity = 2"))
+
+	// Fields moved to the top level by flat_args keep the path of their message.
+	cfg := GenerateConfig{FieldPathComments: true, FlatArgs: true}
+	schema = schemaTitleInputSchema(t, testdata.File_testdata_flat_args_test_proto, cfg, "ProfileService", "EditProfile", "")
+	props = schema["properties"].(map[string]any)
+	g.Expect(props["display_name"]).To(HaveKeyWithValue("$comment", "testdata.Profile.display_name = 1"))
+	g.Expect(props["notify"]).To(HaveKeyWithValue("$comment", "testdata.EditProfileRequest.notify = 2"))
+}
+
+func TestFieldPathCommentsDisabled(t *testing.T) {
+	g := NewWithT(t)
+
+	schema := schemaTitleInputSchema(t, testdata.File_testdata_test_service_proto, GenerateConfig{}, "TestService", "CreateItem", "")
+	for name, prop := range schema["properties"].(map[string]any) {
+		g.Expect(prop).ToNot(HaveKey("$comment"), name)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FlatField is a message field of the request whose own fields are hoisted
+// to the top level of the tool input schema under flat_args. The generated
+// handler moves them back into the message field.
+type FlatField struct {
+	// Name is the protobuf name of the message field.
+	Name string
+	// Keys are the protobuf names of the hoisted fields, sorted.
+	Keys []string
+}
+
+// flattenArgs hoists the fields of every single-level message field of md to
+// the top level of schema, the tool input schema of md, and returns the
+// fields it flattened. A message field is single-level when it is singular,
+// not in a oneof, not a well-known type, and has neither message fields nor
+// oneofs of its own; any other field keeps its nested object. A field is
+// left nested too when one of its fields would clash with another top-level
+// property, so each top-level key has exactly one meaning. Examples are
+// flattened along with the schema.
+func flattenArgs(md protoreflect.MessageDescriptor, schema map[string]any) []FlatField {
+	props, _ := schema["properties"].(map[string]any)
+	defs, _ := schema["$defs"].(map[string]any)
+
+	var candidates []FlatField
+	inner := map[string]map[string]any{}
+	count := map[string]int{}
+	for name := range props {
+		count[name]++
+	}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := string(fd.Name())
+		if !isSingleLevelMessageField(fd) {
+			continue
+		}
+		prop, ok := props[name].(map[string]any)
+		if !ok {
+			continue
+		}
+		target := prop
+		if ref, ok := prop["$ref"].(string); ok {
+			if target, ok = defs[strings.TrimPrefix(ref, "#/$defs/")].(map[string]any); !ok {
+				continue
+			}
+		}
+		innerProps, ok := target["properties"].(map[string]any)
+		if !ok || len(innerProps) == 0 {
+			continue
+		}
+		keys := make([]string, 0, len(innerProps))
+		for key := range innerProps {
+			keys = append(keys, key)
+			count[key]++
+		}
+		sort.Strings(keys)
+		candidates = append(candidates, FlatField{Name: name, Keys: keys})
+		inner[name] = target
+	}
+
+	var flat []FlatField
+	for _, c := range candidates {
+		ambiguous := false
+		for _, key := range c.Keys {
+			if count[key] > 1 {
+				ambiguous = true
+				break
+			}
+		}
+		if ambiguous {
+			continue
+		}
+
+		ref, _ := props[c.Name].(map[string]any)["$ref"].(string)
+		delete(props, c.Name)
+		innerProps := inner[c.Name]["properties"].(map[string]any)
+		for _, key := range c.Keys {
+			props[key] = innerProps[key]
+		}
+		// The hoisted fields are only required when the message field is.
+		if required, ok := schema["required"].([]string); ok {
+			kept := required[:0:0]
+			wrapped := false
+			for _, r := range required {
+				if r == c.Name {
+					wrapped = true
+					continue
+				}
+				kept = append(kept, r)
+			}
+			if innerRequired, ok := inner[c.Name]["required"].([]string); ok && wrapped {
+				kept = append(kept, innerRequired...)
+			}
+			schema["required"] = kept
+		}
+		if ref != "" && !refersTo(schema, ref) {
+			delete(defs, strings.TrimPrefix(ref, "#/$defs/"))
+		}
+		if examples, ok := schema["examples"].([]any); ok {
+			for _, example := range examples {
+				flattenExample(example, c.Name)
+			}
+		}
+		flat = append(flat, c)
+	}
+	if defs != nil && len(defs) == 0 {
+		delete(schema, "$defs")
+	}
+	return flat
+}
+
+// isSingleLevelMessageField reports whether fd may be flattened by
+// flattenArgs.
+func isSingleLevelMessageField(fd protoreflect.FieldDescriptor) bool {
+	if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+		return false
+	}
+	if oneOf := fd.ContainingOneof(); oneOf != nil && !oneOf.IsSynthetic() {
+		return false
+	}
+	if _, isWKT := wellKnownTypeSchemas[string(fd.Message().FullName())]; isWKT {
+		return false
+	}
+	md := fd.Message()
+	for i := 0; i < md.Oneofs().Len(); i++ {
+		if !md.Oneofs().Get(i).IsSynthetic() {
+			return false
+		}
+	}
+	for i := 0; i < md.Fields().Len(); i++ {
+		if md.Fields().Get(i).Message() != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// flattenExample moves the fields of the message field name of example, a
+// request in the tool shape, to its top level.
+func flattenExample(example any, name string) {
+	obj, ok := example.(map[string]any)
+	if !ok {
+		return
+	}
+	nested, ok := obj[name].(map[string]any)
+	delete(obj, name)
+	if !ok {
+		return
+	}
+	for key, v := range nested {
+		obj[key] = v
+	}
+}
+
+// refersTo reports whether v has a $ref to ref, outside of the $defs entry
+// ref itself points to.
+func refersTo(v any, ref string) bool {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			if k == "$ref" && child == ref {
+				return true
+			}
+			if k == "$defs" {
+				defs, _ := child.(map[string]any)
+				for name, def := range defs {
+					if "#/$defs/"+name != ref && refersTo(def, ref) {
+						return true
+					}
+				}
+				continue
+			}
+			if refersTo(child, ref) {
+				return true
+			}
+		}
+	case []any:
+		for _, child := range v {
+			if refersTo(child, ref) {
+				return true
+			}
+		}
+	case []map[string]any:
+		for _, child := range v {
+			if refersTo(child, ref) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// flatArgsSchemas returns the flat_args input schema and flattened fields of
+// every ProfileService method, by method name.
+func flatArgsSchemas(t *testing.T) (map[string]map[string]any, map[string][]FlatField) {
+	t.Helper()
+	file := testdata.File_testdata_flat_args_test_proto
+	plugin, err := protogen.Options{}.New(codeGeneratorRequest(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fg := NewFileGenerator(plugin.FilesByPath[file.Path()], plugin)
+	schemas := map[string]map[string]any{}
+	flat := map[string][]FlatField{}
+	for _, meth := range fg.f.Services[0].Methods {
+		schema := fg.messageSchemaWithDefs(meth.Input.Desc, meth.Input, directionInput)
+		if err := fg.addExampleRequest(meth, methodToolOptions(meth), schema); err != nil {
+			t.Fatal(err)
+		}
+		flat[meth.GoName] = flattenArgs(meth.Input.Desc, schema)
+		// Round-trip through JSON, as the generated tool does.
+		raw, err := json.Marshal(schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var parsed map[string]any
+		if err := json.Unmarshal(raw, &parsed); err != nil {
+			t.Fatal(err)
+		}
+		schemas[meth.GoName] = parsed
+	}
+	return schemas, flat
+}
+
+func TestFlatArgsFlattensSingleLevelMessages(t *testing.T) {
+	g := NewWithT(t)
+
+	schemas, flat := flatArgsSchemas(t)
+	schema := schemas["EditProfile"]
+
+	g.Expect(flat["EditProfile"]).To(Equal([]FlatField{{Name: "profile", Keys: []string{"bio", "display_name", "interests"}}}))
+	props := schema["properties"].(map[string]any)
+	g.Expect(props).To(HaveKey("display_name"))
+	g.Expect(props).To(HaveKey("bio"))
+	g.Expect(props).To(HaveKey("interests"))
+	g.Expect(props).To(HaveKey("notify"))
+	g.Expect(props).ToNot(HaveKey("profile"))
+	// The required message field hands its required fields to the top level.
+	g.Expect(schema["required"]).To(ConsistOf("display_name"))
+
+	// ProfileAddress has a nested message, so it keeps its object.
+	g.Expect(props["address"]).To(HaveKeyWithValue("$ref", "#/$defs/ProfileAddress"))
+	defs := schema["$defs"].(map[string]any)
+	g.Expect(defs).To(HaveKey("ProfileAddress"))
+	g.Expect(defs).To(HaveKey("ProfileCity"))
+	g.Expect(defs).ToNot(HaveKey("Profile"))
+
+	g.Expect(schema["examples"]).To(Equal([]any{map[string]any{
+		"display_name": "Ada",
+		"interests":    []any{"math"},
+		"notify":       true,
+	}}))
+}
+
+func TestFlatArgsKeepsAmbiguousFieldsNested(t *testing.T) {
+	g := NewWithT(t)
+
+	schemas, flat := flatArgsSchemas(t)
+
+	// from and to both have a name field, which would clash at the top level.
+	g.Expect(flat["MoveProfile"]).To(BeEmpty())
+	props := schemas["MoveProfile"]["properties"].(map[string]any)
+	g.Expect(props).To(HaveKeyWithValue("from", HaveKeyWithValue("$ref", "#/$defs/ProfileCity")))
+	g.Expect(props).To(HaveKeyWithValue("to", HaveKeyWithValue("$ref", "#/$defs/ProfileCity")))
+}
+
+func TestFlatArgsRebuildsRequest(t *testing.T) {
+	g := NewWithT(t)
+
+	_, flat := flatArgsSchemas(t)
+	var fields []runtime.FlatField
+	for _, f := range flat["EditProfile"] {
+		fields = append(fields, runtime.FlatField{Name: f.Name, Keys: f.Keys})
+	}
+
+	args := map[string]any{
+		"display_name": "Ada",
+		"interests":    []any{"math", "engines"},
+		"notify":       true,
+		"address":      map[string]any{"street": "1 Analytical Way", "city": map[string]any{"name": "London"}},
+	}
+	runtime.NestFlatFields(args, fields)
+	raw, err := json.Marshal(args)
+	g.Expect(err).ToNot(HaveOccurred())
+	var got testdata.EditProfileRequest
+	g.Expect(protojson.Unmarshal(raw, &got)).To(Succeed(), "nested: %s", raw)
+
+	want := &testdata.EditProfileRequest{
+		Profile: &testdata.Profile{DisplayName: "Ada", Interests: []string{"math", "engines"}},
+		Notify:  true,
+		Address: &testdata.ProfileAddress{Street: "1 Analytical Way", City: &testdata.ProfileCity{Name: "London"}},
+	}
+	g.Expect(proto.Equal(&got, want)).To(BeTrue(), "got %v", &got)
+}
+
+func TestFlatArgsGeneratedHandler(t *testing.T) {
+	g := NewWithT(t)
+
+	file := testdata.File_testdata_flat_args_test_proto
+	src := generatedGoFile(t, file, GenerateConfig{FlatArgs: true})
+	g.Expect(src).To(MatchRegexp(`ProfileService_EditProfileFlatFields\s+= \[\]runtime.FlatField\{\{Name: "profile", Keys: \[\]string\{"bio", "display_name", "interests"\}\}\}`))
+	g.Expect(src).To(ContainSubstring("runtime.NestFlatFields(args, ProfileService_EditProfileFlatFields)"))
+	g.Expect(src).To(ContainSubstring("runtime.NestFlatFields(args, ProfileService_EditProfileFlatFields)"))
+	g.Expect(src).ToNot(ContainSubstring("ProfileService_MoveProfileFlatFields"))
+
+	g.Expect(generatedGoFile(t, file, GenerateConfig{})).ToNot(ContainSubstring("NestFlatFields"))
+}
@@ -0,0 +1,25 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+// ForwardFunc returns the name of the function registering the tools of
+// service with a client: ForwardTo<Service>Client, or forwardTo<Service>Client
+// under SkipForward, where only RegisterInProcess<Service>Server calls it.
+func (p TplParams) ForwardFunc(service string) string {
+	if p.SkipForward {
+		return "forwardTo" + service + "Client"
+	}
+	return "ForwardTo" + service + "Client"
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGenerateForwardRegister(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg     GenerateConfig
+		present []string
+		absent  []string
+	}{
+		"both": {
+			cfg:     GenerateConfig{PackageSuffix: "mcp"},
+			present: []string{"func ForwardToTestServiceClient(", "func RegisterInProcessTestServiceServer(", "type TestServiceInProcessServer interface"},
+		},
+		"forward only": {
+			cfg:     GenerateConfig{PackageSuffix: "mcp", SkipRegister: true},
+			present: []string{"func ForwardToTestServiceClient("},
+			absent:  []string{"RegisterInProcessTestServiceServer", "TestServiceInProcessServer", "inProcessTestServiceClient", "grpc/codes"},
+		},
+		"register only": {
+			cfg:     GenerateConfig{PackageSuffix: "mcp", SkipForward: true},
+			present: []string{"func forwardToTestServiceClient(", "func RegisterInProcessTestServiceServer(", "forwardToTestServiceClient(s, inProcessTestServiceClient{impl: impl}, opts...)"},
+			absent:  []string{"ForwardToTestServiceClient"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			resp := generateLayout(t, tc.cfg)
+			g.Expect(resp.Error).To(BeNil())
+			g.Expect(resp.File).To(HaveLen(1))
+			content := resp.File[0].GetContent()
+			for _, s := range tc.present {
+				g.Expect(content).To(ContainSubstring(s))
+			}
+			for _, s := range tc.absent {
+				g.Expect(content).ToNot(ContainSubstring(s))
+			}
+		})
+	}
+}
+
+func TestGenerateForwardRegisterInvalid(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := generateLayout(t, GenerateConfig{PackageSuffix: "mcp", SkipForward: true, SkipRegister: true})
+	g.Expect(resp.GetError()).To(ContainSubstring("generate_forward and generate_register cannot both be false"))
+}
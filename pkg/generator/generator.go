@@ -16,22 +16,24 @@ package generator
 
 import (
 	"crypto/sha1"
-	"encoding/json"
 	"fmt"
 	"go/token"
 	"math/big"
 	"path"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
 	"google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/protobuf/compiler/protogen"
-	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
 
 	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
@@ -50,6 +52,70 @@ const (
 
 	// HashPrefixLength is the length of the hash prefix for mangled names
 	HashPrefixLength = 6
+
+	// DefaultInt64Note is the description note added to 64-bit integer
+	// fields. protojson writes these as strings, so without it models tend to
+	// quote numbers elsewhere too.
+	DefaultInt64Note = "64-bit integer; may be encoded as a decimal string"
+
+	// TimestampFormatRFC3339 and TimestampFormatUnixSeconds are the values of
+	// the timestamp_format option: google.protobuf.Timestamp fields are either
+	// RFC 3339 strings (the protojson mapping, and the default) or integer
+	// seconds since the Unix epoch, converted by the generated handler.
+	TimestampFormatRFC3339     = "rfc3339"
+	TimestampFormatUnixSeconds = "unix_seconds"
+
+	// OptionalFieldsNullable and OptionalFieldsOmit are the values of the
+	// optional_fields option, which chooses how singular fields that may be
+	// unset are represented: with "null" added to their type (the default),
+	// or only by leaving them out of "required", for clients that dislike
+	// explicit nulls. Either way an unset field may be omitted, and protojson
+	// reads an explicit null as unset.
+	OptionalFieldsNullable = "nullable"
+	OptionalFieldsOmit     = "omit"
+
+	// NullableStyleTypeArray and NullableStyleKeyword are the values of the
+	// nullable_style option, which chooses how a schema that accepts null
+	// says so: with "null" in its type array, as in JSON Schema (the
+	// default), or with the OpenAPI 3.0 "nullable": true keyword next to a
+	// single type, for consumers that do not understand type arrays.
+	NullableStyleTypeArray = "type_array"
+	NullableStyleKeyword   = "keyword"
+
+	// EnumAliasesAll and EnumAliasesCanonical are the values of the
+	// enum_aliases option, which chooses how the names of an enum with
+	// allow_alias are listed: all of them, with a note grouping the names of
+	// each number (the default), or only the first name of each number. The
+	// generated handler accepts every name either way.
+	EnumAliasesAll       = "all"
+	EnumAliasesCanonical = "canonical"
+
+	// EnumOrderDeclaration, EnumOrderAlphabetical and EnumOrderNumeric are
+	// the values of the enum_order option, which orders the names listed in
+	// the schema of an enum and in the note describing them: as declared in
+	// the proto (the default), sorted by name, or sorted by number. Sorting keeps the
+	// schema stable when values are reordered in the proto; names of the
+	// same number keep their declaration order.
+	EnumOrderDeclaration  = "declaration"
+	EnumOrderAlphabetical = "alphabetical"
+	EnumOrderNumeric      = "numeric"
+
+	// OneOfKeyTypeSuffix, OneOfKeyCamel and OneOfKeyUnion are the values of
+	// the oneof_key option, which names the property wrapping the variants of
+	// a oneof: "<oneof>OneOfType" (the default), "<oneofInLowerCamel>OneOf",
+	// or "<oneof>__union". The generated handler recognizes the same naming.
+	OneOfKeyTypeSuffix = "type_suffix"
+	OneOfKeyCamel      = "camel"
+	OneOfKeyUnion      = "union"
+
+	// unixSecondsNote describes a Timestamp field in unix_seconds mode.
+	unixSecondsNote = "Unix time in seconds"
+
+	timestampFullName = "google.protobuf.Timestamp"
+	// nullValueFullName is the single-value enum standing for JSON null.
+	nullValueFullName = "google.protobuf.NullValue"
+	// nullValueNote describes a google.protobuf.NullValue field.
+	nullValueNote = "Always null; google.protobuf.NullValue has no other value."
 )
 
 // FileGenerator handles protobuf to MCP schema generation for a single file
@@ -59,6 +125,12 @@ type FileGenerator struct {
 
 	gf *protogen.GeneratedFile
 
+	// goImportPath is the import path of gf, and imports the packages gf
+	// refers to for request and response messages, in the order qualify
+	// first saw them.
+	goImportPath protogen.GoImportPath
+	imports      []GoImport
+
 	// messageMap maps from protoreflect.MessageDescriptor to protogen.Message
 	// for efficient lookup of protogen messages from descriptors
 	messageMap map[string]*protogen.Message
@@ -70,6 +142,105 @@ type FileGenerator struct {
 	// name a hard error instead of falling back to the legacy autogenerated name.
 	requireToolAnnotation bool
 
+	// inlineMessages, when true, inlines message schemas instead of
+	// referencing them from $defs; only enums (and recursive messages) are
+	// factored into $defs.
+	inlineMessages bool
+
+	// skipDeprecated, when true, generates no tool for methods marked
+	// [deprecated = true].
+	skipDeprecated bool
+
+	// flatArgs, when true, hoists the fields of single-level message fields
+	// of the request to the top level of the tool input schema.
+	flatArgs bool
+
+	// markFieldBehavior, when true, keeps INPUT_ONLY and OUTPUT_ONLY fields in
+	// every schema and marks them writeOnly/readOnly instead of dropping them
+	// from the schema of the other direction.
+	markFieldBehavior bool
+
+	// uiHints, when true, adds an x-order vendor extension with the
+	// declaration position of every property, and marks the properties of
+	// deprecated fields deprecated.
+	uiHints bool
+
+	// schemaTitle, when true, sets the title of every input schema to the
+	// method name and its description to the method comment.
+	schemaTitle bool
+
+	// fieldPathComments, when true, adds a $comment with the full name and
+	// number of its proto field, or the name of its oneof, to every property.
+	fieldPathComments bool
+
+	// toolMeta, when true, stamps the proto package and version into the
+	// _meta of every tool, even when there is no version.
+	toolMeta bool
+
+	// toolVersion is the version stamped into the _meta of the tools of files
+	// without an (mcp.options.file) version.
+	toolVersion string
+
+	// grpcMethod, when true, stamps the gRPC method path of every tool into
+	// its input schema as x-grpc-method and into its _meta.
+	grpcMethod bool
+
+	// validateTool, when true, adds a validation tool to every service.
+	validateTool bool
+
+	// rawTools, when true, adds a raw tool to every method.
+	rawTools bool
+
+	// int64Note is the description note for 64-bit integer fields; empty
+	// means no note.
+	int64Note string
+
+	// timestampFormat is TimestampFormatRFC3339 or TimestampFormatUnixSeconds.
+	timestampFormat string
+
+	// optionalFields is OptionalFieldsNullable or OptionalFieldsOmit.
+	optionalFields string
+
+	// nullableStyle is NullableStyleTypeArray or NullableStyleKeyword.
+	nullableStyle string
+
+	// enumAliases is EnumAliasesAll or EnumAliasesCanonical.
+	enumAliases string
+
+	// enumOrder is EnumOrderDeclaration, EnumOrderAlphabetical or
+	// EnumOrderNumeric.
+	enumOrder string
+
+	// enumAsInt, when true, describes input enums by their numbers instead
+	// of their names.
+	enumAsInt bool
+
+	// oneOfKey is OneOfKeyTypeSuffix, OneOfKeyCamel or OneOfKeyUnion.
+	oneOfKey string
+
+	// descriptionComposer merges field comments with constraint summaries;
+	// nil means DefaultDescriptionComposer.
+	descriptionComposer DescriptionComposer
+
+	// descriptionPrefix, when not empty, is prepended to every tool
+	// description, with {service} and {method} replaced by the simple names
+	// of the method's service and of the method.
+	descriptionPrefix string
+
+	// schemaOut, when not empty, is the directory (relative to the plugin
+	// output) that receives one JSON file per RPC with its tool schemas.
+	schemaOut string
+
+	// refBaseURI, when not empty, is the base URI, ending in a slash, of the
+	// $id of every schema; see withRefBase.
+	refBaseURI string
+
+	// report, when not nil, collects the generation report.
+	report *Report
+
+	// openAPI, when not nil, collects the message schemas of every tool.
+	openAPI *OpenAPIDocument
+
 	// seenToolNames tracks tool names already emitted across all files in this
 	// generation run so that a duplicate name fails the build. It is shared
 	// between FileGenerators; always non-nil once generation starts.
@@ -91,17 +262,24 @@ type ToolNameRegistry map[string]ToolNameEntry
 
 // NewFileGenerator creates a new FileGenerator for the given protobuf file
 func NewFileGenerator(f *protogen.File, gen *protogen.Plugin) *FileGenerator {
-	gen.SupportedFeatures |= uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+	gen.SupportedFeatures |= uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL |
+		pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+	gen.SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
+	gen.SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2023
 
 	fg := &FileGenerator{f: f, gen: gen}
 	fg.buildMessageMap()
 	return fg
 }
 
-// buildMessageMap creates a mapping from message full names to protogen.Message
+// buildMessageMap creates a mapping from message full names to protogen.Message.
+// It covers every file of the request, including files that are only
+// imported, so messages shared across files keep their comments.
 func (g *FileGenerator) buildMessageMap() {
 	g.messageMap = make(map[string]*protogen.Message)
-	g.addMessagesToMap(g.f.Messages)
+	for _, f := range g.gen.Files {
+		g.addMessagesToMap(f.Messages)
+	}
 }
 
 // addMessagesToMap recursively adds messages and nested messages to the map
@@ -113,6 +291,27 @@ func (g *FileGenerator) addMessagesToMap(messages []*protogen.Message) {
 	}
 }
 
+// resolveMessage returns the full descriptor of md, with its protogen message
+// when known. A message whose file was not part of the request is only a
+// placeholder without fields; it is resolved from the global registry, where
+// it is found if the Go package defining it is linked in.
+func (g *FileGenerator) resolveMessage(md protoreflect.MessageDescriptor, protoMsg *protogen.Message) (protoreflect.MessageDescriptor, *protogen.Message) {
+	if protoMsg == nil {
+		protoMsg = g.messageMap[string(md.FullName())]
+	}
+	if protoMsg != nil {
+		return protoMsg.Desc, protoMsg
+	}
+	if md.IsPlaceholder() {
+		if d, err := protoregistry.GlobalFiles.FindDescriptorByName(md.FullName()); err == nil {
+			if resolved, ok := d.(protoreflect.MessageDescriptor); ok {
+				return resolved, nil
+			}
+		}
+	}
+	return md, nil
+}
+
 // extractFieldComments extracts and cleans comments from protogen message fields
 func (g *FileGenerator) extractFieldComments(protoMsg *protogen.Message) map[string]string {
 	if protoMsg == nil {
@@ -121,46 +320,111 @@ func (g *FileGenerator) extractFieldComments(protoMsg *protogen.Message) map[str
 
 	fieldComments := make(map[string]string, len(protoMsg.Fields))
 	for _, field := range protoMsg.Fields {
-		if comment := cleanComment(string(field.Comments.Leading)); comment != "" {
+		if comment := fieldComment(field); comment != "" {
 			fieldComments[string(field.Desc.Name())] = comment
 		}
 	}
 	return fieldComments
 }
 
+// fieldComment returns the description of field from its comments. The
+// leading comment is the description; a trailing comment on the same line
+// stands in for it when there is none, and is otherwise appended as a
+// separate paragraph. Comment text, markdown included, is kept verbatim.
+func fieldComment(field *protogen.Field) string {
+	leading := strings.TrimSpace(cleanComment(string(field.Comments.Leading)))
+	trailing := strings.TrimSpace(cleanComment(string(field.Comments.Trailing)))
+	switch {
+	case trailing == "" || trailing == leading:
+		return leading
+	case leading == "":
+		return trailing
+	default:
+		return leading + "\n\n" + trailing
+	}
+}
+
 // getEnumSchema generates schema for an enum
 func (g *FileGenerator) getEnumSchema(ed protoreflect.EnumDescriptor) map[string]any {
 	values := make([]string, 0, ed.Values().Len())
-	for i := 0; i < ed.Values().Len(); i++ {
-		values = append(values, string(ed.Values().Get(i).Name()))
+	for _, v := range g.enumValues(ed) {
+		values = append(values, string(v.Name()))
 	}
-	return map[string]any{
+	schema := map[string]any{
 		"type": "string",
 		"enum": values,
 	}
+	var notes []string
+	for _, note := range []string{g.enumValuesNote(ed), g.enumAliasesNote(ed)} {
+		if note != "" {
+			notes = append(notes, note)
+		}
+	}
+	if len(notes) > 0 {
+		schema["description"] = strings.Join(notes, "\n\n")
+	}
+	return schema
 }
 
-// addOneOfConstraints adds simplified oneOf fields to the schema properties and marks them as required
-func (g *FileGenerator) addOneOfConstraints(normalFields map[string]any, oneOf map[string][]map[string]any, required []string) []string {
+// nullValueSchema is the schema of a google.protobuf.NullValue field. Its
+// only value is read and written by protojson as JSON null, so the field is
+// described as null rather than as a single-value enum.
+func (g *FileGenerator) nullValueSchema() map[string]any {
+	if g.nullableStyle == NullableStyleKeyword {
+		return map[string]any{"nullable": true, "enum": []any{nil}, "description": nullValueNote}
+	}
+	return map[string]any{"type": "null", "description": nullValueNote}
+}
+
+// addOneOfConstraints adds simplified oneOf fields to the schema properties and marks them as required.
+// The oneofs of md are visited in declaration order so required is stable between runs.
+func (g *FileGenerator) addOneOfConstraints(md protoreflect.MessageDescriptor, normalFields map[string]any, oneOf map[string][]map[string]any, required []string) []string {
 	// For each oneOf group, add a oneOf field to properties
-	for oneOfName, variants := range oneOf {
-		// Add "OneOfType" postfix to the field name
-		fieldName := oneOfName + "OneOfType"
+	for i := 0; i < md.Oneofs().Len(); i++ {
+		oneOfName := string(md.Oneofs().Get(i).Name())
+		variants, ok := oneOf[oneOfName]
+		if !ok {
+			continue
+		}
+		// Name the wrapper property in the oneof_key naming
+		fieldName := g.oneOfWrapperKey(oneOfName)
 		// Declare "type": "object" alongside "oneOf" so that strict JSON Schema
 		// consumers (notably Qwen / vLLM tool-call chat templates) do not try to
 		// recurse into the variant list as if it were a property map and crash
 		// with "Can only get item pairs from a mapping". Every variant in the
 		// oneOf list is itself an object, so this is type-safe.
-		normalFields[fieldName] = map[string]any{
-			"type":  "object",
-			"oneOf": variants,
+		wrapper := map[string]any{
+			"type":        "object",
+			"description": oneOfDescription(oneOfName, variants),
+			"oneOf":       variants,
+		}
+		// The wrapper takes the place of the first field of the oneof.
+		if g.uiHints && md.Oneofs().Get(i).Fields().Len() > 0 {
+			wrapper["x-order"] = md.Oneofs().Get(i).Fields().Get(0).Index()
 		}
+		if g.fieldPathComments {
+			wrapper["$comment"] = "oneof " + string(md.Oneofs().Get(i).FullName())
+		}
+		normalFields[fieldName] = wrapper
 		// OneOf fields are mandatory in protobuf, so add to required array
 		required = append(required, fieldName)
 	}
 	return required
 }
 
+// oneOfDescription explains the discriminated-union contract of the wrapper
+// object of the oneof group oneOfName, listing the valid object_type values in
+// field order.
+func oneOfDescription(oneOfName string, variants []map[string]any) string {
+	names := make([]string, 0, len(variants))
+	for _, v := range variants {
+		if title, ok := v["title"].(string); ok {
+			names = append(names, strconv.Quote(title))
+		}
+	}
+	return fmt.Sprintf("Exactly one variant of the oneof %q. Set \"object_type\" to one of %s, and fill in the properties of that variant.", oneOfName, strings.Join(names, ", "))
+}
+
 const fileTemplate = `// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
 // source: {{ .SourcePath }}
 
@@ -168,24 +432,74 @@ package {{ .GoPackage }}
 
 import (
   "context"
-  "strings"
   "github.com/mark3labs/mcp-go/mcp"
   mcpserver "github.com/mark3labs/mcp-go/server"
   "encoding/json"
   "google.golang.org/protobuf/encoding/protojson"
   grpc "google.golang.org/grpc"
+  {{- if or .GenerateHandlers (and .HasStreamResources (not .SkipRegister)) }}
+  "google.golang.org/grpc/codes"
+  "google.golang.org/grpc/status"
+  {{- end }}
   "github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+  {{- if .HasTimeouts }}
+  "time"
+  {{- end }}
+  {{- range .Imports }}
+  {{ .Name }} {{ printf "%q" .Path }}
+  {{- end }}
+)
+
+{{- define "result" }}{{ if .StreamResource }}grpc.ServerStreamingClient[{{ .ResponseType }}]{{ else }}*{{ .ResponseType }}{{ end }}{{ end }}
+{{- define "tool" }}runtime.Tool{Name: {{ printf "%q" .Name }}, Description: {{ printf "%q" .Description }}, JSONSchema: {{ printf "%q" .JSONSchema }}{{ if .Title }}, Title: {{ printf "%q" .Title }}{{ end }}{{ if .ReadOnly }}, ReadOnly: runtime.BoolPtr({{ .ReadOnly }}){{ end }}{{ if .Destructive }}, Destructive: runtime.BoolPtr({{ .Destructive }}){{ end }}{{ if .Idempotent }}, Idempotent: runtime.BoolPtr({{ .Idempotent }}){{ end }}{{ if .OpenWorld }}, OpenWorld: runtime.BoolPtr({{ .OpenWorld }}){{ end }}{{ if .Timeout }}, Timeout: {{ durationLiteral .Timeout }}{{ end }}{{ if .Scopes }}, Scopes: []string{ {{- range $i, $s := .Scopes }}{{ if $i }}, {{ end }}{{ printf "%q" $s }}{{- end }} }{{ end }}{{ if .Deprecated }}, Deprecated: true{{ end }}{{ if .Package }}, Package: {{ printf "%q" .Package }}{{ end }}{{ if .Version }}, Version: {{ printf "%q" .Version }}{{ end }}{{ if .Method }}, Method: {{ printf "%q" .Method }}{{ end }}}{{ end }}
+// Tool names as generated, and the fully-qualified method names that
+// runtime.WithToolNameOverride keys on, for references that must not drift
+// from the proto. Overrides apply at registration and are not reflected here.
+const (
+{{- range $serviceName, $methods := .Services }}
+{{- range $methodName, $tool := $methods }}
+  {{$serviceName | capitalizeFirst}}_{{$methodName}}ToolName = {{ printf "%q" $tool.Tool.Name }}
+  {{$serviceName | capitalizeFirst}}_{{$methodName}}FullMethod = {{ printf "%q" $tool.FullMethod }}
+  {{- if $tool.BatchTool }}
+  {{$serviceName | capitalizeFirst}}_{{$methodName}}BatchToolName = {{ printf "%q" $tool.BatchTool.Name }}
+  {{- end }}
+  {{- if $tool.RawTool }}
+  {{$serviceName | capitalizeFirst}}_{{$methodName}}RawToolName = {{ printf "%q" $tool.RawTool.Name }}
+  {{- end }}
+{{- end }}
+{{- with index $.ValidateTools $serviceName }}
+  {{$serviceName | capitalizeFirst}}_ValidateToolName = {{ printf "%q" .Name }}
+{{- end }}
+{{- end }}
 )
 
 var (
 {{- range $key, $val := .Tools }}
-  {{$key}}Tool = runtime.Tool{Name: {{ printf "%q" $val.Name }}, Description: {{ printf "%q" $val.Description }}, JSONSchema: {{ printf "%q" $val.JSONSchema }}{{ if $val.Title }}, Title: {{ printf "%q" $val.Title }}{{ end }}{{ if $val.ReadOnly }}, ReadOnly: runtime.BoolPtr({{ $val.ReadOnly }}){{ end }}{{ if $val.Destructive }}, Destructive: runtime.BoolPtr({{ $val.Destructive }}){{ end }}{{ if $val.Idempotent }}, Idempotent: runtime.BoolPtr({{ $val.Idempotent }}){{ end }}{{ if $val.OpenWorld }}, OpenWorld: runtime.BoolPtr({{ $val.OpenWorld }}){{ end }}}
+  {{$key}}Tool = {{ template "tool" $val }}
+{{- end }}
+{{- range $key, $val := .BatchTools }}
+  {{$key}}BatchTool = {{ template "tool" $val }}
+{{- end }}
+{{- range $key, $val := .RawTools }}
+  {{$key}}RawTool = {{ template "tool" $val }}
 {{- end }}
 )
 
 var (
 {{- range $key, $val := .Tools }}
   {{$key}}ZeroBasedPaginationPaths = [][]string{ {{- range $path := $val.ZeroBasedPaginationPaths }}{ {{- range $i, $p := $path }}{{ if $i }}, {{ end }}{{ printf "%q" $p }}{{- end }} }, {{- end }} }
+  {{- if $val.MapPairLimits }}
+  {{$key}}MapPairLimits = []runtime.MapPairLimit{ {{- range $l := $val.MapPairLimits }}{Path: []string{ {{- range $i, $p := $l.Path }}{{ if $i }}, {{ end }}{{ printf "%q" $p }}{{- end }} }, Min: {{ $l.Min }}, Max: {{ $l.Max }}}, {{- end }} }
+  {{- end }}
+  {{- if $val.ConstFields }}
+  {{$key}}ConstFields = []runtime.ConstField{ {{- range $f := $val.ConstFields }}{Path: []string{ {{- range $i, $p := $f.Path }}{{ if $i }}, {{ end }}{{ printf "%q" $p }}{{- end }} }, JSONPath: []string{ {{- range $i, $p := $f.JSONPath }}{{ if $i }}, {{ end }}{{ printf "%q" $p }}{{- end }} }, Value: json.RawMessage({{ printf "%q" $f.Value }})}, {{- end }} }
+  {{- end }}
+  {{- if $val.OneOfDiscriminators }}
+  {{$key}}OneOfDiscriminators = []runtime.OneOfDiscriminator{ {{- range $d := $val.OneOfDiscriminators }}{Key: {{ printf "%q" $d.Key }}, Value: {{ printf "%q" $d.Value }}, Field: {{ printf "%q" $d.Field }}}, {{- end }} }
+  {{- end }}
+  {{- if $val.FlatFields }}
+  {{$key}}FlatFields = []runtime.FlatField{ {{- range $f := $val.FlatFields }}{Name: {{ printf "%q" $f.Name }}, Keys: []string{ {{- range $i, $k := $f.Keys }}{{ if $i }}, {{ end }}{{ printf "%q" $k }}{{- end }} }}, {{- end }} }
+  {{- end }}
 {{- end }}
 )
 
@@ -195,185 +509,241 @@ var (
 // {{$serviceName}}Client is compatible with the grpc-go client interface.
 type {{$serviceName}}Client interface {
   {{- range $methodName, $tool := $methods }}
-  {{$methodName}}(ctx context.Context, req *{{$tool.RequestType}}, opts ...grpc.CallOption) (*{{$tool.ResponseType}}, error)
+  {{$methodName}}(ctx context.Context, req *{{$tool.RequestType}}, opts ...grpc.CallOption) ({{ template "result" $tool }}, error)
+  {{- end }}
+}
+{{- if $.GenerateHandlers }}
+
+// Unimplemented{{$serviceName}}Handler implements {{$serviceName}}Client by
+// returning codes.Unimplemented from every method. Embed it to implement only
+// the methods you need.
+type Unimplemented{{$serviceName}}Handler struct{}
+{{ range $methodName, $tool := $methods }}
+func (Unimplemented{{$serviceName}}Handler) {{$methodName}}(context.Context, *{{$tool.RequestType}}, ...grpc.CallOption) ({{ template "result" $tool }}, error) {
+  return nil, status.Error(codes.Unimplemented, "method {{$methodName}} not implemented")
+}
+{{ end }}
+// Mock{{$serviceName}}Handler implements {{$serviceName}}Client with a settable
+// func per method. A method whose func is nil returns codes.Unimplemented.
+type Mock{{$serviceName}}Handler struct {
+  {{- range $methodName, $tool := $methods }}
+  {{$methodName}}Func func(ctx context.Context, req *{{$tool.RequestType}}) ({{ template "result" $tool }}, error)
   {{- end }}
 }
+{{ range $methodName, $tool := $methods }}
+func (m *Mock{{$serviceName}}Handler) {{$methodName}}(ctx context.Context, req *{{$tool.RequestType}}, opts ...grpc.CallOption) ({{ template "result" $tool }}, error) {
+  if m.{{$methodName}}Func == nil {
+    return Unimplemented{{$serviceName}}Handler{}.{{$methodName}}(ctx, req, opts...)
+  }
+  return m.{{$methodName}}Func(ctx, req)
+}
+{{ end }}
+{{- end }}
 {{ end }}
 
 
 
 {{- range $serviceName, $methods := .Services }}
-// {{$serviceName}}NormalizeTopLevelJSONStrings scans m's top level and checks if any fields
-// that should be objects according to the JSON schema are actually strings. If so, it will
-// parse string values that look like JSON and replace them with the parsed value.
-// This handles both OneOf fields and regular object fields.
-func {{$serviceName}}NormalizeTopLevelJSONStrings(
-	m map[string]interface{},
-	toolSchema string,
-) (changed bool) {
-	if m == nil || toolSchema == "" {
-		return false
-	}
+// {{$serviceName}}NormalizeTopLevelJSONStrings parses top-level string values of m that
+// should be objects according to the JSON schema. See runtime.NormalizeTopLevelJSONStrings.
+func {{$serviceName}}NormalizeTopLevelJSONStrings(m map[string]interface{}, toolSchema string) (changed bool) {
+	return runtime.NormalizeTopLevelJSONStrings(m, toolSchema)
+}
 
-	// Parse the tool schema
-	var schema map[string]interface{}
-	if err := json.Unmarshal([]byte(toolSchema), &schema); err != nil {
-		return false
-	}
+// {{$serviceName}}TransformOneOfFields transforms discriminated union fields back to protobuf
+// oneOf format. See runtime.TransformOneOfFields. It knows no custom
+// (mcp.options.oneof_discriminator) values; Transform{{$serviceName | capitalizeFirst}}<Method>OneOfFields does.
+func {{$serviceName}}TransformOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return {{ if $.OneOfKeySuffix }}runtime.TransformOneOfFieldsWithKeySuffix(m, maxDepth, {{ printf "%q" $.OneOfKeySuffix }}){{ else }}runtime.TransformOneOfFields(m, maxDepth){{ end }}
+}
+{{- range $methodName, $tool := $methods }}
 
-	// Extract properties from the schema
-	properties, ok := schema["properties"].(map[string]interface{})
-	if !ok {
-		return false
-	}
+// Transform{{$serviceName | capitalizeFirst}}{{$methodName}}OneOfFields transforms the discriminated union fields
+// of the arguments of the {{$methodName}} tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func Transform{{$serviceName | capitalizeFirst}}{{$methodName}}OneOfFields(m map[string]interface{}, maxDepth int) error {
+	return {{ if $.OneOfKeySuffix }}runtime.TransformOneOfFieldsWithKeySuffix(m, maxDepth, {{ printf "%q" $.OneOfKeySuffix }}{{ else }}runtime.TransformOneOfFields(m, maxDepth{{ end }}{{ if $tool.Tool.OneOfDiscriminators }}, {{$serviceName | capitalizeFirst}}_{{$methodName}}OneOfDiscriminators...{{ end }})
+}
+{{- end }}
+{{- end }}
 
-	// Helper function to check if a schema defines an object type
-	isObjectSchema := func(propSchema map[string]interface{}) bool {
-		// Check if type is "object"
-		if typeVal, ok := propSchema["type"]; ok {
-			if typeStr, ok := typeVal.(string); ok && typeStr == "object" {
-				return true
-			}
-			// Could also be an array of types
-			if typeArr, ok := typeVal.([]interface{}); ok {
-				for _, t := range typeArr {
-					if tStr, ok := t.(string); ok && tStr == "object" {
-						return true
-					}
-				}
-			}
-		}
+{{- range $serviceName, $methods := .Services }}
+{{- range $methodName, $tool := $methods }}
+
+// Parse{{$serviceName | capitalizeFirst}}{{$methodName}}Args builds the typed request of the {{$methodName}} tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func Parse{{$serviceName | capitalizeFirst}}{{$methodName}}Args(args map[string]interface{}, opts ...runtime.Option) (*{{$tool.RequestType}}, error) {
+  config := runtime.NewConfig()
+  for _, opt := range opts {
+    opt(config)
+  }
+  return parse{{$serviceName | capitalizeFirst}}{{$methodName}}Args(args, config)
+}
 
-		// Check if it has properties (inline object)
-		if _, hasProps := propSchema["properties"]; hasProps {
-			return true
-		}
+// parse{{$serviceName | capitalizeFirst}}{{$methodName}}Args runs the arguments of the {{$methodName}} tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// Parse{{$serviceName | capitalizeFirst}}{{$methodName}}Args.
+func parse{{$serviceName | capitalizeFirst}}{{$methodName}}Args(args map[string]interface{}, config *runtime.Config) (*{{$tool.RequestType}}, error) {
+  // Reject oversized arguments under runtime.WithMaxRequestBytes
+  if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+    return nil, err
+  }
 
-		// Check if it has a $ref (reference to object)
-		if _, hasRef := propSchema["$ref"]; hasRef {
-			return true
-		}
+  var req {{$tool.RequestType}}
 
-		// Check if it has oneOf (discriminated union - treated as object)
-		if _, hasOneOf := propSchema["oneOf"]; hasOneOf {
-			return true
-		}
+  // Normalize JSON strings for object fields (including oneOf's), by the
+  // schema of runtime.WithToolSchemaOverride if any
+  _ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema({{$serviceName | capitalizeFirst}}_{{$methodName}}Tool, {{ printf "%q" $tool.FullMethod }}, config.ToolSchemaOverrides).JSONSchema)
+  {{- if $tool.Tool.FlatFields }}
 
-		return false
-	}
+  // Move the fields flattened by flat_args back into their message fields
+  runtime.NestFlatFields(args, {{$serviceName | capitalizeFirst}}_{{$methodName}}FlatFields)
+  {{- end }}
 
-	// Iterate through all top-level fields in the payload
-	for k, v := range m {
-		// Get the schema for this field
-		propSchema, ok := properties[k]
-		if !ok {
-			continue
-		}
+  // Transform oneOf discriminated unions back to protobuf format, rejecting
+  // arguments nested deeper than runtime.WithMaxNestingDepth allows
+  if err := {{ if $.OneOfKeySuffix }}runtime.TransformOneOfFieldsWithKeySuffix(args, config.MaxNestingDepth, {{ printf "%q" $.OneOfKeySuffix }}{{ else }}runtime.TransformOneOfFields(args, config.MaxNestingDepth{{ end }}{{ if $tool.Tool.OneOfDiscriminators }}, {{$serviceName | capitalizeFirst}}_{{$methodName}}OneOfDiscriminators...{{ end }}); err != nil {
+    return nil, err
+  }
 
-		propSchemaMap, ok := propSchema.(map[string]interface{})
-		if !ok {
-			continue
-		}
+  // Drop a per-call "__format" override, which only shapes the result
+  if _, err := runtime.UseToonForCall(args, false); err != nil {
+    return nil, status.Error(codes.InvalidArgument, err.Error())
+  }
 
-		// Check if this field should be an object according to the schema
-		if !isObjectSchema(propSchemaMap) {
-			continue
-		}
+  // Fill in omitted fields from runtime.WithInputDefaults
+  runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName({{$serviceName | capitalizeFirst}}_{{$methodName}}FullMethod, {{$serviceName | capitalizeFirst}}_{{$methodName}}Tool.Name, config.ToolNameOverrides)])
 
-		// Check if the actual value is a string
-		s, ok := v.(string)
-		if !ok {
-			continue
-		}
+  // Turn integral floats such as 5.0 sent for integer fields into integers
+  if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+    return nil, err
+  }
 
-		// Try to parse it as JSON
-		trim := strings.TrimSpace(s)
-		if trim == "" || !(strings.HasPrefix(trim, "{") || strings.HasPrefix(trim, "[")) {
-			continue
-		}
+  // Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+  runtime.AdjustZeroBasedPaginationFields(args, {{$serviceName | capitalizeFirst}}_{{$methodName}}ZeroBasedPaginationPaths)
+  {{- if $tool.Tool.ConstFields }}
 
-		var parsed any
-		if err := json.Unmarshal([]byte(trim), &parsed); err != nil {
-			continue // ignore if it's not valid JSON
-		}
+  // Fill in omitted fields pinned by a protovalidate const rule
+  runtime.FillConstFields(args, {{$serviceName | capitalizeFirst}}_{{$methodName}}ConstFields)
+  {{- end }}
+  {{- if $tool.Tool.MapPairLimits }}
 
-		m[k] = parsed
-		changed = true
-	}
-	return changed
-}
-
-// {{$serviceName}}TransformOneOfFields transforms discriminated union fields back to protobuf oneOf format
-func {{$serviceName}}TransformOneOfFields(m map[string]interface{}) {
-	{{$serviceName}}TransformOneOfFieldsRecursive(m)
-}
-
-// {{$serviceName}}TransformOneOfFieldsRecursive recursively transforms oneOf fields in nested objects
-func {{$serviceName}}TransformOneOfFieldsRecursive(obj interface{}) {
-	switch v := obj.(type) {
-	case map[string]interface{}:
-		// Transform oneOf fields in this object
-		for key, value := range v {
-			// Check if this looks like a oneOf discriminated union (must have OneOfType postfix)
-			if strings.HasSuffix(key, "OneOfType") {
-				if unionObj, ok := value.(map[string]interface{}); ok {
-					if typeField, hasType := unionObj["object_type"]; hasType {
-						if typeStr, ok := typeField.(string); ok {
-							// First try to extract the field that matches the object_type
-							// (for message types with $ref)
-							if fieldValue, hasField := unionObj[typeStr]; hasField {
-								// Move the field value directly to the parent level
-								v[typeStr] = fieldValue
-								delete(v, key)
-							} else {
-								// Fall back to old logic: create object without object_type field
-								// (for primitive types or inline objects)
-								variantObj := make(map[string]interface{})
-								for k, val := range unionObj {
-									if k != "object_type" {
-										variantObj[k] = val
-									}
-								}
-								// Replace the union object with the variant object
-								v[typeStr] = variantObj
-								delete(v, key)
-							}
-						}
-					}
-				}
-			}
-		}
+  // Reject maps with too few or too many entries under strict validation
+  if config.StrictValidation {
+    if err := runtime.CheckMapPairLimits(args, {{$serviceName | capitalizeFirst}}_{{$methodName}}MapPairLimits); err != nil {
+      return nil, err
+    }
+  }
+  {{- end }}
+  {{- if $.UnixTimestamps }}
 
-		// Recursively process all values
-		for _, value := range v {
-			{{$serviceName}}TransformOneOfFieldsRecursive(value)
-		}
-	case []interface{}:
-		// Process array elements
-		for _, item := range v {
-			{{$serviceName}}TransformOneOfFieldsRecursive(item)
-		}
-	}
+  // Convert google.protobuf.Timestamp values sent as Unix seconds
+  runtime.UnixTimestampsToRFC3339(args, req.ProtoReflect().Descriptor())
+  {{- end }}
+  {{- if $tool.Tool.DateStrings }}
+
+  // Convert google.type.Date values sent as date strings
+  if err := runtime.DateStringsToObjects(args, req.ProtoReflect().Descriptor()); err != nil {
+    return nil, err
+  }
+  {{- end }}
+  {{- if $tool.Tool.OneOfCollections }}
+
+  // Wrap oneof variants sent as the bare list or map of their message
+  runtime.WrapOneOfCollections(args, req.ProtoReflect().Descriptor())
+  {{- end }}
+
+  // Coerce argument values under runtime.WithFieldCoercer
+  if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+    return nil, err
+  }
+
+  marshaled, err := json.Marshal(args)
+  if err != nil {
+    return nil, status.Error(codes.InvalidArgument, err.Error())
+  }
+  if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+    return nil, status.Error(codes.InvalidArgument, err.Error())
+  }
+  return &req, nil
 }
 {{- end }}
+{{- end }}
 
 
 {{- range $key, $val := .Services }}
-// ForwardTo{{$key}}Client registers a gRPC client, to forward MCP calls to it.
-func ForwardTo{{$key}}Client(s *mcpserver.MCPServer, client {{$key}}Client, opts ...runtime.Option) {
+// {{ $.ForwardFunc $key }} registers a gRPC client, to forward MCP calls to it.
+// It panics if runtime.WithToolNameOverride yields invalid or conflicting tool
+// names, if runtime.WithStartupValidation finds an invalid tool schema, if
+// runtime.WithSessionScopedClient or runtime.WithMethodClients has clients of
+// another service, or if a runtime.WithOnRegister callback renames a tool.
+func {{ $.ForwardFunc $key }}(s *mcpserver.MCPServer, client {{$key}}Client, opts ...runtime.Option) {
   config := runtime.NewConfig()
   for _, opt := range opts {
     opt(config)
   }
 
+  toolNames, err := runtime.ResolveToolNames(map[string]string{
+    {{- range $tool_name, $tool_val := $val }}
+    {{ printf "%q" $tool_val.FullMethod }}: {{$key | capitalizeFirst}}_{{$tool_name}}Tool.Name,
+    {{- if $tool_val.BatchTool }}
+    {{ printf "%q" $tool_val.BatchToolKey }}: {{$key | capitalizeFirst}}_{{$tool_name}}BatchTool.Name,
+    {{- end }}
+    {{- if $tool_val.RawTool }}
+    {{ printf "%q" $tool_val.RawToolKey }}: {{$key | capitalizeFirst}}_{{$tool_name}}RawTool.Name,
+    {{- end }}
+    {{- end }}
+    {{- with index $.ValidateTools $key }}
+    {{ printf "%q" .Key }}: {{$key | capitalizeFirst}}_ValidateToolName,
+    {{- end }}
+  }, config.ToolNameOverrides)
+  if err != nil {
+    panic(err)
+  }
+  if err := runtime.ValidateToolDescriptionTemplate(config.DescriptionTemplate); err != nil {
+    panic(err)
+  }
+
+  // Reject a runtime.WithSessionScopedClient resolver of another client type
+  if err := runtime.CheckClientResolver[{{$key}}Client](config.ClientResolverType); err != nil {
+    panic(err)
+  }
+
+  // Reject runtime.WithMethodClients clients of another client type
+  if err := runtime.CheckMethodClients[{{$key}}Client](config.MethodClients); err != nil {
+    panic(err)
+  }
+
+  {{- if $val }}
+
+  // Shared by every tool of this registration under runtime.WithConcurrencyLimit
+  limiter := runtime.NewCallLimiter(config.ConcurrencyLimit, config.ConcurrencyFailFast)
+
+  // Shared by every tool of this registration under runtime.WithRateLimit
+  rateLimiter := runtime.NewRateLimiter(config.RateLimits, config.DefaultRateLimit, config.RateLimitPerSession)
+  {{- end }}
+  {{- if index $.ValidateTools $key }}
+
+  // The tools whose arguments the validation tool checks
+  var validationTargets []runtime.ValidationTarget
+  {{- end }}
+
   {{- range $tool_name, $tool_val := $val }}
-  {{$tool_name}}ToolDef := {{$key | capitalizeFirst}}_{{$tool_name}}Tool
+  // Swap in the input schema of runtime.WithToolSchemaOverride, if any
+  {{$tool_name}}ToolDef := runtime.OverrideToolSchema({{$key | capitalizeFirst}}_{{$tool_name}}Tool, {{ printf "%q" $tool_val.FullMethod }}, config.ToolSchemaOverrides)
 
   // Convert simple Tool to mcp.Tool
   {{$tool_name}}Tool := mcp.Tool{
-    Name:        {{$tool_name}}ToolDef.Name,
-    Description: {{$tool_name}}ToolDef.Description,
+    Name:        toolNames[{{ printf "%q" $tool_val.FullMethod }}],
+    Description: runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, {{ printf "%q" $tool_val.FullMethod }}, {{$tool_name}}ToolDef.Description),
     RawInputSchema: json.RawMessage({{$tool_name}}ToolDef.JSONSchema),
+    {{- if or $tool_val.Tool.Package $tool_val.Tool.Version $tool_val.Tool.Method }}
+    Meta:           runtime.ToolMeta({{$tool_name}}ToolDef),
+    {{- end }}
     {{- if $tool_val.Tool.HasToolAnnotations }}
     Annotations: mcp.ToolAnnotation{
       Title:           {{$tool_name}}ToolDef.Title,
@@ -390,19 +760,62 @@ func ForwardTo{{$key}}Client(s *mcpserver.MCPServer, client {{$key}}Client, opts
     {{$tool_name}}Tool = runtime.AddExtraPropertiesToTool({{$tool_name}}Tool, config.ExtraProperties)
   }
 
-  s.AddTool({{$tool_name}}Tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-    var req {{$tool_val.RequestType}}
+  {{- if not (or $tool_val.StreamResource $tool_val.ResultTemplate) }}
+
+  // Offer the per-call "__format" override of the response format
+  {{$tool_name}}Tool = runtime.AddFormatArgumentToTool({{$tool_name}}Tool)
+  {{- end }}
+
+  // Show the defaults of runtime.WithInputDefaults in the schema
+  {{$tool_name}}Tool, err = runtime.AddInputDefaultsToTool({{$tool_name}}Tool, (&{{$tool_val.RequestType}}{}).ProtoReflect().Descriptor(), config.InputDefaults[{{$tool_name}}Tool.Name])
+  if err != nil {
+    panic(err)
+  }
 
-    message := request.GetArguments()
+  // Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+  runtime.NotifyRegister(&{{$tool_name}}Tool, config.OnRegister)
+
+  // Fail fast on a broken schema under runtime.WithStartupValidation
+  if err := runtime.ValidateToolSchema({{$tool_name}}Tool, config.StartupValidation); err != nil {
+    panic(err)
+  }
+  {{- if $tool_val.ResultTemplate }}
+
+  {{$tool_name}}ResultTemplate, err := runtime.ParseResultTemplate({{ printf "%q" $tool_val.FullMethod }}, {{ printf "%q" $tool_val.ResultTemplate }})
+  if err != nil {
+    panic(err)
+  }
+  {{- end }}
+  {{- if index $.ValidateTools $key }}
+
+  // Let the validation tool check arguments against the final schema
+  validationTargets = append(validationTargets, runtime.ValidationTarget{
+    Tool: {{$tool_name}}Tool,
+    Parse: func(args map[string]interface{}) error {
+      _, err := parse{{$key | capitalizeFirst}}{{$tool_name}}Args(args, config)
+      return err
+    },
+  })
+  {{- end }}
 
-    // Normalize JSON strings for object fields (including oneOf's).
-    _ = {{$key}}NormalizeTopLevelJSONStrings(message, {{$tool_name}}ToolDef.JSONSchema)
+  {{$tool_name}}Handler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+    // See the values of runtime.WithBaseContext behind those of the request
+    ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
+    {{- if $tool_val.Tool.Scopes }}
 
-    // Transform oneOf discriminated unions back to protobuf format
-    {{$key}}TransformOneOfFields(message)
+    // Authorize the caller under runtime.WithScopeChecker
+    if err := runtime.CheckScopes(ctx, {{$tool_name}}ToolDef.Scopes, config.ScopeChecker); err != nil {
+      return runtime.HandleError(err)
+    }
+    {{- end }}
+    {{- if not $tool_val.StreamResource }}
 
-    // Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-    runtime.AdjustZeroBasedPaginationFields(message, {{$key | capitalizeFirst}}_{{$tool_name}}ZeroBasedPaginationPaths)
+    // Honor a per-call "__format" override of the response format
+    useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+    if err != nil {
+      return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+    }
+    {{- end }}
 
     // Extract extra properties if configured
     for _, prop := range config.ExtraProperties {
@@ -411,38 +824,303 @@ func ForwardTo{{$key}}Client(s *mcpserver.MCPServer, client {{$key}}Client, opts
       }
     }
 
-    marshaled, err := json.Marshal(message)
+    // Build the typed request from the arguments
+    req, err := parse{{$key | capitalizeFirst}}{{$tool_name}}Args(message, config)
+    if err != nil {
+      return runtime.HandleError(err)
+    }
+
+    // Let request interceptors inspect, amend or reject the typed request
+    if err := runtime.InterceptRequest(ctx, {{ printf "%q" $tool_val.FullMethod }}, req, config.RequestInterceptors); err != nil {
+      return runtime.HandleError(err)
+    }
+
+    // Pick the client of this method under runtime.WithMethodClients
+    methodClient, err := runtime.MethodClient({{$key | capitalizeFirst}}_{{$tool_name}}FullMethod, client, config.MethodClients)
+    if err != nil {
+      return runtime.HandleError(err)
+    }
+
+    // Resolve the client of this call under runtime.WithSessionScopedClient
+    callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+    if err != nil {
+      return runtime.HandleError(err)
+    }
+
+    // Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+    release, err := limiter.Acquire(ctx)
+    if err != nil {
+      return runtime.HandleCallError(ctx, err, true)
+    }
+    {{- if $tool_val.StreamResource }}
+
+    // Open the stream apart from the MCP call, which returns before the stream
+    // ends, bounded by the method timeout, or else runtime.WithCallTimeout
+    streamCtx, cancelStream := context.WithCancel(context.WithoutCancel(ctx))
+    streamCtx, cancelTimeout := runtime.CallContext(streamCtx, {{$tool_name}}ToolDef.Timeout, config.CallTimeout)
+    closeStream := func() {
+      cancelTimeout()
+      cancelStream()
+      release()
+    }
+    stream, err := callClient.{{$tool_name}}(streamCtx, req)
+    if err != nil {
+      closeStream()
+      return runtime.HandleCallErrorWithProtocolCodes(ctx, err, {{$tool_name}}ToolDef.RetrySafe(), config.ProtocolErrorCodes)
+    }
+
+    // Publish its messages as a resource of the calling session. The stream
+    // keeps its concurrency slot until it ends
+    return runtime.StreamToResource(streamCtx, closeStream, s, {{ printf "%q" $tool_val.FullMethod }}, stream.Recv, config.ResponseTransformers), nil
+    {{- else }}
+    defer release()
+
+    // Bound the call by the method timeout, or else runtime.WithCallTimeout
+    ctx, cancel := runtime.CallContext(ctx, {{$tool_name}}ToolDef.Timeout, config.CallTimeout)
+    defer cancel()
+
+    resp, err := callClient.{{$tool_name}}(ctx, req)
+    if err != nil {
+      // Report an interruption by the MCP client apart from backend errors,
+      // and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+      return runtime.HandleCallErrorWithProtocolCodes(ctx, err, {{$tool_name}}ToolDef.RetrySafe(), config.ProtocolErrorCodes)
+    }
+
+    // Apply response transformers (redaction, enrichment) if configured
+    transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+    if err != nil {
+      return runtime.HandleError(err)
+    }
+
+    marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
     if err != nil {
       return nil, err
     }
 
-    if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+    // Summarize bytes values over the configured inline limit
+    marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+    if err != nil {
       return nil, err
     }
 
-    resp, err := client.{{$tool_name}}(ctx, &req)
+    // Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+    allowedFields := config.ResponseFieldAllowlist[{{ printf "%q" $tool_val.FullMethod }}]
+    marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
     if err != nil {
-      return runtime.HandleError(err)
+      return nil, err
     }
 
-    marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+    // Reshape the result under runtime.WithToolResultPostProcessor
+    marshaled, err = runtime.PostProcessResult({{ printf "%q" $tool_val.FullMethod }}, marshaled, config.ResultPostProcessors)
     if err != nil {
       return nil, err
     }
+    {{- if $tool_val.SummaryField }}
+    if !runtime.FieldAllowed(allowedFields, {{ printf "%q" $tool_val.SummaryField }}) {
+      // Leave out the summary block along with its field
+      transformed = nil
+    }
+    {{- end }}
+    {{- if $tool_val.ResultTemplate }}
 
-    // Optionally compress to TOON format if configured
-    if config.UseToonCompression {
+    // Render the result with the (mcp.options.tool) result_template, in place
+    // of the JSON and of TOON
+    marshaled, err = runtime.RenderResultTemplate({{$tool_name}}ResultTemplate, marshaled)
+    if err != nil {
+      return runtime.HandleError(err)
+    }
+    useToon = false
+    {{- end }}
+
+    // Optionally compress to TOON format if configured or requested
+    if useToon {
       if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
+        {{- if $tool_val.SummaryField }}
+        return runtime.NewSummaryResult(transformed, {{ printf "%q" $tool_val.SummaryField }}, toonData), nil
+        {{- else }}
         return mcp.NewToolResultText(toonData), nil
+        {{- end }}
       }
       // Fall back to JSON if TOON compression fails
     }
+    {{- if $tool_val.SummaryField }}
+
+    // Lead with the summary field, followed by the full response
+    {{- if $tool_val.ContentType }}
+    return runtime.WithContentType(runtime.NewSummaryResult(transformed, {{ printf "%q" $tool_val.SummaryField }}, string(marshaled)), {{ printf "%q" $tool_val.ContentType }}), nil
+    {{- else }}
+    return runtime.NewSummaryResult(transformed, {{ printf "%q" $tool_val.SummaryField }}, string(marshaled)), nil
+    {{- end }}
+    {{- else if $tool_val.ContentType }}
+
+    // Mark the media type of (mcp.options.tool) result_content_type
+    return runtime.WithContentType(mcp.NewToolResultText(string(marshaled)), {{ printf "%q" $tool_val.ContentType }}), nil
+    {{- else }}
 
     return mcp.NewToolResultText(string(marshaled)), nil
+    {{- end }}
+    {{- end }}
+  }
+
+  // Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+  {{$tool_name}}Handler = runtime.RecoverPanics({{$tool_name}}Handler, config.PanicRecovery, config.PanicStackTrace)
+
+  // Report the sizes, duration and error of every call under runtime.WithMetrics
+  {{$tool_name}}Handler = runtime.RecordMetrics({{$tool_name}}Handler, {{ printf "%q" $tool_val.FullMethod }}, config.Metrics)
+
+  {{- if $tool_val.StreamResource }}
+
+  // Serve the resources its streams are published under
+  runtime.AddStreamResourceTemplate(s)
+  {{- end }}
+
+  s.AddTool({{$tool_name}}Tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+    // Reject calls over the rate of runtime.WithRateLimit
+    if err := rateLimiter.Allow(ctx, {{$tool_name}}Tool.Name); err != nil {
+      return runtime.HandleError(err)
+    }
+    return {{$tool_name}}Handler(ctx, request.GetArguments())
+  })
+  {{- if $tool_val.BatchTool }}
+
+  {{$tool_name}}BatchToolDef := runtime.OverrideToolSchema({{$key | capitalizeFirst}}_{{$tool_name}}BatchTool, {{ printf "%q" $tool_val.BatchToolKey }}, config.ToolSchemaOverrides)
+  {{$tool_name}}BatchTool := mcp.Tool{
+    Name:        toolNames[{{ printf "%q" $tool_val.BatchToolKey }}],
+    Description: runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, {{ printf "%q" $tool_val.FullMethod }}, {{$tool_name}}BatchToolDef.Description),
+    RawInputSchema: json.RawMessage({{$tool_name}}BatchToolDef.JSONSchema),
+    {{- if or $tool_val.Tool.Package $tool_val.Tool.Version $tool_val.Tool.Method }}
+    Meta:           runtime.ToolMeta({{$tool_name}}BatchToolDef),
+    {{- end }}
+    {{- if $tool_val.BatchTool.HasToolAnnotations }}
+    Annotations: mcp.ToolAnnotation{
+      Title:           {{$tool_name}}BatchToolDef.Title,
+      ReadOnlyHint:    {{$tool_name}}BatchToolDef.ReadOnly,
+      DestructiveHint: {{$tool_name}}BatchToolDef.Destructive,
+      IdempotentHint:  {{$tool_name}}BatchToolDef.Idempotent,
+      OpenWorldHint:   {{$tool_name}}BatchToolDef.OpenWorld,
+    },
+    {{- end }}
+  }
+
+  // Its requests are filled with the defaults of the single tool
+  {{$tool_name}}BatchTool, err = runtime.AddInputDefaultsToBatchTool({{$tool_name}}BatchTool, (&{{$tool_val.RequestType}}{}).ProtoReflect().Descriptor(), config.InputDefaults[{{$tool_name}}Tool.Name])
+  if err != nil {
+    panic(err)
+  }
+
+  runtime.NotifyRegister(&{{$tool_name}}BatchTool, config.OnRegister)
+
+  if err := runtime.ValidateToolSchema({{$tool_name}}BatchTool, config.StartupValidation); err != nil {
+    panic(err)
+  }
+
+  // Forward each request separately, reporting failures per request. Each
+  // request counts against the rate limit of the single tool
+  s.AddTool({{$tool_name}}BatchTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+    if err := runtime.CheckRequestSize(request.GetArguments(), config.MaxRequestBytes); err != nil {
+      return runtime.HandleError(err)
+    }
+    return runtime.RunBatch(ctx, request.GetArguments(), config.BatchConcurrency, func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+      if err := rateLimiter.Allow(ctx, {{$tool_name}}Tool.Name); err != nil {
+        return runtime.HandleError(err)
+      }
+      return {{$tool_name}}Handler(ctx, message)
+    })
   })
   {{- end }}
+  {{- if $tool_val.RawTool }}
+
+  {{$tool_name}}RawToolDef := runtime.OverrideToolSchema({{$key | capitalizeFirst}}_{{$tool_name}}RawTool, {{ printf "%q" $tool_val.RawToolKey }}, config.ToolSchemaOverrides)
+  {{$tool_name}}RawTool := mcp.Tool{
+    Name:        toolNames[{{ printf "%q" $tool_val.RawToolKey }}],
+    Description: runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, {{ printf "%q" $tool_val.FullMethod }}, {{$tool_name}}RawToolDef.Description),
+    RawInputSchema: json.RawMessage({{$tool_name}}RawToolDef.JSONSchema),
+    {{- if or $tool_val.Tool.Package $tool_val.Tool.Version $tool_val.Tool.Method }}
+    Meta:           runtime.ToolMeta({{$tool_name}}RawToolDef),
+    {{- end }}
+    {{- if $tool_val.RawTool.HasToolAnnotations }}
+    Annotations: mcp.ToolAnnotation{
+      Title:           {{$tool_name}}RawToolDef.Title,
+      ReadOnlyHint:    {{$tool_name}}RawToolDef.ReadOnly,
+      DestructiveHint: {{$tool_name}}RawToolDef.Destructive,
+      IdempotentHint:  {{$tool_name}}RawToolDef.Idempotent,
+      OpenWorldHint:   {{$tool_name}}RawToolDef.OpenWorld,
+    },
+    {{- end }}
+  }
+
+  runtime.NotifyRegister(&{{$tool_name}}RawTool, config.OnRegister)
+
+  if err := runtime.ValidateToolSchema({{$tool_name}}RawTool, config.StartupValidation); err != nil {
+    panic(err)
+  }
+
+  // Decode the arguments passed as one JSON string and run them as a call
+  // of the single tool, counted against its rate limit
+  s.AddTool({{$tool_name}}RawTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+    if err := rateLimiter.Allow(ctx, {{$tool_name}}Tool.Name); err != nil {
+      return runtime.HandleError(err)
+    }
+    message, err := runtime.RawArguments(request.GetArguments())
+    if err != nil {
+      return runtime.HandleError(err)
+    }
+    return {{$tool_name}}Handler(ctx, message)
+  })
+  {{- end }}
+  {{- end }}
+  {{- with index $.ValidateTools $key }}
+
+  // Check arguments for the tools above without calling them, under validate_tool
+  validationTool, validationHandler, err := runtime.NewValidationTool(toolNames[{{ printf "%q" .Key }}], {{ printf "%q" .Service }}, validationTargets)
+  if err != nil {
+    panic(err)
+  }
+  runtime.NotifyRegister(&validationTool, config.OnRegister)
+  s.AddTool(validationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+    if err := rateLimiter.Allow(ctx, validationTool.Name); err != nil {
+      return runtime.HandleError(err)
+    }
+    return validationHandler(ctx, request)
+  })
+  {{- end }}
+}
+{{- if not $.SkipRegister }}
+
+// {{$key}}InProcessServer is the server side of {{$key}}. Every grpc-go
+// {{$key}}Server implementation satisfies it.
+type {{$key}}InProcessServer interface {
+  {{- range $methodName, $tool := $val }}
+  {{- if not $tool.StreamResource }}
+  {{$methodName}}(ctx context.Context, req *{{$tool.RequestType}}) (*{{$tool.ResponseType}}, error)
+  {{- end }}
+  {{- end }}
+}
+
+// inProcess{{$key}}Client implements {{$key}}Client by calling a
+// {{$key}}InProcessServer directly. Call options have no effect.
+type inProcess{{$key}}Client struct {
+  impl {{$key}}InProcessServer
+}
+{{ range $methodName, $tool := $val }}
+{{- if $tool.StreamResource }}
+func (c inProcess{{$key}}Client) {{$methodName}}(context.Context, *{{$tool.RequestType}}, ...grpc.CallOption) ({{ template "result" $tool }}, error) {
+  return nil, status.Error(codes.Unimplemented, "streaming method {{$methodName}} is not served in-process")
+}
+{{- else }}
+func (c inProcess{{$key}}Client) {{$methodName}}(ctx context.Context, req *{{$tool.RequestType}}, _ ...grpc.CallOption) (*{{$tool.ResponseType}}, error) {
+  return c.impl.{{$methodName}}(ctx, req)
+}
+{{- end }}
+{{ end }}
+// RegisterInProcess{{$key}}Server registers impl so that MCP calls reach it
+// in-process, through the same pipeline as {{ $.ForwardFunc $key }} but
+// without a gRPC connection. gRPC interceptors do not run.
+func RegisterInProcess{{$key}}Server(s *mcpserver.MCPServer, impl {{$key}}InProcessServer, opts ...runtime.Option) {
+  {{ $.ForwardFunc $key }}(s, inProcess{{$key}}Client{impl: impl}, opts...)
 }
 {{- end }}
+{{- end }}
 
 
 `
@@ -453,6 +1131,31 @@ type TplParams struct {
 	GoPackage   string
 	Tools       map[string]SimpleTool
 	Services    map[string]map[string]MethodInfo
+	// BatchTools holds the batch tools of methods annotated with
+	// (mcp.options.tool) batch, keyed like Tools.
+	BatchTools map[string]SimpleTool
+	// RawTools holds the raw tools of methods annotated with
+	// (mcp.options.tool) raw, or of every method under raw_tools, keyed like
+	// Tools.
+	RawTools map[string]SimpleTool
+	// GenerateHandlers emits the Unimplemented/Mock client implementations.
+	GenerateHandlers bool
+	// SkipForward unexports ForwardTo<Service>Client, leaving it to
+	// RegisterInProcess<Service>Server; SkipRegister leaves out the latter.
+	SkipForward  bool
+	SkipRegister bool
+	// UnixTimestamps makes handlers convert Timestamp fields sent as Unix
+	// seconds before unmarshaling.
+	UnixTimestamps bool
+	// OneOfKeySuffix is the suffix of oneof wrapper properties under the
+	// oneof_key option, or "" for the runtime default.
+	OneOfKeySuffix string
+	// ValidateTools holds the validation tools of services under
+	// validate_tool, keyed like Services.
+	ValidateTools map[string]*ValidateTool
+	// Imports are the message packages the file refers to, under the
+	// aliases RequestType and ResponseType use.
+	Imports []GoImport
 }
 
 // SimpleTool represents the generated tool definition
@@ -475,6 +1178,49 @@ type SimpleTool struct {
 	// protobuf field names. The runtime decrements each value by 1 before
 	// forwarding the request to gRPC.
 	ZeroBasedPaginationPaths [][]string
+
+	// ConstFields lists the fields pinned by a protovalidate const rule. The
+	// runtime fills in the ones the caller omitted.
+	ConstFields []ConstField
+
+	// MapPairLimits lists the map fields with a protovalidate min_pairs or
+	// max_pairs rule, checked by the runtime under strict validation.
+	MapPairLimits []MapPairLimit
+
+	// OneOfDiscriminators lists the (mcp.options.oneof_discriminator) values
+	// the runtime maps back to their oneof fields.
+	OneOfDiscriminators []OneOfDiscriminator
+
+	// Timeout is the deadline of the forwarded call from the
+	// (mcp.options.tool) timeout, or 0 when not set.
+	Timeout time.Duration
+
+	// Scopes are the (mcp.options.tool) scopes, checked by the runtime
+	// before the arguments are processed.
+	Scopes []string
+	// Deprecated is set for a method marked [deprecated = true].
+	Deprecated bool
+
+	// Package and Version are the proto package and service version stamped
+	// into the _meta of the tool, or empty when it has none; see
+	// toolProvenance.
+	Package string
+	Version string
+	// Method is the gRPC method path stamped into the _meta of the tool
+	// under grpc_method, or empty.
+	Method string
+
+	// FlatFields lists the message fields flattened to the top level of the
+	// input schema under flat_args, nested again by the runtime.
+	FlatFields []FlatField
+
+	// DateStrings is set when the request has a google.type.Date field, sent
+	// as a date string that the runtime converts back before unmarshaling.
+	DateStrings bool
+
+	// OneOfCollections is set when the request has a oneof variant whose
+	// message holds only a repeated or map field; see hasOneOfCollection.
+	OneOfCollections bool
 }
 
 // HasToolAnnotations reports whether the method carried any
@@ -488,10 +1234,41 @@ func (t SimpleTool) HasToolAnnotations() bool {
 type MethodInfo struct {
 	RequestType  string
 	ResponseType string
+	// FullMethod is the fully-qualified RPC method name, the key used by
+	// runtime.WithToolNameOverride.
+	FullMethod string
 
 	// Tool is the tool generated for this method; the registration part of
 	// the template reads its metadata.
 	Tool SimpleTool
+	// BatchTool is the companion batch tool, or nil when the method is not
+	// annotated with (mcp.options.tool) batch.
+	BatchTool *SimpleTool
+	// RawTool is the companion raw tool, or nil when the method has neither
+	// (mcp.options.tool) raw nor the raw_tools option.
+	RawTool *SimpleTool
+	// SummaryField is the (mcp.options.summary) field of the response, whose
+	// text leads the tool result, or "" when there is none.
+	SummaryField string
+	// StreamResource is set for a server-streaming method annotated with
+	// (mcp.options.tool) stream_resource; see runtime.StreamToResource.
+	StreamResource bool
+	// ContentType is the (mcp.options.tool) result_content_type stamped on
+	// the result, or "" to leave it unmarked; see runtime.WithContentType.
+	ContentType string
+	// ResultTemplate is the (mcp.options.tool) result_template rendering the
+	// result, or "" for the JSON of the response.
+	ResultTemplate string
+}
+
+// BatchToolKey is the runtime.WithToolNameOverride key of the batch tool.
+func (m MethodInfo) BatchToolKey() string {
+	return m.FullMethod + "#batch"
+}
+
+// RawToolKey is the runtime.WithToolNameOverride key of the raw tool.
+func (m MethodInfo) RawToolKey() string {
+	return m.FullMethod + "#raw"
 }
 
 func kindToType(kind protoreflect.Kind) string {
@@ -518,17 +1295,54 @@ func kindToType(kind protoreflect.Kind) string {
 }
 
 func isFieldRequired(fd protoreflect.FieldDescriptor) bool {
-	if proto.HasExtension(fd.Options(), annotations.E_FieldBehavior) {
-		behaviors := proto.GetExtension(fd.Options(), annotations.E_FieldBehavior).([]annotations.FieldBehavior)
-		for _, behavior := range behaviors {
-			if behavior == annotations.FieldBehavior_REQUIRED {
-				return true
-			}
+	return hasFieldBehavior(fd, annotations.FieldBehavior_REQUIRED)
+}
+
+// hasFieldBehavior reports whether fd carries the given google.api.field_behavior.
+func hasFieldBehavior(fd protoreflect.FieldDescriptor, want annotations.FieldBehavior) bool {
+	behaviors, _, _ := getExtension[[]annotations.FieldBehavior](fd, annotations.E_FieldBehavior)
+	for _, behavior := range behaviors {
+		if behavior == want {
+			return true
 		}
 	}
 	return false
 }
 
+// hasExplicitPresence reports whether fd is optional in the sense of
+// optional_keyword_support. proto3 message fields always track presence, so
+// there only the optional keyword counts; everywhere else, including every
+// field of an editions file, presence is what the features resolve to.
+func hasExplicitPresence(fd protoreflect.FieldDescriptor) bool {
+	if fd.Message() != nil && fd.ParentFile().Syntax() == protoreflect.Proto3 {
+		return fd.HasOptionalKeyword()
+	}
+	return fd.HasPresence()
+}
+
+// schemaDirection tells the schema generator whether a message is sent to the
+// server (a request) or returned by it (a response).
+type schemaDirection int
+
+const (
+	directionInput schemaDirection = iota
+	directionOutput
+)
+
+// fieldInDirection reports whether fd belongs in a schema for dir: OUTPUT_ONLY
+// fields are never set by the caller, and INPUT_ONLY fields are never returned
+// by the server. With markFieldBehavior such fields are still emitted, but
+// never as required.
+func fieldInDirection(fd protoreflect.FieldDescriptor, dir schemaDirection) bool {
+	switch dir {
+	case directionInput:
+		return !hasFieldBehavior(fd, annotations.FieldBehavior_OUTPUT_ONLY)
+	case directionOutput:
+		return !hasFieldBehavior(fd, annotations.FieldBehavior_INPUT_ONLY)
+	}
+	return true
+}
+
 // isFieldRequiredWithOptionalSupport checks if a field is required considering optional keyword support
 func (g *FileGenerator) isFieldRequiredWithOptionalSupport(fd protoreflect.FieldDescriptor) bool {
 	// Repeated fields are never required (they can be empty arrays)
@@ -546,22 +1360,25 @@ func (g *FileGenerator) isFieldRequiredWithOptionalSupport(fd protoreflect.Field
 		return true
 	}
 
-	// If optional keyword support is enabled
-	if g.optionalKeywordSupport {
-		// Check if field is marked as optional (proto3 optional or part of synthetic oneof)
-		if fd.HasOptionalKeyword() {
-			return false
-		}
-		// In optional keyword support mode, fields are required by default unless marked optional
+	// proto2 required and editions LEGACY_REQUIRED fields must be set, or
+	// protojson rejects the message.
+	if fd.Cardinality() == protoreflect.Required {
 		return true
 	}
 
+	// If optional keyword support is enabled, fields are required by default
+	// unless they track presence (proto3 optional, proto2 optional, or
+	// editions EXPLICIT presence).
+	if g.optionalKeywordSupport {
+		return !hasExplicitPresence(fd)
+	}
+
 	// Default behavior: fields are not required unless explicitly marked
 	return false
 }
 
 // messageSchemaWithDefs generates a top-level schema with $defs for nested message types
-func (g *FileGenerator) messageSchemaWithDefs(md protoreflect.MessageDescriptor, protoMsg *protogen.Message) map[string]any {
+func (g *FileGenerator) messageSchemaWithDefs(md protoreflect.MessageDescriptor, protoMsg *protogen.Message, dir schemaDirection) map[string]any {
 	defs := make(map[string]any)
 	visiting := make(map[string]bool) // Track types being processed to prevent cycles
 	required := make([]string, 0)
@@ -576,6 +1393,9 @@ func (g *FileGenerator) messageSchemaWithDefs(md protoreflect.MessageDescriptor,
 	// Process all fields in the message descriptor
 	for i := 0; i < md.Fields().Len(); i++ {
 		nestedFd := md.Fields().Get(i)
+		if !g.markFieldBehavior && !fieldInDirection(nestedFd, dir) {
+			continue
+		}
 		name := string(nestedFd.Name())
 
 		// Get field comment if available
@@ -589,12 +1409,12 @@ func (g *FileGenerator) messageSchemaWithDefs(md protoreflect.MessageDescriptor,
 			oneOfName := string(oneof.Name())
 			g.processOneOfField(nestedFd, comment, name, oneOfName, oneOf,
 				func(fd protoreflect.FieldDescriptor, c string) map[string]any {
-					return g.getTypeWithDefsAndComment(fd, c, defs, visiting)
+					return g.getTypeWithDefsAndComment(fd, c, dir, defs, visiting)
 				})
 		} else {
 			// If not part of a oneof, handle as a normal field
-			normalFields[name] = g.getTypeWithDefsAndComment(nestedFd, comment, defs, visiting)
-			if g.isFieldRequiredWithOptionalSupport(nestedFd) {
+			normalFields[name] = g.getTypeWithDefsAndComment(nestedFd, comment, dir, defs, visiting)
+			if fieldInDirection(nestedFd, dir) && g.isFieldRequiredWithOptionalSupport(nestedFd) {
 				required = append(required, name)
 			}
 		}
@@ -602,7 +1422,7 @@ func (g *FileGenerator) messageSchemaWithDefs(md protoreflect.MessageDescriptor,
 
 	// Add oneOf constraints if any exist
 	if len(oneOf) > 0 {
-		required = g.addOneOfConstraints(normalFields, oneOf, required)
+		required = g.addOneOfConstraints(md, normalFields, oneOf, required)
 	}
 
 	// Build final schema
@@ -613,6 +1433,9 @@ func (g *FileGenerator) messageSchemaWithDefs(md protoreflect.MessageDescriptor,
 		"required":   required,
 	}
 
+	applyMessageOptions(md, result)
+	applyProtovalidateMessageRules(md, result)
+
 	// Add $defs if any were collected
 	if len(defs) > 0 {
 		result["$defs"] = defs
@@ -622,7 +1445,8 @@ func (g *FileGenerator) messageSchemaWithDefs(md protoreflect.MessageDescriptor,
 }
 
 // messageSchemaWithDefsInternal generates schema with cycle detection support
-func (g *FileGenerator) messageSchemaWithDefsInternal(md protoreflect.MessageDescriptor, protoMsg *protogen.Message, defs map[string]any, visiting map[string]bool) map[string]any {
+func (g *FileGenerator) messageSchemaWithDefsInternal(md protoreflect.MessageDescriptor, protoMsg *protogen.Message, dir schemaDirection, defs map[string]any, visiting map[string]bool) map[string]any {
+	md, protoMsg = g.resolveMessage(md, protoMsg)
 	required := make([]string, 0)
 	normalFields := make(map[string]any)
 	oneOf := make(map[string][]map[string]any)
@@ -631,6 +1455,9 @@ func (g *FileGenerator) messageSchemaWithDefsInternal(md protoreflect.MessageDes
 
 	for i := 0; i < md.Fields().Len(); i++ {
 		nestedFd := md.Fields().Get(i)
+		if !g.markFieldBehavior && !fieldInDirection(nestedFd, dir) {
+			continue
+		}
 		name := string(nestedFd.Name())
 
 		var comment string
@@ -642,18 +1469,18 @@ func (g *FileGenerator) messageSchemaWithDefsInternal(md protoreflect.MessageDes
 			oneOfName := string(oneof.Name())
 			g.processOneOfField(nestedFd, comment, name, oneOfName, oneOf,
 				func(fd protoreflect.FieldDescriptor, c string) map[string]any {
-					return g.getTypeWithDefsAndComment(fd, c, defs, visiting)
+					return g.getTypeWithDefsAndComment(fd, c, dir, defs, visiting)
 				})
 		} else {
-			normalFields[name] = g.getTypeWithDefsAndComment(nestedFd, comment, defs, visiting)
-			if g.isFieldRequiredWithOptionalSupport(nestedFd) {
+			normalFields[name] = g.getTypeWithDefsAndComment(nestedFd, comment, dir, defs, visiting)
+			if fieldInDirection(nestedFd, dir) && g.isFieldRequiredWithOptionalSupport(nestedFd) {
 				required = append(required, name)
 			}
 		}
 	}
 
 	if len(oneOf) > 0 {
-		required = g.addOneOfConstraints(normalFields, oneOf, required)
+		required = g.addOneOfConstraints(md, normalFields, oneOf, required)
 	}
 
 	result := map[string]any{
@@ -661,13 +1488,15 @@ func (g *FileGenerator) messageSchemaWithDefsInternal(md protoreflect.MessageDes
 		"properties": normalFields,
 		"required":   required,
 	}
+	applyMessageOptions(md, result)
+	applyProtovalidateMessageRules(md, result)
 
 	return result
 }
 
 // messageSchemaFromDescriptorWithDefs generates schema for nested messages with cycle detection
-func (g *FileGenerator) messageSchemaFromDescriptorWithDefs(md protoreflect.MessageDescriptor, protoMsg *protogen.Message, defs map[string]any, visiting map[string]bool) map[string]any {
-	return g.messageSchemaWithDefsInternal(md, protoMsg, defs, visiting)
+func (g *FileGenerator) messageSchemaFromDescriptorWithDefs(md protoreflect.MessageDescriptor, protoMsg *protogen.Message, dir schemaDirection, defs map[string]any, visiting map[string]bool) map[string]any {
+	return g.messageSchemaWithDefsInternal(md, protoMsg, dir, defs, visiting)
 }
 
 // processOneOfField handles the creation of oneOf schema variants with proper type assertions
@@ -685,6 +1514,7 @@ func (g *FileGenerator) processOneOfField(
 
 	// Create a discriminated union entry
 	fieldSchema := getSchemaFunc(nestedFd, comment)
+	discriminator := oneofDiscriminator(nestedFd)
 
 	// Check if the field schema is a $ref (for message types)
 	if _, isRef := fieldSchema["$ref"]; isRef {
@@ -693,13 +1523,13 @@ func (g *FileGenerator) processOneOfField(
 			name: fieldSchema, // Include the field with its $ref
 			"object_type": map[string]any{
 				"type":  "string",
-				"const": name,
+				"const": discriminator,
 			},
 		}
 
 		variant := map[string]any{
 			"type":       "object",
-			"title":      name,
+			"title":      discriminator,
 			"properties": props,
 			"required":   []string{"object_type", name},
 		}
@@ -714,12 +1544,12 @@ func (g *FileGenerator) processOneOfField(
 
 		props["object_type"] = map[string]any{
 			"type":  "string",
-			"const": name,
+			"const": discriminator,
 		}
 
 		variant := map[string]any{
 			"type":       "object",
-			"title":      name,
+			"title":      discriminator,
 			"properties": props,
 			"required":   []string{"object_type"},
 		}
@@ -739,13 +1569,13 @@ func (g *FileGenerator) processOneOfField(
 			name: fieldSchema, // Include the primitive field with its schema
 			"object_type": map[string]any{
 				"type":  "string",
-				"const": name,
+				"const": discriminator,
 			},
 		}
 
 		variant := map[string]any{
 			"type":       "object",
-			"title":      name,
+			"title":      discriminator,
 			"properties": props,
 			"required":   []string{"object_type", name},
 		}
@@ -755,12 +1585,20 @@ func (g *FileGenerator) processOneOfField(
 }
 
 // getTypeWithDefsAndComment generates a schema for a field with $defs collection
-func (g *FileGenerator) getTypeWithDefsAndComment(fd protoreflect.FieldDescriptor, comment string, defs map[string]any, visiting map[string]bool) map[string]any {
-	schema := g.getTypeWithDefs(fd, defs, visiting)
+func (g *FileGenerator) getTypeWithDefsAndComment(fd protoreflect.FieldDescriptor, comment string, dir schemaDirection, defs map[string]any, visiting map[string]bool) map[string]any {
+	schema := g.getTypeWithDefs(fd, dir, defs, visiting)
 
 	// Add description if comment is available and not empty
-	if trimmed := strings.TrimSpace(comment); trimmed != "" {
+	if trimmed := g.describeField(fd, strings.TrimSpace(comment)); trimmed != "" {
+		values, _ := schema["description"].(string)
 		schema["description"] = trimmed
+		if note := g.typeNote(fd, dir); note != "" && !fd.IsList() && !fd.IsMap() {
+			schema["description"] = trimmed + " (" + note + ")"
+		}
+		// Keep the enum value descriptions after the field comment.
+		if fd.Kind() == protoreflect.EnumKind && values != "" && !fd.IsList() && !fd.IsMap() {
+			schema["description"] = trimmed + "\n\n" + values
+		}
 	}
 
 	if isZeroBasedPagination(fd) {
@@ -768,6 +1606,26 @@ func (g *FileGenerator) getTypeWithDefsAndComment(fd protoreflect.FieldDescripto
 		schema["description"] = adjustDescriptionForOneBased(schema["description"])
 	}
 
+	if g.markFieldBehavior {
+		if hasFieldBehavior(fd, annotations.FieldBehavior_OUTPUT_ONLY) {
+			schema["readOnly"] = true
+		}
+		if hasFieldBehavior(fd, annotations.FieldBehavior_INPUT_ONLY) {
+			schema["writeOnly"] = true
+		}
+	}
+
+	if g.uiHints {
+		schema["x-order"] = fd.Index()
+		if isFieldDeprecated(fd) {
+			schema["deprecated"] = true
+		}
+	}
+
+	if g.fieldPathComments {
+		schema["$comment"] = fmt.Sprintf("%s = %d", fd.FullName(), fd.Number())
+	}
+
 	return schema
 }
 
@@ -790,15 +1648,135 @@ func isZeroBasedPagination(fd protoreflect.FieldDescriptor) bool {
 	if !isIntegerKind(fd.Kind()) {
 		return false
 	}
-	opts := fd.Options()
-	if opts == nil {
-		return false
+	v, _, _ := getExtension[bool](fd, mcpoptions.E_ZeroBasedPagination)
+	return v
+}
+
+// typeNote returns the note explaining the JSON encoding of fd's type in
+// schemas of direction dir, or "" when it needs none.
+func (g *FileGenerator) typeNote(fd protoreflect.FieldDescriptor, dir schemaDirection) string {
+	switch {
+	case is64BitIntegerKind(fd.Kind()):
+		return g.int64Note
+	case g.unixSecondsIn(dir) && fd.Message() != nil && fd.Message().FullName() == timestampFullName:
+		return unixSecondsNote
 	}
-	if !proto.HasExtension(opts, mcpoptions.E_ZeroBasedPagination) {
-		return false
+	return ""
+}
+
+// unixSecondsIn reports whether timestamps are described as integer seconds
+// in schemas of direction dir. Responses are written by protojson as RFC 3339
+// strings, so only input schemas use seconds.
+func (g *FileGenerator) unixSecondsIn(dir schemaDirection) bool {
+	return g.timestampFormat == TimestampFormatUnixSeconds && dir == directionInput
+}
+
+// timestampSchema returns the schema of a google.protobuf.Timestamp field in
+// direction dir. A singular required field cannot be null, and neither can a
+// singular field with optional_fields=omit; everything else keeps the
+// nullable schema protojson accepts.
+func (g *FileGenerator) timestampSchema(fd protoreflect.FieldDescriptor, dir schemaDirection) map[string]any {
+	schema := map[string]any{"type": "string", "format": "date-time"}
+	if g.unixSecondsIn(dir) {
+		schema = map[string]any{"type": "integer", "description": unixSecondsNote}
+	}
+	if !isSingularField(fd) || (!g.isFieldRequiredWithOptionalSupport(fd) && g.optionalFields != OptionalFieldsOmit) {
+		schema["type"] = []string{schema["type"].(string), "null"}
+	}
+	if g.nullableStyle == NullableStyleKeyword {
+		nullableKeyword(schema)
+	}
+	return schema
+}
+
+// isSingularField reports whether fd is neither repeated nor a map value.
+func isSingularField(fd protoreflect.FieldDescriptor) bool {
+	return !fd.IsList() && !fd.ContainingMessage().IsMapEntry()
+}
+
+// dropNull removes the null type and the nullable marker from the schema of
+// a well-known type, for singular fields with optional_fields=omit.
+// google.protobuf.Value keeps null, which is one of its values rather than
+// the absence of one.
+func dropNull(fullName string, schema map[string]any) {
+	if fullName == "google.protobuf.Value" {
+		return
+	}
+	delete(schema, "nullable")
+	types, ok := schema["type"].([]string)
+	if !ok {
+		return
+	}
+	kept := make([]string, 0, len(types))
+	for _, t := range types {
+		if t != "null" {
+			kept = append(kept, t)
+		}
+	}
+	if len(kept) == 1 {
+		schema["type"] = kept[0]
+	} else {
+		schema["type"] = kept
+	}
+}
+
+// nullableKeyword rewrites every type array with "null" in schema and its
+// subschemas to the OpenAPI 3.0 form: the remaining type with "nullable":
+// true. A type array with several other types, which OpenAPI 3.0 cannot
+// express, is dropped so that the schema accepts any value.
+func nullableKeyword(schema map[string]any) {
+	if types, ok := schema["type"].([]string); ok {
+		kept := make([]string, 0, len(types))
+		for _, t := range types {
+			if t != "null" {
+				kept = append(kept, t)
+			}
+		}
+		if len(kept) < len(types) {
+			schema["nullable"] = true
+			if len(kept) == 1 {
+				schema["type"] = kept[0]
+			} else {
+				delete(schema, "type")
+			}
+		}
+	}
+	for _, key := range []string{"items", "additionalProperties"} {
+		if sub, ok := schema[key].(map[string]any); ok {
+			nullableKeyword(sub)
+		}
+	}
+	if props, ok := schema["properties"].(map[string]any); ok {
+		for _, prop := range props {
+			if sub, ok := prop.(map[string]any); ok {
+				nullableKeyword(sub)
+			}
+		}
 	}
-	v, ok := proto.GetExtension(opts, mcpoptions.E_ZeroBasedPagination).(bool)
-	return ok && v
+}
+
+// is64BitIntegerKind reports whether kind is a 64-bit integer kind, which
+// protojson encodes as a JSON string.
+func is64BitIntegerKind(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return true
+	}
+	return false
+}
+
+// int64Format returns the OpenAPI format of the 64-bit integer kind, "int64"
+// or "uint64", so consumers keep the width and signedness of values that
+// protojson may encode as strings. It returns "" for other kinds.
+func int64Format(kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64"
+	}
+	return ""
 }
 
 // isIntegerKind reports whether kind is one of the protobuf integer kinds
@@ -891,7 +1869,7 @@ func (g *FileGenerator) getType(fd protoreflect.FieldDescriptor) map[string]any
 	// Simplified version without $defs support
 	defs := make(map[string]any)
 	visiting := make(map[string]bool)
-	return g.getTypeWithDefs(fd, defs, visiting)
+	return g.getTypeWithDefs(fd, directionInput, defs, visiting)
 }
 
 // messageSchema generates a schema for a message without using $defs (for testing)
@@ -899,19 +1877,21 @@ func (g *FileGenerator) messageSchema(md protoreflect.MessageDescriptor) map[str
 	// Simplified version without $defs support
 	defs := make(map[string]any)
 	visiting := make(map[string]bool)
-	return g.messageSchemaWithDefsInternal(md, nil, defs, visiting)
+	return g.messageSchemaWithDefsInternal(md, nil, directionInput, defs, visiting)
 }
 
-// messageSchemaFromDescriptor generates a schema from descriptor without using $defs (for testing)
-func (g *FileGenerator) messageSchemaFromDescriptor(md protoreflect.MessageDescriptor, protoMsg *protogen.Message) map[string]any {
+// messageSchemaFromDescriptor generates a schema from descriptor without using
+// $defs (for testing). dir selects which field_behavior-restricted fields are
+// kept.
+func (g *FileGenerator) messageSchemaFromDescriptor(md protoreflect.MessageDescriptor, protoMsg *protogen.Message, dir schemaDirection) map[string]any {
 	// Simplified version without $defs support
 	defs := make(map[string]any)
 	visiting := make(map[string]bool)
-	return g.messageSchemaWithDefsInternal(md, protoMsg, defs, visiting)
+	return g.messageSchemaWithDefsInternal(md, protoMsg, dir, defs, visiting)
 }
 
 // getTypeWithDefs generates a schema for a field, using $ref for message types
-func (g *FileGenerator) getTypeWithDefs(fd protoreflect.FieldDescriptor, defs map[string]any, visiting map[string]bool) map[string]any {
+func (g *FileGenerator) getTypeWithDefs(fd protoreflect.FieldDescriptor, dir schemaDirection, defs map[string]any, visiting map[string]bool) map[string]any {
 	if fd.IsMap() {
 		keyType := fd.MapKey().Kind()
 		keyConstraints := map[string]any{"type": "string"}
@@ -927,13 +1907,29 @@ func (g *FileGenerator) getTypeWithDefs(fd protoreflect.FieldDescriptor, defs ma
 
 		// Get the schema for the map value type
 		mapValue := fd.MapValue()
-		valueSchema := g.getTypeWithDefs(mapValue, defs, visiting)
 
-		return map[string]any{
+		// map<_, google.protobuf.Value> is a free-form JSON object, just like a
+		// Struct: any JSON value is allowed per key.
+		if mapValue.Kind() == protoreflect.MessageKind && mapValue.Message().FullName() == "google.protobuf.Value" {
+			schema := map[string]any{
+				"type":                 "object",
+				"additionalProperties": true,
+				"description":          "represents a map of google.protobuf.Value, a JSON object whose values may be any JSON value (string, number, boolean, array, object, null).",
+			}
+			if applyProtovalidateMapRules(fd, schema, keyConstraints, nil) || keyType != protoreflect.StringKind {
+				schema["propertyNames"] = keyConstraints
+			}
+			return schema
+		}
+		valueSchema := g.getTypeWithDefs(mapValue, dir, defs, visiting)
+
+		schema := map[string]any{
 			"type":                 "object",
 			"propertyNames":        keyConstraints,
 			"additionalProperties": valueSchema,
 		}
+		applyProtovalidateMapRules(fd, schema, keyConstraints, valueSchema)
+		return schema
 	}
 
 	var schema map[string]any
@@ -944,10 +1940,33 @@ func (g *FileGenerator) getTypeWithDefs(fd protoreflect.FieldDescriptor, defs ma
 		fullName := string(md.FullName())
 
 		// Check if this is a well-known type
-		if wktSchema, ok := wellKnownTypeSchemas[fullName]; ok {
+		if fullName == timestampFullName {
+			schema = g.timestampSchema(fd, dir)
+		} else if wktSchema, ok := wellKnownTypeSchemas[fullName]; ok {
 			// Deep copy to avoid mutating the shared schema
 			schema = deepCopySchema(wktSchema)
+			if fullName == dateFullName && dir == directionOutput {
+				schema = deepCopySchema(dateOutputSchema)
+			}
+			if g.optionalFields == OptionalFieldsOmit && isSingularField(fd) {
+				dropNull(fullName, schema)
+			}
+			if g.nullableStyle == NullableStyleKeyword {
+				nullableKeyword(schema)
+			}
+			// checkStructValueSchemas reported invalid annotations already.
+			if values, _ := structValueSchema(fd); values != nil {
+				schema["additionalProperties"] = values
+			}
+		} else if rendering, _ := repeatedRendering(fd); rendering == mcpoptions.RepeatedRendering_REPEATED_RENDERING_SUMMARY {
+			g.noteUnmappedType(md)
+			schema = g.summarizedMessageSchema(md, dir)
+		} else if rendering == mcpoptions.RepeatedRendering_REPEATED_RENDERING_INLINE ||
+			(g.inlineMessages && rendering != mcpoptions.RepeatedRendering_REPEATED_RENDERING_REF) {
+			g.noteUnmappedType(md)
+			schema = g.inlineMessageSchema(md, dir, defs, visiting)
 		} else {
+			g.noteUnmappedType(md)
 			// Use simple name for the definition key
 			defName := string(md.Name())
 
@@ -964,9 +1983,9 @@ func (g *FileGenerator) getTypeWithDefs(fd protoreflect.FieldDescriptor, defs ma
 
 				// Generate the full schema for this message
 				if protoMsg, ok := g.messageMap[fullName]; ok {
-					defs[defName] = g.messageSchemaFromDescriptorWithDefs(md, protoMsg, defs, visiting)
+					defs[defName] = g.messageSchemaFromDescriptorWithDefs(md, protoMsg, dir, defs, visiting)
 				} else {
-					defs[defName] = g.messageSchemaWithDefsInternal(md, nil, defs, visiting)
+					defs[defName] = g.messageSchemaWithDefsInternal(md, nil, dir, defs, visiting)
 				}
 
 				// Unmark after processing
@@ -987,7 +2006,13 @@ func (g *FileGenerator) getTypeWithDefs(fd protoreflect.FieldDescriptor, defs ma
 		}
 
 	case protoreflect.EnumKind:
-		schema = g.getEnumSchema(fd.Enum())
+		if fd.Enum().FullName() == nullValueFullName {
+			schema = g.nullValueSchema()
+		} else if g.inlineMessages {
+			schema = g.enumSchemaRef(fd.Enum(), dir, defs)
+		} else {
+			schema = g.enumSchema(fd.Enum(), dir)
+		}
 
 	default:
 		schema = map[string]any{
@@ -997,9 +2022,25 @@ func (g *FileGenerator) getTypeWithDefs(fd protoreflect.FieldDescriptor, defs ma
 			schema["contentEncoding"] = "base64"
 			schema["format"] = "byte"
 		}
+		if format := int64Format(fd.Kind()); format != "" {
+			schema["format"] = format
+		}
+		applyProtovalidateStringFormat(fd, schema)
+		if g.int64Note != "" && is64BitIntegerKind(fd.Kind()) {
+			schema["description"] = g.int64Note
+		}
+	}
+
+	applyProtovalidateConst(fd, schema)
+	applyProtovalidateExamples(fd, schema)
+	if fd.Kind() == protoreflect.EnumKind && g.enumAsIntIn(dir) && fd.Enum().FullName() != nullValueFullName {
+		enumNamesToNumbers(fd.Enum(), schema)
 	}
 
 	// Handle repeated fields here, wrapping the actual schema in an array.
+	if positions, _ := tupleItems(fd); positions != nil {
+		return tupleSchema(schema, positions)
+	}
 	if fd.IsList() {
 		return map[string]any{
 			"type":  "array",
@@ -1009,6 +2050,53 @@ func (g *FileGenerator) getTypeWithDefs(fd protoreflect.FieldDescriptor, defs ma
 	return schema
 }
 
+// inlineMessageSchema returns the schema of md inline instead of as a $ref.
+// A recursive reference cannot be inlined, so it still points into $defs; the
+// definition is filled in once the outermost occurrence has been generated.
+func (g *FileGenerator) inlineMessageSchema(md protoreflect.MessageDescriptor, dir schemaDirection, defs map[string]any, visiting map[string]bool) map[string]any {
+	fullName := string(md.FullName())
+	defName := string(md.Name())
+
+	if visiting[fullName] {
+		if _, exists := defs[defName]; !exists {
+			// Placeholder, replaced below when the recursion unwinds.
+			defs[defName] = nil
+		}
+		return map[string]any{
+			"$ref": "#/$defs/" + defName,
+			"type": "object",
+		}
+	}
+
+	visiting[fullName] = true
+	schema := g.messageSchemaWithDefsInternal(md, g.messageMap[fullName], dir, defs, visiting)
+	delete(visiting, fullName)
+
+	if def, exists := defs[defName]; exists && def == nil {
+		defs[defName] = deepCopySchema(schema)
+	}
+	return schema
+}
+
+// enumSchemaRef factors the schema of ed into defs and returns a $ref to it.
+// Enums are the most repeated part of large schemas, so this keeps
+// inline_messages output compact. The definition is keyed by the full name of
+// ed, since enums nested in different messages often share a simple name.
+func (g *FileGenerator) enumSchemaRef(ed protoreflect.EnumDescriptor, dir schemaDirection, defs map[string]any) map[string]any {
+	defName := string(ed.FullName())
+	if _, exists := defs[defName]; !exists {
+		defs[defName] = g.enumSchema(ed, dir)
+	}
+	typ := "string"
+	if g.enumAsIntIn(dir) {
+		typ = "integer"
+	}
+	return map[string]any{
+		"$ref": "#/$defs/" + defName,
+		"type": typ,
+	}
+}
+
 // deepCopySchema creates a deep copy of a schema map to avoid mutation of shared schemas
 func deepCopySchema(schema map[string]any) map[string]any {
 	if schema == nil {
@@ -1079,11 +2167,46 @@ var (
 		"google.protobuf.FloatValue":  {"type": "number", "nullable": true},
 		"google.protobuf.Int32Value":  {"type": "integer", "nullable": true},
 		"google.protobuf.UInt32Value": {"type": "integer", "nullable": true},
-		"google.protobuf.Int64Value":  {"type": "integer", "nullable": true},
-		"google.protobuf.UInt64Value": {"type": "integer", "nullable": true},
+		"google.protobuf.Int64Value":  {"type": "integer", "format": "int64", "nullable": true},
+		"google.protobuf.UInt64Value": {"type": "integer", "format": "uint64", "nullable": true},
 		"google.protobuf.StringValue": {"type": "string", "nullable": true},
 		"google.protobuf.BoolValue":   {"type": "boolean", "nullable": true},
 		"google.protobuf.BytesValue":  {"type": "string", "format": "byte", "nullable": true},
+
+		// A Date is sent as an ISO 8601 date string, which the generated
+		// handler converts to the object protojson expects; responses keep
+		// the object, see dateOutputSchema.
+		dateFullName: {"type": []string{"string", "null"}, "format": "date"},
+		moneyFullName: {
+			"type": []string{"object", "null"},
+			"properties": map[string]any{
+				"currency_code": map[string]any{
+					"type":        "string",
+					"pattern":     "^[A-Z]{3}$",
+					"description": `ISO 4217 currency code, e.g. "USD".`,
+				},
+				"units": map[string]any{
+					"type":        "string",
+					"pattern":     "^-?[0-9]+$",
+					"description": `Whole units of the amount as a decimal string, e.g. "12" for 12.50.`,
+				},
+				"nanos": map[string]any{
+					"type":        "integer",
+					"minimum":     -999999999,
+					"maximum":     999999999,
+					"description": "Nano units of the amount, e.g. 500000000 for 12.50. Has the sign of units.",
+				},
+			},
+			"required": []string{"currency_code"},
+		},
+		latLngFullName: {
+			"type": []string{"object", "null"},
+			"properties": map[string]any{
+				"latitude":  map[string]any{"type": "number", "minimum": -90, "maximum": 90, "description": "Latitude in degrees."},
+				"longitude": map[string]any{"type": "number", "minimum": -180, "maximum": 180, "description": "Longitude in degrees."},
+			},
+			"required": []string{"latitude", "longitude"},
+		},
 	}
 )
 
@@ -1121,14 +2244,7 @@ var toolNameRe = regexp.MustCompile(toolNamePattern)
 // nil when it is absent. The proto getters are nil-safe, so callers may use
 // the result directly.
 func methodToolOptions(meth *protogen.Method) *mcpoptions.ToolOptions {
-	opts := meth.Desc.Options()
-	if opts == nil {
-		return nil
-	}
-	if !proto.HasExtension(opts, mcpoptions.E_Tool) {
-		return nil
-	}
-	t, _ := proto.GetExtension(opts, mcpoptions.E_Tool).(*mcpoptions.ToolOptions)
+	t, _, _ := getExtension[*mcpoptions.ToolOptions](meth.Desc, mcpoptions.E_Tool)
 	return t
 }
 
@@ -1158,16 +2274,66 @@ func (g *FileGenerator) resolveToolName(meth *protogen.Method, opts *mcpoptions.
 		name = MangleHeadIfTooLong(strings.ReplaceAll(string(meth.Desc.FullName()), ".", "_"), MaxToolNameLength)
 	}
 
+	if err := g.claimToolName(meth, name, annotated); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// claimToolName records that meth registers a tool called name, failing if
+// another method already claimed it.
+func (g *FileGenerator) claimToolName(meth *protogen.Method, name string, annotated bool) error {
 	if prev, dup := g.seenToolNames[name]; dup && prev.Method != meth.Desc.FullName() {
 		// A collision between two legacy autogenerated names keeps the
 		// historic silent behavior; any collision involving an annotated
 		// name is an error.
 		if annotated || prev.Annotated {
-			return "", fmt.Errorf("mcpgen: duplicate MCP tool name %q on %s and %s", name, prev.Method, meth.Desc.FullName())
+			return fmt.Errorf("mcpgen: duplicate MCP tool name %q on %s and %s", name, prev.Method, meth.Desc.FullName())
 		}
 	}
 	g.seenToolNames[name] = ToolNameEntry{Method: meth.Desc.FullName(), Annotated: annotated}
-	return name, nil
+	return nil
+}
+
+// toolDescription returns the description of the tool generated for meth: the
+// (mcp.options.tool) description when set, otherwise the method's leading
+// comment, behind the descriptionPrefix if one is configured.
+func (g *FileGenerator) toolDescription(meth *protogen.Method, opts *mcpoptions.ToolOptions) string {
+	d := methodDescription(meth, opts)
+	if isDeprecated(meth) {
+		d = strings.TrimRight(deprecatedMarker+" "+d, " ")
+	}
+	if g.descriptionPrefix == "" {
+		return d
+	}
+	prefix := strings.NewReplacer(
+		"{service}", string(meth.Parent.Desc.Name()),
+		"{method}", string(meth.Desc.Name()),
+	).Replace(g.descriptionPrefix)
+	if d == "" {
+		return strings.TrimSpace(prefix)
+	}
+	return prefix + d
+}
+
+// methodDescription returns the (mcp.options.tool) description of meth, or
+// else its leading comment.
+func methodDescription(meth *protogen.Method, opts *mcpoptions.ToolOptions) string {
+	if d := strings.TrimSpace(opts.GetDescription()); d != "" {
+		return d
+	}
+	return cleanComment(string(meth.Comments.Leading))
+}
+
+// schemaTitle sets the title of schema, the input schema of meth, to the
+// method name and its description to the methodDescription. Unlike the tool
+// description, it has neither the descriptionPrefix nor the deprecation
+// marker.
+func schemaTitle(meth *protogen.Method, opts *mcpoptions.ToolOptions, schema map[string]any) {
+	schema["title"] = string(meth.Desc.Name())
+	if d := strings.TrimSpace(methodDescription(meth, opts)); d != "" {
+		schema["description"] = d
+	}
 }
 
 // MangleHeadIfTooLong truncates and mangles long names to fit within maxLen
@@ -1315,6 +2481,12 @@ type GenerateConfig struct {
 	// PackageSuffix generates files into a sub-package of the package
 	// containing the base .pb.go files. Empty means the same package.
 	PackageSuffix string
+	// PackageName, when not empty, names the sub-package the files are
+	// generated into, instead of the base package name plus PackageSuffix.
+	PackageName string
+	// FileSuffix replaces GeneratedFilenameExtension as the suffix of the
+	// generated file names. It must end in ".go".
+	FileSuffix string
 	// OptionalKeywordSupport, when true, makes fields required by default
 	// unless marked optional in protobuf.
 	OptionalKeywordSupport bool
@@ -1322,6 +2494,130 @@ type GenerateConfig struct {
 	// (mcp.options.tool) name a hard error instead of falling back to the
 	// legacy autogenerated name.
 	RequireToolAnnotation bool
+	// InlineMessages, when true, inlines message schemas for clients that do
+	// not resolve $ref. Enums are still deduplicated into $defs, and recursive
+	// messages keep a $ref since they cannot be inlined.
+	InlineMessages bool
+	// SkipDeprecated, when true, generates no tool for methods marked
+	// [deprecated = true]; they are reported as skipped.
+	SkipDeprecated bool
+	// FlatArgs, when true, hoists the fields of single-level message fields
+	// of a request to the top level of its tool input schema, for clients
+	// that pass arguments flat. See flattenArgs.
+	FlatArgs bool
+	// MarkFieldBehavior, when true, keeps INPUT_ONLY and OUTPUT_ONLY fields
+	// in both input and output schemas, marked writeOnly and readOnly, instead
+	// of dropping them from the schema of the other direction.
+	MarkFieldBehavior bool
+	// UIHints, when true, adds an x-order vendor extension with the
+	// declaration position of every property, and deprecated: true to the
+	// properties of deprecated fields, for clients that render forms.
+	UIHints bool
+	// SchemaTitle, when true, sets the title of the root of every input
+	// schema to the method name and its description to the (mcp.options.tool)
+	// description or method comment, so that schemas exported on their own
+	// still name their operation. See schemaTitle.
+	SchemaTitle bool
+	// FieldPathComments, when true, adds a $comment with the fully-qualified
+	// proto field name and number, e.g. "testdata.Item.name = 2", to every
+	// property, to trace a property back to its field when debugging, e.g.
+	// after flat_args moved it. Validators and models ignore $comment; it
+	// only adds size.
+	FieldPathComments bool
+	// ToolMeta, when true, stamps the proto package and version of every
+	// tool into its _meta, for clients and audits that trace a tool back to
+	// its service. A file with an (mcp.options.file) version, or a
+	// ToolVersion, is stamped either way.
+	ToolMeta bool
+	// ToolVersion is the version stamped into the _meta of the tools of
+	// files that set no (mcp.options.file) version.
+	ToolVersion string
+	// GRPCMethod, when true, stamps the gRPC method path of every tool, e.g.
+	// "/testdata.TestService/GetItem", into the root of its input schema as
+	// an x-grpc-method extension and into its _meta, so that dashboards can
+	// correlate tool calls with gRPC metrics.
+	GRPCMethod bool
+	// ValidateTool, when true, adds a <package>_<Service>_validate tool to
+	// every service that checks arguments for its other tools against their
+	// input schema and request conversion, without calling the backend, so
+	// that models can check arguments before a call with side effects.
+	ValidateTool bool
+	// RawTools, when true, adds a <name>_raw tool to every method, as
+	// (mcp.options.tool) raw does for one, whose only argument is a string
+	// holding the arguments of the tool as JSON, for clients that only pass
+	// a single string argument.
+	RawTools bool
+	// Int64Note replaces DefaultInt64Note as the description note on 64-bit
+	// integer fields.
+	Int64Note string
+	// SuppressInt64Note, when true, leaves 64-bit integer fields without a
+	// note.
+	SuppressInt64Note bool
+	// GenerateHandlers, when true, also emits Unimplemented<Service>Handler
+	// and Mock<Service>Handler, ready-made <Service>Client implementations for
+	// tests and prototypes.
+	GenerateHandlers bool
+	// SkipForward, when true, leaves out ForwardTo<Service>Client for
+	// services only served in-process. The forwarding pipeline is still
+	// generated, unexported, for RegisterInProcess<Service>Server.
+	SkipForward bool
+	// SkipRegister, when true, leaves out RegisterInProcess<Service>Server
+	// and its <Service>InProcessServer interface, for services only reached
+	// over gRPC. SkipForward and SkipRegister cannot both be set.
+	SkipRegister bool
+	// GenerateSmokeTest, when true, also writes SmokeTest<Service> helpers to
+	// a <package>test package next to the generated code, for its tests.
+	GenerateSmokeTest bool
+	// TimestampFormat is TimestampFormatRFC3339 (the default when empty) or
+	// TimestampFormatUnixSeconds.
+	TimestampFormat string
+	// OptionalFields is OptionalFieldsNullable (the default when empty) or
+	// OptionalFieldsOmit.
+	OptionalFields string
+	// NullableStyle is NullableStyleTypeArray (the default when empty) or
+	// NullableStyleKeyword.
+	NullableStyle string
+	// EnumAliases is EnumAliasesAll (the default when empty) or
+	// EnumAliasesCanonical.
+	EnumAliases string
+	// EnumOrder is EnumOrderDeclaration (the default when empty),
+	// EnumOrderAlphabetical or EnumOrderNumeric.
+	EnumOrder string
+	// EnumAsInt, when true, gives input enum fields an integer schema: a
+	// minimum and maximum when their numbers are contiguous, an enum list of
+	// the numbers otherwise. A note maps each number to its name. Output
+	// schemas keep the names, which protojson writes.
+	EnumAsInt bool
+	// OneOfKey is OneOfKeyTypeSuffix (the default when empty),
+	// OneOfKeyCamel or OneOfKeyUnion.
+	OneOfKey string
+	// DescriptionPrefix, when not empty, is prepended to every tool
+	// description after replacing the {service} and {method} placeholders,
+	// e.g. "[{service}] " to tell the tools of aggregated services apart.
+	DescriptionPrefix string
+	// SchemaOut, when not empty, additionally writes each tool's input and
+	// output schema to <SchemaOut>/<proto package path>/<Service>/<Method>.json
+	// for consumers outside Go.
+	SchemaOut string
+	// RefBaseURI, when not empty, identifies every tool schema with an
+	// absolute $id under this base URI, <RefBaseURI>/<package>.<Service>/
+	// <Method>, and makes its $refs absolute against it, e.g.
+	// "https://schemas.example.com/pkg.Service/Method#/$defs/pkg.Bar", for
+	// schemas hosted and referenced across documents. The default keeps
+	// local "#/$defs/..." refs and no $id.
+	RefBaseURI string
+	// Report, when not nil, collects what was generated and skipped. Share
+	// one Report between the files of an invocation and write it with
+	// Report.Write once they are all generated.
+	Report *Report
+	// OpenAPI, when not nil, collects the request and response schemas of
+	// every tool. Share one document between the files of an invocation and
+	// write it with OpenAPIDocument.Write once they are all generated.
+	OpenAPI *OpenAPIDocument
+	// DescriptionComposer, when not nil, replaces DefaultDescriptionComposer
+	// in merging field comments with the summaries of their protovalidate
+	// rules.
+	DescriptionComposer DescriptionComposer
 	// ToolNames enforces tool-name uniqueness across every file generated
 	// with the same registry. Leaving it nil still checks uniqueness, but
 	// only within the single file.
@@ -1334,6 +2630,101 @@ func (g *FileGenerator) GenerateWithConfig(cfg GenerateConfig) {
 	packageSuffix := cfg.PackageSuffix
 	g.optionalKeywordSupport = cfg.OptionalKeywordSupport
 	g.requireToolAnnotation = cfg.RequireToolAnnotation
+	g.inlineMessages = cfg.InlineMessages
+	g.flatArgs = cfg.FlatArgs
+	g.skipDeprecated = cfg.SkipDeprecated
+	g.markFieldBehavior = cfg.MarkFieldBehavior
+	g.uiHints = cfg.UIHints
+	g.schemaTitle = cfg.SchemaTitle
+	g.fieldPathComments = cfg.FieldPathComments
+	g.toolMeta = cfg.ToolMeta
+	g.toolVersion = cfg.ToolVersion
+	g.grpcMethod = cfg.GRPCMethod
+	g.validateTool = cfg.ValidateTool
+	g.rawTools = cfg.RawTools
+	g.descriptionComposer = cfg.DescriptionComposer
+	g.schemaOut = cfg.SchemaOut
+	if cfg.RefBaseURI != "" {
+		base, err := checkRefBaseURI(cfg.RefBaseURI)
+		if err != nil {
+			g.gen.Error(err)
+			return
+		}
+		g.refBaseURI = base
+	}
+	g.descriptionPrefix = cfg.DescriptionPrefix
+	g.report = cfg.Report
+	g.openAPI = cfg.OpenAPI
+	g.int64Note = cfg.Int64Note
+	if g.int64Note == "" {
+		g.int64Note = DefaultInt64Note
+	}
+	if cfg.SuppressInt64Note {
+		g.int64Note = ""
+	}
+	if cfg.SkipForward && cfg.SkipRegister {
+		g.gen.Error(fmt.Errorf("generate_forward and generate_register cannot both be false"))
+		return
+	}
+	if cfg.SkipForward && cfg.GenerateSmokeTest {
+		g.gen.Error(fmt.Errorf("generate_smoke_test needs generate_forward, since the helpers register clients from another package"))
+		return
+	}
+	g.timestampFormat = cfg.TimestampFormat
+	switch g.timestampFormat {
+	case "":
+		g.timestampFormat = TimestampFormatRFC3339
+	case TimestampFormatRFC3339, TimestampFormatUnixSeconds:
+	default:
+		g.gen.Error(fmt.Errorf("timestamp_format %q must be %q or %q", g.timestampFormat, TimestampFormatRFC3339, TimestampFormatUnixSeconds))
+		return
+	}
+	g.optionalFields = cfg.OptionalFields
+	switch g.optionalFields {
+	case "":
+		g.optionalFields = OptionalFieldsNullable
+	case OptionalFieldsNullable, OptionalFieldsOmit:
+	default:
+		g.gen.Error(fmt.Errorf("optional_fields %q must be %q or %q", g.optionalFields, OptionalFieldsNullable, OptionalFieldsOmit))
+		return
+	}
+	g.nullableStyle = cfg.NullableStyle
+	switch g.nullableStyle {
+	case "":
+		g.nullableStyle = NullableStyleTypeArray
+	case NullableStyleTypeArray, NullableStyleKeyword:
+	default:
+		g.gen.Error(fmt.Errorf("nullable_style %q must be %q or %q", g.nullableStyle, NullableStyleTypeArray, NullableStyleKeyword))
+		return
+	}
+	g.enumAliases = cfg.EnumAliases
+	switch g.enumAliases {
+	case "":
+		g.enumAliases = EnumAliasesAll
+	case EnumAliasesAll, EnumAliasesCanonical:
+	default:
+		g.gen.Error(fmt.Errorf("enum_aliases %q must be %q or %q", g.enumAliases, EnumAliasesAll, EnumAliasesCanonical))
+		return
+	}
+	g.enumOrder = cfg.EnumOrder
+	switch g.enumOrder {
+	case "":
+		g.enumOrder = EnumOrderDeclaration
+	case EnumOrderDeclaration, EnumOrderAlphabetical, EnumOrderNumeric:
+	default:
+		g.gen.Error(fmt.Errorf("enum_order %q must be %q, %q or %q", g.enumOrder, EnumOrderDeclaration, EnumOrderAlphabetical, EnumOrderNumeric))
+		return
+	}
+	g.enumAsInt = cfg.EnumAsInt
+	g.oneOfKey = cfg.OneOfKey
+	switch g.oneOfKey {
+	case "":
+		g.oneOfKey = OneOfKeyTypeSuffix
+	case OneOfKeyTypeSuffix, OneOfKeyCamel, OneOfKeyUnion:
+	default:
+		g.gen.Error(fmt.Errorf("oneof_key %q must be %q, %q or %q", g.oneOfKey, OneOfKeyTypeSuffix, OneOfKeyCamel, OneOfKeyUnion))
+		return
+	}
 	g.seenToolNames = cfg.ToolNames
 	if g.seenToolNames == nil {
 		g.seenToolNames = ToolNameRegistry{}
@@ -1342,13 +2733,42 @@ func (g *FileGenerator) GenerateWithConfig(cfg GenerateConfig) {
 	if len(g.f.Services) == 0 {
 		return
 	}
+	if !g.checkStructValueSchemas() {
+		return
+	}
+	if !g.checkOneofDiscriminators(g.f.Messages) {
+		return
+	}
+	if !g.checkTupleItems(g.f.Messages) {
+		return
+	}
+	if !g.checkRepeatedRendering(g.f.Messages) {
+		return
+	}
+	fileSuffix := cfg.FileSuffix
+	if fileSuffix == "" {
+		fileSuffix = GeneratedFilenameExtension
+	}
+	if !strings.HasSuffix(fileSuffix, ".go") || strings.ContainsAny(fileSuffix, `/\`) || fileSuffix == ".pb.go" {
+		g.gen.Error(fmt.Errorf("file_suffix %q must end in .go, differ from .pb.go and contain no path separator", fileSuffix))
+		return
+	}
 	goImportPath := file.GoImportPath
-	if packageSuffix != "" {
+	subPackage := cfg.PackageName
+	if subPackage != "" {
+		if !token.IsIdentifier(subPackage) {
+			g.gen.Error(fmt.Errorf("package_name %q is not a valid Go identifier", subPackage))
+			return
+		}
+	} else if packageSuffix != "" {
 		if !token.IsIdentifier(packageSuffix) {
 			g.gen.Error(fmt.Errorf("package_suffix %q is not a valid Go identifier", packageSuffix))
 			return
 		}
-		file.GoPackageName += protogen.GoPackageName(packageSuffix)
+		subPackage = string(file.GoPackageName) + packageSuffix
+	}
+	if subPackage != "" {
+		file.GoPackageName = protogen.GoPackageName(subPackage)
 		generatedFilenamePrefixToSlash := filepath.ToSlash(file.GeneratedFilenamePrefix)
 		file.GeneratedFilenamePrefix = path.Join(
 			path.Dir(generatedFilenamePrefixToSlash),
@@ -1362,15 +2782,15 @@ func (g *FileGenerator) GenerateWithConfig(cfg GenerateConfig) {
 	}
 
 	g.gf = g.gen.NewGeneratedFile(
-		file.GeneratedFilenamePrefix+GeneratedFilenameExtension,
+		file.GeneratedFilenamePrefix+fileSuffix,
 		goImportPath,
 	)
-	if packageSuffix != "" {
-		g.gf.Import(file.GoImportPath)
-	}
+	g.goImportPath = goImportPath
+	g.imports = nil
 
 	funcMap := template.FuncMap{
 		"capitalizeFirst": capitalizeFirstLetter,
+		"durationLiteral": durationLiteral,
 	}
 
 	fileTpl := fileTemplate
@@ -1382,38 +2802,99 @@ func (g *FileGenerator) GenerateWithConfig(cfg GenerateConfig) {
 
 	services := map[string]map[string]MethodInfo{}
 	tools := map[string]SimpleTool{}
+	batchTools := map[string]SimpleTool{}
+	rawTools := map[string]SimpleTool{}
+	validateTools := map[string]*ValidateTool{}
 
 	for _, svc := range g.f.Services {
 		s := map[string]MethodInfo{}
 		for _, meth := range svc.Methods {
-			// Only unary supported at the moment
-			if meth.Desc.IsStreamingClient() || meth.Desc.IsStreamingServer() {
+			// Resolve the tool name, example and behavioral hints from (mcp.options.tool).
+			opts := methodToolOptions(meth)
+
+			// Only unary methods are supported, and server-streaming ones
+			// published as a resource
+			streamResource, err := isStreamResource(meth, opts)
+			if err != nil {
+				g.gen.Error(err)
+				continue
+			}
+			if reason := streamingReason(meth); reason != "" && !streamResource {
+				g.report.addSkipped(meth, reason)
+				continue
+			}
+			if g.skipDeprecated && isDeprecated(meth) {
+				g.report.addSkipped(meth, skippedDeprecated)
 				continue
 			}
 
 			// Generate schema with $defs for nested messages
-			schema := g.messageSchemaWithDefs(meth.Input.Desc, meth.Input)
-			marshaled, err := json.Marshal(schema)
+			schema := g.messageSchemaWithDefs(meth.Input.Desc, meth.Input, directionInput)
+
+			if err := g.addExampleRequest(meth, opts, schema); err != nil {
+				g.gen.Error(err)
+				continue
+			}
+			if isDeprecated(meth) {
+				schema["deprecated"] = true
+			}
+			if g.schemaTitle {
+				schemaTitle(meth, opts, schema)
+			}
+			if g.grpcMethod {
+				schema["x-grpc-method"] = grpcMethodPath(meth)
+			}
+			var flatFields []FlatField
+			if g.flatArgs {
+				flatFields = flattenArgs(meth.Input.Desc, schema)
+			}
+			marshaled, err := g.marshalSchema(schema, g.schemaID(meth, ""))
 			if err != nil {
 				g.gen.Error(fmt.Errorf("failed to marshal JSON schema for %s: %w", meth.Desc.FullName(), err))
 				continue
 			}
 
-			// Resolve the tool name and behavioral hints from (mcp.options.tool).
-			opts := methodToolOptions(meth)
 			name, err := g.resolveToolName(meth, opts)
 			if err != nil {
 				g.gen.Error(err)
 				continue
 			}
+			timeout, err := methodTimeout(meth, opts)
+			if err != nil {
+				g.gen.Error(err)
+				continue
+			}
+			scopes, err := toolScopes(meth, opts)
+			if err != nil {
+				g.gen.Error(err)
+				continue
+			}
+			discriminators, err := g.collectOneofDiscriminators(meth.Input.Desc)
+			if err != nil {
+				g.gen.Error(err)
+				continue
+			}
 
 			// Create simple tool
 			tool := SimpleTool{
 				Name:                     name,
-				Description:              cleanComment(string(meth.Comments.Leading)),
+				Description:              g.toolDescription(meth, opts),
 				JSONSchema:               string(marshaled),
 				Title:                    opts.GetTitle(),
 				ZeroBasedPaginationPaths: collectZeroBasedPaginationPaths(meth.Input.Desc),
+				ConstFields:              collectConstFields(meth.Input.Desc),
+				MapPairLimits:            collectMapPairLimits(meth.Input.Desc),
+				OneOfDiscriminators:      discriminators,
+				Timeout:                  timeout,
+				Scopes:                   scopes,
+				FlatFields:               flatFields,
+				Deprecated:               isDeprecated(meth),
+				DateStrings:              hasDateField(meth.Input.Desc),
+				OneOfCollections:         hasOneOfCollection(meth.Input.Desc),
+			}
+			tool.Package, tool.Version = g.toolProvenance()
+			if g.grpcMethod {
+				tool.Method = grpcMethodPath(meth)
 			}
 			if opts != nil {
 				// Copy the optional hints with their presence: nil stays nil.
@@ -1423,15 +2904,67 @@ func (g *FileGenerator) GenerateWithConfig(cfg GenerateConfig) {
 				tool.OpenWorld = opts.OpenWorld
 			}
 
+			summary, err := summaryField(meth.Output)
+			if err != nil {
+				g.gen.Error(err)
+				continue
+			}
+			contentType, resultTemplate, err := resultContent(meth, opts, streamResource)
+			if err != nil {
+				g.gen.Error(err)
+				continue
+			}
+
+			batch, err := g.batchTool(meth, opts, tool, schema)
+			if err != nil {
+				g.gen.Error(err)
+				continue
+			}
+			raw, err := g.rawTool(meth, opts, tool, schema)
+			if err != nil {
+				g.gen.Error(err)
+				continue
+			}
+
 			s[meth.GoName] = MethodInfo{
-				RequestType:  g.gf.QualifiedGoIdent(meth.Input.GoIdent),
-				ResponseType: g.gf.QualifiedGoIdent(meth.Output.GoIdent),
+				RequestType:  g.qualify(meth.Input.GoIdent),
+				ResponseType: g.qualify(meth.Output.GoIdent),
+				FullMethod:   string(meth.Desc.FullName()),
 				Tool:         tool,
+				BatchTool:    batch,
+				RawTool:      raw,
+				SummaryField: string(summary),
+
+				StreamResource: streamResource,
+				ContentType:    contentType,
+				ResultTemplate: resultTemplate,
 			}
 
 			tools[svc.GoName+"_"+meth.GoName] = tool
+			g.report.addTool(meth, tool.Name)
+			g.openAPI.addMethod(g, meth)
+			if batch != nil {
+				batchTools[svc.GoName+"_"+meth.GoName] = *batch
+			}
+			if raw != nil {
+				rawTools[svc.GoName+"_"+meth.GoName] = *raw
+			}
+
+			if g.schemaOut != "" {
+				if err := g.writeSchemaFile(meth, tool, schema); err != nil {
+					g.gen.Error(err)
+				}
+			}
 		}
 		services[string(svc.Desc.Name())] = s
+		if g.validateTool && len(s) > 0 {
+			tool, err := g.serviceValidateTool(svc)
+			if err != nil {
+				g.gen.Error(err)
+				continue
+			}
+			validateTools[string(svc.Desc.Name())] = tool
+		}
 	}
 
 	params := TplParams{
@@ -1440,9 +2973,27 @@ func (g *FileGenerator) GenerateWithConfig(cfg GenerateConfig) {
 		GoPackage:   string(g.f.GoPackageName),
 		Services:    services,
 		Tools:       tools,
+		BatchTools:  batchTools,
+		RawTools:    rawTools,
+
+		GenerateHandlers: cfg.GenerateHandlers,
+		SkipForward:      cfg.SkipForward,
+		SkipRegister:     cfg.SkipRegister,
+		UnixTimestamps:   g.timestampFormat == TimestampFormatUnixSeconds,
+		OneOfKeySuffix:   g.oneOfKeySuffix(),
+		ValidateTools:    validateTools,
+		Imports:          g.imports,
+	}
+	if subPackage != "" && !g.imported(file.GoImportPath) {
+		// Keep the messages of the file linked in even when no tool
+		// refers to them.
+		g.gf.Import(file.GoImportPath)
 	}
 	err = tpl.Execute(g.gf, params)
 	if err != nil {
 		g.gen.Error(err)
 	}
+	if cfg.GenerateSmokeTest {
+		g.generateSmokeTest(file.GeneratedFilenamePrefix, fileSuffix, goImportPath, params)
+	}
 }
@@ -300,7 +300,7 @@ func TestMessageSchemaWithOptionalSupport(t *testing.T) {
 
 			// Use CreateItemRequest which has mix of required, optional, and annotated fields
 			msg := &testdata.CreateItemRequest{}
-			schema := fg.messageSchemaFromDescriptor(msg.ProtoReflect().Descriptor(), nil)
+			schema := fg.messageSchemaFromDescriptor(msg.ProtoReflect().Descriptor(), nil, directionInput)
 
 			g.Expect(schema).To(HaveKey("required"))
 			requiredFields, ok := schema["required"].([]string)
@@ -351,7 +351,8 @@ func TestAddOneOfConstraintsHasTypeObject(t *testing.T) {
 		},
 	}
 
-	required := fg.addOneOfConstraints(normalFields, oneOf, nil)
+	md := (&testdata.GrantDeviceDataModificationRightOnApplicationRequest{}).ProtoReflect().Descriptor()
+	required := fg.addOneOfConstraints(md, normalFields, oneOf, nil)
 
 	g.Expect(required).To(ContainElement("kindOneOfType"),
 		"oneOf field must be added to required list")
@@ -391,6 +392,8 @@ func TestFullGeneration(t *testing.T) {
 	g.Expect(err).ToNot(HaveOccurred())
 
 	const optionsExclude = "--exclude-path=proto/mcp/options/options.proto"
+	// The proto file whose SmokeTest<Service> helpers TestSmokeTestHelper runs
+	const smokePath = "--path=proto/testdata/test_service.proto"
 
 	if *updateGolden {
 		// Generate golden files
@@ -401,6 +404,12 @@ func TestFullGeneration(t *testing.T) {
 			t.Fatalf("Failed to generate golden files: %v\nOutput: %s", err, output)
 		}
 
+		cmd = exec.Command("buf", "generate", "--template", "buf.gen.smoke.golden.yaml", smokePath)
+		output, err = cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("Failed to generate golden smoke test helpers: %v\nOutput: %s", err, output)
+		}
+
 		// Also generate googleapis golden files
 		t.Logf("Generating googleapis golden files...")
 		cmd = exec.Command("buf", "generate", "buf.build/googleapis/googleapis", "--template", "buf.gen.golden.yaml")
@@ -421,6 +430,12 @@ func TestFullGeneration(t *testing.T) {
 		t.Fatalf("Failed to generate current files: %v\nOutput: %s", err, output)
 	}
 
+	cmd = exec.Command("buf", "generate", "--template", "buf.gen.smoke.yaml", smokePath)
+	output, err = cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Failed to generate smoke test helpers: %v\nOutput: %s", err, output)
+	}
+
 	// Also generate googleapis files
 	t.Logf("Generating googleapis files...")
 	cmd = exec.Command("buf", "generate", "buf.build/googleapis/googleapis")
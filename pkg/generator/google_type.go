@@ -0,0 +1,98 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The google.type messages with a dedicated schema, in wellKnownTypeSchemas.
+const (
+	dateFullName   = "google.type.Date"
+	moneyFullName  = "google.type.Money"
+	latLngFullName = "google.type.LatLng"
+)
+
+// dateOutputSchema is the schema of a google.type.Date in a response, which
+// keeps the protojson object encoding.
+var dateOutputSchema = map[string]any{
+	"type": []string{"object", "null"},
+	"properties": map[string]any{
+		"year":  map[string]any{"type": "integer", "minimum": 0, "maximum": 9999},
+		"month": map[string]any{"type": "integer", "minimum": 0, "maximum": 12},
+		"day":   map[string]any{"type": "integer", "minimum": 0, "maximum": 31},
+	},
+}
+
+// dateString formats obj, the protojson form of a google.type.Date, as the
+// ISO 8601 date the tool schema expects.
+func dateString(obj map[string]any) (string, error) {
+	var parts [3]int64
+	for i, key := range []string{"year", "month", "day"} {
+		v, ok := obj[key]
+		if !ok {
+			continue
+		}
+		n, ok := v.(json.Number)
+		if !ok {
+			return "", fmt.Errorf("date %s is a %T, not a number", key, v)
+		}
+		var err error
+		if parts[i], err = n.Int64(); err != nil {
+			return "", err
+		}
+	}
+	if parts[0] == 0 || parts[1] == 0 || parts[2] == 0 {
+		return "", fmt.Errorf("date %v is partial, which a date string cannot express", obj)
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", parts[0], parts[1], parts[2]), nil
+}
+
+// hasDateField reports whether md has a google.type.Date field, directly or
+// in a nested message, list or map value. The generated handler then converts
+// date strings back to the protojson object before unmarshaling.
+func hasDateField(md protoreflect.MessageDescriptor) bool {
+	return hasDateFieldIn(md, map[protoreflect.FullName]bool{})
+}
+
+func hasDateFieldIn(md protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool) bool {
+	if visited[md.FullName()] {
+		return false
+	}
+	visited[md.FullName()] = true
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Message() == nil {
+			continue
+		}
+		if fd.Message().FullName() == dateFullName {
+			return true
+		}
+		if _, isWKT := wellKnownTypeSchemas[string(fd.Message().FullName())]; isWKT {
+			continue
+		}
+		if hasDateFieldIn(fd.Message(), visited) {
+			return true
+		}
+	}
+	return false
+}
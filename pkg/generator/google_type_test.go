@@ -0,0 +1,161 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestGoogleTypeGolden(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(testdatamcp.BookingService_CreateBookingTool.JSONSchema), &schema)).To(Succeed())
+	props := schema["properties"].(map[string]any)
+
+	dateSchema := map[string]any{"type": []any{"string", "null"}, "format": "date"}
+	g.Expect(props["check_in"]).To(Equal(dateSchema))
+	g.Expect(props["blackout_dates"]).To(HaveKeyWithValue("items", dateSchema))
+	guest := schema["$defs"].(map[string]any)["BookingGuest"].(map[string]any)
+	g.Expect(guest["properties"]).To(HaveKeyWithValue("birth_date", dateSchema))
+
+	price := props["price"].(map[string]any)
+	g.Expect(price["required"]).To(Equal([]any{"currency_code"}))
+	g.Expect(price["properties"]).To(HaveKeyWithValue("currency_code", HaveKeyWithValue("pattern", "^[A-Z]{3}$")))
+	g.Expect(price["properties"]).To(HaveKeyWithValue("units", And(
+		HaveKeyWithValue("type", "string"),
+		HaveKeyWithValue("pattern", "^-?[0-9]+$"),
+	)))
+	g.Expect(price["properties"]).To(HaveKeyWithValue("nanos", And(
+		HaveKeyWithValue("minimum", -999999999.0),
+		HaveKeyWithValue("maximum", 999999999.0),
+	)))
+
+	location := props["location"].(map[string]any)
+	g.Expect(location["required"]).To(ConsistOf("latitude", "longitude"))
+	g.Expect(location["properties"]).To(HaveKeyWithValue("latitude", And(
+		HaveKeyWithValue("minimum", -90.0),
+		HaveKeyWithValue("maximum", 90.0),
+	)))
+	g.Expect(location["properties"]).To(HaveKeyWithValue("longitude", And(
+		HaveKeyWithValue("minimum", -180.0),
+		HaveKeyWithValue("maximum", 180.0),
+	)))
+
+	// The example request is rewritten to the shape of the schema.
+	g.Expect(schema["examples"]).To(Equal([]any{map[string]any{
+		"check_in":       "2025-06-01",
+		"blackout_dates": []any{"2025-12-24"},
+		"price":          map[string]any{"currency_code": "EUR", "units": "120", "nanos": 500000000.0},
+		"location":       map[string]any{"latitude": 48.8566, "longitude": 2.3522},
+	}}))
+
+	raw, err := os.ReadFile("../testdata/gen/go-golden/testdata/testdatamcp/google_type_test.pb.mcp.go")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(raw)).To(ContainSubstring("runtime.DateStringsToObjects(args, req.ProtoReflect().Descriptor())"))
+	g.Expect(string(raw)).To(ContainSubstring("runtime.DateStringsToObjects(args, req.ProtoReflect().Descriptor())"))
+
+	// Requests without a Date skip the conversion.
+	raw, err = os.ReadFile("../testdata/gen/go-golden/testdata/testdatamcp/test_service.pb.mcp.go")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(raw)).ToNot(ContainSubstring("DateStringsToObjects"))
+}
+
+func TestGoogleTypeDateOutputSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	file := testdata.File_testdata_google_type_test_proto
+	plugin, err := protogen.Options{}.New(codeGeneratorRequest(file))
+	g.Expect(err).ToNot(HaveOccurred())
+	fg := NewFileGenerator(plugin.FilesByPath[file.Path()], plugin)
+	meth := fg.f.Services[0].Methods[0]
+
+	// Responses keep the protojson object encoding of a Date.
+	schema := fg.messageSchemaWithDefs(meth.Output.Desc, meth.Output, directionOutput)
+	checkIn := schema["properties"].(map[string]any)["check_in"].(map[string]any)
+	g.Expect(checkIn["type"]).To(Equal([]string{"object", "null"}))
+	g.Expect(checkIn["properties"]).To(HaveKey("year"))
+	g.Expect(checkIn["properties"]).To(HaveKey("month"))
+	g.Expect(checkIn["properties"]).To(HaveKey("day"))
+}
+
+func TestGoogleTypeDateStringsReachClient(t *testing.T) {
+	g := NewWithT(t)
+
+	var got *testdata.CreateBookingRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToBookingServiceClient(s, &testdatamcp.MockBookingServiceHandler{
+		CreateBookingFunc: func(_ context.Context, req *testdata.CreateBookingRequest) (*testdata.CreateBookingResponse, error) {
+			got = req
+			return &testdata.CreateBookingResponse{BookingId: "b-1", CheckIn: req.CheckIn}, nil
+		},
+	})
+
+	resp := callTool(t, s, testdatamcp.BookingService_CreateBookingTool.Name, map[string]any{
+		"check_in":       "2025-06-01",
+		"blackout_dates": []any{"2025-12-24", map[string]any{"year": 2025, "month": 12, "day": 31}},
+		"price":          map[string]any{"currency_code": "EUR", "units": "120", "nanos": 500000000},
+		"location":       map[string]any{"latitude": 48.8566, "longitude": 2.3522},
+		"guest":          map[string]any{"name": "Ada", "birth_date": "1815-12-10"},
+	})
+	g.Expect(resp).To(HaveKey("result"), "unexpected response: %v", resp)
+
+	want := &testdata.CreateBookingRequest{
+		CheckIn: &date.Date{Year: 2025, Month: 6, Day: 1},
+		BlackoutDates: []*date.Date{
+			{Year: 2025, Month: 12, Day: 24},
+			{Year: 2025, Month: 12, Day: 31},
+		},
+		Price:    &money.Money{CurrencyCode: "EUR", Units: 120, Nanos: 500000000},
+		Location: &latlng.LatLng{Latitude: 48.8566, Longitude: 2.3522},
+		Guest:    &testdata.BookingGuest{Name: "Ada", BirthDate: &date.Date{Year: 1815, Month: 12, Day: 10}},
+	}
+	g.Expect(proto.Equal(got, want)).To(BeTrue(), "got %v", got)
+
+	// The response keeps the object encoding.
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"booking_id":"b-1","check_in":{"year":2025,"month":6,"day":1}}`))
+}
+
+func TestGoogleTypeInvalidDate(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToBookingServiceClient(s, &testdatamcp.MockBookingServiceHandler{})
+
+	resp := callTool(t, s, testdatamcp.BookingService_CreateBookingTool.Name, map[string]any{"check_in": "2025-02-30"})
+	g.Expect(resultText(g, resp)).To(ContainSubstring("INVALID_ARGUMENT"))
+	g.Expect(resultText(g, resp)).To(ContainSubstring(`check_in: \"2025-02-30\" is not a YYYY-MM-DD date`))
+
+	_, err := testdatamcp.ParseBookingServiceCreateBookingArgs(map[string]any{"check_in": "June 1st"})
+	g.Expect(err).To(MatchError(ContainSubstring(`"June 1st" is not a YYYY-MM-DD date`)))
+
+	req, err := testdatamcp.ParseBookingServiceCreateBookingArgs(map[string]any{"check_in": "2025-06-01"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(proto.Equal(req.CheckIn, &date.Date{Year: 2025, Month: 6, Day: 1})).To(BeTrue())
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// getItemOnly implements GetItem and relies on the generated unimplemented
+// handler for everything else.
+type getItemOnly struct {
+	testdatamcp.UnimplementedTestServiceHandler
+}
+
+func (getItemOnly) GetItem(_ context.Context, in *testdata.GetItemRequest, _ ...grpc.CallOption) (*testdata.GetItemResponse, error) {
+	return &testdata.GetItemResponse{Item: &testdata.Item{Id: in.GetId(), Name: "embedded"}}, nil
+}
+
+func TestUnimplementedHandlerEmbedding(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, getItemOnly{})
+
+	text := resultText(g, callGetItem(t, s, map[string]any{"id": "item-1"}))
+	g.Expect(text).To(ContainSubstring("embedded"))
+
+	resp := callTool(t, s, testdatamcp.TestService_CreateItemTool.Name, map[string]any{"name": "x"})
+	g.Expect(resp).To(HaveKey("result"), "unexpected response: %v", resp)
+	result := resp["result"].(map[string]any)
+	g.Expect(result["isError"]).To(BeTrue())
+	g.Expect(result["content"].([]any)[0].(map[string]any)["text"]).To(ContainSubstring("method CreateItem not implemented"))
+}
+
+func TestMockHandler(t *testing.T) {
+	g := NewWithT(t)
+
+	var got *testdata.GetItemRequest
+	mock := &testdatamcp.MockTestServiceHandler{
+		GetItemFunc: func(_ context.Context, req *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+			got = req
+			return &testdata.GetItemResponse{Item: &testdata.Item{Id: req.GetId(), Name: "mocked"}}, nil
+		},
+	}
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, mock)
+
+	text := resultText(g, callGetItem(t, s, map[string]any{"id": "item-2"}))
+	g.Expect(text).To(ContainSubstring("mocked"))
+	g.Expect(got.GetId()).To(Equal("item-2"))
+
+	// Methods without a func fall back to Unimplemented.
+	_, err := mock.CreateItem(context.Background(), &testdata.CreateItemRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// runPlugin runs the generator with cfg over each file req asks for, as
+// protoc-gen-go-mcp does, and returns the plugin holding the response along
+// with the generator of the last file.
+func runPlugin(t *testing.T, req *pluginpb.CodeGeneratorRequest, cfg GenerateConfig) (*protogen.Plugin, *FileGenerator) {
+	t.Helper()
+	plugin, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fg *FileGenerator
+	for _, f := range plugin.Files {
+		if f.Generate {
+			fg = NewFileGenerator(f, plugin)
+			fg.GenerateWithConfig(cfg)
+		}
+	}
+	return plugin, fg
+}
+
+// generatedFiles runs the generator with cfg over req and returns every
+// generated file by name, failing t on a plugin error.
+func generatedFiles(t *testing.T, req *pluginpb.CodeGeneratorRequest, cfg GenerateConfig) map[string]string {
+	t.Helper()
+	plugin, _ := runPlugin(t, req, cfg)
+	resp := plugin.Response()
+	if resp.Error != nil {
+		t.Fatal(resp.GetError())
+	}
+	files := map[string]string{}
+	for _, f := range resp.File {
+		files[f.GetName()] = f.GetContent()
+	}
+	return files
+}
+
+// generatedGoFile generates file with cfg and returns the generated Go
+// file, failing t on a plugin error.
+func generatedGoFile(t *testing.T, file protoreflect.FileDescriptor, cfg GenerateConfig) string {
+	t.Helper()
+	for name, content := range generatedFiles(t, codeGeneratorRequest(file), cfg) {
+		if strings.HasSuffix(name, ".go") {
+			return content
+		}
+	}
+	t.Fatalf("no Go file was generated for %s", file.Path())
+	return ""
+}
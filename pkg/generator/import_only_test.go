@@ -0,0 +1,81 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// sharedAddressDef returns the SharedAddress definition of a
+// CreateShipmentRequest schema.
+func sharedAddressDef(g *WithT, schema map[string]any) map[string]any {
+	g.Expect(schema["$defs"]).To(HaveKey("SharedAddress"))
+	return schema["$defs"].(map[string]any)["SharedAddress"].(map[string]any)
+}
+
+func TestImportOnlyMessageSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	// Only shipping_test.proto is generated; shared_types_test.proto is
+	// merely imported.
+	target := testdata.File_testdata_shipping_test_proto
+	plugin, err := protogen.Options{}.New(codeGeneratorRequest(target))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(plugin.FilesByPath["testdata/shared_types_test.proto"].Generate).To(BeFalse())
+
+	file := plugin.FilesByPath[target.Path()]
+	fg := NewFileGenerator(file, plugin)
+	req := file.Messages[0]
+	g.Expect(req.Desc.Name()).To(BeEquivalentTo("CreateShipmentRequest"))
+
+	// The imported messages are known with their comments.
+	g.Expect(fg.messageMap).To(HaveKey("testdata.SharedAddress"))
+	g.Expect(fg.messageMap).To(HaveKey("testdata.SharedRegion"))
+
+	schema := fg.messageSchemaWithDefs(req.Desc, req, directionInput)
+	address := sharedAddressDef(g, schema)
+	g.Expect(address["properties"]).To(HaveKey("street"))
+	g.Expect(address["properties"]).To(HaveKey("region"))
+	g.Expect(schema["$defs"]).To(HaveKey("SharedRegion"))
+}
+
+func TestPlaceholderMessageResolvedFromRegistry(t *testing.T) {
+	g := NewWithT(t)
+
+	// Build shipping_test.proto without its import, as when the importing
+	// tool could not supply it: SharedAddress is then a field-less
+	// placeholder.
+	fdp := protodesc.ToFileDescriptorProto(testdata.File_testdata_shipping_test_proto)
+	fd, err := protodesc.FileOptions{AllowUnresolvable: true}.New(fdp, new(protoregistry.Files))
+	g.Expect(err).ToNot(HaveOccurred())
+	md := fd.Messages().ByName("CreateShipmentRequest")
+	g.Expect(md.Fields().ByName("destination").Message().IsPlaceholder()).To(BeTrue())
+
+	schema := (&FileGenerator{}).messageSchemaWithDefs(md, nil, directionInput)
+	address := sharedAddressDef(g, schema)
+	g.Expect(address["properties"]).To(HaveKey("street"))
+	g.Expect(address["properties"]).To(HaveKey("city"))
+	g.Expect(schema["$defs"]).To(HaveKey("SharedRegion"))
+
+	stops := schema["properties"].(map[string]any)["stops"].(map[string]any)
+	g.Expect(stops["items"]).To(HaveKeyWithValue("$ref", "#/$defs/SharedAddress"))
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"go/token"
+	"go/types"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// GoImport is a package the generated file imports for its message types.
+type GoImport struct {
+	Name string
+	Path string
+}
+
+// templateNames are the identifiers in scope where the file template refers
+// to a message type: the packages it imports itself and the locals of the
+// generated functions. A message package is never aliased to one of them, so
+// that a package named e.g. status or config is neither imported twice
+// under one name nor shadowed.
+var templateNames = map[string]bool{
+	// Imports of the template.
+	"context":   true,
+	"mcp":       true,
+	"mcpserver": true,
+	"json":      true,
+	"protojson": true,
+	"grpc":      true,
+	"codes":     true,
+	"status":    true,
+	"runtime":   true,
+	"time":      true,
+	// Locals of ForwardTo<Service>Client and Parse<Service><Method>Args.
+	"s":                 true,
+	"client":            true,
+	"opts":              true,
+	"opt":               true,
+	"config":            true,
+	"toolNames":         true,
+	"err":               true,
+	"limiter":           true,
+	"rateLimiter":       true,
+	"ctx":               true,
+	"message":           true,
+	"args":              true,
+	"req":               true,
+	"validationTargets": true,
+	"validationTool":    true,
+	"validationHandler": true,
+}
+
+// qualify returns the reference to ident from the generated file. A message
+// of another package is qualified with the alias of its import, which is the
+// last element of the import path unless that is taken, in which case a
+// number is appended as protoc-gen-go does.
+func (g *FileGenerator) qualify(ident protogen.GoIdent) string {
+	if ident.GoImportPath == g.goImportPath {
+		return ident.GoName
+	}
+	for _, imp := range g.imports {
+		if imp.Path == string(ident.GoImportPath) {
+			return imp.Name + "." + ident.GoName
+		}
+	}
+	base := packageAlias(string(ident.GoImportPath))
+	name := base
+	for i := 1; g.aliasTaken(name); i++ {
+		name = base + strconv.Itoa(i)
+	}
+	g.imports = append(g.imports, GoImport{Name: name, Path: string(ident.GoImportPath)})
+	return name + "." + ident.GoName
+}
+
+// aliasTaken reports whether name cannot alias another message package.
+func (g *FileGenerator) aliasTaken(name string) bool {
+	if templateNames[name] || types.Universe.Lookup(name) != nil {
+		return true
+	}
+	for _, imp := range g.imports {
+		if imp.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// imported reports whether qualify imported importPath.
+func (g *FileGenerator) imported(importPath protogen.GoImportPath) bool {
+	for _, imp := range g.imports {
+		if imp.Path == string(importPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// packageAlias derives an identifier from the last element of importPath,
+// replacing the characters an identifier cannot hold with underscores.
+func packageAlias(importPath string) string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, path.Base(importPath))
+	if r := []rune(name)[0]; token.IsKeyword(name) || unicode.IsDigit(r) {
+		name = "_" + name
+	}
+	return name
+}
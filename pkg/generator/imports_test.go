@@ -0,0 +1,84 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/catalog"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/status"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+const testdataImportPath = "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+
+// TestCrossPackageMessages generates catalog_proxy_test.proto, whose methods
+// take and return messages of testdata.catalog and testdata.status, in one
+// run with catalog.proto.
+func TestCrossPackageMessages(t *testing.T) {
+	g := NewWithT(t)
+
+	req := codeGeneratorRequest(testdata.File_testdata_catalog_proxy_test_proto)
+	req.FileToGenerate = []string{catalog.File_testdata_catalog_catalog_proto.Path(), testdata.File_testdata_catalog_proxy_test_proto.Path()}
+	content := generatedFiles(t, req, GenerateConfig{PackageSuffix: "mcp"})
+	g.Expect(content).To(HaveLen(2))
+
+	proxy := content[testdataImportPath+"/testdatamcp/catalog_proxy_test.pb.mcp.go"]
+	g.Expect(proxy).To(ContainSubstring(`catalog "` + testdataImportPath + `/catalog"`))
+	g.Expect(proxy).To(ContainSubstring(`testdata "` + testdataImportPath + `"`))
+	// status is the gRPC package of the template, so the message package
+	// takes the next free alias.
+	g.Expect(proxy).To(ContainSubstring(`status1 "` + testdataImportPath + `/status"`))
+	g.Expect(proxy).To(ContainSubstring("var req catalog.LookupSkuRequest"))
+	g.Expect(proxy).To(ContainSubstring("var req testdata.DescribeSkuRequest"))
+	g.Expect(proxy).To(ContainSubstring("(*status1.SkuStatus, error)"))
+
+	own := content[testdataImportPath+"/catalog/catalogmcp/catalog.pb.mcp.go"]
+	g.Expect(own).To(ContainSubstring(`catalog "` + testdataImportPath + `/catalog"`))
+	g.Expect(own).To(ContainSubstring("var req catalog.LookupSkuRequest"))
+}
+
+func TestCrossPackageMessagesCall(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToCatalogProxyServiceClient(s, &testdatamcp.MockCatalogProxyServiceHandler{
+		GetSkuStatusFunc: func(_ context.Context, req *catalog.LookupSkuRequest) (*status.SkuStatus, error) {
+			return &status.SkuStatus{Sku: req.GetSku(), InStock: true}, nil
+		},
+	})
+	resp := callTool(t, s, testdatamcp.CatalogProxyService_GetSkuStatusToolName, map[string]any{"sku": "A-1"})
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"sku":"A-1","in_stock":true}`))
+}
+
+func TestQualifyAliases(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{goImportPath: "example.com/acme/acmemcp"}
+	g.Expect(fg.qualify(protogen.GoIdent{GoName: "Tool", GoImportPath: "example.com/acme/acmemcp"})).To(Equal("Tool"))
+	g.Expect(fg.qualify(protogen.GoIdent{GoName: "Config", GoImportPath: "example.com/acme/config"})).To(Equal("config1.Config"))
+	g.Expect(fg.qualify(protogen.GoIdent{GoName: "Order", GoImportPath: "example.com/shop/v1"})).To(Equal("v1.Order"))
+	g.Expect(fg.qualify(protogen.GoIdent{GoName: "Item", GoImportPath: "example.com/stock/v1"})).To(Equal("v11.Item"))
+	g.Expect(fg.qualify(protogen.GoIdent{GoName: "Cart", GoImportPath: "example.com/shop/v1"})).To(Equal("v1.Cart"))
+	g.Expect(fg.qualify(protogen.GoIdent{GoName: "Kind", GoImportPath: "example.com/acme/type"})).To(Equal("_type.Kind"))
+	g.Expect(fg.qualify(protogen.GoIdent{GoName: "Order", GoImportPath: "example.com/acme/order-api"})).To(Equal("order_api.Order"))
+	g.Expect(fg.qualify(protogen.GoIdent{GoName: "Money", GoImportPath: "example.com/acme/string"})).To(Equal("string1.Money"))
+}
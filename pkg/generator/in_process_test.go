@@ -0,0 +1,71 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// Any grpc-go server implementation can be registered in-process.
+var _ testdatamcp.TestServiceInProcessServer = testdata.TestServiceServer(nil)
+
+// inProcessItemServer is a gRPC service implementation that only serves
+// GetItem.
+type inProcessItemServer struct {
+	testdata.UnimplementedTestServiceServer
+	calls int
+}
+
+func (s *inProcessItemServer) GetItem(_ context.Context, in *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+	s.calls++
+	if in.GetId() == "missing" {
+		return nil, status.Error(codes.NotFound, "item missing not found")
+	}
+	return &testdata.GetItemResponse{Item: &testdata.Item{Id: in.GetId(), Name: "in-process"}}, nil
+}
+
+func TestRegisterInProcess(t *testing.T) {
+	g := NewWithT(t)
+
+	impl := &inProcessItemServer{}
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.RegisterInProcessTestServiceServer(s, impl, runtime.WithToolNameOverride(map[string]string{
+		"testdata.TestService.GetItem": "get_item",
+	}))
+
+	resp := callTool(t, s, "get_item", map[string]any{"id": "item-1"})
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"item":{"id":"item-1","name":"in-process","description":"","labels":{}}}`))
+
+	resp = callTool(t, s, "get_item", map[string]any{"id": "missing"})
+	g.Expect(resp["result"]).To(HaveKeyWithValue("isError", true))
+	g.Expect(resultText(g, resp)).To(ContainSubstring("item missing not found"))
+
+	// Methods the implementation does not override keep gRPC semantics.
+	resp = callTool(t, s, testdatamcp.TestService_CreateItemTool.Name, map[string]any{"name": "x"})
+	g.Expect(resp["result"]).To(HaveKeyWithValue("isError", true))
+	g.Expect(resultText(g, resp)).To(ContainSubstring("UNIMPLEMENTED"))
+
+	g.Expect(impl.calls).To(Equal(2))
+}
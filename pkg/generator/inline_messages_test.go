@@ -0,0 +1,123 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestInlineMessagesFactorsEnumsIntoDefs(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{inlineMessages: true}
+	md := (&testdata.Incident{}).ProtoReflect().Descriptor()
+	schema := fg.messageSchemaWithDefs(md, nil, directionInput)
+
+	defs := schema["$defs"].(map[string]any)
+	g.Expect(defs).To(HaveKey("testdata.IncidentSeverity"))
+	g.Expect(defs).ToNot(HaveKey("Escalation"), "non-recursive messages must be inlined")
+	g.Expect(defs["testdata.IncidentSeverity"]).To(HaveKeyWithValue("type", "string"))
+
+	props := schema["properties"].(map[string]any)
+	severity := props["severity"].(map[string]any)
+	g.Expect(severity["$ref"]).To(Equal("#/$defs/testdata.IncidentSeverity"))
+
+	history := props["history"].(map[string]any)
+	g.Expect(history["items"]).To(HaveKeyWithValue("$ref", "#/$defs/testdata.IncidentSeverity"))
+
+	byTeam := props["severity_by_team"].(map[string]any)
+	g.Expect(byTeam["additionalProperties"]).To(HaveKeyWithValue("$ref", "#/$defs/testdata.IncidentSeverity"))
+
+	escalation := props["escalation"].(map[string]any)
+	g.Expect(escalation).ToNot(HaveKey("$ref"))
+	g.Expect(escalation["type"]).To(Equal("object"))
+	escProps := escalation["properties"].(map[string]any)
+	g.Expect(escProps["from"]).To(HaveKeyWithValue("$ref", "#/$defs/testdata.IncidentSeverity"))
+}
+
+func TestInlineMessagesEnumDefsByFullName(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{inlineMessages: true}
+	schema := fg.messageSchemaWithDefs((&testdata.IncidentAction{}).ProtoReflect().Descriptor(), nil, directionInput)
+
+	props := schema["properties"].(map[string]any)
+	g.Expect(props["page_state"]).To(HaveKeyWithValue("$ref", "#/$defs/testdata.IncidentPage.State"))
+	g.Expect(props["ticket_state"]).To(HaveKeyWithValue("$ref", "#/$defs/testdata.IncidentTicket.State"))
+	defs := schema["$defs"].(map[string]any)
+	g.Expect(defs).To(HaveLen(2))
+	g.Expect(defs["testdata.IncidentPage.State"]).To(HaveKeyWithValue("enum", ContainElement("STATE_SENT")))
+	g.Expect(defs["testdata.IncidentTicket.State"]).To(HaveKeyWithValue("enum", ContainElement("STATE_OPEN")))
+}
+
+func TestInlineMessagesRecursiveMessageUsesDefs(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{inlineMessages: true}
+	md := (&testdata.Incident{}).ProtoReflect().Descriptor()
+	schema := fg.messageSchemaWithDefs(md, nil, directionInput)
+
+	props := schema["properties"].(map[string]any)
+	thread := props["thread"].(map[string]any)
+	g.Expect(thread).ToNot(HaveKey("$ref"), "outermost occurrence is inlined")
+
+	replies := thread["properties"].(map[string]any)["replies"].(map[string]any)
+	g.Expect(replies["items"]).To(HaveKeyWithValue("$ref", "#/$defs/IncidentThread"))
+
+	defs := schema["$defs"].(map[string]any)
+	g.Expect(defs["IncidentThread"]).ToNot(BeNil(), "recursive placeholder must be filled in")
+
+	_, err := json.Marshal(schema)
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestInlineMessagesSizeReduction(t *testing.T) {
+	g := NewWithT(t)
+
+	md := (&testdata.Incident{}).ProtoReflect().Descriptor()
+
+	inlined, err := json.Marshal((&FileGenerator{inlineMessages: true}).messageSchemaWithDefs(md, nil, directionInput))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// What a $ref-less schema would cost: every enum spelled out at every use.
+	enumSchema, err := json.Marshal((&FileGenerator{}).getEnumSchema((testdata.IncidentSeverity)(0).Descriptor()))
+	g.Expect(err).ToNot(HaveOccurred())
+	ref := `{"$ref":"#/$defs/testdata.IncidentSeverity","type":"string"`
+	uses := strings.Count(string(inlined), ref)
+	g.Expect(uses).To(BeNumerically(">=", 7))
+	expanded := strings.ReplaceAll(string(inlined), ref, string(enumSchema[:len(enumSchema)-1]))
+
+	reduction := 1 - float64(len(inlined))/float64(len(expanded))
+	t.Logf("enum $defs: %d bytes, fully inlined: %d bytes (%.0f%% smaller)", len(inlined), len(expanded), reduction*100)
+	g.Expect(reduction).To(BeNumerically(">", 0.3))
+}
+
+func TestDefaultModeUnchangedForEnums(t *testing.T) {
+	g := NewWithT(t)
+
+	md := (&testdata.Incident{}).ProtoReflect().Descriptor()
+	schema := (&FileGenerator{}).messageSchemaWithDefs(md, nil, directionInput)
+
+	props := schema["properties"].(map[string]any)
+	g.Expect(props["severity"]).To(HaveKey("enum"))
+	g.Expect(props["escalation"]).To(HaveKeyWithValue("$ref", "#/$defs/Escalation"))
+	g.Expect(schema["$defs"]).ToNot(HaveKey("testdata.IncidentSeverity"))
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestInputDefaults(t *testing.T) {
+	g := NewWithT(t)
+
+	var got *testdata.CreateItemRequest
+	register := func(s *mcpserver.MCPServer) {
+		testdatamcp.ForwardToTestServiceClient(s, &testdatamcp.MockTestServiceHandler{
+			CreateItemFunc: func(_ context.Context, req *testdata.CreateItemRequest) (*testdata.CreateItemResponse, error) {
+				got = req
+				return &testdata.CreateItemResponse{}, nil
+			},
+		}, runtime.WithInputDefaults(map[string]map[string]any{
+			testdatamcp.TestService_CreateItemToolName: {"name": "widget", "description": "Stocked item", "tags": []any{"new"}},
+		}))
+	}
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	register(s)
+
+	// Omitted fields get their default.
+	resultText(g, callTool(t, s, testdatamcp.TestService_CreateItemToolName, map[string]any{}))
+	g.Expect(got.GetName()).To(Equal("widget"))
+	g.Expect(got.GetDescription()).To(Equal("Stocked item"))
+	g.Expect(got.GetTags()).To(Equal([]string{"new"}))
+
+	// Sent values, even zero ones, are kept; null is omitted.
+	resultText(g, callTool(t, s, testdatamcp.TestService_CreateItemToolName, map[string]any{"name": "gadget", "description": "", "tags": nil}))
+	g.Expect(got.GetName()).To(Equal("gadget"))
+	g.Expect(got.Description).ToNot(BeNil())
+	g.Expect(got.GetDescription()).To(BeEmpty())
+	g.Expect(got.GetTags()).To(Equal([]string{"new"}))
+
+	// A defaulted field is present, so the schema no longer requires it.
+	var schema map[string]any
+	g.Expect(json.Unmarshal(registeredTools(t, register)[testdatamcp.TestService_CreateItemToolName].RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema["required"]).To(ConsistOf("item_typeOneOfType"))
+	g.Expect(schema["properties"]).To(HaveKeyWithValue("name", HaveKeyWithValue("default", "widget")))
+}
+
+func TestInputDefaultsNested(t *testing.T) {
+	g := NewWithT(t)
+
+	var got *testdata.CreateBookingRequest
+	register := func(s *mcpserver.MCPServer) {
+		testdatamcp.ForwardToBookingServiceClient(s, &testdatamcp.MockBookingServiceHandler{
+			CreateBookingFunc: func(_ context.Context, req *testdata.CreateBookingRequest) (*testdata.CreateBookingResponse, error) {
+				got = req
+				return &testdata.CreateBookingResponse{}, nil
+			},
+		}, runtime.WithInputDefaults(map[string]map[string]any{
+			testdatamcp.BookingService_CreateBookingToolName: {"guest.name": "Walk-in"},
+		}))
+	}
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	register(s)
+
+	// An omitted message on the way is created.
+	resultText(g, callTool(t, s, testdatamcp.BookingService_CreateBookingToolName, map[string]any{}))
+	g.Expect(got.GetGuest().GetName()).To(Equal("Walk-in"))
+
+	resultText(g, callTool(t, s, testdatamcp.BookingService_CreateBookingToolName, map[string]any{"guest": map[string]any{"birthDate": "1815-12-10"}}))
+	g.Expect(got.GetGuest().GetName()).To(Equal("Walk-in"))
+	g.Expect(got.GetGuest().GetBirthDate().GetYear()).To(BeEquivalentTo(1815))
+
+	resultText(g, callTool(t, s, testdatamcp.BookingService_CreateBookingToolName, map[string]any{"guest": map[string]any{"name": "Ada"}}))
+	g.Expect(got.GetGuest().GetName()).To(Equal("Ada"))
+
+	// The message schema is inlined to carry the default, leaving $defs alone.
+	var schema map[string]any
+	g.Expect(json.Unmarshal(registeredTools(t, register)[testdatamcp.BookingService_CreateBookingToolName].RawInputSchema, &schema)).To(Succeed())
+	guest := schema["properties"].(map[string]any)["guest"].(map[string]any)
+	g.Expect(guest).ToNot(HaveKey("$ref"))
+	g.Expect(guest["properties"]).To(HaveKeyWithValue("name", HaveKeyWithValue("default", "Walk-in")))
+	for _, def := range schema["$defs"].(map[string]any) {
+		g.Expect(def).ToNot(HaveKeyWithValue("properties", HaveKeyWithValue("name", HaveKey("default"))))
+	}
+}
+
+func TestInputDefaultsParseArgs(t *testing.T) {
+	g := NewWithT(t)
+
+	// Defaults are keyed by the registered tool name, also when overridden.
+	req, err := testdatamcp.ParseTestServiceCreateItemArgs(map[string]any{},
+		runtime.WithToolNameOverride(map[string]string{"testdata.TestService.CreateItem": "create_item"}),
+		runtime.WithInputDefaults(map[string]map[string]any{"create_item": {"name": "widget"}}),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(req.GetName()).To(Equal("widget"))
+}
+
+func TestInputDefaultsUnknownField(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	g.Expect(func() {
+		testdatamcp.ForwardToTestServiceClient(s, testServiceClient{server: &testServer{}}, runtime.WithInputDefaults(map[string]map[string]any{
+			testdatamcp.TestService_CreateItemToolName: {"region": "us-east-1"},
+		}))
+	}).To(PanicWith(MatchError(ContainSubstring(`input default "region": testdata.CreateItemRequest has no field "region"`))))
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestInt64Note(t *testing.T) {
+	g := NewWithT(t)
+
+	md := (&testdata.SearchWidgetsRequest{}).ProtoReflect().Descriptor()
+	fg := &FileGenerator{int64Note: DefaultInt64Note}
+
+	g.Expect(fg.getType(md.Fields().ByName("max_size_bytes"))["description"]).To(Equal(DefaultInt64Note))
+	g.Expect(fg.getType(md.Fields().ByName("page"))).ToNot(HaveKey("description"), "int32 needs no note")
+
+	// The field comment stays first.
+	withComment := fg.getTypeWithDefsAndComment(md.Fields().ByName("max_size_bytes"), "Upper bound.", directionInput, map[string]any{}, map[string]bool{})
+	g.Expect(withComment["description"]).To(Equal("Upper bound. (" + DefaultInt64Note + ")"))
+
+	// Generated tools carry the default note.
+	g.Expect(testdatamcp.ExampleService_CountWidgetsTool.JSONSchema).To(ContainSubstring("Only count widgets created after this id. (" + DefaultInt64Note + ")"))
+}
+
+func TestInt64Format(t *testing.T) {
+	g := NewWithT(t)
+
+	kinds := map[descriptorpb.FieldDescriptorProto_Type]string{
+		descriptorpb.FieldDescriptorProto_TYPE_INT64:    "int64",
+		descriptorpb.FieldDescriptorProto_TYPE_SINT64:   "int64",
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED64: "int64",
+		descriptorpb.FieldDescriptorProto_TYPE_UINT64:   "uint64",
+		descriptorpb.FieldDescriptorProto_TYPE_FIXED64:  "uint64",
+	}
+	msg := &descriptorpb.DescriptorProto{Name: proto.String("M")}
+	for typ := range kinds {
+		msg.Field = append(msg.Field, &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(typ.String()),
+			Number: proto.Int32(int32(typ)),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   typ.Enum(),
+		})
+	}
+	msg.Field = append(msg.Field, &descriptorpb.FieldDescriptorProto{
+		Name:   proto.String("small"),
+		Number: proto.Int32(100),
+		Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:   descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum(),
+	})
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:        proto.String("int64format/test.proto"),
+		Package:     proto.String("int64format"),
+		Syntax:      proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{msg},
+	}, protoregistry.GlobalFiles)
+	g.Expect(err).ToNot(HaveOccurred())
+	md := fd.Messages().Get(0)
+
+	fg := &FileGenerator{int64Note: DefaultInt64Note}
+	for typ, format := range kinds {
+		schema := fg.getType(md.Fields().ByName(protoreflect.Name(typ.String())))
+		g.Expect(schema).To(HaveKeyWithValue("type", "integer"), typ.String())
+		g.Expect(schema).To(HaveKeyWithValue("format", format), typ.String())
+		g.Expect(schema).To(HaveKeyWithValue("description", DefaultInt64Note), typ.String())
+	}
+	g.Expect(fg.getType(md.Fields().ByName("small"))).ToNot(HaveKey("format"), "int32 keeps no format")
+
+	// The wrapper types carry the format too.
+	wkt := (&testdata.WktTestMessage{}).ProtoReflect().Descriptor()
+	g.Expect(fg.getType(wkt.Fields().ByName("int64_value"))).To(HaveKeyWithValue("format", "int64"))
+}
+
+func TestInt64NoteConfig(t *testing.T) {
+	file := (&testdata.CountWidgetsRequest{}).ProtoReflect().Descriptor().ParentFile()
+
+	t.Run("custom", func(t *testing.T) {
+		g := NewWithT(t)
+		out := generatedGoFile(t, file, GenerateConfig{Int64Note: "ID; pass it as a string"})
+		g.Expect(out).To(ContainSubstring("ID; pass it as a string"))
+		g.Expect(out).ToNot(ContainSubstring(DefaultInt64Note))
+	})
+
+	t.Run("suppressed", func(t *testing.T) {
+		g := NewWithT(t)
+		out := generatedGoFile(t, file, GenerateConfig{Int64Note: "ignored", SuppressInt64Note: true})
+		g.Expect(out).ToNot(ContainSubstring("ignored"))
+		g.Expect(out).ToNot(ContainSubstring(DefaultInt64Note))
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestIntegerFields(t *testing.T) {
+	var got *testdata.ListItemsRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToPaginationServiceClient(s, &testdatamcp.MockPaginationServiceHandler{
+		ListItemsFunc: func(_ context.Context, req *testdata.ListItemsRequest) (*testdata.ListItemsResponse, error) {
+			got = req
+			return &testdata.ListItemsResponse{}, nil
+		},
+	})
+
+	t.Run("integral floats are accepted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		resp := callTool(t, s, testdatamcp.PaginationService_ListItemsToolName, map[string]any{
+			"page_size": 5.0,
+			"page":      2.0,
+		})
+		g.Expect(resultText(g, resp)).To(MatchJSON(`{"items":[],"total":0}`))
+		g.Expect(got.GetPageSize()).To(Equal(int32(5)))
+		g.Expect(got.GetPage()).To(Equal(int32(1)))
+	})
+
+	t.Run("fractions are rejected", func(t *testing.T) {
+		g := NewWithT(t)
+
+		resp := callTool(t, s, testdatamcp.PaginationService_ListItemsToolName, map[string]any{"page_size": 5.5})
+		g.Expect(resultText(g, resp)).To(MatchJSON(`{"code":"INVALID_ARGUMENT","message":"page_size: 5.5 is not an integer"}`))
+	})
+
+	t.Run("out of range values are rejected", func(t *testing.T) {
+		g := NewWithT(t)
+
+		resp := callTool(t, s, testdatamcp.PaginationService_ListItemsToolName, map[string]any{"page_size": 3e9})
+		g.Expect(resultText(g, resp)).To(MatchJSON(`{"code":"INVALID_ARGUMENT","message":"page_size: 3e+09 is out of range for int32"}`))
+	})
+
+	t.Run("parse args", func(t *testing.T) {
+		g := NewWithT(t)
+
+		req, err := testdatamcp.ParsePaginationServiceListItemsArgs(map[string]any{"query": map[string]any{"inner_page": 3.0}})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(req.GetQuery().GetInnerPage()).To(Equal(int32(2)))
+
+		_, err = testdatamcp.ParsePaginationServiceListItemsArgs(map[string]any{"ignored_repeated_pages": []any{1.0, 2.5}})
+		g.Expect(err).To(MatchError(ContainSubstring("ignored_repeated_pages: 2.5 is not an integer")))
+	})
+}
+
+func TestIntegerFieldsInt64Strings(t *testing.T) {
+	g := NewWithT(t)
+
+	var got *testdata.CountWidgetsRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToExampleServiceClient(s, &testdatamcp.MockExampleServiceHandler{
+		CountWidgetsFunc: func(_ context.Context, req *testdata.CountWidgetsRequest) (*testdata.CountWidgetsResponse, error) {
+			got = req
+			return &testdata.CountWidgetsResponse{}, nil
+		},
+	})
+
+	resp := callTool(t, s, testdatamcp.ExampleService_CountWidgetsToolName, map[string]any{"since_id": "9007199254740993.0"})
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"count":"0"}`))
+	g.Expect(got.GetSinceId()).To(Equal(int64(9007199254740993)))
+
+	resp = callTool(t, s, testdatamcp.ExampleService_CountWidgetsToolName, map[string]any{"since_id": "42.5"})
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"code":"INVALID_ARGUMENT","message":"since_id: 42.5 is not an integer"}`))
+}
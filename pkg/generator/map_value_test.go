@@ -0,0 +1,140 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestMapOfValueSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{}
+	md := (&testdata.ProcessWellKnownTypesRequest{}).ProtoReflect().Descriptor()
+
+	schema := fg.getType(md.Fields().ByName("attributes"))
+	g.Expect(schema["type"]).To(Equal("object"))
+	g.Expect(schema["additionalProperties"]).To(BeTrue())
+	g.Expect(schema["description"]).To(ContainSubstring("any JSON value"))
+	g.Expect(schema).ToNot(HaveKey("propertyNames"), "string keys need no constraint")
+
+	// Other maps keep the typed value schema.
+	labels := fg.getType((&testdata.CreateItemRequest{}).ProtoReflect().Descriptor().Fields().ByName("labels"))
+	g.Expect(labels["additionalProperties"]).To(Equal(map[string]any{"type": "string"}))
+
+	// The field comment still wins in the tool schema.
+	withComment := fg.getTypeWithDefsAndComment(md.Fields().ByName("attributes"), "Free-form attributes", directionInput, map[string]any{}, map[string]bool{})
+	g.Expect(withComment["description"]).To(Equal("Free-form attributes"))
+	g.Expect(withComment["additionalProperties"]).To(BeTrue())
+}
+
+func TestRepeatedValueSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	md := (&testdata.ProcessWellKnownTypesRequest{}).ProtoReflect().Descriptor()
+	anyJSON := []string{"object", "array", "string", "number", "boolean", "null"}
+
+	// Each item takes any JSON value, as a singular Value field does.
+	schema := (&FileGenerator{}).getType(md.Fields().ByName("arguments"))
+	g.Expect(schema["type"]).To(Equal("array"))
+	g.Expect(schema["items"]).To(HaveKeyWithValue("type", anyJSON))
+	g.Expect(schema["items"]).ToNot(HaveKey("properties"))
+
+	// Items keep null under optional_fields=omit, and accept anything under
+	// nullable_style=keyword.
+	schema = (&FileGenerator{optionalFields: OptionalFieldsOmit}).getType(md.Fields().ByName("arguments"))
+	g.Expect(schema["items"]).To(HaveKeyWithValue("type", anyJSON))
+	schema = (&FileGenerator{nullableStyle: NullableStyleKeyword}).getType(md.Fields().ByName("arguments"))
+	g.Expect(schema["items"]).To(HaveKeyWithValue("nullable", true))
+	g.Expect(schema["items"]).ToNot(HaveKey("type"))
+
+	// The map counterpart takes any JSON value per key in every mode.
+	for _, fg := range []*FileGenerator{{}, {optionalFields: OptionalFieldsOmit}, {nullableStyle: NullableStyleKeyword}, {inlineMessages: true}} {
+		schema = fg.getType(md.Fields().ByName("attributes"))
+		g.Expect(schema["additionalProperties"]).To(BeTrue())
+	}
+}
+
+func TestRepeatedValueRoundTrip(t *testing.T) {
+	g := NewWithT(t)
+
+	client := &recordingWKTClient{testServiceClient: testServiceClient{server: &testServer{}}}
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, client)
+
+	arguments := []any{"a", 1.5, true, nil, []any{"b", 2.0}, map[string]any{"k": map[string]any{"v": "w"}}}
+	resp := callTool(t, s, testdatamcp.TestService_ProcessWellKnownTypesTool.Name, map[string]any{"arguments": arguments})
+	g.Expect(resp).To(HaveKey("result"), "unexpected response: %v", resp)
+
+	g.Expect(client.last).ToNot(BeNil())
+	got := make([]any, 0, len(client.last.GetArguments()))
+	for _, v := range client.last.GetArguments() {
+		got = append(got, v.AsInterface())
+	}
+	g.Expect(got).To(Equal(arguments))
+}
+
+// recordingWKTClient captures the ProcessWellKnownTypes request it receives.
+type recordingWKTClient struct {
+	testServiceClient
+	last *testdata.ProcessWellKnownTypesRequest
+}
+
+func (c *recordingWKTClient) ProcessWellKnownTypes(_ context.Context, in *testdata.ProcessWellKnownTypesRequest, _ ...grpc.CallOption) (*testdata.ProcessWellKnownTypesResponse, error) {
+	c.last = in
+	return &testdata.ProcessWellKnownTypesResponse{Success: true}, nil
+}
+
+func TestMapOfValueRoundTrip(t *testing.T) {
+	g := NewWithT(t)
+
+	client := &recordingWKTClient{testServiceClient: testServiceClient{server: &testServer{}}}
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, client)
+
+	attributes := map[string]any{
+		"owner":   "team-a",
+		"retries": 3.0,
+		"enabled": true,
+		"nothing": nil,
+		"tags":    []any{"a", 1.0, false},
+		"nested": map[string]any{
+			"depth": map[string]any{"level": 2.0, "items": []any{map[string]any{"k": "v"}}},
+		},
+	}
+	resp := callTool(t, s, testdatamcp.TestService_ProcessWellKnownTypesTool.Name, map[string]any{"attributes": attributes})
+	g.Expect(resp).To(HaveKey("result"), "unexpected response: %v", resp)
+
+	g.Expect(client.last).ToNot(BeNil())
+	got := map[string]any{}
+	for k, v := range client.last.GetAttributes() {
+		got[k] = v.AsInterface()
+	}
+	g.Expect(got).To(Equal(attributes))
+
+	// And the wire form is a plain JSON object.
+	raw, err := protojson.Marshal(client.last)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(raw)).To(ContainSubstring(`"level":2`))
+}
@@ -0,0 +1,30 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+// applyMessageOptions adds the schema metadata of the (mcp.options.message)
+// of md, if any, to its object schema.
+func applyMessageOptions(md protoreflect.MessageDescriptor, schema map[string]any) {
+	opts, _, _ := getExtension[*mcpoptions.MessageOptions](md, mcpoptions.E_Message)
+	if opts.GetAllowAdditional() {
+		schema["additionalProperties"] = true
+	}
+}
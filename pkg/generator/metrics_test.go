@@ -0,0 +1,93 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// callMetrics is what a runtime.MetricsFunc received for one call.
+type callMetrics struct {
+	method              string
+	reqBytes, respBytes int
+	dur                 time.Duration
+	err                 error
+}
+
+// recordingMetrics returns a runtime.MetricsFunc that appends to got.
+func recordingMetrics(mu *sync.Mutex, got *[]callMetrics) runtime.MetricsFunc {
+	return func(method string, reqBytes, respBytes int, dur time.Duration, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		*got = append(*got, callMetrics{method, reqBytes, respBytes, dur, err})
+	}
+}
+
+func TestMetricsSuccessfulCall(t *testing.T) {
+	g := NewWithT(t)
+
+	var mu sync.Mutex
+	var got []callMetrics
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, &testdatamcp.MockTestServiceHandler{
+		GetItemFunc: func(_ context.Context, req *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+			time.Sleep(5 * time.Millisecond)
+			return &testdata.GetItemResponse{Item: &testdata.Item{Id: req.Id, Name: "widget"}}, nil
+		},
+	}, runtime.WithMetrics(recordingMetrics(&mu, &got)))
+
+	resp := callTool(t, s, testdatamcp.TestService_GetItemTool.Name, map[string]any{"id": "42"})
+	text := resultText(g, resp)
+
+	g.Expect(got).To(HaveLen(1))
+	g.Expect(got[0].method).To(Equal("testdata.TestService.GetItem"))
+	g.Expect(got[0].reqBytes).To(Equal(len(`{"id":"42"}`)))
+	g.Expect(got[0].respBytes).To(Equal(len(text)))
+	g.Expect(got[0].dur).To(BeNumerically(">=", 5*time.Millisecond))
+	g.Expect(got[0].err).ToNot(HaveOccurred())
+}
+
+func TestMetricsFailedCall(t *testing.T) {
+	g := NewWithT(t)
+
+	var mu sync.Mutex
+	var got []callMetrics
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, &testdatamcp.MockTestServiceHandler{
+		GetItemFunc: func(context.Context, *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+			return nil, status.Error(codes.NotFound, "no such item")
+		},
+	}, runtime.WithMetrics(recordingMetrics(&mu, &got)))
+
+	resp := callTool(t, s, testdatamcp.TestService_GetItemTool.Name, map[string]any{"id": "42"})
+	g.Expect(resultText(g, resp)).To(ContainSubstring("NOT_FOUND"))
+
+	g.Expect(got).To(HaveLen(1))
+	g.Expect(got[0].respBytes).To(BeZero())
+	g.Expect(status.Code(got[0].err)).To(Equal(codes.NotFound))
+	g.Expect(status.Convert(got[0].err).Message()).To(Equal("no such item"))
+}
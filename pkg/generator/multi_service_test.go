@@ -0,0 +1,74 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// TestMultiServiceFileSharesHelpers checks that the argument helpers of a
+// file with two services live in the runtime package instead of being
+// emitted once per service.
+func TestMultiServiceFileSharesHelpers(t *testing.T) {
+	g := NewWithT(t)
+
+	file := testdata.File_testdata_multi_service_test_proto
+	content := generatedGoFile(t, file, GenerateConfig{PackageSuffix: "mcp"})
+	g.Expect(content).ToNot(ContainSubstring(`strings.HasSuffix(key, "OneOfType")`), "the oneof transform is not inlined")
+	g.Expect(strings.Count(content, "runtime.TransformOneOfFields(")).To(Equal(6), "one argument parser, one wrapper per service and one per method")
+	g.Expect(strings.Count(content, "runtime.NormalizeTopLevelJSONStrings(")).To(Equal(4), "one argument parser and one wrapper per service")
+}
+
+func TestMultiServiceFileForwardsBothServices(t *testing.T) {
+	g := NewWithT(t)
+
+	var order *testdata.PlaceOrderRequest
+	var stock *testdata.ReserveStockRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToOrderServiceClient(s, &testdatamcp.MockOrderServiceHandler{
+		PlaceOrderFunc: func(_ context.Context, req *testdata.PlaceOrderRequest) (*testdata.PlaceOrderResponse, error) {
+			order = req
+			return &testdata.PlaceOrderResponse{OrderId: "o-1"}, nil
+		},
+	})
+	testdatamcp.ForwardToInventoryServiceClient(s, &testdatamcp.MockInventoryServiceHandler{
+		ReserveStockFunc: func(_ context.Context, req *testdata.ReserveStockRequest) (*testdata.ReserveStockResponse, error) {
+			stock = req
+			return &testdata.ReserveStockResponse{Reserved: true}, nil
+		},
+	})
+
+	text := resultText(g, callTool(t, s, "testdata_OrderService_PlaceOrder", map[string]any{
+		"paymentOneOfType": map[string]any{"object_type": "voucher_code", "voucher_code": "SPRING"},
+	}))
+	g.Expect(text).To(ContainSubstring("o-1"))
+	g.Expect(order.GetVoucherCode()).To(Equal("SPRING"))
+
+	text = resultText(g, callTool(t, s, "testdata_InventoryService_ReserveStock", map[string]any{
+		"sku":             "sku-1",
+		"targetOneOfType": map[string]any{"object_type": "store", "store": "berlin"},
+	}))
+	g.Expect(text).To(ContainSubstring("reserved"))
+	g.Expect(stock.GetSku()).To(Equal("sku-1"))
+	g.Expect(stock.GetStore()).To(Equal("berlin"))
+}
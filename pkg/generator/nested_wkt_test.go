@@ -0,0 +1,110 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// tripLegProperties are the properties of TripLeg, two messages below
+// PlanTripRequest, which keep the schemas of their well-known types.
+var tripLegProperties = map[string]any{
+	"departs_at": map[string]any{"type": []any{"string", "null"}, "format": "date-time"},
+	"length":     map[string]any{"type": []any{"string", "null"}, "pattern": `^-?[0-9]+(\.[0-9]+)?s$`},
+	"notes":      map[string]any{"type": "object"},
+	"stops_at": map[string]any{
+		"type":  "array",
+		"items": map[string]any{"type": []any{"string", "null"}, "format": "date-time"},
+	},
+}
+
+func TestNestedWellKnownTypes(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(testdatamcp.TripService_PlanTripTool.JSONSchema), &schema)).To(Succeed())
+	defs := schema["$defs"].(map[string]any)
+	// The well-known types are not factored out as generic messages.
+	g.Expect(defs).To(HaveLen(2))
+	g.Expect(defs).To(HaveKey("Itinerary"))
+	g.Expect(defs["TripLeg"]).To(HaveKeyWithValue("properties", tripLegProperties))
+}
+
+func TestNestedWellKnownTypesInline(t *testing.T) {
+	g := NewWithT(t)
+
+	file := testdata.File_testdata_nested_wkt_test_proto
+	plugin, err := protogen.Options{}.New(codeGeneratorRequest(file))
+	g.Expect(err).ToNot(HaveOccurred())
+	fg := NewFileGenerator(plugin.FilesByPath[file.Path()], plugin)
+	fg.inlineMessages = true
+	fg.timestampFormat = TimestampFormatUnixSeconds
+	schema := fg.messageSchemaFromDescriptor((&testdata.PlanTripRequest{}).ProtoReflect().Descriptor(), nil, directionInput)
+
+	itinerary := schema["properties"].(map[string]any)["itinerary"].(map[string]any)
+	firstLeg := itinerary["properties"].(map[string]any)["first_leg"].(map[string]any)
+	g.Expect(firstLeg["properties"]).To(HaveKeyWithValue("departs_at", map[string]any{
+		"type":        []string{"integer", "null"},
+		"description": unixSecondsNote,
+	}))
+	legsByCity := itinerary["properties"].(map[string]any)["legs_by_city"].(map[string]any)
+	g.Expect(legsByCity["additionalProperties"]).To(HaveKeyWithValue("properties", HaveKeyWithValue("length", HaveKeyWithValue("pattern", `^-?[0-9]+(\.[0-9]+)?s$`))))
+	g.Expect(legsByCity["additionalProperties"]).To(HaveKeyWithValue("properties", HaveKeyWithValue("notes", map[string]any{"type": "object"})))
+}
+
+func TestNestedWellKnownTypesCall(t *testing.T) {
+	g := NewWithT(t)
+
+	var got *testdata.PlanTripRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTripServiceClient(s, &testdatamcp.MockTripServiceHandler{
+		PlanTripFunc: func(_ context.Context, req *testdata.PlanTripRequest) (*testdata.PlanTripResponse, error) {
+			got = req
+			return &testdata.PlanTripResponse{Itinerary: req.GetItinerary()}, nil
+		},
+	})
+	leg := map[string]any{
+		"departs_at": "2025-03-01T08:30:00Z",
+		"length":     "5400s",
+		"notes":      map[string]any{"seat": "12A"},
+		"stops_at":   []any{"2025-03-01T09:15:00Z"},
+	}
+	resp := callTool(t, s, testdatamcp.TripService_PlanTripToolName, map[string]any{
+		"itinerary": map[string]any{
+			"name":         "spring",
+			"first_leg":    leg,
+			"legs_by_city": map[string]any{"Lyon": leg},
+		},
+	})
+	g.Expect(resp["result"]).ToNot(HaveKeyWithValue("isError", true), "unexpected response: %v", resp)
+
+	for _, leg := range []*testdata.TripLeg{got.GetItinerary().GetFirstLeg(), got.GetItinerary().GetLegsByCity()["Lyon"]} {
+		g.Expect(leg.GetDepartsAt().AsTime()).To(Equal(time.Date(2025, 3, 1, 8, 30, 0, 0, time.UTC)))
+		g.Expect(leg.GetLength().AsDuration()).To(Equal(90 * time.Minute))
+		g.Expect(leg.GetNotes().AsMap()).To(Equal(map[string]any{"seat": "12A"}))
+		g.Expect(leg.GetStopsAt()).To(HaveLen(1))
+	}
+	g.Expect(resultText(g, resp)).To(ContainSubstring(`"departs_at":"2025-03-01T08:30:00Z"`))
+}
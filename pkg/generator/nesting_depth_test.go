@@ -0,0 +1,70 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// nestedArgs returns an object nested depth levels deep, where every second
+// level is an array.
+func nestedArgs(depth int) map[string]any {
+	var v any = map[string]any{"leaf": true}
+	for level := 2; level < depth; level++ {
+		if level%2 == 0 {
+			v = []any{v}
+		} else {
+			v = map[string]any{"next": v}
+		}
+	}
+	if depth == 1 {
+		return v.(map[string]any)
+	}
+	return map[string]any{"root": v}
+}
+
+func TestTransformOneOfFieldsDepthGuard(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(testdatamcp.TestServiceTransformOneOfFields(nestedArgs(10), 10)).To(Succeed())
+
+	err := testdatamcp.TestServiceTransformOneOfFields(nestedArgs(11), 10)
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	g.Expect(err).To(MatchError(ContainSubstring("nested more than 10 levels deep")))
+
+	g.Expect(testdatamcp.TestServiceTransformOneOfFields(nestedArgs(5000), 0)).To(Succeed(), "0 disables the limit")
+}
+
+func TestDeeplyNestedArgumentsRejected(t *testing.T) {
+	g := NewWithT(t)
+
+	args := map[string]any{"id": "item-1", "extra": nestedArgs(runtime.DefaultMaxNestingDepth + 10)}
+	resp := callGetItem(t, newTransformerTestServer(), args)
+	g.Expect(resp).To(HaveKey("result"), "unexpected response: %v", resp)
+	result := resp["result"].(map[string]any)
+	g.Expect(result["isError"]).To(BeTrue())
+	g.Expect(result["content"].([]any)[0].(map[string]any)["text"]).To(ContainSubstring("nested more than 100 levels deep"))
+
+	// A raised limit lets the same call through; the unknown field is dropped.
+	text := resultText(g, callGetItem(t, newTransformerTestServer(runtime.WithMaxNestingDepth(1000)), args))
+	g.Expect(text).To(ContainSubstring("widget"))
+}
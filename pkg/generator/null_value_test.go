@@ -0,0 +1,79 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestNullValueSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(testdatamcp.NicknameService_UpdateNicknameTool.JSONSchema), &schema)).To(Succeed())
+	props := schema["properties"].(map[string]any)
+	g.Expect(props["reserved_marker"]).To(Equal(map[string]any{"type": "null", "description": nullValueNote}))
+	g.Expect(props["placeholders"].(map[string]any)["items"]).To(HaveKeyWithValue("type", "null"))
+
+	variants := props["changeOneOfType"].(map[string]any)["oneOf"].([]any)
+	clear := variants[1].(map[string]any)["properties"].(map[string]any)["clear"]
+	g.Expect(clear).To(HaveKeyWithValue("type", "null"))
+	g.Expect(clear).To(HaveKeyWithValue("description", "Clears the nickname.\n\n"+nullValueNote))
+}
+
+func TestNullValueSchemaKeyword(t *testing.T) {
+	g := NewWithT(t)
+
+	file := testdata.File_testdata_null_value_test_proto
+	plugin, fg := runPlugin(t, codeGeneratorRequest(file), GenerateConfig{NullableStyle: NullableStyleKeyword})
+	g.Expect(plugin.Response().GetError()).To(BeEmpty())
+
+	meth := plugin.FilesByPath[file.Path()].Services[0].Methods[0]
+	props := fg.messageSchemaWithDefs(meth.Input.Desc, meth.Input, directionInput)["properties"].(map[string]any)
+	g.Expect(props["reserved_marker"]).To(Equal(map[string]any{"nullable": true, "enum": []any{nil}, "description": nullValueNote}))
+}
+
+func TestNullValueCall(t *testing.T) {
+	g := NewWithT(t)
+
+	var got *testdata.UpdateNicknameRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToNicknameServiceClient(s, &testdatamcp.MockNicknameServiceHandler{
+		UpdateNicknameFunc: func(_ context.Context, req *testdata.UpdateNicknameRequest) (*testdata.UpdateNicknameResponse, error) {
+			got = req
+			_, cleared := req.GetChange().(*testdata.UpdateNicknameRequest_Clear)
+			return &testdata.UpdateNicknameResponse{UserId: req.GetUserId(), Cleared: cleared}, nil
+		},
+	})
+
+	resp := callTool(t, s, testdatamcp.NicknameService_UpdateNicknameToolName, map[string]any{
+		"user_id":         "u-1",
+		"changeOneOfType": map[string]any{"object_type": "clear", "clear": nil},
+		"reserved_marker": nil,
+		"placeholders":    []any{nil, nil},
+	})
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"user_id":"u-1","cleared":true,"previous":null}`))
+	g.Expect(got.GetChange()).To(Equal(&testdata.UpdateNicknameRequest_Clear{Clear: structpb.NullValue_NULL_VALUE}))
+	g.Expect(got.GetPlaceholders()).To(HaveLen(2))
+}
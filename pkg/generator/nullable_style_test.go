@@ -0,0 +1,65 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNullableStyle(t *testing.T) {
+	t.Run("type_array", func(t *testing.T) {
+		g := NewWithT(t)
+		schema, errMsg := setReminderSchemaWithConfig(t, GenerateConfig{NullableStyle: NullableStyleTypeArray})
+		g.Expect(errMsg).To(BeEmpty())
+		props := schema["properties"].(map[string]any)
+		g.Expect(props["due"]).To(HaveKeyWithValue("type", []string{"string", "null"}))
+		g.Expect(props["due"]).ToNot(HaveKey("nullable"))
+		g.Expect(props["snooze"]).To(HaveKeyWithValue("type", []string{"string", "null"}))
+		g.Expect(props["history"].(map[string]any)["items"]).To(HaveKeyWithValue("type", []string{"string", "null"}))
+	})
+
+	t.Run("keyword", func(t *testing.T) {
+		g := NewWithT(t)
+		schema, errMsg := setReminderSchemaWithConfig(t, GenerateConfig{NullableStyle: NullableStyleKeyword})
+		g.Expect(errMsg).To(BeEmpty())
+		props := schema["properties"].(map[string]any)
+		g.Expect(props["due"]).To(And(HaveKeyWithValue("type", "string"), HaveKeyWithValue("nullable", true)))
+		g.Expect(props["snooze"]).To(And(HaveKeyWithValue("type", "string"), HaveKeyWithValue("nullable", true)))
+		g.Expect(props["priority"]).To(And(HaveKeyWithValue("type", "integer"), HaveKeyWithValue("nullable", true)))
+		g.Expect(props["history"].(map[string]any)["items"]).To(And(HaveKeyWithValue("type", "string"), HaveKeyWithValue("nullable", true)))
+
+		// OpenAPI 3.0 has no type arrays, so a google.protobuf.Value takes
+		// any value.
+		g.Expect(props["payload"]).ToNot(HaveKey("type"))
+		g.Expect(props["payload"]).To(HaveKeyWithValue("nullable", true))
+	})
+
+	t.Run("keyword with omit", func(t *testing.T) {
+		g := NewWithT(t)
+		schema, errMsg := setReminderSchemaWithConfig(t, GenerateConfig{NullableStyle: NullableStyleKeyword, OptionalFields: OptionalFieldsOmit})
+		g.Expect(errMsg).To(BeEmpty())
+		props := schema["properties"].(map[string]any)
+		g.Expect(props["due"]).To(HaveKeyWithValue("type", "string"))
+		g.Expect(props["due"]).ToNot(HaveKey("nullable"))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		g := NewWithT(t)
+		_, errMsg := setReminderSchemaWithConfig(t, GenerateConfig{NullableStyle: "openapi"})
+		g.Expect(errMsg).To(Equal(`nullable_style "openapi" must be "type_array" or "keyword"`))
+	})
+}
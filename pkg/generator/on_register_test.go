@@ -0,0 +1,82 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestOnRegister(t *testing.T) {
+	g := NewWithT(t)
+
+	var names []string
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToBatchServiceClient(s, &testdatamcp.MockBatchServiceHandler{},
+		runtime.WithOnRegister(func(tool *mcp.Tool) {
+			names = append(names, tool.Name)
+		}),
+		runtime.WithOnRegister(func(tool *mcp.Tool) {
+			if tool.Name == testdatamcp.BatchService_LookupWidgetToolName {
+				tool.Description = "Finds a widget."
+				tool.Annotations.Title = "Find widget"
+			}
+		}),
+		runtime.WithStartupValidation(true),
+	)
+	// Once per tool, in registration order.
+	g.Expect(names).To(Equal([]string{
+		testdatamcp.BatchService_LookupWidgetToolName,
+		testdatamcp.BatchService_LookupWidgetBatchToolName,
+		testdatamcp.BatchService_RenameWidgetToolName,
+	}))
+
+	msg := json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`)
+	raw, err := json.Marshal(s.HandleMessage(context.Background(), msg))
+	g.Expect(err).ToNot(HaveOccurred())
+	var resp struct {
+		Result struct {
+			Tools []mcp.Tool `json:"tools"`
+		} `json:"result"`
+	}
+	g.Expect(json.Unmarshal(raw, &resp)).To(Succeed())
+	tools := map[string]mcp.Tool{}
+	for _, tool := range resp.Result.Tools {
+		tools[tool.Name] = tool
+	}
+	g.Expect(tools).To(HaveLen(3))
+	g.Expect(tools[testdatamcp.BatchService_LookupWidgetToolName].Description).To(Equal("Finds a widget."))
+	g.Expect(tools[testdatamcp.BatchService_LookupWidgetToolName].Annotations.Title).To(Equal("Find widget"))
+	g.Expect(tools[testdatamcp.BatchService_LookupWidgetBatchToolName].Description).To(HavePrefix("Runs lookup_widget"))
+}
+
+func TestOnRegisterRename(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	g.Expect(func() {
+		testdatamcp.ForwardToTestServiceClient(s, testServiceClient{server: &testServer{}}, runtime.WithOnRegister(func(tool *mcp.Tool) {
+			tool.Name = "get_item"
+		}))
+	}).To(PanicWith(ContainSubstring("use runtime.WithToolNameOverride instead")))
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// hasOneOfCollection reports whether md has a oneof variant, directly or in a
+// nested message, list or map value, whose message holds nothing but one
+// repeated or map field. The generated handler then wraps such a variant sent
+// as the bare list or map with runtime.WrapOneOfCollections.
+func hasOneOfCollection(md protoreflect.MessageDescriptor) bool {
+	return hasOneOfCollectionIn(md, map[protoreflect.FullName]bool{})
+}
+
+func hasOneOfCollectionIn(md protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool) bool {
+	if visited[md.FullName()] {
+		return false
+	}
+	visited[md.FullName()] = true
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Message() == nil {
+			continue
+		}
+		if _, isWKT := wellKnownTypeSchemas[string(fd.Message().FullName())]; isWKT {
+			continue
+		}
+		if isOneOfCollection(fd) || hasOneOfCollectionIn(fd.Message(), visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// isOneOfCollection reports whether fd is a oneof variant whose message has a
+// single field, which is repeated or a map.
+func isOneOfCollection(fd protoreflect.FieldDescriptor) bool {
+	if oneof := fd.ContainingOneof(); oneof == nil || oneof.IsSynthetic() || fd.Message() == nil {
+		return false
+	}
+	fields := fd.Message().Fields()
+	return fields.Len() == 1 && (fields.Get(0).IsList() || fields.Get(0).IsMap())
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestOneOfCollectionSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(testdatamcp.SegmentService_DefineSegmentTool.JSONSchema), &schema)).To(Succeed())
+	members := schema["properties"].(map[string]any)["membersOneOfType"].(map[string]any)
+	g.Expect(members["oneOf"]).To(ContainElement(HaveKeyWithValue("properties", HaveKeyWithValue("user_ids", HaveKeyWithValue("$ref", "#/$defs/UserIdList")))))
+	g.Expect(members["oneOf"]).To(ContainElement(HaveKeyWithValue("properties", HaveKeyWithValue("filters", HaveKeyWithValue("$ref", "#/$defs/AttributeFilters")))))
+
+	defs := schema["$defs"].(map[string]any)
+	g.Expect(defs["UserIdList"]).To(HaveKeyWithValue("properties", HaveKeyWithValue("user_ids", HaveKeyWithValue("type", "array"))))
+	g.Expect(defs["AttributeFilters"]).To(HaveKeyWithValue("properties", HaveKeyWithValue("by_attribute", HaveKeyWithValue("type", "object"))))
+
+	g.Expect(hasOneOfCollection((&testdata.DefineSegmentRequest{}).ProtoReflect().Descriptor())).To(BeTrue())
+	g.Expect(hasOneOfCollection((&testdata.CreateBookingRequest{}).ProtoReflect().Descriptor())).To(BeFalse())
+}
+
+func TestOneOfCollectionCall(t *testing.T) {
+	var got *testdata.DefineSegmentRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToSegmentServiceClient(s, &testdatamcp.MockSegmentServiceHandler{
+		DefineSegmentFunc: func(_ context.Context, req *testdata.DefineSegmentRequest) (*testdata.DefineSegmentResponse, error) {
+			got = req
+			return &testdata.DefineSegmentResponse{Name: req.GetName()}, nil
+		},
+	})
+
+	for _, tc := range []struct {
+		name    string
+		members map[string]any
+		want    string
+	}{
+		{
+			name:    "message",
+			members: map[string]any{"object_type": "user_ids", "user_ids": map[string]any{"user_ids": []any{"a", "b"}}},
+			want:    `{"name":"vip","userIds":{"userIds":["a","b"]}}`,
+		},
+		{
+			name:    "bare list",
+			members: map[string]any{"object_type": "user_ids", "user_ids": []any{"a", "b"}},
+			want:    `{"name":"vip","userIds":{"userIds":["a","b"]}}`,
+		},
+		{
+			name:    "flattened map",
+			members: map[string]any{"object_type": "filters", "by_attribute": map[string]any{"plan": "pro"}},
+			want:    `{"name":"vip","filters":{"byAttribute":{"plan":"pro"}}}`,
+		},
+		{
+			name:    "bare map",
+			members: map[string]any{"object_type": "filters", "filters": map[string]any{"plan": "pro"}},
+			want:    `{"name":"vip","filters":{"byAttribute":{"plan":"pro"}}}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got = nil
+			resp := callTool(t, s, testdatamcp.SegmentService_DefineSegmentToolName, map[string]any{
+				"name":             "vip",
+				"membersOneOfType": tc.members,
+			})
+			g.Expect(resultText(g, resp)).To(MatchJSON(`{"name":"vip","member_count":0}`))
+			g.Expect(protojson.Marshal(got)).To(MatchJSON(tc.want))
+		})
+	}
+
+	t.Run("nested", func(t *testing.T) {
+		g := NewWithT(t)
+		resp := callTool(t, s, testdatamcp.SegmentService_DefineSegmentToolName, map[string]any{
+			"name":             "vip",
+			"membersOneOfType": map[string]any{"object_type": "query", "query": "plan = 'pro'"},
+			"rules": []any{
+				map[string]any{"matchOneOfType": map[string]any{"object_type": "exclude", "exclude": []any{"c"}}},
+				map[string]any{"matchOneOfType": map[string]any{"object_type": "pattern", "pattern": "test-*"}},
+			},
+		})
+		g.Expect(resultText(g, resp)).To(MatchJSON(`{"name":"vip","member_count":0}`))
+		g.Expect(got.GetRules()).To(HaveLen(2))
+		g.Expect(got.GetRules()[0].GetExclude().GetUserIds()).To(Equal([]string{"c"}))
+		g.Expect(got.GetRules()[1].GetPattern()).To(Equal("test-*"))
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestOneOfWrapperDescription(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(testdatamcp.OrderService_PlaceOrderTool.JSONSchema), &schema)).To(Succeed())
+	wrapper := schema["properties"].(map[string]any)["paymentOneOfType"].(map[string]any)
+	g.Expect(wrapper["description"]).To(Equal(`Exactly one variant of the oneof "payment". Set "object_type" to one of "card_token", "voucher_code", and fill in the properties of that variant.`))
+}
+
+func TestOneOfDescriptionListsVariantsInOrder(t *testing.T) {
+	g := NewWithT(t)
+
+	desc := oneOfDescription("kind", []map[string]any{{"title": "product"}, {"title": "service"}, {"title": "bundle"}})
+	g.Expect(desc).To(ContainSubstring(`"kind"`))
+	g.Expect(desc).To(ContainSubstring(`"object_type" to one of "product", "service", "bundle"`))
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+// OneOfDiscriminator maps a (mcp.options.oneof_discriminator) value back to
+// the oneof field it selects. Key is the wrapper property of the oneof.
+type OneOfDiscriminator struct {
+	Key   string
+	Value string
+	Field string
+}
+
+// oneofDiscriminator returns the "object_type" value that selects fd in its
+// oneof: the (mcp.options.oneof_discriminator) when set, else the field name.
+func oneofDiscriminator(fd protoreflect.FieldDescriptor) string {
+	value, _, _ := getExtension[string](fd, mcpoptions.E_OneofDiscriminator)
+	if value == "" {
+		return string(fd.Name())
+	}
+	return value
+}
+
+// checkOneofDiscriminators reports every (mcp.options.oneof_discriminator)
+// on a field outside a oneof, and every oneof with two fields selected by the
+// same value, in messages and their nested messages.
+func (g *FileGenerator) checkOneofDiscriminators(messages []*protogen.Message) bool {
+	ok := true
+	for _, msg := range messages {
+		for _, field := range msg.Fields {
+			value, _, err := getExtension[string](field.Desc, mcpoptions.E_OneofDiscriminator)
+			if err != nil {
+				g.gen.Error(err)
+				ok = false
+				continue
+			}
+			if value == "" {
+				continue
+			}
+			if oneOf := field.Desc.ContainingOneof(); oneOf == nil || oneOf.IsSynthetic() {
+				g.gen.Error(fmt.Errorf("mcpgen: %s has (mcp.options.oneof_discriminator) but is not in a oneof", field.Desc.FullName()))
+				ok = false
+			}
+		}
+		for _, oneOf := range msg.Oneofs {
+			seen := map[string]protoreflect.Name{}
+			for _, field := range oneOf.Fields {
+				value := oneofDiscriminator(field.Desc)
+				if other, dup := seen[value]; dup {
+					g.gen.Error(fmt.Errorf("mcpgen: fields %s and %s of oneof %s share the discriminator %q", other, field.Desc.Name(), oneOf.Desc.FullName(), value))
+					ok = false
+				}
+				seen[value] = field.Desc.Name()
+			}
+		}
+		if !g.checkOneofDiscriminators(msg.Messages) {
+			ok = false
+		}
+	}
+	return ok
+}
+
+// collectOneofDiscriminators walks md and every message it reaches, and
+// returns the custom discriminators the runtime needs to map back. It fails
+// when two oneofs of the same name select different fields with one value,
+// as the runtime could not tell them apart.
+func (g *FileGenerator) collectOneofDiscriminators(md protoreflect.MessageDescriptor) ([]OneOfDiscriminator, error) {
+	var all []OneOfDiscriminator
+	if err := g.collectOneofDiscriminatorsInto(md, map[string]bool{}, &all); err != nil {
+		return nil, err
+	}
+	var out []OneOfDiscriminator
+	for _, d := range all {
+		if d.Value != d.Field {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func (g *FileGenerator) collectOneofDiscriminatorsInto(md protoreflect.MessageDescriptor, visited map[string]bool, out *[]OneOfDiscriminator) error {
+	full := string(md.FullName())
+	if visited[full] {
+		return nil
+	}
+	visited[full] = true
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if oneOf := fd.ContainingOneof(); oneOf != nil && !oneOf.IsSynthetic() {
+			d := OneOfDiscriminator{Key: g.oneOfWrapperKeyOf(oneOf), Value: oneofDiscriminator(fd), Field: string(fd.Name())}
+			if err := addOneofDiscriminator(out, d, fd); err != nil {
+				return err
+			}
+		}
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Message() == nil {
+			continue
+		}
+		if _, isWKT := wellKnownTypeSchemas[string(fd.Message().FullName())]; !isWKT {
+			if err := g.collectOneofDiscriminatorsInto(fd.Message(), visited, out); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func addOneofDiscriminator(out *[]OneOfDiscriminator, d OneOfDiscriminator, fd protoreflect.FieldDescriptor) error {
+	for _, have := range *out {
+		if have.Key != d.Key || have.Value != d.Value {
+			continue
+		}
+		if have.Field != d.Field {
+			return fmt.Errorf("mcpgen: discriminator %q of %s maps to both %s and %s in one tool", d.Value, d.Key, have.Field, fd.FullName())
+		}
+		return nil
+	}
+	*out = append(*out, d)
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestOneofDiscriminatorSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(testdatamcp.AttributeService_SetAttributeTool.JSONSchema), &schema)).To(Succeed())
+	union := schema["properties"].(map[string]any)["valueOneOfType"].(map[string]any)
+
+	consts := map[string]string{}
+	for _, v := range union["oneOf"].([]any) {
+		props := v.(map[string]any)["properties"].(map[string]any)
+		for name := range props {
+			if name != "object_type" {
+				consts[name] = props["object_type"].(map[string]any)["const"].(string)
+			}
+		}
+	}
+	g.Expect(consts).To(Equal(map[string]string{
+		"string_value": "text",
+		"bool_value":   "bool_value",
+		"list_value":   "list",
+	}))
+	g.Expect(union["description"]).To(ContainSubstring(`"text", "bool_value", "list"`))
+}
+
+func TestOneofDiscriminatorMapsBack(t *testing.T) {
+	var got *testdata.SetAttributeRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToAttributeServiceClient(s, &testdatamcp.MockAttributeServiceHandler{
+		SetAttributeFunc: func(_ context.Context, req *testdata.SetAttributeRequest) (*testdata.SetAttributeResponse, error) {
+			got = req
+			return &testdata.SetAttributeResponse{Key: req.GetKey()}, nil
+		},
+	})
+
+	for name, tc := range map[string]struct {
+		union map[string]any
+		want  *testdata.SetAttributeRequest
+	}{
+		"custom scalar": {
+			union: map[string]any{"object_type": "text", "string_value": "blue"},
+			want:  &testdata.SetAttributeRequest{Key: "color", Value: &testdata.SetAttributeRequest_StringValue{StringValue: "blue"}},
+		},
+		"custom message": {
+			union: map[string]any{"object_type": "list", "list_value": map[string]any{"items": []any{"a", "b"}}},
+			want:  &testdata.SetAttributeRequest{Key: "color", Value: &testdata.SetAttributeRequest_ListValue{ListValue: &testdata.AttributeList{Items: []string{"a", "b"}}}},
+		},
+		"field name": {
+			union: map[string]any{"object_type": "bool_value", "bool_value": true},
+			want:  &testdata.SetAttributeRequest{Key: "color", Value: &testdata.SetAttributeRequest_BoolValue{BoolValue: true}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got = nil
+			resultText(g, callTool(t, s, testdatamcp.AttributeService_SetAttributeToolName, map[string]any{
+				"key":            "color",
+				"valueOneOfType": tc.union,
+			}))
+			g.Expect(proto.Equal(got, tc.want)).To(BeTrue(), "got %v", got)
+		})
+	}
+}
+
+func TestOneofDiscriminatorBatch(t *testing.T) {
+	g := NewWithT(t)
+
+	var got []string
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToAttributeServiceClient(s, &testdatamcp.MockAttributeServiceHandler{
+		SetAttributeFunc: func(_ context.Context, req *testdata.SetAttributeRequest) (*testdata.SetAttributeResponse, error) {
+			got = append(got, req.GetStringValue())
+			return &testdata.SetAttributeResponse{Key: req.GetKey()}, nil
+		},
+	})
+	resultText(g, callTool(t, s, testdatamcp.AttributeService_SetAttributeBatchToolName, map[string]any{
+		"requests": []any{map[string]any{
+			"key":            "color",
+			"valueOneOfType": map[string]any{"object_type": "text", "string_value": "blue"},
+		}},
+	}))
+	g.Expect(got).To(Equal([]string{"blue"}))
+}
+
+func TestOneofDiscriminatorInvalid(t *testing.T) {
+	for name, tc := range map[string]struct {
+		values map[string]string
+		err    string
+	}{
+		"not in a oneof": {values: map[string]string{"key": "name"}, err: "testdata.SetAttributeRequest.key has (mcp.options.oneof_discriminator) but is not in a oneof"},
+		"duplicate":      {values: map[string]string{"string_value": "bool_value"}, err: `fields string_value and bool_value of oneof testdata.SetAttributeRequest.value share the discriminator "bool_value"`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			file := testdata.File_testdata_oneof_discriminator_test_proto
+			req := codeGeneratorRequest(file)
+			for _, fdp := range req.ProtoFile {
+				if fdp.GetName() != file.Path() {
+					continue
+				}
+				for _, msg := range fdp.MessageType {
+					if msg.GetName() != "SetAttributeRequest" {
+						continue
+					}
+					for _, field := range msg.Field {
+						opts := &descriptorpb.FieldOptions{}
+						if value, ok := tc.values[field.GetName()]; ok {
+							proto.SetExtension(opts, mcpoptions.E_OneofDiscriminator, value)
+						}
+						field.Options = opts
+					}
+				}
+			}
+			plugin, _ := runPlugin(t, req, GenerateConfig{})
+			g.Expect(plugin.Response().GetError()).To(ContainSubstring(tc.err))
+		})
+	}
+}
+
+func TestOneofDiscriminatorTransformWrapper(t *testing.T) {
+	g := NewWithT(t)
+
+	args := map[string]any{"valueOneOfType": map[string]any{"object_type": "text", "string_value": "blue"}}
+	g.Expect(testdatamcp.TransformAttributeServiceSetAttributeOneOfFields(args, 0)).To(Succeed())
+	g.Expect(args).To(Equal(map[string]any{"string_value": "blue"}))
+
+	// The service-wide helper knows only the field names.
+	args = map[string]any{"valueOneOfType": map[string]any{"object_type": "bool_value", "bool_value": true}}
+	g.Expect(testdatamcp.AttributeServiceTransformOneOfFields(args, 0)).To(Succeed())
+	g.Expect(args).To(Equal(map[string]any{"bool_value": true}))
+}
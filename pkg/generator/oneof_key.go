@@ -0,0 +1,72 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// oneOfWrapperKey returns the property that wraps the variants of the oneof
+// named oneOfName, in the naming of the oneof_key option.
+func (g *FileGenerator) oneOfWrapperKey(oneOfName string) string {
+	switch g.oneOfKey {
+	case OneOfKeyCamel:
+		return lowerCamelCase(oneOfName) + "OneOf"
+	case OneOfKeyUnion:
+		return oneOfName + "__union"
+	default:
+		return oneOfName + "OneOfType"
+	}
+}
+
+// oneOfKeySuffix returns the suffix the generated handler recognizes wrapper
+// properties by, or "" for the runtime default.
+func (g *FileGenerator) oneOfKeySuffix() string {
+	switch g.oneOfKey {
+	case OneOfKeyCamel:
+		return "OneOf"
+	case OneOfKeyUnion:
+		return "__union"
+	default:
+		return ""
+	}
+}
+
+// oneOfWrapperKeyOf is oneOfWrapperKey for a oneof descriptor.
+func (g *FileGenerator) oneOfWrapperKeyOf(oneOf protoreflect.OneofDescriptor) string {
+	return g.oneOfWrapperKey(string(oneOf.Name()))
+}
+
+// lowerCamelCase converts a snake_case name to lowerCamelCase the way protoc
+// derives JSON names: each underscore is dropped and the letter after it is
+// upper-cased.
+func lowerCamelCase(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper && 'a' <= r && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		upper = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// generateWithOneOfKey generates test_service.proto with the given oneof_key
+// and returns the generated source.
+func generateWithOneOfKey(t *testing.T, oneOfKey string) (string, *FileGenerator) {
+	t.Helper()
+	plugin, fg := runPlugin(t, codeGeneratorRequest(testdata.File_testdata_test_service_proto), GenerateConfig{OneOfKey: oneOfKey})
+	resp := plugin.Response()
+	if resp.GetError() != "" {
+		t.Fatal(resp.GetError())
+	}
+	return resp.File[0].GetContent(), fg
+}
+
+func TestOneOfKey(t *testing.T) {
+	for name, tc := range map[string]struct {
+		oneOfKey string
+		key      string
+		call     string
+	}{
+		"default":     {oneOfKey: "", key: "item_typeOneOfType", call: "runtime.TransformOneOfFields(args, config.MaxNestingDepth)"},
+		"type_suffix": {oneOfKey: OneOfKeyTypeSuffix, key: "item_typeOneOfType", call: "runtime.TransformOneOfFields(args, config.MaxNestingDepth)"},
+		"camel":       {oneOfKey: OneOfKeyCamel, key: "itemTypeOneOf", call: `runtime.TransformOneOfFieldsWithKeySuffix(args, config.MaxNestingDepth, "OneOf")`},
+		"union":       {oneOfKey: OneOfKeyUnion, key: "item_type__union", call: `runtime.TransformOneOfFieldsWithKeySuffix(args, config.MaxNestingDepth, "__union")`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			src, fg := generateWithOneOfKey(t, tc.oneOfKey)
+			g.Expect(src).To(ContainSubstring(tc.call))
+
+			schema := fg.messageSchema(testdata.File_testdata_test_service_proto.Messages().ByName("CreateItemRequest"))
+			g.Expect(schema["properties"]).To(HaveKey(tc.key))
+			g.Expect(schema["required"]).To(ContainElement(tc.key))
+
+			// The arguments a client builds from the schema round-trip
+			// through the transform the generated handler runs.
+			args := map[string]any{
+				"name": "widget",
+				tc.key: map[string]any{"object_type": "service", "service": map[string]any{"duration": "1h"}},
+			}
+			suffix := fg.oneOfKeySuffix()
+			if suffix == "" {
+				suffix = runtime.OneOfTypeSuffix
+			}
+			g.Expect(runtime.TransformOneOfFieldsWithKeySuffix(args, runtime.DefaultMaxNestingDepth, suffix)).To(Succeed())
+			raw, err := json.Marshal(args)
+			g.Expect(err).ToNot(HaveOccurred())
+			var req testdata.CreateItemRequest
+			g.Expect(protojson.Unmarshal(raw, &req)).To(Succeed())
+			g.Expect(proto.Equal(&req, &testdata.CreateItemRequest{
+				Name:     "widget",
+				ItemType: &testdata.CreateItemRequest_Service{Service: &testdata.ServiceDetails{Duration: "1h"}},
+			})).To(BeTrue(), "got %v", &req)
+		})
+	}
+}
+
+func TestOneOfKeyInvalid(t *testing.T) {
+	g := NewWithT(t)
+
+	file := testdata.File_testdata_test_service_proto
+	plugin, _ := runPlugin(t, codeGeneratorRequest(file), GenerateConfig{OneOfKey: "kebab"})
+	g.Expect(plugin.Response().GetError()).To(Equal(`oneof_key "kebab" must be "type_suffix", "camel" or "union"`))
+}
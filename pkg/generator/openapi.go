@@ -0,0 +1,230 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// OpenAPIVersion is the version of the document written by OpenAPIDocument.
+// OpenAPI 3.1 schema objects are JSON Schema 2020-12, the dialect of the tool
+// schemas, so they are reused as they are.
+const OpenAPIVersion = "3.1.0"
+
+// openAPIOutputSuffix is appended to the component name of a response-side
+// schema that differs from the request-side schema of the same message, for
+// example because of OUTPUT_ONLY fields.
+const openAPIOutputSuffix = "Output"
+
+// OpenAPIDocument collects the request and response message schemas of every
+// generated tool into an OpenAPI 3.1 document with only components/schemas.
+// It is shared between the FileGenerators of an invocation, like Report, and
+// written with Write once they are all generated.
+type OpenAPIDocument struct {
+	inputs  []openAPIMessage
+	outputs []openAPIMessage
+	seen    map[string]bool
+}
+
+// openAPIMessage is the tool schema of one message, with its $defs.
+type openAPIMessage struct {
+	name     string
+	fullName string
+	schema   map[string]any
+}
+
+// addMethod records the request and response schemas of meth.
+func (d *OpenAPIDocument) addMethod(g *FileGenerator, meth *protogen.Method) {
+	if d == nil {
+		return
+	}
+	if d.seen == nil {
+		d.seen = map[string]bool{}
+	}
+	if key := "in:" + string(meth.Input.Desc.FullName()); !d.seen[key] {
+		d.seen[key] = true
+		d.inputs = append(d.inputs, openAPIMessage{
+			name:     string(meth.Input.Desc.Name()),
+			fullName: string(meth.Input.Desc.FullName()),
+			schema:   g.messageSchemaWithDefs(meth.Input.Desc, meth.Input, directionInput),
+		})
+	}
+	if key := "out:" + string(meth.Output.Desc.FullName()); !d.seen[key] {
+		d.seen[key] = true
+		d.outputs = append(d.outputs, openAPIMessage{
+			name:     string(meth.Output.Desc.Name()),
+			fullName: string(meth.Output.Desc.FullName()),
+			schema:   g.messageSchemaWithDefs(meth.Output.Desc, meth.Output, directionOutput),
+		})
+	}
+}
+
+// Build returns the OpenAPI document. The $defs of every tool schema are
+// hoisted into components/schemas under the same names, and their $refs
+// rewritten to match. Request-side schemas are added first; a response-side
+// schema that differs from the request-side one of the same name is named
+// with an "Output" suffix. Two different messages with the same simple name
+// cannot share the namespace and are reported as an error.
+func (d *OpenAPIDocument) Build() (map[string]any, error) {
+	components := map[string]any{}
+	owners := map[string]string{}
+	for _, msg := range d.inputs {
+		if err := addOpenAPIMessage(components, owners, msg, ""); err != nil {
+			return nil, err
+		}
+	}
+	for _, msg := range d.outputs {
+		if err := addOpenAPIMessage(components, owners, msg, openAPIOutputSuffix); err != nil {
+			return nil, err
+		}
+	}
+	return map[string]any{
+		"openapi":           OpenAPIVersion,
+		"jsonSchemaDialect": "https://json-schema.org/draft/2020-12/schema",
+		"info": map[string]any{
+			"title":   "MCP tool schemas",
+			"version": "1.0.0",
+		},
+		"components": map[string]any{
+			"schemas": components,
+		},
+	}, nil
+}
+
+// Write writes the document as indented JSON to the file dest, relative to
+// the plugin output directory.
+func (d *OpenAPIDocument) Write(gen *protogen.Plugin, dest string) error {
+	doc, err := d.Build()
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to marshal OpenAPI document: %w", err)
+	}
+	_, err = gen.NewGeneratedFile(dest, "").Write(buf.Bytes())
+	return err
+}
+
+// addOpenAPIMessage adds msg and its $defs to components. A schema whose
+// name is already taken by a different schema is renamed with suffix, which
+// in turn changes the schemas referring to it; renaming is repeated until the
+// names are stable. With an empty suffix a conflict is an error.
+func addOpenAPIMessage(components map[string]any, owners map[string]string, msg openAPIMessage, suffix string) error {
+	schemas := map[string]any{}
+	if defs, ok := msg.schema["$defs"].(map[string]any); ok {
+		for name, def := range defs {
+			schemas[name] = def
+		}
+	}
+	if _, ok := schemas[msg.name]; !ok {
+		root := make(map[string]any, len(msg.schema))
+		for k, v := range msg.schema {
+			if k != "$schema" && k != "$defs" {
+				root[k] = v
+			}
+		}
+		schemas[msg.name] = root
+	}
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	renamed := map[string]bool{}
+	rewritten := map[string]any{}
+	for changed := true; changed; {
+		changed = false
+		for _, name := range names {
+			rewritten[name] = rewriteOpenAPIRefs(schemas[name], renamed, suffix)
+		}
+		for _, name := range names {
+			key := openAPIComponentName(name, renamed, suffix)
+			existing, ok := components[key]
+			if !ok || sameJSON(existing, rewritten[name]) {
+				continue
+			}
+			if suffix == "" || renamed[name] {
+				return fmt.Errorf("mcpgen: openapi: %s and %s both need a different schema named %q", owners[key], msg.fullName, key)
+			}
+			renamed[name] = true
+			changed = true
+		}
+	}
+	for _, name := range names {
+		key := openAPIComponentName(name, renamed, suffix)
+		if _, ok := components[key]; !ok {
+			components[key] = rewritten[name]
+			owners[key] = msg.fullName
+		}
+	}
+	return nil
+}
+
+// openAPIComponentName returns the component name of the schema name.
+func openAPIComponentName(name string, renamed map[string]bool, suffix string) string {
+	if renamed[name] {
+		return name + suffix
+	}
+	return name
+}
+
+// rewriteOpenAPIRefs returns a copy of v with every "#/$defs/<name>" $ref
+// pointing at the matching components/schemas entry instead.
+func rewriteOpenAPIRefs(v any, renamed map[string]bool, suffix string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, child := range v {
+			if ref, ok := child.(string); ok && k == "$ref" && strings.HasPrefix(ref, "#/$defs/") {
+				name := strings.TrimPrefix(ref, "#/$defs/")
+				out[k] = "#/components/schemas/" + openAPIComponentName(name, renamed, suffix)
+				continue
+			}
+			out[k] = rewriteOpenAPIRefs(child, renamed, suffix)
+		}
+		return out
+	case []map[string]any:
+		out := make([]any, len(v))
+		for i, child := range v {
+			out[i] = rewriteOpenAPIRefs(child, renamed, suffix)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, child := range v {
+			out[i] = rewriteOpenAPIRefs(child, renamed, suffix)
+		}
+		return out
+	}
+	return v
+}
+
+// sameJSON reports whether a and b encode to the same JSON.
+func sameJSON(a, b any) bool {
+	ra, errA := json.Marshal(a)
+	rb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(ra, rb)
+}
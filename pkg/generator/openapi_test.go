@@ -0,0 +1,149 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// generateOpenAPI generates files into one OpenAPIDocument and returns the
+// written document.
+func generateOpenAPI(t *testing.T, files ...protoreflect.FileDescriptor) []byte {
+	t.Helper()
+	doc := &OpenAPIDocument{}
+	var plugin *protogen.Plugin
+	for _, file := range files {
+		plugin, _ = runPlugin(t, codeGeneratorRequest(file), GenerateConfig{OpenAPI: doc})
+		if resp := plugin.Response(); resp.Error != nil {
+			t.Fatal(resp.GetError())
+		}
+	}
+	if err := doc.Write(plugin, "openapi.json"); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range plugin.Response().File {
+		if f.GetName() == "openapi.json" {
+			return []byte(f.GetContent())
+		}
+	}
+	t.Fatal("openapi.json was not written")
+	return nil
+}
+
+// collectRefs returns every $ref in v.
+func collectRefs(v any) []string {
+	var refs []string
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			if ref, ok := child.(string); ok && k == "$ref" {
+				refs = append(refs, ref)
+				continue
+			}
+			refs = append(refs, collectRefs(child)...)
+		}
+	case []any:
+		for _, child := range v {
+			refs = append(refs, collectRefs(child)...)
+		}
+	}
+	return refs
+}
+
+func TestOpenAPIDocumentParses(t *testing.T) {
+	g := NewWithT(t)
+
+	raw := generateOpenAPI(t, testdata.File_testdata_test_service_proto, testdata.File_testdata_field_behavior_test_proto)
+
+	var doc map[string]any
+	g.Expect(json.Unmarshal(raw, &doc)).To(Succeed())
+	g.Expect(doc).To(HaveKeyWithValue("openapi", "3.1.0"))
+	g.Expect(doc).To(HaveKeyWithValue("jsonSchemaDialect", "https://json-schema.org/draft/2020-12/schema"))
+	g.Expect(doc["info"]).To(HaveKey("title"))
+	g.Expect(doc["info"]).To(HaveKey("version"))
+
+	schemas := doc["components"].(map[string]any)["schemas"].(map[string]any)
+	g.Expect(schemas).To(HaveKey("CreateItemRequest"))
+	g.Expect(schemas).To(HaveKey("CreateItemResponse"))
+	g.Expect(schemas).To(HaveKey("Item"))
+	for name, schema := range schemas {
+		g.Expect(schema).ToNot(HaveKey("$schema"), name)
+		g.Expect(schema).ToNot(HaveKey("$defs"), name)
+	}
+	for _, ref := range collectRefs(doc) {
+		g.Expect(ref).To(HavePrefix("#/components/schemas/"))
+		g.Expect(schemas).To(HaveKey(strings.TrimPrefix(ref, "#/components/schemas/")), ref)
+	}
+
+	// Every schema object compiles as JSON Schema 2020-12 in place, with its
+	// $refs resolved within the document.
+	parsed, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+	g.Expect(err).ToNot(HaveOccurred())
+	c := jsonschema.NewCompiler()
+	c.DefaultDraft(jsonschema.Draft2020)
+	g.Expect(c.AddResource("openapi.json", parsed)).To(Succeed())
+	for name := range schemas {
+		_, err := c.Compile("openapi.json#/components/schemas/" + name)
+		g.Expect(err).ToNot(HaveOccurred(), name)
+	}
+}
+
+func TestOpenAPIDocumentSplitsDirections(t *testing.T) {
+	g := NewWithT(t)
+
+	var doc map[string]any
+	g.Expect(json.Unmarshal(generateOpenAPI(t, testdata.File_testdata_field_behavior_test_proto), &doc)).To(Succeed())
+	schemas := doc["components"].(map[string]any)["schemas"].(map[string]any)
+
+	// Account is both the request and the response, with INPUT_ONLY and
+	// OUTPUT_ONLY fields, so each direction gets its own component.
+	g.Expect(schemas).To(HaveKey("Account"))
+	g.Expect(schemas).To(HaveKey("AccountOutput"))
+	g.Expect(schemas["Account"].(map[string]any)["properties"]).To(HaveKey("password"))
+	g.Expect(schemas["Account"].(map[string]any)["properties"]).ToNot(HaveKey("uid"))
+	g.Expect(schemas["AccountOutput"].(map[string]any)["properties"]).To(HaveKey("uid"))
+	g.Expect(schemas["AccountOutput"].(map[string]any)["properties"]).ToNot(HaveKey("password"))
+
+	contact := func(name string) any {
+		return schemas[name].(map[string]any)["properties"].(map[string]any)["contact"]
+	}
+	g.Expect(contact("Account")).To(HaveKeyWithValue("$ref", "#/components/schemas/AccountContact"))
+	g.Expect(contact("AccountOutput")).To(HaveKeyWithValue("$ref", "#/components/schemas/AccountContactOutput"))
+	g.Expect(schemas["AccountContactOutput"].(map[string]any)["properties"]).To(HaveKey("verified"))
+}
+
+func TestOpenAPIDocumentNameConflict(t *testing.T) {
+	g := NewWithT(t)
+
+	components := map[string]any{}
+	owners := map[string]string{}
+	g.Expect(addOpenAPIMessage(components, owners, openAPIMessage{
+		name: "Thing", fullName: "a.Thing", schema: map[string]any{"type": "object"},
+	}, "")).To(Succeed())
+	err := addOpenAPIMessage(components, owners, openAPIMessage{
+		name: "Thing", fullName: "b.Thing", schema: map[string]any{"type": "string"},
+	}, "")
+	g.Expect(err).To(MatchError(ContainSubstring(`a.Thing and b.Thing both need a different schema named "Thing"`)))
+}
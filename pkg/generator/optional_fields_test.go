@@ -0,0 +1,115 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// setReminderSchema returns the input schema of SetReminder generated with
+// the given optional_fields, or the plugin error.
+func setReminderSchema(t *testing.T, optionalFields string) (map[string]any, string) {
+	t.Helper()
+	return setReminderSchemaWithConfig(t, GenerateConfig{OptionalFields: optionalFields})
+}
+
+// setReminderSchemaWithConfig is setReminderSchema for any cfg.
+func setReminderSchemaWithConfig(t *testing.T, cfg GenerateConfig) (map[string]any, string) {
+	t.Helper()
+	file := testdata.File_testdata_optional_fields_test_proto
+	plugin, fg := runPlugin(t, codeGeneratorRequest(file), cfg)
+	if resp := plugin.Response(); resp.Error != nil {
+		return nil, resp.GetError()
+	}
+	meth := plugin.FilesByPath[file.Path()].Services[0].Methods[0]
+	return fg.messageSchemaWithDefs(meth.Input.Desc, meth.Input, directionInput), ""
+}
+
+func TestOptionalFieldsSchema(t *testing.T) {
+	t.Run("nullable", func(t *testing.T) {
+		g := NewWithT(t)
+		schema, errMsg := setReminderSchema(t, "")
+		g.Expect(errMsg).To(BeEmpty())
+		props := schema["properties"].(map[string]any)
+		g.Expect(props["due"]).To(HaveKeyWithValue("type", []string{"string", "null"}))
+		g.Expect(props["snooze"]).To(HaveKeyWithValue("type", []string{"string", "null"}))
+		g.Expect(props["priority"]).To(HaveKeyWithValue("nullable", true))
+		g.Expect(schema["required"]).To(BeEmpty())
+	})
+
+	t.Run("omit", func(t *testing.T) {
+		g := NewWithT(t)
+		schema, errMsg := setReminderSchema(t, OptionalFieldsOmit)
+		g.Expect(errMsg).To(BeEmpty())
+		props := schema["properties"].(map[string]any)
+		g.Expect(props["due"]).To(HaveKeyWithValue("type", "string"))
+		g.Expect(props["snooze"]).To(HaveKeyWithValue("type", "string"))
+		g.Expect(props["priority"]).To(HaveKeyWithValue("type", "integer"))
+		g.Expect(props["priority"]).ToNot(HaveKey("nullable"))
+		g.Expect(schema["required"]).To(BeEmpty(), "unset fields are omitted instead")
+
+		// null is a value of google.protobuf.Value, and repeated fields are
+		// not optional fields.
+		g.Expect(props["payload"].(map[string]any)["type"]).To(ContainElement("null"))
+		g.Expect(props["history"].(map[string]any)["items"]).To(HaveKeyWithValue("type", []string{"string", "null"}))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		g := NewWithT(t)
+		_, errMsg := setReminderSchema(t, "absent")
+		g.Expect(errMsg).To(ContainSubstring(`optional_fields "absent" must be "nullable" or "omit"`))
+	})
+}
+
+// TestOptionalFieldsRequest checks that the handler builds the same request
+// whether an unset field is sent as null or left out, so it serves both
+// representations.
+func TestOptionalFieldsRequest(t *testing.T) {
+	g := NewWithT(t)
+
+	var received *testdata.SetReminderRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToReminderServiceClient(s, &testdatamcp.MockReminderServiceHandler{
+		SetReminderFunc: func(_ context.Context, req *testdata.SetReminderRequest) (*testdata.SetReminderResponse, error) {
+			received = req
+			return &testdata.SetReminderResponse{ReminderId: "r-1"}, nil
+		},
+	})
+
+	resultText(g, callTool(t, s, "testdata_ReminderService_SetReminder", map[string]any{
+		"title": "standup", "due": nil, "snooze": nil, "priority": nil,
+	}))
+	g.Expect(received.GetTitle()).To(Equal("standup"))
+	g.Expect(received.Due).To(BeNil())
+	g.Expect(received.Snooze).To(BeNil())
+	g.Expect(received.Priority).To(BeNil())
+
+	received = nil
+	resultText(g, callTool(t, s, "testdata_ReminderService_SetReminder", map[string]any{"title": "standup"}))
+	g.Expect(received.GetTitle()).To(Equal("standup"))
+	g.Expect(received.Due).To(BeNil())
+	g.Expect(received.Priority).To(BeNil())
+
+	received = nil
+	resultText(g, callTool(t, s, "testdata_ReminderService_SetReminder", map[string]any{"title": "standup", "priority": 2}))
+	g.Expect(received.GetPriority().GetValue()).To(BeEquivalentTo(2))
+}
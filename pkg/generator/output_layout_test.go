@@ -0,0 +1,67 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// generateLayout generates test_service.proto with cfg and returns the
+// response.
+func generateLayout(t *testing.T, cfg GenerateConfig) *pluginpb.CodeGeneratorResponse {
+	t.Helper()
+	plugin, _ := runPlugin(t, codeGeneratorRequest(testdata.File_testdata_test_service_proto), cfg)
+	return plugin.Response()
+}
+
+func TestOutputLayout(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := generateLayout(t, GenerateConfig{PackageSuffix: "mcp"})
+	g.Expect(resp.Error).To(BeNil())
+	g.Expect(resp.File).To(HaveLen(1))
+	g.Expect(resp.File[0].GetName()).To(HaveSuffix("testdatamcp/test_service.pb.mcp.go"))
+	g.Expect(resp.File[0].GetContent()).To(ContainSubstring("package testdatamcp\n"))
+
+	resp = generateLayout(t, GenerateConfig{PackageSuffix: "mcp", FileSuffix: "_mcp.go"})
+	g.Expect(resp.Error).To(BeNil())
+	g.Expect(resp.File[0].GetName()).To(HaveSuffix("testdatamcp/test_service_mcp.go"))
+
+	// package_name replaces the base package name plus suffix.
+	resp = generateLayout(t, GenerateConfig{PackageSuffix: "mcp", PackageName: "mcptools", FileSuffix: ".mcp.go"})
+	g.Expect(resp.Error).To(BeNil())
+	g.Expect(resp.File[0].GetName()).To(HaveSuffix("mcptools/test_service.mcp.go"))
+	g.Expect(resp.File[0].GetContent()).To(ContainSubstring("package mcptools\n"))
+
+	// Without a sub-package the file sits next to the .pb.go file.
+	resp = generateLayout(t, GenerateConfig{FileSuffix: ".mcp.go"})
+	g.Expect(resp.Error).To(BeNil())
+	g.Expect(resp.File[0].GetName()).To(HaveSuffix("testdata/test_service.mcp.go"))
+	g.Expect(resp.File[0].GetContent()).To(ContainSubstring("package testdata\n"))
+}
+
+func TestOutputLayoutInvalid(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(generateLayout(t, GenerateConfig{FileSuffix: ".pb.go"}).GetError()).To(ContainSubstring(`file_suffix ".pb.go"`))
+	g.Expect(generateLayout(t, GenerateConfig{FileSuffix: ".mcp"}).GetError()).To(ContainSubstring(`file_suffix ".mcp"`))
+	g.Expect(generateLayout(t, GenerateConfig{FileSuffix: "/x.go"}).GetError()).To(ContainSubstring(`file_suffix "/x.go"`))
+	g.Expect(generateLayout(t, GenerateConfig{PackageName: "mcp-tools"}).GetError()).To(ContainSubstring(`package_name "mcp-tools" is not a valid Go identifier`))
+}
@@ -41,18 +41,18 @@ func TestZeroBasedPaginationSchema(t *testing.T) {
 
 	defs := map[string]any{}
 	visiting := map[string]bool{}
-	pageSchema := fg.getTypeWithDefsAndComment(pageField, "Page number (0-based).", defs, visiting)
+	pageSchema := fg.getTypeWithDefsAndComment(pageField, "Page number (0-based).", directionInput, defs, visiting)
 
 	g.Expect(pageSchema["minimum"]).To(Equal(1))
 	g.Expect(pageSchema["description"]).To(ContainSubstring("1-based"))
 	g.Expect(pageSchema["description"]).ToNot(ContainSubstring("0-based"))
 
-	repeatedSchema := fg.getTypeWithDefsAndComment(repeatedField, "Annotation on a repeated field.", defs, visiting)
+	repeatedSchema := fg.getTypeWithDefsAndComment(repeatedField, "Annotation on a repeated field.", directionInput, defs, visiting)
 	g.Expect(repeatedSchema).ToNot(HaveKey("minimum"),
 		"repeated field schema must not get minimum=1")
 	g.Expect(repeatedSchema["type"]).To(Equal("array"))
 
-	stringSchema := fg.getTypeWithDefsAndComment(stringField, "Annotation on a string field.", defs, visiting)
+	stringSchema := fg.getTypeWithDefsAndComment(stringField, "Annotation on a string field.", directionInput, defs, visiting)
 	g.Expect(stringSchema).ToNot(HaveKey("minimum"),
 		"non-integer field schema must not get minimum=1")
 	g.Expect(stringSchema["type"]).To(Equal("string"))
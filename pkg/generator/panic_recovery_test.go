@@ -0,0 +1,54 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+)
+
+func panickingTransformer(context.Context, proto.Message) (proto.Message, error) {
+	panic("redactor bug")
+}
+
+func TestPanicRecoveryInGeneratedHandler(t *testing.T) {
+	g := NewWithT(t)
+
+	s := newTransformerTestServer(runtime.WithResponseTransformer(panickingTransformer))
+	resp := callGetItem(t, s, map[string]any{"id": "item-1"})
+	g.Expect(resp["result"]).To(HaveKeyWithValue("isError", true))
+	text := resultText(g, resp)
+	g.Expect(text).To(ContainSubstring("INTERNAL"))
+	g.Expect(text).To(ContainSubstring("redactor bug"))
+
+	// The server keeps serving after the panic.
+	resp = callGetItem(t, s, map[string]any{"id": "item-2"})
+	g.Expect(resultText(g, resp)).To(ContainSubstring("redactor bug"))
+}
+
+func TestPanicRecoveryDisabled(t *testing.T) {
+	g := NewWithT(t)
+
+	s := newTransformerTestServer(
+		runtime.WithResponseTransformer(panickingTransformer),
+		runtime.WithPanicRecovery(false),
+	)
+	g.Expect(func() { callGetItem(t, s, map[string]any{"id": "item-1"}) }).To(PanicWith("redactor bug"))
+}
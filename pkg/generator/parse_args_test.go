@@ -0,0 +1,56 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestParseArgsOneOf(t *testing.T) {
+	g := NewWithT(t)
+
+	req, err := testdatamcp.ParseOrderServicePlaceOrderArgs(map[string]any{
+		"paymentOneOfType": map[string]any{"object_type": "voucher_code", "voucher_code": "SPRING"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(req.GetVoucherCode()).To(Equal("SPRING"))
+	g.Expect(req.GetCardToken()).To(BeEmpty())
+
+	// Object arguments sent as JSON strings are accepted, as by the handler.
+	req, err = testdatamcp.ParseOrderServicePlaceOrderArgs(map[string]any{
+		"paymentOneOfType": `{"object_type": "card_token", "card_token": "tok-1"}`,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(req.GetCardToken()).To(Equal("tok-1"))
+}
+
+func TestParseArgsInvalid(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := testdatamcp.ParseInventoryServiceReserveStockArgs(map[string]any{"sku": 42})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	// The runtime options of the registration apply.
+	_, err = testdatamcp.ParseTestServiceGetItemArgs(nestedArgs(5), runtime.WithMaxNestingDepth(3))
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	g.Expect(err).To(MatchError(ContainSubstring("nested more than 3 levels deep")))
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/catalog"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// newFailingCatalogServer serves the catalog proxy with a LookupSku that
+// fails with err.
+func newFailingCatalogServer(err error, opts ...runtime.Option) *mcpserver.MCPServer {
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToCatalogProxyServiceClient(s, &testdatamcp.MockCatalogProxyServiceHandler{
+		LookupSkuFunc: func(context.Context, *catalog.LookupSkuRequest) (*catalog.LookupSkuResponse, error) {
+			return nil, err
+		},
+	}, opts...)
+	return s
+}
+
+func TestBackendErrorsAsToolResults(t *testing.T) {
+	for _, code := range []codes.Code{codes.NotFound, codes.InvalidArgument, codes.AlreadyExists} {
+		t.Run(code.String(), func(t *testing.T) {
+			g := NewWithT(t)
+
+			s := newFailingCatalogServer(status.Error(code, "sku A-1"))
+			resp := callTool(t, s, testdatamcp.CatalogProxyService_LookupSkuToolName, map[string]any{"sku": "A-1"})
+			g.Expect(resp).To(HaveKey("result"))
+			g.Expect(resp["result"]).To(HaveKeyWithValue("isError", true))
+			g.Expect(resultText(g, resp)).To(ContainSubstring("sku A-1"))
+		})
+	}
+}
+
+func TestBackendErrorsAsProtocolErrors(t *testing.T) {
+	for _, code := range []codes.Code{codes.Internal, codes.Unavailable} {
+		t.Run(code.String(), func(t *testing.T) {
+			g := NewWithT(t)
+
+			s := newFailingCatalogServer(status.Error(code, "backend down"))
+			resp := callTool(t, s, testdatamcp.CatalogProxyService_LookupSkuToolName, map[string]any{"sku": "A-1"})
+			g.Expect(resp).ToNot(HaveKey("result"))
+			g.Expect(resp["error"]).To(HaveKeyWithValue("message", ContainSubstring("backend down")))
+		})
+	}
+}
+
+func TestProtocolErrorCodesConfigured(t *testing.T) {
+	g := NewWithT(t)
+
+	// Unavailable becomes a tool result, NotFound a protocol error.
+	s := newFailingCatalogServer(status.Error(codes.Unavailable, "backend down"), runtime.WithProtocolErrorCodes(codes.NotFound))
+	resp := callTool(t, s, testdatamcp.CatalogProxyService_LookupSkuToolName, map[string]any{"sku": "A-1"})
+	g.Expect(resp["result"]).To(HaveKeyWithValue("isError", true))
+
+	s = newFailingCatalogServer(status.Error(codes.NotFound, "sku A-1"), runtime.WithProtocolErrorCodes(codes.NotFound))
+	resp = callTool(t, s, testdatamcp.CatalogProxyService_LookupSkuToolName, map[string]any{"sku": "A-1"})
+	g.Expect(resp).To(HaveKey("error"))
+}
@@ -0,0 +1,470 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// protovalidateFieldExtension is the full name of the protovalidate field
+// option. The generator does not link the protovalidate Go package: the
+// extension is resolved from the descriptors of the file being generated, so
+// any proto that imports buf/validate/validate.proto works.
+const protovalidateFieldExtension protoreflect.FullName = "buf.validate.field"
+
+// protovalidateMessageExtension is the full name of the protovalidate message
+// option, resolved like protovalidateFieldExtension.
+const protovalidateMessageExtension protoreflect.FullName = "buf.validate.message"
+
+// stringFormat describes the JSON Schema translation of one protovalidate
+// well-known string predicate. pattern is only set where the JSON Schema
+// format keyword is not widely enforced by tool-calling clients.
+type stringFormat struct {
+	format    string
+	pattern   string
+	minLength int
+	maxLength int
+}
+
+const (
+	ipv4Expr = `((25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\.){3}(25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])`
+	// ipv6Expr is deliberately loose: a full RFC 4291 regex is several
+	// hundred characters and the server-side validator remains authoritative.
+	ipv6Expr = `[0-9A-Fa-f:.]*:[0-9A-Fa-f:.]*`
+)
+
+// protovalidateStringFormats maps StringRules well_known field names to their
+// schema constraints.
+var protovalidateStringFormats = map[protoreflect.Name]stringFormat{
+	"uuid": {
+		format:    "uuid",
+		pattern:   `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`,
+		minLength: 36,
+		maxLength: 36,
+	},
+	"email":    {format: "email", maxLength: 254},
+	"hostname": {format: "hostname", pattern: `^[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?)*\.?$`, maxLength: 253},
+	// JSON Schema has no format covering both address families.
+	"ip":      {pattern: `^(` + ipv4Expr + `|` + ipv6Expr + `)$`, minLength: 2, maxLength: 45},
+	"ipv4":    {format: "ipv4", pattern: `^` + ipv4Expr + `$`, minLength: 7, maxLength: 15},
+	"ipv6":    {format: "ipv6", pattern: `^` + ipv6Expr + `$`, minLength: 2, maxLength: 45},
+	"uri":     {format: "uri"},
+	"uri_ref": {format: "uri-reference"},
+}
+
+// protovalidateFieldRules returns the (buf.validate.field) FieldRules message
+// set on fd, or nil if there is none. The rules are returned reflectively so
+// that the generator works whether or not the protovalidate Go types are
+// linked into the binary.
+func protovalidateFieldRules(fd protoreflect.FieldDescriptor) protoreflect.Message {
+	v, ok, err := extensionValue(fd, protovalidateFieldExtension)
+	if err != nil || !ok {
+		return nil
+	}
+	return v.Message()
+}
+
+// protovalidateMessageRulesNote describes the CEL rules of the
+// (buf.validate.message) of md, such as "b is required when a is set", which
+// JSON Schema cannot express. A rule is described by its message, or by its
+// expression when it has none. It returns "" when md has no CEL rules.
+func protovalidateMessageRulesNote(md protoreflect.MessageDescriptor) string {
+	v, ok, err := extensionValue(md, protovalidateMessageExtension)
+	if err != nil || !ok {
+		return ""
+	}
+	rules := v.Message()
+	var lines []string
+	if fd := rules.Descriptor().Fields().ByName("cel"); fd != nil && fd.IsList() && fd.Message() != nil {
+		list := rules.Get(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			rule := list.Get(i).Message()
+			fields := rule.Descriptor().Fields()
+			text := strings.TrimSpace(rule.Get(fields.ByName("message")).String())
+			if text == "" {
+				text = "`" + strings.TrimSpace(rule.Get(fields.ByName("expression")).String()) + "`"
+			}
+			if text != "``" {
+				lines = append(lines, "- "+text)
+			}
+		}
+	}
+	for _, expr := range stringListRule(rules, "cel_expression") {
+		if expr = strings.TrimSpace(expr); expr != "" {
+			lines = append(lines, "- `"+expr+"`")
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "Validation rules:\n" + strings.Join(lines, "\n")
+}
+
+// applyProtovalidateMessageRules appends the protovalidateMessageRulesNote of
+// md to the description of its object schema.
+func applyProtovalidateMessageRules(md protoreflect.MessageDescriptor, schema map[string]any) {
+	note := protovalidateMessageRulesNote(md)
+	if note == "" {
+		return
+	}
+	if description, _ := schema["description"].(string); description != "" {
+		note = description + "\n\n" + note
+	}
+	schema["description"] = note
+}
+
+// protovalidateStringRules returns the StringRules that apply to the string
+// values of fd: (buf.validate.field).string for singular fields and
+// (buf.validate.field).repeated.items.string for lists.
+func protovalidateStringRules(fd protoreflect.FieldDescriptor) protoreflect.Message {
+	rules := protovalidateFieldRules(fd)
+	if fd.IsList() {
+		rules = subMessage(subMessage(rules, "repeated"), "items")
+	}
+	return subMessage(rules, "string")
+}
+
+// subMessage returns the populated message field name of m, or nil.
+func subMessage(m protoreflect.Message, name protoreflect.Name) protoreflect.Message {
+	if m == nil {
+		return nil
+	}
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Message() == nil || !m.Has(fd) {
+		return nil
+	}
+	return m.Get(fd).Message()
+}
+
+// applyProtovalidateStringFormat adds format, pattern and length constraints
+// to schema for the well-known string predicate set on fd, if any.
+func applyProtovalidateStringFormat(fd protoreflect.FieldDescriptor, schema map[string]any) {
+	if fd.Kind() != protoreflect.StringKind || fd.IsMap() {
+		return
+	}
+	applyStringRules(protovalidateStringRules(fd), schema)
+}
+
+// applyStringRules adds the constraints of the well-known string predicate
+// set in the StringRules rules, if any, to schema. An in list makes the string
+// an enum of its values, and a not_in list excludes its values.
+func applyStringRules(rules protoreflect.Message, schema map[string]any) {
+	if rules == nil {
+		return
+	}
+	if values := stringListRule(rules, "in"); len(values) > 0 {
+		schema["enum"] = values
+	}
+	if values := stringListRule(rules, "not_in"); len(values) > 0 {
+		schema["not"] = map[string]any{"enum": values}
+	}
+	fields := rules.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		ruleField := fields.Get(i)
+		sf, ok := protovalidateStringFormats[ruleField.Name()]
+		if !ok || ruleField.Kind() != protoreflect.BoolKind || !rules.Has(ruleField) || !rules.Get(ruleField).Bool() {
+			continue
+		}
+		if sf.format != "" {
+			schema["format"] = sf.format
+		}
+		if sf.pattern != "" {
+			schema["pattern"] = sf.pattern
+		}
+		if sf.minLength > 0 {
+			schema["minLength"] = sf.minLength
+		}
+		if sf.maxLength > 0 {
+			schema["maxLength"] = sf.maxLength
+		}
+	}
+}
+
+// stringListRule returns the values of the repeated string rule field name of
+// rules, such as in, or nil when it is empty.
+func stringListRule(rules protoreflect.Message, name protoreflect.Name) []string {
+	fd := rules.Descriptor().Fields().ByName(name)
+	if fd == nil || !fd.IsList() || fd.Kind() != protoreflect.StringKind {
+		return nil
+	}
+	list := rules.Get(fd).List()
+	var values []string
+	for i := 0; i < list.Len(); i++ {
+		values = append(values, list.Get(i).String())
+	}
+	return values
+}
+
+// applyProtovalidateMapRules translates the (buf.validate.field).map rules of
+// the map field fd: min_pairs and max_pairs bound the properties of schema,
+// and the keys and values string rules constrain keySchema and valueSchema.
+// valueSchema may be nil when the values are free-form. It reports whether a
+// key rule was applied.
+func applyProtovalidateMapRules(fd protoreflect.FieldDescriptor, schema, keySchema, valueSchema map[string]any) bool {
+	rules := subMessage(protovalidateFieldRules(fd), "map")
+	if rules == nil {
+		return false
+	}
+	if n, ok := uintRule(rules, "min_pairs"); ok {
+		schema["minProperties"] = n
+	}
+	if n, ok := uintRule(rules, "max_pairs"); ok {
+		schema["maxProperties"] = n
+	}
+	keyRules := subMessage(subMessage(rules, "keys"), "string")
+	before := len(keySchema)
+	applyStringRules(keyRules, keySchema)
+	if valueSchema != nil && fd.MapValue().Kind() == protoreflect.StringKind {
+		applyStringRules(subMessage(subMessage(rules, "values"), "string"), valueSchema)
+	}
+	return len(keySchema) != before
+}
+
+// uintRule returns the uint64 rule field name of rules when it is set.
+func uintRule(rules protoreflect.Message, name protoreflect.Name) (uint64, bool) {
+	fd := rules.Descriptor().Fields().ByName(name)
+	if fd == nil || !rules.Has(fd) {
+		return 0, false
+	}
+	return rules.Get(fd).Uint(), true
+}
+
+// MapPairLimit is a map field with a protovalidate min_pairs or max_pairs
+// rule.
+type MapPairLimit struct {
+	// Path is the field path, as protobuf field names.
+	Path []string
+	// Min and Max bound the number of entries; Max is 0 when unbounded.
+	Min, Max uint64
+}
+
+// collectMapPairLimits walks md like collectConstFields and returns the map
+// fields with a min_pairs or max_pairs rule.
+func collectMapPairLimits(md protoreflect.MessageDescriptor) []MapPairLimit {
+	var out []MapPairLimit
+	collectMapPairLimitsInto(md, nil, map[string]bool{}, &out)
+	return out
+}
+
+func collectMapPairLimitsInto(md protoreflect.MessageDescriptor, prefix []string, visited map[string]bool, out *[]MapPairLimit) {
+	full := string(md.FullName())
+	if visited[full] {
+		return
+	}
+	visited[full] = true
+	defer delete(visited, full)
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if oneOf := fd.ContainingOneof(); oneOf != nil && !oneOf.IsSynthetic() {
+			continue
+		}
+		path := appendPath(prefix, string(fd.Name()))
+		if fd.IsMap() {
+			if rules := subMessage(protovalidateFieldRules(fd), "map"); rules != nil {
+				lo, hasMin := uintRule(rules, "min_pairs")
+				hi, hasMax := uintRule(rules, "max_pairs")
+				if hasMin || hasMax {
+					*out = append(*out, MapPairLimit{Path: path, Min: lo, Max: hi})
+				}
+			}
+			continue
+		}
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() {
+			continue
+		}
+		if _, isWKT := wellKnownTypeSchemas[string(fd.Message().FullName())]; isWKT {
+			continue
+		}
+		collectMapPairLimitsInto(fd.Message(), path, visited, out)
+	}
+}
+
+// protovalidateScalarRules maps field kinds to the name of the FieldRules
+// field holding their rules.
+var protovalidateScalarRules = map[protoreflect.Kind]protoreflect.Name{
+	protoreflect.BoolKind:     "bool",
+	protoreflect.StringKind:   "string",
+	protoreflect.EnumKind:     "enum",
+	protoreflect.Int32Kind:    "int32",
+	protoreflect.Int64Kind:    "int64",
+	protoreflect.Uint32Kind:   "uint32",
+	protoreflect.Uint64Kind:   "uint64",
+	protoreflect.Sint32Kind:   "sint32",
+	protoreflect.Sint64Kind:   "sint64",
+	protoreflect.Fixed32Kind:  "fixed32",
+	protoreflect.Fixed64Kind:  "fixed64",
+	protoreflect.Sfixed32Kind: "sfixed32",
+	protoreflect.Sfixed64Kind: "sfixed64",
+	protoreflect.FloatKind:    "float",
+	protoreflect.DoubleKind:   "double",
+}
+
+// protovalidateConst returns the JSON value of the const rule set on the
+// singular scalar or enum field fd, e.g. (buf.validate.field).string.const.
+// Enum consts are returned as the name of the value, matching the enum
+// schema.
+func protovalidateConst(fd protoreflect.FieldDescriptor) (any, bool) {
+	if fd.IsList() || fd.IsMap() {
+		return nil, false
+	}
+	name, ok := protovalidateScalarRules[fd.Kind()]
+	if !ok {
+		return nil, false
+	}
+	rules := subMessage(protovalidateFieldRules(fd), name)
+	if rules == nil {
+		return nil, false
+	}
+	constField := rules.Descriptor().Fields().ByName("const")
+	if constField == nil || !rules.Has(constField) {
+		return nil, false
+	}
+	return protovalidateRuleValue(fd, rules.Get(constField))
+}
+
+// protovalidateRuleValue returns the JSON value of v, a value of a rule of
+// the scalar or enum field fd, as it appears in the schema of fd.
+func protovalidateRuleValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) (any, bool) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return v.Bool(), true
+	case protoreflect.StringKind:
+		return v.String(), true
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes()), true
+	case protoreflect.EnumKind:
+		ev := fd.Enum().Values().ByNumber(protoreflect.EnumNumber(v.Int()))
+		if ev == nil {
+			return nil, false
+		}
+		return string(ev.Name()), true
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return v.Float(), true
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return v.Uint(), true
+	default:
+		return v.Int(), true
+	}
+}
+
+// applyProtovalidateConst pins schema to the const rule set on fd, if any.
+func applyProtovalidateConst(fd protoreflect.FieldDescriptor, schema map[string]any) {
+	if v, ok := protovalidateConst(fd); ok {
+		schema["const"] = v
+	}
+}
+
+// protovalidateExamples returns the JSON values of the example rule set on the
+// scalar or enum field fd, e.g. (buf.validate.field).string.example, in
+// order. For a repeated field the examples of its items rule are returned.
+func protovalidateExamples(fd protoreflect.FieldDescriptor) []any {
+	if fd.IsMap() {
+		return nil
+	}
+	name, ok := protovalidateScalarRules[fd.Kind()]
+	if fd.Kind() == protoreflect.BytesKind {
+		name, ok = "bytes", true
+	}
+	if !ok {
+		return nil
+	}
+	rules := protovalidateFieldRules(fd)
+	if fd.IsList() {
+		rules = subMessage(subMessage(rules, "repeated"), "items")
+	}
+	rules = subMessage(rules, name)
+	if rules == nil {
+		return nil
+	}
+	exampleField := rules.Descriptor().Fields().ByName("example")
+	if exampleField == nil || !exampleField.IsList() {
+		return nil
+	}
+	list := rules.Get(exampleField).List()
+	var out []any
+	for i := 0; i < list.Len(); i++ {
+		if v, ok := protovalidateRuleValue(fd, list.Get(i)); ok {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// applyProtovalidateExamples lifts the example rules set on fd, if any, into
+// the examples of schema. For a repeated field schema is the items schema.
+func applyProtovalidateExamples(fd protoreflect.FieldDescriptor, schema map[string]any) {
+	if examples := protovalidateExamples(fd); len(examples) > 0 {
+		schema["examples"] = examples
+	}
+}
+
+// ConstField is a field pinned by a protovalidate const rule, which the
+// generated handler fills in when the caller omits it.
+type ConstField struct {
+	// Path is the field path, as protobuf field names.
+	Path []string
+	// JSONPath is the same path, as JSON names.
+	JSONPath []string
+	// Value is the JSON encoding of the const value.
+	Value string
+}
+
+// collectConstFields walks md and returns the fields with a const rule. Like
+// collectZeroBasedPaginationPaths it follows singular nested messages but not
+// lists, maps, oneofs or well-known types.
+func collectConstFields(md protoreflect.MessageDescriptor) []ConstField {
+	var out []ConstField
+	collectConstFieldsInto(md, nil, nil, map[string]bool{}, &out)
+	return out
+}
+
+func collectConstFieldsInto(md protoreflect.MessageDescriptor, prefix, jsonPrefix []string, visited map[string]bool, out *[]ConstField) {
+	full := string(md.FullName())
+	if visited[full] {
+		return
+	}
+	visited[full] = true
+	defer delete(visited, full)
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if oneOf := fd.ContainingOneof(); oneOf != nil && !oneOf.IsSynthetic() {
+			continue
+		}
+		path := appendPath(prefix, string(fd.Name()))
+		jsonPath := appendPath(jsonPrefix, fd.JSONName())
+		if v, ok := protovalidateConst(fd); ok {
+			raw, err := json.Marshal(v)
+			if err == nil {
+				*out = append(*out, ConstField{Path: path, JSONPath: jsonPath, Value: string(raw)})
+			}
+			continue
+		}
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+			continue
+		}
+		if _, isWKT := wellKnownTypeSchemas[string(fd.Message().FullName())]; isWKT {
+			continue
+		}
+		collectConstFieldsInto(fd.Message(), path, jsonPath, visited, out)
+	}
+}
@@ -0,0 +1,377 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestProtovalidateUUIDFormat(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{}
+	md := (&testdata.RegisterHostRequest{}).ProtoReflect().Descriptor()
+
+	schema := fg.getType(md.Fields().ByName("request_id"))
+	g.Expect(schema["type"]).To(Equal("string"))
+	g.Expect(schema["format"]).To(Equal("uuid"))
+	g.Expect(schema["minLength"]).To(Equal(36))
+	g.Expect(schema["maxLength"]).To(Equal(36))
+
+	re := regexp.MustCompile(schema["pattern"].(string))
+	g.Expect(re.MatchString("0b6f3f2c-5d0e-4c5b-9f58-7f3a2d1e0c9b")).To(BeTrue())
+	g.Expect(re.MatchString("0B6F3F2C-5D0E-4C5B-9F58-7F3A2D1E0C9B")).To(BeTrue())
+	g.Expect(re.MatchString("0b6f3f2c5d0e4c5b9f587f3a2d1e0c9b")).To(BeFalse())
+	g.Expect(re.MatchString("not-a-uuid")).To(BeFalse())
+}
+
+func TestProtovalidateIPv4Format(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{}
+	md := (&testdata.RegisterHostRequest{}).ProtoReflect().Descriptor()
+
+	schema := fg.getType(md.Fields().ByName("ipv4_address"))
+	g.Expect(schema["type"]).To(Equal("string"))
+	g.Expect(schema["format"]).To(Equal("ipv4"))
+	g.Expect(schema["minLength"]).To(Equal(7))
+	g.Expect(schema["maxLength"]).To(Equal(15))
+
+	re := regexp.MustCompile(schema["pattern"].(string))
+	for _, ok := range []string{"0.0.0.0", "10.1.2.3", "192.168.0.1", "255.255.255.255"} {
+		g.Expect(re.MatchString(ok)).To(BeTrue(), ok)
+	}
+	for _, bad := range []string{"256.0.0.1", "1.2.3", "1.2.3.4.5", "01.2.3.4", "::1", "a.b.c.d"} {
+		g.Expect(re.MatchString(bad)).To(BeFalse(), bad)
+	}
+
+	// repeated.items.string rules apply to the array items.
+	list := fg.getType(md.Fields().ByName("secondary_ipv4_addresses"))
+	g.Expect(list["type"]).To(Equal("array"))
+	g.Expect(list).ToNot(HaveKey("format"))
+	items := list["items"].(map[string]any)
+	g.Expect(items["format"]).To(Equal("ipv4"))
+	g.Expect(items["pattern"]).To(Equal(schema["pattern"]))
+}
+
+func TestProtovalidateOtherFormats(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{}
+	md := (&testdata.RegisterHostRequest{}).ProtoReflect().Descriptor()
+
+	tests := []struct {
+		field  protoreflect.Name
+		format string
+	}{
+		{"owner_email", "email"},
+		{"hostname", "hostname"},
+		{"address", ""},
+		{"ipv6_address", "ipv6"},
+		{"health_check_url", "uri"},
+		{"docs_path", "uri-reference"},
+		{"display_name", ""},
+	}
+	for _, tt := range tests {
+		schema := fg.getType(md.Fields().ByName(tt.field))
+		if tt.format == "" {
+			g.Expect(schema).ToNot(HaveKey("format"), string(tt.field))
+			continue
+		}
+		g.Expect(schema["format"]).To(Equal(tt.format), string(tt.field))
+	}
+
+	ip := fg.getType(md.Fields().ByName("address"))
+	re := regexp.MustCompile(ip["pattern"].(string))
+	g.Expect(re.MatchString("10.0.0.1")).To(BeTrue())
+	g.Expect(re.MatchString("2001:db8::1")).To(BeTrue())
+	g.Expect(re.MatchString("example.com")).To(BeFalse())
+}
+
+// codeGeneratorRequest returns the request protoc would send to generate
+// target.
+func codeGeneratorRequest(target protoreflect.FileDescriptor) *pluginpb.CodeGeneratorRequest {
+	var files []*descriptorpb.FileDescriptorProto
+	seen := map[string]bool{}
+	var collect func(fd protoreflect.FileDescriptor)
+	collect = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			collect(fd.Imports().Get(i).FileDescriptor)
+		}
+		files = append(files, protodesc.ToFileDescriptorProto(fd))
+	}
+	collect(target)
+
+	return &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{target.Path()},
+		ProtoFile:      files,
+	}
+}
+
+func TestProtovalidateStringIn(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{}
+	md := (&testdata.RegisterHostRequest{}).ProtoReflect().Descriptor()
+
+	schema := fg.getType(md.Fields().ByName("environment"))
+	g.Expect(schema["type"]).To(Equal("string"))
+	g.Expect(schema["enum"]).To(Equal([]string{"dev", "staging", "prod"}))
+
+	schema = fg.getType(md.Fields().ByName("region"))
+	g.Expect(schema).ToNot(HaveKey("enum"))
+	g.Expect(schema["not"]).To(Equal(map[string]any{"enum": []string{"global", "local"}}))
+
+	// repeated.items.string rules apply to the array items.
+	list := fg.getType(md.Fields().ByName("tiers"))
+	g.Expect(list).ToNot(HaveKey("enum"))
+	g.Expect(list["items"]).To(HaveKeyWithValue("enum", []string{"gold", "silver"}))
+
+	// The rules hold in the compiled tool schema.
+	var toolSchema any
+	g.Expect(json.Unmarshal([]byte(testdatamcp.ValidatedService_RegisterHostTool.JSONSchema), &toolSchema)).To(Succeed())
+	compiled, err := compileSchema(toolSchema)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(compiled.Validate(map[string]any{"environment": "prod", "region": "eu-west", "tiers": []any{"gold"}})).To(Succeed())
+	g.Expect(compiled.Validate(map[string]any{"environment": "qa"})).ToNot(Succeed())
+	g.Expect(compiled.Validate(map[string]any{"region": "global"})).ToNot(Succeed())
+	g.Expect(compiled.Validate(map[string]any{"tiers": []any{"bronze"}})).ToNot(Succeed())
+}
+
+// TestProtovalidateUnlinkedExtension covers the protoc plugin path, where the
+// protovalidate Go types are not registered and (buf.validate.field) arrives
+// as unknown bytes on FieldOptions.
+func TestProtovalidateUnlinkedExtension(t *testing.T) {
+	g := NewWithT(t)
+
+	target := (&testdata.RegisterHostRequest{}).ProtoReflect().Descriptor().ParentFile()
+
+	// Round-trip through the wire format without a resolver, as protoc does.
+	raw, err := proto.Marshal(codeGeneratorRequest(target))
+	g.Expect(err).ToNot(HaveOccurred())
+	req := &pluginpb.CodeGeneratorRequest{}
+	g.Expect(proto.UnmarshalOptions{Resolver: new(protoregistry.Types)}.Unmarshal(raw, req)).To(Succeed())
+
+	plugin, err := protogen.Options{}.New(req)
+	g.Expect(err).ToNot(HaveOccurred())
+	file := plugin.FilesByPath[target.Path()]
+	g.Expect(file).ToNot(BeNil())
+
+	fg := NewFileGenerator(file, plugin)
+	md := file.Desc.Messages().ByName("RegisterHostRequest")
+	field := md.Fields().ByName("request_id")
+	g.Expect(field.Options().(proto.Message).ProtoReflect().GetUnknown()).ToNot(BeEmpty())
+
+	schema := fg.getType(field)
+	g.Expect(schema["format"]).To(Equal("uuid"))
+	g.Expect(schema["maxLength"]).To(Equal(36))
+}
+
+func TestProtovalidateConst(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{}
+	md := (&testdata.PublishEventRequest{}).ProtoReflect().Descriptor()
+
+	g.Expect(fg.getType(md.Fields().ByName("api_version"))).To(HaveKeyWithValue("const", "v2"))
+	g.Expect(fg.getType(md.Fields().ByName("schema_revision"))).To(HaveKeyWithValue("const", int64(3)))
+	kind := fg.getType(md.Fields().ByName("kind"))
+	g.Expect(kind).To(HaveKeyWithValue("const", "EVENT_KIND_DELETED"))
+	g.Expect(kind["enum"]).To(ContainElement("EVENT_KIND_DELETED"))
+	g.Expect(fg.getType(md.Fields().ByName("payload"))).ToNot(HaveKey("const"))
+
+	// Inlined enums are referenced from $defs; the const sits next to the $ref.
+	inline := &FileGenerator{inlineMessages: true}
+	g.Expect(inline.getType(md.Fields().ByName("kind"))).To(HaveKeyWithValue("const", "EVENT_KIND_DELETED"))
+
+	g.Expect(collectConstFields(md)).To(Equal([]ConstField{
+		{Path: []string{"api_version"}, JSONPath: []string{"apiVersion"}, Value: `"v2"`},
+		{Path: []string{"schema_revision"}, JSONPath: []string{"schemaRevision"}, Value: `3`},
+		{Path: []string{"kind"}, JSONPath: []string{"kind"}, Value: `"EVENT_KIND_DELETED"`},
+		{Path: []string{"source", "system"}, JSONPath: []string{"source", "system"}, Value: `"inventory"`},
+	}))
+}
+
+func TestProtovalidateExamples(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{}
+	md := (&testdata.RegisterHostRequest{}).ProtoReflect().Descriptor()
+
+	g.Expect(fg.getType(md.Fields().ByName("rack_slot"))).To(HaveKeyWithValue("examples", []any{int64(1), int64(42)}))
+	g.Expect(fg.getType(md.Fields().ByName("display_name"))).ToNot(HaveKey("examples"))
+
+	// repeated.items examples apply to the array items.
+	list := fg.getType(md.Fields().ByName("labels"))
+	g.Expect(list).ToNot(HaveKey("examples"))
+	g.Expect(list["items"]).To(HaveKeyWithValue("examples", []any{"edge", "gpu"}))
+
+	var toolSchema map[string]any
+	g.Expect(json.Unmarshal([]byte(testdatamcp.ValidatedService_RegisterHostTool.JSONSchema), &toolSchema)).To(Succeed())
+	props := toolSchema["properties"].(map[string]any)
+	g.Expect(props["rack_slot"]).To(HaveKeyWithValue("examples", []any{float64(1), float64(42)}))
+}
+
+func TestProtovalidateMessageRules(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal([]byte(testdatamcp.ValidatedService_ScheduleMaintenanceTool.JSONSchema), &schema)).To(Succeed())
+
+	// A rule is described by its message, or else by its expression.
+	g.Expect(schema["description"]).To(Equal("Validation rules:\n" +
+		"- contact_email is required when notify is set\n" +
+		"- `this.host_id != '' || this.pool != ''`"))
+
+	// The rules of nested messages go on their definitions.
+	window := schema["$defs"].(map[string]any)["MaintenanceWindow"]
+	g.Expect(window).To(HaveKeyWithValue("description", "Validation rules:\n- end_hour must be after start_hour"))
+
+	// Messages without rules get no description.
+	g.Expect(protovalidateMessageRulesNote((&testdata.RegisterHostRequest{}).ProtoReflect().Descriptor())).To(BeEmpty())
+}
+
+func TestProtovalidateConstFilledByHandler(t *testing.T) {
+	g := NewWithT(t)
+
+	var got *testdata.PublishEventRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToValidatedServiceClient(s, &testdatamcp.MockValidatedServiceHandler{
+		PublishEventFunc: func(_ context.Context, req *testdata.PublishEventRequest) (*testdata.PublishEventResponse, error) {
+			got = req
+			return &testdata.PublishEventResponse{EventId: "evt-1"}, nil
+		},
+	})
+
+	text := resultText(g, callTool(t, s, testdatamcp.ValidatedService_PublishEventTool.Name, map[string]any{
+		"payload": "{}",
+		"source":  map[string]any{"host": "db-1"},
+	}))
+	g.Expect(text).To(ContainSubstring("evt-1"))
+	g.Expect(got.GetApiVersion()).To(Equal("v2"))
+	g.Expect(got.GetSchemaRevision()).To(Equal(int32(3)))
+	g.Expect(got.GetKind()).To(Equal(testdata.EventKind_EVENT_KIND_DELETED))
+	g.Expect(got.GetSource().GetSystem()).To(Equal("inventory"))
+	g.Expect(got.GetSource().GetHost()).To(Equal("db-1"))
+
+	// Without a source, none is created just to hold its const field.
+	callTool(t, s, testdatamcp.ValidatedService_PublishEventTool.Name, map[string]any{"payload": "{}"})
+	g.Expect(got.GetSource()).To(BeNil())
+
+	// Fields sent under their JSON name keep their value, and a null there
+	// is replaced rather than duplicated.
+	resp := callTool(t, s, testdatamcp.ValidatedService_PublishEventTool.Name, map[string]any{
+		"payload":        "{}",
+		"apiVersion":     "v2",
+		"schemaRevision": nil,
+	})
+	g.Expect(resp["result"]).ToNot(HaveKeyWithValue("isError", true), "unexpected response: %v", resp)
+	g.Expect(got.GetApiVersion()).To(Equal("v2"))
+	g.Expect(got.GetSchemaRevision()).To(Equal(int32(3)))
+}
+
+func TestProtovalidateMapRules(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{}
+	md := (&testdata.LabelHostRequest{}).ProtoReflect().Descriptor()
+
+	labels := fg.getType(md.Fields().ByName("labels"))
+	g.Expect(labels["minProperties"]).To(Equal(uint64(1)))
+	g.Expect(labels["maxProperties"]).To(Equal(uint64(4)))
+
+	owners := fg.getType(md.Fields().ByName("owners"))
+	g.Expect(owners).ToNot(HaveKey("minProperties"))
+	g.Expect(owners["propertyNames"]).To(HaveKeyWithValue("format", "uuid"))
+	g.Expect(owners["additionalProperties"]).To(HaveKeyWithValue("format", "email"))
+
+	// Free-form values keep their schema; string keys need no propertyNames
+	// unless a rule constrains them.
+	annotations := fg.getType(md.Fields().ByName("annotations"))
+	g.Expect(annotations["maxProperties"]).To(Equal(uint64(2)))
+	g.Expect(annotations["additionalProperties"]).To(BeTrue())
+	g.Expect(annotations).ToNot(HaveKey("propertyNames"))
+
+	g.Expect(collectMapPairLimits(md)).To(Equal([]MapPairLimit{
+		{Path: []string{"labels"}, Min: 1, Max: 4},
+		{Path: []string{"annotations"}, Max: 2},
+		{Path: []string{"options", "priorities"}, Max: 3},
+	}))
+}
+
+func TestProtovalidateMapPairsStrictValidation(t *testing.T) {
+	newServer := func(opts ...runtime.Option) (*mcpserver.MCPServer, *int) {
+		calls := 0
+		s := mcpserver.NewMCPServer("test-server", "1.0.0")
+		testdatamcp.ForwardToValidatedServiceClient(s, &testdatamcp.MockValidatedServiceHandler{
+			LabelHostFunc: func(context.Context, *testdata.LabelHostRequest) (*testdata.LabelHostResponse, error) {
+				calls++
+				return &testdata.LabelHostResponse{}, nil
+			},
+		}, opts...)
+		return s, &calls
+	}
+	tooMany := map[string]any{"labels": map[string]any{"a": "1", "b": "2", "c": "3", "d": "4", "e": "5"}}
+
+	t.Run("strict", func(t *testing.T) {
+		g := NewWithT(t)
+		s, calls := newServer(runtime.WithStrictValidation(true))
+
+		resp := callTool(t, s, testdatamcp.ValidatedService_LabelHostTool.Name, tooMany)
+		g.Expect(resp["result"]).To(HaveKeyWithValue("isError", true))
+		g.Expect(resultText(g, resp)).To(ContainSubstring("labels must have at most 4 entries, got 5"))
+
+		resp = callTool(t, s, testdatamcp.ValidatedService_LabelHostTool.Name, map[string]any{})
+		g.Expect(resultText(g, resp)).To(ContainSubstring("labels must have at least 1 entry, got 0"))
+
+		resp = callTool(t, s, testdatamcp.ValidatedService_LabelHostTool.Name, map[string]any{
+			"labels":  map[string]any{"env": "prod"},
+			"options": map[string]any{"priorities": map[string]any{"1": "a", "2": "b", "3": "c", "4": "d"}},
+		})
+		g.Expect(resultText(g, resp)).To(ContainSubstring("options.priorities must have at most 3 entries"))
+		g.Expect(*calls).To(BeZero())
+
+		callTool(t, s, testdatamcp.ValidatedService_LabelHostTool.Name, map[string]any{"labels": map[string]any{"env": "prod"}})
+		g.Expect(*calls).To(Equal(1))
+	})
+
+	t.Run("off by default", func(t *testing.T) {
+		g := NewWithT(t)
+		s, calls := newServer()
+		callTool(t, s, testdatamcp.ValidatedService_LabelHostTool.Name, tooMany)
+		g.Expect(*calls).To(Equal(1))
+	})
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// rateSession is an initialized client session with the given id.
+type rateSession string
+
+func (s rateSession) Initialize()                                         {}
+func (s rateSession) Initialized() bool                                   { return true }
+func (s rateSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return nil }
+func (s rateSession) SessionID() string                                   { return string(s) }
+
+// expectRateLimited checks that resp is the tool error of a call over the
+// rate limit.
+func expectRateLimited(g *WithT, resp map[string]any) {
+	g.Expect(resp).To(HaveKey("result"), "unexpected response: %v", resp)
+	result := resp["result"].(map[string]any)
+	g.Expect(result["isError"]).To(BeTrue())
+	g.Expect(result["content"].([]any)[0].(map[string]any)["text"]).To(And(
+		ContainSubstring("RESOURCE_EXHAUSTED"),
+		ContainSubstring("too many calls of "+testdatamcp.TestService_GetItemToolName),
+	))
+}
+
+func TestRateLimit(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, testServiceClient{server: &testServer{}}, runtime.WithRateLimit(map[string]runtime.RateLimit{
+		testdatamcp.TestService_GetItemToolName: {Rate: 0.001, Burst: 2},
+	}))
+
+	for _, id := range []string{"item-1", "item-2"} {
+		g.Expect(resultText(g, callGetItem(t, s, map[string]any{"id": id}))).To(ContainSubstring(id))
+	}
+	expectRateLimited(g, callGetItem(t, s, map[string]any{"id": "item-3"}))
+
+	// Other tools have no limit.
+	for range 3 {
+		resp := callTool(t, s, testdatamcp.TestService_CreateItemToolName, map[string]any{"name": "widget"})
+		g.Expect(resp["result"]).ToNot(HaveKeyWithValue("isError", true))
+	}
+}
+
+func TestRateLimitPerSession(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, testServiceClient{server: &testServer{}},
+		runtime.WithDefaultRateLimit(runtime.RateLimit{Rate: 0.001, Burst: 1}),
+		runtime.WithRateLimitPerSession(true),
+	)
+	alice := s.WithContext(context.Background(), rateSession("alice"))
+	bob := s.WithContext(context.Background(), rateSession("bob"))
+
+	args := map[string]any{"id": "item-1"}
+	g.Expect(resultText(g, callToolWithContext(t, alice, s, testdatamcp.TestService_GetItemToolName, args))).To(ContainSubstring("item-1"))
+	expectRateLimited(g, callToolWithContext(t, alice, s, testdatamcp.TestService_GetItemToolName, args))
+	// Another session has a bucket of its own.
+	g.Expect(resultText(g, callToolWithContext(t, bob, s, testdatamcp.TestService_GetItemToolName, args))).To(ContainSubstring("item-1"))
+	expectRateLimited(g, callToolWithContext(t, bob, s, testdatamcp.TestService_GetItemToolName, args))
+}
+
+func TestRateLimitCompanionTools(t *testing.T) {
+	g := NewWithT(t)
+
+	limit := runtime.WithRateLimit(map[string]runtime.RateLimit{
+		testdatamcp.BatchService_LookupWidgetToolName: {Rate: 0.001, Burst: 2},
+		testdatamcp.MemoService_AddMemoToolName:       {Rate: 0.001, Burst: 1},
+	})
+
+	// Every request of a batch takes a call out of the single tool's bucket.
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToBatchServiceClient(s, &testdatamcp.MockBatchServiceHandler{
+		LookupWidgetFunc: func(_ context.Context, req *testdata.LookupWidgetRequest) (*testdata.LookupWidgetResponse, error) {
+			return &testdata.LookupWidgetResponse{Id: req.GetId()}, nil
+		},
+	}, limit)
+	resp := callTool(t, s, "lookup_widget_batch", map[string]any{
+		"requests": []any{
+			map[string]any{"id": "a"},
+			map[string]any{"id": "b"},
+			map[string]any{"id": "c"},
+		},
+	})
+	var out struct {
+		Results []struct {
+			Result json.RawMessage `json:"result"`
+			Error  json.RawMessage `json:"error"`
+		} `json:"results"`
+	}
+	g.Expect(json.Unmarshal([]byte(resultText(g, resp)), &out)).To(Succeed())
+	g.Expect(out.Results).To(HaveLen(3))
+	g.Expect(out.Results[0].Result).To(MatchJSON(`{"id":"a","name":""}`))
+	g.Expect(out.Results[1].Result).To(MatchJSON(`{"id":"b","name":""}`))
+	g.Expect(string(out.Results[2].Error)).To(ContainSubstring("too many calls of " + testdatamcp.BatchService_LookupWidgetToolName))
+	resp = callTool(t, s, testdatamcp.BatchService_LookupWidgetToolName, map[string]any{"id": "d"})
+	g.Expect(resultText(g, resp)).To(ContainSubstring("RESOURCE_EXHAUSTED"))
+
+	// A raw call shares the bucket of the single tool.
+	var received *testdata.AddMemoRequest
+	s = mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToMemoServiceClient(s, &testdatamcp.MockMemoServiceHandler{
+		AddMemoFunc: func(_ context.Context, req *testdata.AddMemoRequest) (*testdata.Memo, error) {
+			received = req
+			return &testdata.Memo{Text: req.GetText()}, nil
+		},
+	}, limit)
+	resp = callTool(t, s, testdatamcp.MemoService_AddMemoRawToolName, map[string]any{"request": `{"text": "buy milk"}`})
+	g.Expect(resultText(g, resp)).To(ContainSubstring("buy milk"))
+	g.Expect(received).ToNot(BeNil())
+	resp = callTool(t, s, testdatamcp.MemoService_AddMemoToolName, map[string]any{"text": "buy eggs"})
+	g.Expect(resultText(g, resp)).To(ContainSubstring("too many calls of " + testdatamcp.MemoService_AddMemoToolName))
+}
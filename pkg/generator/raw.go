@@ -0,0 +1,97 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+// rawToolSuffix is appended to a tool name to name its raw tool.
+const rawToolSuffix = "_raw"
+
+// rawTool returns the companion raw tool of tool, the tool generated for
+// meth from inputSchema, or nil when neither (mcp.options.tool) raw nor the
+// raw_tools option is set. Its input is a "request" string holding the
+// arguments of tool as JSON, described by contentSchema.
+func (g *FileGenerator) rawTool(meth *protogen.Method, opts *mcpoptions.ToolOptions, tool SimpleTool, inputSchema map[string]any) (*SimpleTool, error) {
+	if !g.rawTools && !opts.GetRaw() {
+		return nil, nil
+	}
+
+	annotated := opts.GetName() != ""
+	name := tool.Name + rawToolSuffix
+	if len(name) > MaxToolNameLength {
+		if annotated {
+			return nil, fmt.Errorf("mcpgen: %s raw tool name %q is longer than %d characters; shorten the (mcp.options.tool) name", meth.Desc.FullName(), name, MaxToolNameLength)
+		}
+		name = MangleHeadIfTooLong(strings.ReplaceAll(string(meth.Desc.FullName()), ".", "_")+rawToolSuffix, MaxToolNameLength)
+	}
+	if err := g.claimToolName(meth, name, annotated); err != nil {
+		return nil, err
+	}
+
+	// The encoded arguments share the $defs of the tool schema, hoisted to
+	// the root so their $refs still resolve.
+	content := make(map[string]any, len(inputSchema))
+	for k, v := range inputSchema {
+		switch k {
+		case "$schema", "$defs", "x-grpc-method":
+		default:
+			content[k] = v
+		}
+	}
+	schema := map[string]any{
+		"$schema": inputSchema["$schema"],
+		"type":    "object",
+		"properties": map[string]any{
+			"request": map[string]any{
+				"type":             "string",
+				"description":      fmt.Sprintf("Arguments of %s, as a JSON object encoded in a string.", tool.Name),
+				"contentMediaType": "application/json",
+				"contentSchema":    content,
+			},
+		},
+		"required": []string{"request"},
+	}
+	if defs, ok := inputSchema["$defs"]; ok {
+		schema["$defs"] = defs
+	}
+	if method, ok := inputSchema["x-grpc-method"]; ok {
+		schema["x-grpc-method"] = method
+	}
+	marshaled, err := g.marshalSchema(schema, g.schemaID(meth, "raw"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal raw JSON schema for %s: %w", meth.Desc.FullName(), err)
+	}
+
+	description := fmt.Sprintf("Runs %s with its arguments passed as one JSON string, for clients that only support a single string argument.", tool.Name)
+	if tool.Description != "" {
+		description += "\n\n" + tool.Description
+	}
+
+	raw := tool
+	raw.Name = name
+	raw.Description = description
+	raw.JSONSchema = string(marshaled)
+	if raw.Title != "" {
+		raw.Title += " (raw)"
+	}
+	return &raw, nil
+}
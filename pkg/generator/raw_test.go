@@ -0,0 +1,107 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func newMemoTestServer(received **testdata.AddMemoRequest) *mcpserver.MCPServer {
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToMemoServiceClient(s, &testdatamcp.MockMemoServiceHandler{
+		AddMemoFunc: func(_ context.Context, req *testdata.AddMemoRequest) (*testdata.Memo, error) {
+			*received = req
+			return &testdata.Memo{Text: req.GetText(), Author: req.GetAuthor(), Position: req.GetPosition()}, nil
+		},
+	})
+	return s
+}
+
+func TestRawTool(t *testing.T) {
+	g := NewWithT(t)
+
+	var received *testdata.AddMemoRequest
+	s := newMemoTestServer(&received)
+	resp := callTool(t, s, testdatamcp.MemoService_AddMemoRawToolName, map[string]any{
+		"request": `{"text": "buy milk", "author": {"name": "sam"}, "position": 2}`,
+	})
+	// The request runs through the pipeline of the single tool, which makes
+	// the one-based position zero-based.
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"text": "buy milk", "author": {"name": "sam"}, "position": 1}`))
+	g.Expect(received.GetAuthor().GetName()).To(Equal("sam"))
+	g.Expect(received.GetPosition()).To(BeEquivalentTo(1))
+}
+
+func TestRawToolInvalidRequest(t *testing.T) {
+	for name, args := range map[string]map[string]any{
+		"missing":    {},
+		"not string": {"request": map[string]any{"text": "buy milk"}},
+		"not JSON":   {"request": "text=buy milk"},
+		"not object": {"request": `["buy milk"]`},
+		"null":       {"request": "null"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var received *testdata.AddMemoRequest
+			s := newMemoTestServer(&received)
+			text := resultText(g, callTool(t, s, testdatamcp.MemoService_AddMemoRawToolName, args))
+			g.Expect(text).To(ContainSubstring(`"code":"INVALID_ARGUMENT"`))
+			g.Expect(received).To(BeNil())
+		})
+	}
+}
+
+func TestRawToolSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	tools := registeredTools(t, func(s *mcpserver.MCPServer) {
+		testdatamcp.ForwardToMemoServiceClient(s, &testdatamcp.MockMemoServiceHandler{})
+	})
+	g.Expect(tools).To(HaveKey("add_memo"))
+	raw := tools["add_memo_raw"]
+	g.Expect(raw.Description).To(HavePrefix("Runs add_memo with its arguments passed as one JSON string"))
+	g.Expect(runtime.ValidateToolSchema(raw, true)).To(Succeed())
+
+	var schema map[string]any
+	g.Expect(json.Unmarshal(raw.RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema["required"]).To(ConsistOf(runtime.RawRequestArgument))
+	g.Expect(schema["$defs"]).To(HaveKey("MemoAuthor"))
+	request := schema["properties"].(map[string]any)[runtime.RawRequestArgument].(map[string]any)
+	g.Expect(request).To(HaveKeyWithValue("type", "string"))
+	g.Expect(request).To(HaveKeyWithValue("contentMediaType", "application/json"))
+	content := request["contentSchema"].(map[string]any)
+	g.Expect(content["properties"]).To(HaveKeyWithValue("author", HaveKeyWithValue("$ref", "#/$defs/MemoAuthor")))
+	g.Expect(content).ToNot(HaveKey("$defs"))
+}
+
+func TestRawToolsConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	file := testdata.File_testdata_test_service_proto
+	content := generatedGoFile(t, file, GenerateConfig{RawTools: true})
+	g.Expect(content).To(MatchRegexp(`TestService_GetItemRawToolName\s+= "testdata_TestService_GetItem_raw"`))
+	g.Expect(content).To(MatchRegexp(`"testdata.TestService.CreateItem#raw":\s+TestService_CreateItemRawTool.Name,`))
+	g.Expect(content).To(ContainSubstring("message, err := runtime.RawArguments(request.GetArguments())"))
+}
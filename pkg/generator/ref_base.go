@@ -0,0 +1,104 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// checkRefBaseURI returns base, an absolute URI without a fragment, with a
+// trailing slash.
+func checkRefBaseURI(base string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil || !u.IsAbs() || u.Fragment != "" || strings.HasSuffix(base, "#") {
+		return "", fmt.Errorf("ref_base_uri %q is not an absolute URI without a fragment", base)
+	}
+	if !strings.HasSuffix(base, "/") {
+		base += "/"
+	}
+	return base, nil
+}
+
+// schemaID returns the $id of a schema of meth under ref_base_uri:
+// <base><package>.<Service>/<Method>, followed by "/" and kind for the
+// schemas other than the input schema of the tool, e.g. "batch".
+func (g *FileGenerator) schemaID(meth *protogen.Method, kind string) string {
+	id := g.refBaseURI + string(meth.Parent.Desc.FullName()) + "/" + string(meth.Desc.Name())
+	if kind != "" {
+		id += "/" + kind
+	}
+	return id
+}
+
+// withRefBase returns a copy of schema identified by id, with its local $refs
+// such as "#/$defs/Bar" made absolute, e.g.
+// "https://schemas.example.com/pkg.Service/Method#/$defs/Bar". Without
+// ref_base_uri, schema itself is returned.
+func (g *FileGenerator) withRefBase(schema map[string]any, id string) (map[string]any, error) {
+	if g.refBaseURI == "" {
+		return schema, nil
+	}
+	// Copy through JSON, which also turns the typed slices and maps of the
+	// generated schema into plain ones.
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	var based map[string]any
+	if err := json.Unmarshal(b, &based); err != nil {
+		return nil, err
+	}
+	absoluteRefs(based, id)
+	based["$id"] = id
+	return based, nil
+}
+
+// marshalSchema encodes schema as made absolute against id by withRefBase.
+func (g *FileGenerator) marshalSchema(schema map[string]any, id string) ([]byte, error) {
+	based, err := g.withRefBase(schema, id)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(based)
+}
+
+// absoluteRefs prefixes every $ref of v, a decoded schema or a value within
+// one, that starts with "#" with id. Values such as examples and consts are
+// left as they are.
+func absoluteRefs(v any, id string) {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			switch key {
+			case "$ref":
+				if ref, ok := value.(string); ok && strings.HasPrefix(ref, "#") {
+					v[key] = id + ref
+				}
+			case "examples", "const", "default", "enum":
+			default:
+				absoluteRefs(value, id)
+			}
+		}
+	case []any:
+		for _, item := range v {
+			absoluteRefs(item, id)
+		}
+	}
+}
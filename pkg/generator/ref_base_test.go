@@ -0,0 +1,118 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// schemaRefs returns every $ref in v.
+func schemaRefs(v any) []string {
+	var refs []string
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if ref, ok := value.(string); ok && key == "$ref" {
+				refs = append(refs, ref)
+			} else {
+				refs = append(refs, schemaRefs(value)...)
+			}
+		}
+	case []any:
+		for _, item := range v {
+			refs = append(refs, schemaRefs(item)...)
+		}
+	}
+	return refs
+}
+
+// expectRefsUnder checks that schema is identified by id and that its $refs
+// point into its own $defs under id.
+func expectRefsUnder(g *WithT, schema map[string]any, id string) {
+	g.Expect(schema).To(HaveKeyWithValue("$id", id))
+	refs := schemaRefs(schema)
+	g.Expect(refs).ToNot(BeEmpty())
+	defs := schema["$defs"].(map[string]any)
+	for _, ref := range refs {
+		g.Expect(ref).To(HavePrefix(id + "#/$defs/"))
+		g.Expect(defs).To(HaveKey(strings.TrimPrefix(ref, id+"#/$defs/")))
+	}
+}
+
+func TestRefBaseURI(t *testing.T) {
+	g := NewWithT(t)
+
+	files := generatedFiles(t, codeGeneratorRequest(testdata.File_testdata_test_service_proto), GenerateConfig{
+		PackageSuffix: "mcp",
+		SchemaOut:     "schemas",
+		RefBaseURI:    "https://schemas.example.com",
+	})
+
+	var doc struct {
+		InputSchema  map[string]any `json:"inputSchema"`
+		OutputSchema map[string]any `json:"outputSchema"`
+	}
+	g.Expect(json.Unmarshal([]byte(files["schemas/testdata/TestService/GetItem.json"]), &doc)).To(Succeed())
+	expectRefsUnder(g, doc.OutputSchema, "https://schemas.example.com/testdata.TestService/GetItem/output")
+	g.Expect(json.Unmarshal([]byte(files["schemas/testdata/TestService/CreateItem.json"]), &doc)).To(Succeed())
+	expectRefsUnder(g, doc.InputSchema, "https://schemas.example.com/testdata.TestService/CreateItem")
+
+	// The embedded tool schema is the same, and its refs resolve in place.
+	g.Expect(files[testdataImportPath+"/testdatamcp/test_service.pb.mcp.go"]).To(ContainSubstring(
+		`\"$id\":\"https://schemas.example.com/testdata.TestService/CreateItem\"`))
+	raw, err := json.Marshal(doc.InputSchema)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(runtime.ValidateToolSchema(mcp.Tool{Name: "create_item", RawInputSchema: raw}, true)).To(Succeed())
+	adapted, err := runtime.ToAnthropicToolSchema(raw)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(adapted)).ToNot(ContainSubstring("$ref"))
+}
+
+func TestRefBaseURIRecursive(t *testing.T) {
+	g := NewWithT(t)
+
+	// A recursive message keeps its $ref under inline_messages; it becomes
+	// absolute too, and a copy, leaving the local schema alone.
+	fg := &FileGenerator{inlineMessages: true, refBaseURI: "https://schemas.example.com/v1/"}
+	local := fg.messageSchemaWithDefs((&testdata.Incident{}).ProtoReflect().Descriptor(), nil, directionInput)
+	based, err := fg.withRefBase(local, "https://schemas.example.com/v1/testdata.IncidentService/Report")
+	g.Expect(err).ToNot(HaveOccurred())
+	expectRefsUnder(g, based, "https://schemas.example.com/v1/testdata.IncidentService/Report")
+	g.Expect(schemaRefs(based)).To(ContainElement("https://schemas.example.com/v1/testdata.IncidentService/Report#/$defs/IncidentThread"))
+	g.Expect(local).ToNot(HaveKey("$id"))
+	g.Expect(schemaRefs(local)).To(HaveEach(HavePrefix("#/$defs/")))
+
+	raw, err := json.Marshal(based)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(runtime.ValidateToolSchema(mcp.Tool{Name: "report", RawInputSchema: raw}, true)).To(Succeed())
+}
+
+func TestRefBaseURIInvalid(t *testing.T) {
+	for _, base := range []string{"schemas.example.com", "/schemas", "https://schemas.example.com/#top"} {
+		t.Run(base, func(t *testing.T) {
+			g := NewWithT(t)
+			plugin, _ := runPlugin(t, codeGeneratorRequest(testdata.File_testdata_test_service_proto), GenerateConfig{RefBaseURI: base})
+			g.Expect(plugin.Response().GetError()).To(ContainSubstring("is not an absolute URI without a fragment"))
+		})
+	}
+}
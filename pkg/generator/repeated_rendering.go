@@ -0,0 +1,91 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+// repeatedRendering returns the (mcp.options.repeated_rendering) of fd, or
+// REPEATED_RENDERING_UNSPECIFIED when none is set. fd must be a repeated
+// message field.
+func repeatedRendering(fd protoreflect.FieldDescriptor) (mcpoptions.RepeatedRendering, error) {
+	rendering, ok, err := getExtension[mcpoptions.RepeatedRendering](fd, mcpoptions.E_RepeatedRendering)
+	if err != nil || !ok || rendering == mcpoptions.RepeatedRendering_REPEATED_RENDERING_UNSPECIFIED {
+		return mcpoptions.RepeatedRendering_REPEATED_RENDERING_UNSPECIFIED, err
+	}
+	if !fd.IsList() || fd.Kind() != protoreflect.MessageKind {
+		return 0, fmt.Errorf("mcpgen: %s has (mcp.options.repeated_rendering) but is not a repeated message field", fd.FullName())
+	}
+	return rendering, nil
+}
+
+// summarizedMessageSchema returns the REPEATED_RENDERING_SUMMARY schema of
+// the items of type md: an object titled with the message name whose
+// description counts and names the properties a full schema would have, in
+// declaration order, with each oneof under its wrapper key.
+func (g *FileGenerator) summarizedMessageSchema(md protoreflect.MessageDescriptor, dir schemaDirection) map[string]any {
+	var names []string
+	seen := map[protoreflect.FullName]bool{}
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if !g.markFieldBehavior && !fieldInDirection(fd, dir) {
+			continue
+		}
+		if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			if !seen[oneof.FullName()] {
+				seen[oneof.FullName()] = true
+				names = append(names, g.oneOfWrapperKeyOf(oneof))
+			}
+			continue
+		}
+		names = append(names, string(fd.Name()))
+	}
+	description := fmt.Sprintf("%s object with %d fields: %s.", md.Name(), len(names), strings.Join(names, ", "))
+	if len(names) == 1 {
+		description = fmt.Sprintf("%s object with 1 field: %s.", md.Name(), names[0])
+	} else if len(names) == 0 {
+		description = fmt.Sprintf("%s object without fields.", md.Name())
+	}
+	return map[string]any{
+		"type":        "object",
+		"title":       string(md.Name()),
+		"description": description,
+	}
+}
+
+// checkRepeatedRendering reports every misplaced
+// (mcp.options.repeated_rendering) in messages and their nested messages.
+func (g *FileGenerator) checkRepeatedRendering(messages []*protogen.Message) bool {
+	ok := true
+	for _, msg := range messages {
+		for _, field := range msg.Fields {
+			if _, err := repeatedRendering(field.Desc); err != nil {
+				g.gen.Error(err)
+				ok = false
+			}
+		}
+		if !g.checkRepeatedRendering(msg.Messages) {
+			ok = false
+		}
+	}
+	return ok
+}
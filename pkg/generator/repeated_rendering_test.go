@@ -0,0 +1,145 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestRepeatedRenderingGolden(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema struct {
+		Properties map[string]map[string]any `json:"properties"`
+		Defs       map[string]any            `json:"$defs"`
+	}
+	g.Expect(json.Unmarshal([]byte(testdatamcp.BulkOrderService_PlaceBulkOrderTool.JSONSchema), &schema)).To(Succeed())
+	g.Expect(schema.Defs).To(HaveKey("OrderLine"))
+
+	// Without the option, and with REPEATED_RENDERING_REF, the items are a
+	// $ref, the default of the generator.
+	ref := map[string]any{"$ref": "#/$defs/OrderLine", "type": "object"}
+	g.Expect(schema.Properties["lines"]).To(HaveKeyWithValue("items", ref))
+	g.Expect(schema.Properties["ref_lines"]).To(HaveKeyWithValue("items", ref))
+
+	inline := schema.Properties["inline_lines"]["items"].(map[string]any)
+	g.Expect(inline).To(Equal(schema.Defs["OrderLine"]))
+
+	g.Expect(schema.Properties["summary_lines"]).To(Equal(map[string]any{
+		"type":        "array",
+		"description": "Lines rendered as a summary.",
+		"items": map[string]any{
+			"type":        "object",
+			"title":       "OrderLine",
+			"description": "OrderLine object with 3 fields: sku, quantity, discountOneOfType.",
+		},
+	}))
+}
+
+func TestRepeatedRenderingUnderInlineMessages(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{inlineMessages: true}
+	schema := fg.messageSchemaWithDefs((&testdata.PlaceBulkOrderRequest{}).ProtoReflect().Descriptor(), nil, directionInput)
+	props := schema["properties"].(map[string]any)
+
+	// The default follows inline_messages; REPEATED_RENDERING_REF does not.
+	g.Expect(props["lines"].(map[string]any)["items"]).To(HaveKey("properties"))
+	g.Expect(props["inline_lines"].(map[string]any)["items"]).To(HaveKey("properties"))
+	g.Expect(props["ref_lines"].(map[string]any)["items"]).To(HaveKeyWithValue("$ref", "#/$defs/OrderLine"))
+	g.Expect(schema["$defs"]).To(HaveKey("OrderLine"))
+	g.Expect(props["summary_lines"].(map[string]any)["items"]).To(HaveKeyWithValue("title", "OrderLine"))
+}
+
+func TestRepeatedRenderingSummaryForwardsItems(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToBulkOrderServiceClient(s, &testdatamcp.MockBulkOrderServiceHandler{
+		PlaceBulkOrderFunc: func(_ context.Context, req *testdata.PlaceBulkOrderRequest) (*testdata.PlaceBulkOrderResponse, error) {
+			return &testdata.PlaceBulkOrderResponse{Accepted: int32(len(req.GetSummaryLines()))}, nil
+		},
+	})
+	resp := callTool(t, s, testdatamcp.BulkOrderService_PlaceBulkOrderToolName, map[string]any{
+		"summary_lines": []any{
+			map[string]any{"sku": "A-1", "quantity": 2},
+			map[string]any{"sku": "B-2", "discountOneOfType": map[string]any{"object_type": "coupon", "coupon": "SPRING"}},
+		},
+	})
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"accepted":2}`))
+}
+
+// repeatedRenderingField compiles a message with a field "f" of the given
+// label and type carrying rendering as its (mcp.options.repeated_rendering).
+func repeatedRenderingField(t *testing.T, label descriptorpb.FieldDescriptorProto_Label, typ descriptorpb.FieldDescriptorProto_Type, rendering mcpoptions.RepeatedRendering) protoreflect.FieldDescriptor {
+	t.Helper()
+	field := &descriptorpb.FieldDescriptorProto{
+		Name:    proto.String("f"),
+		Number:  proto.Int32(1),
+		Label:   label.Enum(),
+		Type:    typ.Enum(),
+		Options: &descriptorpb.FieldOptions{},
+	}
+	if typ == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+		field.TypeName = proto.String(".rendering.M")
+	}
+	proto.SetExtension(field.Options, mcpoptions.E_RepeatedRendering, rendering)
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("rendering/test.proto"),
+		Package: proto.String("rendering"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:  proto.String("M"),
+			Field: []*descriptorpb.FieldDescriptorProto{field},
+		}},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fd.Messages().Get(0).Fields().Get(0)
+}
+
+func TestRepeatedRenderingErrors(t *testing.T) {
+	g := NewWithT(t)
+
+	summary := mcpoptions.RepeatedRendering_REPEATED_RENDERING_SUMMARY
+	repeated := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	message := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+
+	rendering, err := repeatedRendering(repeatedRenderingField(t, repeated, message, summary))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(rendering).To(Equal(summary))
+
+	_, err = repeatedRendering(repeatedRenderingField(t, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, message, summary))
+	g.Expect(err).To(MatchError("mcpgen: rendering.M.f has (mcp.options.repeated_rendering) but is not a repeated message field"))
+
+	_, err = repeatedRendering(repeatedRenderingField(t, repeated, descriptorpb.FieldDescriptorProto_TYPE_STRING, summary))
+	g.Expect(err).To(MatchError(ContainSubstring("is not a repeated message field")))
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ReportStderr is the report destination that writes a human-readable summary
+// to stderr instead of a JSON file.
+const ReportStderr = "stderr"
+
+// Report summarizes what one plugin invocation generated: the tools of every
+// service, the methods that did not become tools and why, and the
+// well-known types that had no dedicated schema. It is shared between the
+// FileGenerators of the invocation, like ToolNameRegistry.
+type Report struct {
+	Services []*ServiceReport `json:"services"`
+	// UnmappedTypes lists google.protobuf messages without a dedicated
+	// schema, which are described like any other message.
+	UnmappedTypes []string `json:"unmapped_types,omitempty"`
+
+	unmapped map[string]bool
+}
+
+// ServiceReport is the part of a Report about one service.
+type ServiceReport struct {
+	Service string          `json:"service"`
+	File    string          `json:"file"`
+	Tools   []string        `json:"tools"`
+	Skipped []SkippedMethod `json:"skipped,omitempty"`
+}
+
+// SkippedMethod is a method that did not become a tool.
+type SkippedMethod struct {
+	Method string `json:"method"`
+	Reason string `json:"reason"`
+}
+
+// service returns the report of svc, adding it on first use.
+func (r *Report) service(svc *protogen.Service) *ServiceReport {
+	name := string(svc.Desc.FullName())
+	for _, s := range r.Services {
+		if s.Service == name {
+			return s
+		}
+	}
+	s := &ServiceReport{Service: name, File: svc.Desc.ParentFile().Path(), Tools: []string{}}
+	r.Services = append(r.Services, s)
+	return s
+}
+
+// addTool records that meth became the tool name.
+func (r *Report) addTool(meth *protogen.Method, name string) {
+	if r == nil {
+		return
+	}
+	s := r.service(meth.Parent)
+	s.Tools = append(s.Tools, name)
+}
+
+// addSkipped records that meth did not become a tool.
+func (r *Report) addSkipped(meth *protogen.Method, reason string) {
+	if r == nil {
+		return
+	}
+	s := r.service(meth.Parent)
+	s.Skipped = append(s.Skipped, SkippedMethod{Method: string(meth.Desc.FullName()), Reason: reason})
+}
+
+// addUnmappedType records a well-known type without a dedicated schema.
+func (r *Report) addUnmappedType(fullName string) {
+	if r == nil || r.unmapped[fullName] {
+		return
+	}
+	if r.unmapped == nil {
+		r.unmapped = map[string]bool{}
+	}
+	r.unmapped[fullName] = true
+	r.UnmappedTypes = append(r.UnmappedTypes, fullName)
+	sort.Strings(r.UnmappedTypes)
+}
+
+// streamingReason returns why a streaming method is skipped, or "" for a
+// unary method.
+func streamingReason(meth *protogen.Method) string {
+	switch client, server := meth.Desc.IsStreamingClient(), meth.Desc.IsStreamingServer(); {
+	case client && server:
+		return "bidirectional streaming"
+	case client:
+		return "client streaming"
+	case server:
+		return "server streaming"
+	}
+	return ""
+}
+
+// Write writes the report to dest: a human-readable summary to stderr when
+// dest is ReportStderr, otherwise indented JSON to the file dest, relative to
+// the plugin output directory.
+func (r *Report) Write(gen *protogen.Plugin, dest string, stderr io.Writer) error {
+	if dest == ReportStderr {
+		return r.WriteText(stderr)
+	}
+	raw, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal generation report: %w", err)
+	}
+	_, err = gen.NewGeneratedFile(dest, "").Write(append(raw, '\n'))
+	return err
+}
+
+// WriteText writes the report as one line per service, followed by its
+// skipped methods, and one line per unmapped type.
+func (r *Report) WriteText(w io.Writer) error {
+	var b strings.Builder
+	for _, s := range r.Services {
+		fmt.Fprintf(&b, "protoc-gen-go-mcp: %s (%s): %d tools\n", s.Service, s.File, len(s.Tools))
+		for _, skipped := range s.Skipped {
+			fmt.Fprintf(&b, "  skipped %s: %s\n", skipped.Method, skipped.Reason)
+		}
+	}
+	for _, t := range r.UnmappedTypes {
+		fmt.Fprintf(&b, "protoc-gen-go-mcp: no dedicated schema for %s\n", t)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// noteUnmappedType reports md when it is a well-known type that has no
+// dedicated schema. google.protobuf.Empty needs none.
+func (g *FileGenerator) noteUnmappedType(md protoreflect.MessageDescriptor) {
+	if md.FullName().Parent() == "google.protobuf" && md.FullName() != "google.protobuf.Empty" {
+		g.report.addUnmappedType(string(md.FullName()))
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// generateReport generates report_test.proto with a Report and returns the
+// plugin and the report.
+func generateReport(t *testing.T) (*protogen.Plugin, *Report) {
+	t.Helper()
+	report := &Report{}
+	plugin, _ := runPlugin(t, codeGeneratorRequest(testdata.File_testdata_report_test_proto), GenerateConfig{Report: report})
+	return plugin, report
+}
+
+func TestReportListsSkippedStreamingMethods(t *testing.T) {
+	g := NewWithT(t)
+
+	_, report := generateReport(t)
+	g.Expect(report.Services).To(HaveLen(1))
+	svc := report.Services[0]
+	g.Expect(svc.Service).To(Equal("testdata.ReportService"))
+	g.Expect(svc.File).To(Equal("testdata/report_test.proto"))
+	g.Expect(svc.Tools).To(Equal([]string{"testdata_ReportService_Ping"}))
+	g.Expect(svc.Skipped).To(ConsistOf(
+		SkippedMethod{Method: "testdata.ReportService.Watch", Reason: "server streaming"},
+		SkippedMethod{Method: "testdata.ReportService.Upload", Reason: "client streaming"},
+		SkippedMethod{Method: "testdata.ReportService.Chat", Reason: "bidirectional streaming"},
+	))
+	g.Expect(report.UnmappedTypes).To(Equal([]string{"google.protobuf.SourceContext"}))
+}
+
+func TestReportWrite(t *testing.T) {
+	t.Run("json file", func(t *testing.T) {
+		g := NewWithT(t)
+		plugin, report := generateReport(t)
+		g.Expect(report.Write(plugin, "mcp-report.json", nil)).To(Succeed())
+
+		var content string
+		for _, f := range plugin.Response().File {
+			if f.GetName() == "mcp-report.json" {
+				content = f.GetContent()
+			}
+		}
+		var decoded Report
+		g.Expect(json.Unmarshal([]byte(content), &decoded)).To(Succeed())
+		g.Expect(decoded.Services[0].Skipped).To(ContainElement(SkippedMethod{Method: "testdata.ReportService.Watch", Reason: "server streaming"}))
+		g.Expect(decoded.UnmappedTypes).To(ContainElement("google.protobuf.SourceContext"))
+	})
+
+	t.Run("stderr", func(t *testing.T) {
+		g := NewWithT(t)
+		plugin, report := generateReport(t)
+		var stderr strings.Builder
+		g.Expect(report.Write(plugin, ReportStderr, &stderr)).To(Succeed())
+		g.Expect(stderr.String()).To(ContainSubstring("testdata.ReportService (testdata/report_test.proto): 1 tools\n"))
+		g.Expect(stderr.String()).To(ContainSubstring("  skipped testdata.ReportService.Watch: server streaming\n"))
+		g.Expect(stderr.String()).To(ContainSubstring("no dedicated schema for google.protobuf.SourceContext"))
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// newInterceptorTestServer returns a server whose GetItem backend records the
+// request it receives in *received.
+func newInterceptorTestServer(received **testdata.GetItemRequest, opts ...runtime.Option) *mcpserver.MCPServer {
+	mock := &testdatamcp.MockTestServiceHandler{
+		GetItemFunc: func(_ context.Context, req *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+			*received = req
+			return &testdata.GetItemResponse{Item: &testdata.Item{Id: req.GetId()}}, nil
+		},
+	}
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, mock, opts...)
+	return s
+}
+
+func TestRequestInterceptorAmendsRequest(t *testing.T) {
+	g := NewWithT(t)
+
+	var method string
+	var received *testdata.GetItemRequest
+	s := newInterceptorTestServer(&received, runtime.WithRequestInterceptor(func(_ context.Context, m string, req proto.Message) error {
+		method = m
+		r := req.(*testdata.GetItemRequest)
+		r.Id = "tenant-7/" + r.GetId()
+		return nil
+	}))
+
+	text := resultText(g, callGetItem(t, s, map[string]any{"id": "item-1"}))
+	g.Expect(method).To(Equal("testdata.TestService.GetItem"))
+	g.Expect(received).ToNot(BeNil())
+	g.Expect(received.GetId()).To(Equal("tenant-7/item-1"))
+	g.Expect(text).To(ContainSubstring("tenant-7/item-1"))
+}
+
+func TestRequestInterceptorErrorSkipsBackend(t *testing.T) {
+	g := NewWithT(t)
+
+	var received *testdata.GetItemRequest
+	s := newInterceptorTestServer(&received, runtime.WithRequestInterceptor(func(context.Context, string, proto.Message) error {
+		return status.Error(codes.PermissionDenied, "item-1 is outside the caller's tenant")
+	}))
+
+	resp := callGetItem(t, s, map[string]any{"id": "item-1"})
+	g.Expect(resp).To(HaveKey("result"), "unexpected response: %v", resp)
+	result := resp["result"].(map[string]any)
+	g.Expect(result["isError"]).To(BeTrue())
+	g.Expect(result["content"].([]any)[0].(map[string]any)["text"]).To(ContainSubstring("outside the caller's tenant"))
+	g.Expect(received).To(BeNil())
+}
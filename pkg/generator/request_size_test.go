@@ -0,0 +1,48 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestMaxRequestBytes(t *testing.T) {
+	g := NewWithT(t)
+
+	s := newTransformerTestServer(runtime.WithMaxRequestBytes(1024))
+
+	text := resultText(g, callGetItem(t, s, map[string]any{"id": "item-1"}))
+	g.Expect(text).To(ContainSubstring("widget"))
+
+	resp := callGetItem(t, s, map[string]any{"id": strings.Repeat("x", 2048)})
+	result := resp["result"].(map[string]any)
+	g.Expect(result["isError"]).To(BeTrue())
+	g.Expect(result["content"].([]any)[0].(map[string]any)["text"]).To(ContainSubstring("over the limit of 1024 bytes"))
+
+	// The Parse helper applies the same limit.
+	_, err := testdatamcp.ParseTestServiceGetItemArgs(map[string]any{"id": strings.Repeat("x", 2048)}, runtime.WithMaxRequestBytes(1024))
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	req, err := testdatamcp.ParseTestServiceGetItemArgs(map[string]any{"id": "item-1"}, runtime.WithMaxRequestBytes(1024))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(req.GetId()).To(Equal("item-1"))
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/catalog"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestResponseFieldAllowlist(t *testing.T) {
+	g := NewWithT(t)
+
+	sku := &catalog.Sku{Id: "A-1", Title: "Widget"}
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToCatalogProxyServiceClient(s, &testdatamcp.MockCatalogProxyServiceHandler{
+		LookupSkuFunc: func(context.Context, *catalog.LookupSkuRequest) (*catalog.LookupSkuResponse, error) {
+			return &catalog.LookupSkuResponse{Sku: sku}, nil
+		},
+		DescribeSkuFunc: func(context.Context, *testdata.DescribeSkuRequest) (*catalog.Sku, error) {
+			return sku, nil
+		},
+	}, runtime.WithResponseFieldAllowlist(map[string][]string{
+		testdatamcp.CatalogProxyService_LookupSkuFullMethod: {"sku.id"},
+	}))
+
+	resp := callTool(t, s, testdatamcp.CatalogProxyService_LookupSkuToolName, map[string]any{"sku": "A-1"})
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"sku":{"id":"A-1"}}`))
+
+	// Methods without an allowlist return every field.
+	resp = callTool(t, s, testdatamcp.CatalogProxyService_DescribeSkuToolName, map[string]any{})
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"id":"A-1","title":"Widget"}`))
+}
+
+func TestResponseFieldAllowlistSummary(t *testing.T) {
+	for name, tc := range map[string]struct {
+		fields []string
+		texts  []string
+	}{
+		"kept":    {fields: []string{"headline", "total"}, texts: []string{"Markets", `{"headline":"Markets","total":2}`}},
+		"dropped": {fields: []string{"total"}, texts: []string{`{"total":2}`}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s := mcpserver.NewMCPServer("test-server", "1.0.0")
+			testdatamcp.ForwardToDigestServiceClient(s, &testdatamcp.MockDigestServiceHandler{
+				BuildDigestFunc: func(context.Context, *testdata.BuildDigestRequest) (*testdata.BuildDigestResponse, error) {
+					return &testdata.BuildDigestResponse{Headline: "Markets", Entries: []string{"up"}, Total: 2}, nil
+				},
+			}, runtime.WithResponseFieldAllowlist(map[string][]string{
+				testdatamcp.DigestService_BuildDigestFullMethod: tc.fields,
+			}))
+
+			texts := resultTexts(g, callTool(t, s, testdatamcp.DigestService_BuildDigestToolName, map[string]any{"topic": "stocks"}))
+			g.Expect(texts).To(HaveLen(len(tc.texts)))
+			g.Expect(texts[len(texts)-1]).To(MatchJSON(tc.texts[len(tc.texts)-1]))
+			if len(tc.texts) == 2 {
+				g.Expect(texts[0]).To(Equal(tc.texts[0]))
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// testServiceClient adapts testServer to the generated TestServiceClient.
+type testServiceClient struct {
+	server *testServer
+}
+
+func (c testServiceClient) CreateItem(ctx context.Context, in *testdata.CreateItemRequest, _ ...grpc.CallOption) (*testdata.CreateItemResponse, error) {
+	return c.server.CreateItem(ctx, in)
+}
+
+func (c testServiceClient) GetItem(ctx context.Context, in *testdata.GetItemRequest, _ ...grpc.CallOption) (*testdata.GetItemResponse, error) {
+	return c.server.GetItem(ctx, in)
+}
+
+func (c testServiceClient) ProcessWellKnownTypes(ctx context.Context, in *testdata.ProcessWellKnownTypesRequest, _ ...grpc.CallOption) (*testdata.ProcessWellKnownTypesResponse, error) {
+	return c.server.ProcessWellKnownTypes(ctx, in)
+}
+
+// callTool invokes a tool through the MCP server and returns the JSON-RPC
+// response.
+func callTool(t *testing.T, s *mcpserver.MCPServer, name string, args map[string]any) map[string]any {
+	t.Helper()
+	return callToolWithContext(t, context.Background(), s, name, args)
+}
+
+// callToolWithContext is callTool with a caller-provided context.
+func callToolWithContext(t *testing.T, ctx context.Context, s *mcpserver.MCPServer, name string, args map[string]any) map[string]any {
+	t.Helper()
+	msg, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "tools/call",
+		"params":  map[string]any{"name": name, "arguments": args},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := json.Marshal(s.HandleMessage(ctx, msg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp map[string]any
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+// callGetItem invokes the generated GetItem tool.
+func callGetItem(t *testing.T, s *mcpserver.MCPServer, args map[string]any) map[string]any {
+	t.Helper()
+	return callTool(t, s, testdatamcp.TestService_GetItemTool.Name, args)
+}
+
+// resultText returns the text of the first content item of a tools/call result.
+func resultText(g *WithT, resp map[string]any) string {
+	g.Expect(resp).To(HaveKey("result"), "unexpected response: %v", resp)
+	content := resp["result"].(map[string]any)["content"].([]any)
+	g.Expect(content).To(HaveLen(1))
+	return content[0].(map[string]any)["text"].(string)
+}
+
+func newFormatTestServer(opts ...runtime.Option) *mcpserver.MCPServer {
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, testServiceClient{server: &testServer{}}, opts...)
+	return s
+}
+
+func TestPerCallFormatOverride(t *testing.T) {
+	t.Run("toon overrides json server default", func(t *testing.T) {
+		g := NewWithT(t)
+		s := newFormatTestServer()
+
+		text := resultText(g, callGetItem(t, s, map[string]any{"id": "item-1"}))
+		g.Expect(json.Valid([]byte(text))).To(BeTrue(), "default is JSON: %s", text)
+
+		text = resultText(g, callGetItem(t, s, map[string]any{"id": "item-1", runtime.FormatArgument: runtime.FormatToon}))
+		g.Expect(json.Valid([]byte(text))).To(BeFalse(), "expected TOON: %s", text)
+		g.Expect(text).To(ContainSubstring("item-1"))
+	})
+
+	t.Run("json overrides toon server default", func(t *testing.T) {
+		g := NewWithT(t)
+		s := newFormatTestServer(runtime.WithToonCompression(true))
+
+		text := resultText(g, callGetItem(t, s, map[string]any{"id": "item-1"}))
+		g.Expect(json.Valid([]byte(text))).To(BeFalse(), "default is TOON: %s", text)
+
+		text = resultText(g, callGetItem(t, s, map[string]any{"id": "item-1", runtime.FormatArgument: runtime.FormatJSON}))
+		var decoded map[string]any
+		g.Expect(json.Unmarshal([]byte(text), &decoded)).To(Succeed())
+		g.Expect(decoded).To(HaveKey("item"))
+	})
+
+	t.Run("unknown format is rejected", func(t *testing.T) {
+		g := NewWithT(t)
+		s := newFormatTestServer()
+
+		// As a tool error, like other invalid arguments.
+		resp := callGetItem(t, s, map[string]any{"id": "item-1", runtime.FormatArgument: "xml"})
+		g.Expect(resp).ToNot(HaveKey("error"))
+		g.Expect(resp["result"]).To(HaveKeyWithValue("isError", true))
+		g.Expect(resultText(g, resp)).To(MatchJSON(`{"code":"INVALID_ARGUMENT","message":"unknown __format \"xml\": must be \"json\" or \"toon\""}`))
+	})
+}
+
+func TestFormatArgumentInSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	schemas := listToolSchemas(t, newFormatTestServer())
+	g.Expect(string(schemas[testdatamcp.TestService_GetItemToolName])).To(ContainSubstring(`"__format":{"description":"Format of the result of this call, overriding the server default.","enum":["json","toon"],"type":"string"}`))
+
+	// Stream messages are always JSON, so stream tools do not offer it.
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToQuoteServiceClient(s, &testdatamcp.MockQuoteServiceHandler{})
+	schemas = listToolSchemas(t, s)
+	g.Expect(string(schemas[testdatamcp.QuoteService_GetQuoteToolName])).To(ContainSubstring(runtime.FormatArgument))
+	g.Expect(string(schemas[testdatamcp.QuoteService_WatchQuotesToolName])).ToNot(ContainSubstring(runtime.FormatArgument))
+}
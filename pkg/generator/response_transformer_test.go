@@ -0,0 +1,85 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func newTransformerTestServer(opts ...runtime.Option) *mcpserver.MCPServer {
+	mock := &testdatamcp.MockTestServiceHandler{
+		GetItemFunc: func(_ context.Context, req *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+			return &testdata.GetItemResponse{Item: &testdata.Item{
+				Id:          req.GetId(),
+				Name:        "widget",
+				Description: "owner: jane@example.com",
+				CreatedAt:   timestamppb.Now(),
+			}}, nil
+		},
+	}
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, mock, opts...)
+	return s
+}
+
+func TestResponseTransformerRedacts(t *testing.T) {
+	g := NewWithT(t)
+
+	var seen proto.Message
+	s := newTransformerTestServer(runtime.WithResponseTransformer(func(_ context.Context, resp proto.Message) (proto.Message, error) {
+		seen = resp
+		redacted := proto.Clone(resp).(*testdata.GetItemResponse)
+		redacted.GetItem().CreatedAt = nil
+		redacted.GetItem().Description = ""
+		return redacted, nil
+	}))
+
+	text := resultText(g, callGetItem(t, s, map[string]any{"id": "item-1"}))
+	g.Expect(text).To(ContainSubstring("widget"))
+	g.Expect(text).ToNot(ContainSubstring("created_at"))
+	g.Expect(text).ToNot(ContainSubstring("jane@example.com"))
+	g.Expect(seen.(*testdata.GetItemResponse).GetItem().GetDescription()).To(Equal("owner: jane@example.com"), "the transformer sees the original response")
+
+	// Without a transformer the fields are present.
+	text = resultText(g, callGetItem(t, newTransformerTestServer(), map[string]any{"id": "item-1"}))
+	g.Expect(text).To(ContainSubstring("created_at"))
+	g.Expect(text).To(ContainSubstring("jane@example.com"))
+}
+
+func TestResponseTransformerErrorFailsCall(t *testing.T) {
+	g := NewWithT(t)
+
+	s := newTransformerTestServer(runtime.WithResponseTransformer(func(context.Context, proto.Message) (proto.Message, error) {
+		return nil, status.Error(codes.PermissionDenied, "response contains restricted data")
+	}))
+
+	resp := callGetItem(t, s, map[string]any{"id": "item-1"})
+	g.Expect(resp).To(HaveKey("result"), "unexpected response: %v", resp)
+	result := resp["result"].(map[string]any)
+	g.Expect(result["isError"]).To(BeTrue())
+	g.Expect(result["content"].([]any)[0].(map[string]any)["text"]).To(ContainSubstring("response contains restricted data"))
+}
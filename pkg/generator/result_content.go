@@ -0,0 +1,64 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"text/template"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+// Media types of (mcp.options.tool) result_content_type.
+const (
+	contentTypePlain    = "text/plain"
+	contentTypeMarkdown = "text/markdown"
+	contentTypeJSON     = "application/json"
+)
+
+// resultContent returns the media type and template of the result of meth
+// from (mcp.options.tool) result_content_type and result_template. A
+// template without a media type makes markdown. Neither applies to a method
+// publishing its stream as a resource.
+func resultContent(meth *protogen.Method, opts *mcpoptions.ToolOptions, streamResource bool) (contentType, text string, err error) {
+	contentType, text = opts.GetResultContentType(), opts.GetResultTemplate()
+	if contentType == "" && text == "" {
+		return "", "", nil
+	}
+	if streamResource {
+		return "", "", fmt.Errorf("mcpgen: %s has (mcp.options.tool) stream_resource and a result_content_type or result_template", meth.Desc.FullName())
+	}
+	switch contentType {
+	case "":
+		contentType = contentTypeMarkdown
+	case contentTypePlain, contentTypeMarkdown:
+	case contentTypeJSON:
+		if text != "" {
+			return "", "", fmt.Errorf("mcpgen: %s has (mcp.options.tool) result_template, whose output is not %s", meth.Desc.FullName(), contentTypeJSON)
+		}
+	default:
+		return "", "", fmt.Errorf("mcpgen: %s has (mcp.options.tool) result_content_type %q, want %q, %q or %q", meth.Desc.FullName(), contentType, contentTypePlain, contentTypeMarkdown, contentTypeJSON)
+	}
+	if text != "" {
+		// Parsed like runtime.ParseResultTemplate, to fail here rather than
+		// at registration.
+		if _, err := template.New(string(meth.Desc.FullName())).Parse(text); err != nil {
+			return "", "", fmt.Errorf("mcpgen: %s has an invalid (mcp.options.tool) result_template: %w", meth.Desc.FullName(), err)
+		}
+	}
+	return contentType, text, nil
+}
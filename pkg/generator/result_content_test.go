@@ -0,0 +1,123 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func newArticleTestServer(opts ...runtime.Option) *mcpserver.MCPServer {
+	get := func(_ context.Context, req *testdata.GetArticleRequest) (*testdata.Article, error) {
+		return &testdata.Article{Title: "Release " + req.GetId(), Body: "Faster builds.", Tags: []string{"go", "mcp"}}, nil
+	}
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToArticleServiceClient(s, &testdatamcp.MockArticleServiceHandler{
+		RenderArticleFunc: get,
+		GetArticleFunc:    get,
+	}, opts...)
+	return s
+}
+
+// resultContentBlock returns the only content block of resp.
+func resultContentBlock(g *WithT, resp map[string]any) map[string]any {
+	g.Expect(resp).To(HaveKey("result"), "unexpected response: %v", resp)
+	content := resp["result"].(map[string]any)["content"].([]any)
+	g.Expect(content).To(HaveLen(1))
+	return content[0].(map[string]any)
+}
+
+func TestResultTemplateMarkdown(t *testing.T) {
+	g := NewWithT(t)
+
+	s := newArticleTestServer()
+	block := resultContentBlock(g, callTool(t, s, testdatamcp.ArticleService_RenderArticleToolName, map[string]any{"id": "1.2"}))
+	g.Expect(block).To(HaveKeyWithValue("_meta", HaveKeyWithValue(runtime.ContentTypeMetaKey, "text/markdown")))
+	g.Expect(block["text"]).To(Equal("# Release 1.2\n\nFaster builds.\n\n- go\n- mcp\n"))
+}
+
+func TestResultTemplateSkipsToon(t *testing.T) {
+	g := NewWithT(t)
+
+	s := newArticleTestServer(runtime.WithToonCompression(true))
+	block := resultContentBlock(g, callTool(t, s, testdatamcp.ArticleService_RenderArticleToolName, map[string]any{"id": "1.2"}))
+	g.Expect(block["text"]).To(HavePrefix("# Release 1.2\n"))
+}
+
+func TestResultContentTypeJSON(t *testing.T) {
+	g := NewWithT(t)
+
+	s := newArticleTestServer()
+	block := resultContentBlock(g, callTool(t, s, testdatamcp.ArticleService_GetArticleToolName, map[string]any{"id": "1.2"}))
+	g.Expect(block).To(HaveKeyWithValue("_meta", HaveKeyWithValue(runtime.ContentTypeMetaKey, "application/json")))
+	g.Expect(block["text"]).To(MatchJSON(`{"title": "Release 1.2", "body": "Faster builds.", "tags": ["go", "mcp"]}`))
+
+	// TOON is not JSON, so it stays unmarked.
+	s = newArticleTestServer(runtime.WithToonCompression(true))
+	block = resultContentBlock(g, callTool(t, s, testdatamcp.ArticleService_GetArticleToolName, map[string]any{"id": "1.2"}))
+	g.Expect(block).ToNot(HaveKey("_meta"))
+}
+
+func TestResultContentInvalid(t *testing.T) {
+	for name, tc := range map[string]struct {
+		opts *mcpoptions.ToolOptions
+		err  string
+	}{
+		"unknown content type": {
+			opts: &mcpoptions.ToolOptions{ResultContentType: "text/html"},
+			err:  `testdata.ArticleService.GetArticle has (mcp.options.tool) result_content_type "text/html", want "text/plain", "text/markdown" or "application/json"`,
+		},
+		"template for json": {
+			opts: &mcpoptions.ToolOptions{ResultContentType: "application/json", ResultTemplate: "{{.title}}"},
+			err:  "testdata.ArticleService.GetArticle has (mcp.options.tool) result_template, whose output is not application/json",
+		},
+		"unparsable template": {
+			opts: &mcpoptions.ToolOptions{ResultTemplate: "{{.title"},
+			err:  "testdata.ArticleService.GetArticle has an invalid (mcp.options.tool) result_template",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			file := testdata.File_testdata_result_content_test_proto
+			req := codeGeneratorRequest(file)
+			for _, fdp := range req.ProtoFile {
+				if fdp.GetName() != file.Path() {
+					continue
+				}
+				for _, method := range fdp.Service[0].Method {
+					if method.GetName() != "GetArticle" {
+						continue
+					}
+					opts := &descriptorpb.MethodOptions{}
+					proto.SetExtension(opts, mcpoptions.E_Tool, tc.opts)
+					method.Options = opts
+				}
+			}
+			plugin, _ := runPlugin(t, req, GenerateConfig{})
+			g.Expect(plugin.Response().GetError()).To(ContainSubstring(tc.err))
+		})
+	}
+}
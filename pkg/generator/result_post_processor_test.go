@@ -0,0 +1,65 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// envelope wraps every result in {"data": ..., "meta": {"method": ...}}.
+func envelope(methodName string, result any) any {
+	return map[string]any{"data": result, "meta": map[string]any{"method": methodName}}
+}
+
+func TestToolResultPostProcessor(t *testing.T) {
+	s := newFormatTestServer(runtime.WithToolResultPostProcessor(envelope))
+
+	for _, tc := range []struct {
+		tool   string
+		method string
+		args   map[string]any
+	}{
+		{testdatamcp.TestService_CreateItemTool.Name, testdatamcp.TestService_CreateItemFullMethod, map[string]any{"name": "x"}},
+		{testdatamcp.TestService_GetItemTool.Name, testdatamcp.TestService_GetItemFullMethod, map[string]any{"id": "item-1"}},
+		{testdatamcp.TestService_ProcessWellKnownTypesTool.Name, testdatamcp.TestService_ProcessWellKnownTypesFullMethod, map[string]any{}},
+	} {
+		t.Run(tc.tool, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var result map[string]any
+			g.Expect(json.Unmarshal([]byte(resultText(g, callTool(t, s, tc.tool, tc.args))), &result)).To(Succeed())
+			g.Expect(result).To(HaveLen(2))
+			g.Expect(result).To(HaveKeyWithValue("data", BeAssignableToTypeOf(map[string]any{})))
+			g.Expect(result).To(HaveKeyWithValue("meta", map[string]any{"method": tc.method}))
+		})
+	}
+}
+
+func TestToolResultPostProcessorBeforeToon(t *testing.T) {
+	g := NewWithT(t)
+
+	s := newFormatTestServer(runtime.WithToonCompression(true), runtime.WithToolResultPostProcessor(envelope))
+	text := resultText(g, callGetItem(t, s, map[string]any{"id": "item-1"}))
+	g.Expect(json.Valid([]byte(text))).To(BeFalse(), "expected TOON: %s", text)
+	g.Expect(text).To(ContainSubstring("data"))
+	g.Expect(text).To(ContainSubstring(testdatamcp.TestService_GetItemFullMethod))
+	g.Expect(text).To(ContainSubstring("item-1"))
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// schemaFilePath returns where schema_out writes the schemas of meth:
+// <schemaOut>/<proto package path>/<Service>/<Method>.json.
+func (g *FileGenerator) schemaFilePath(meth *protogen.Method) string {
+	svc := meth.Parent.Desc
+	pkg := strings.ReplaceAll(string(svc.ParentFile().Package()), ".", "/")
+	return path.Join(g.schemaOut, pkg, string(svc.Name()), string(meth.Desc.Name())+".json")
+}
+
+// writeSchemaFile writes the tool definition of meth, with its input schema
+// and the schema of its response, as a standalone JSON file. Keys are sorted
+// and the output is indented so the files diff cleanly between runs.
+func (g *FileGenerator) writeSchemaFile(meth *protogen.Method, tool SimpleTool, inputSchema map[string]any) error {
+	inputSchema, err := g.withRefBase(inputSchema, g.schemaID(meth, ""))
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON schema file for %s: %w", meth.Desc.FullName(), err)
+	}
+	outputSchema, err := g.withRefBase(g.messageSchemaWithDefs(meth.Output.Desc, meth.Output, directionOutput), g.schemaID(meth, "output"))
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON schema file for %s: %w", meth.Desc.FullName(), err)
+	}
+	doc := map[string]any{
+		"name":         tool.Name,
+		"method":       string(meth.Desc.FullName()),
+		"inputSchema":  inputSchema,
+		"outputSchema": outputSchema,
+	}
+	if tool.Title != "" {
+		doc["title"] = tool.Title
+	}
+	if tool.Description != "" {
+		doc["description"] = tool.Description
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to marshal JSON schema file for %s: %w", meth.Desc.FullName(), err)
+	}
+
+	f := g.gen.NewGeneratedFile(g.schemaFilePath(meth), "")
+	_, err = f.Write(buf.Bytes())
+	return err
+}
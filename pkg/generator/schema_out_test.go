@@ -0,0 +1,99 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// generateSchemaFiles runs the generator over req with the given schema_out
+// and returns every generated file by name.
+func generateSchemaFiles(t *testing.T, req *pluginpb.CodeGeneratorRequest, schemaOut string) map[string]string {
+	t.Helper()
+	return generatedFiles(t, req, GenerateConfig{PackageSuffix: "mcp", SchemaOut: schemaOut})
+}
+
+func schemaOutRequest() *pluginpb.CodeGeneratorRequest {
+	return codeGeneratorRequest((&testdata.Account{}).ProtoReflect().Descriptor().ParentFile())
+}
+
+func TestSchemaOut(t *testing.T) {
+	g := NewWithT(t)
+
+	files := generateSchemaFiles(t, schemaOutRequest(), "schemas")
+	g.Expect(files).To(HaveLen(2), "the Go file and one JSON file per RPC")
+	content, ok := files["schemas/testdata/FieldBehaviorService/UpsertAccount.json"]
+	g.Expect(ok).To(BeTrue(), "got files %v", files)
+
+	var doc struct {
+		Name         string         `json:"name"`
+		Method       string         `json:"method"`
+		InputSchema  map[string]any `json:"inputSchema"`
+		OutputSchema map[string]any `json:"outputSchema"`
+	}
+	g.Expect(json.Unmarshal([]byte(content), &doc)).To(Succeed())
+	g.Expect(doc.Name).To(Equal("testdata_FieldBehaviorService_UpsertAccount"))
+	g.Expect(doc.Method).To(Equal("testdata.FieldBehaviorService.UpsertAccount"))
+
+	// Same message, filtered by direction.
+	g.Expect(doc.InputSchema["properties"]).To(HaveKey("password"))
+	g.Expect(doc.InputSchema["properties"]).ToNot(HaveKey("uid"))
+	g.Expect(doc.OutputSchema["properties"]).To(HaveKey("uid"))
+	g.Expect(doc.OutputSchema["properties"]).ToNot(HaveKey("password"))
+
+	// A second run produces byte-identical files.
+	again := generateSchemaFiles(t, schemaOutRequest(), "schemas")
+	g.Expect(again["schemas/testdata/FieldBehaviorService/UpsertAccount.json"]).To(Equal(content))
+}
+
+// TestSchemaOutToolDocument checks that the file of a tool is a complete
+// document of it, for docs and client codegen: its name, description and
+// both schemas, with sorted keys.
+func TestSchemaOutToolDocument(t *testing.T) {
+	g := NewWithT(t)
+
+	file := testdata.File_testdata_test_service_proto
+	content := generateSchemaFiles(t, methodCommentRequest(file, "TestService", "GetItem", " GetItem retrieves an item by ID\n"), "schemas")["schemas/testdata/TestService/GetItem.json"]
+
+	var doc map[string]any
+	g.Expect(json.Unmarshal([]byte(content), &doc)).To(Succeed())
+	g.Expect(doc).To(HaveKeyWithValue("name", "testdata_TestService_GetItem"))
+	g.Expect(doc).To(HaveKeyWithValue("description", "GetItem retrieves an item by ID\n"))
+	g.Expect(doc["inputSchema"]).To(HaveKeyWithValue("properties", HaveKey("id")))
+	g.Expect(doc["outputSchema"]).To(HaveKeyWithValue("properties", HaveKey("item")))
+
+	keys := regexp.MustCompile(`(?m)^  "(\w+)":`).FindAllStringSubmatch(content, -1)
+	var order []string
+	for _, k := range keys {
+		order = append(order, k[1])
+	}
+	g.Expect(order).To(Equal([]string{"description", "inputSchema", "method", "name", "outputSchema"}))
+}
+
+func TestSchemaOutDisabledByDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	files := generateSchemaFiles(t, schemaOutRequest(), "")
+	for name := range files {
+		g.Expect(name).To(HaveSuffix(GeneratedFilenameExtension))
+	}
+}
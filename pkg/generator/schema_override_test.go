@@ -0,0 +1,117 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// listToolSchemas returns the input schemas reported by tools/list, keyed by
+// tool name.
+func listToolSchemas(t *testing.T, s *mcpserver.MCPServer) map[string]json.RawMessage {
+	t.Helper()
+	msg := json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`)
+	raw, err := json.Marshal(s.HandleMessage(context.Background(), msg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp struct {
+		Result struct {
+			Tools []struct {
+				Name        string          `json:"name"`
+				InputSchema json.RawMessage `json:"inputSchema"`
+			} `json:"tools"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		t.Fatal(err)
+	}
+	schemas := make(map[string]json.RawMessage, len(resp.Result.Tools))
+	for _, tool := range resp.Result.Tools {
+		schemas[tool.Name] = tool.InputSchema
+	}
+	return schemas
+}
+
+// withoutFormatArgument returns schema without the runtime.FormatArgument
+// property, which registration adds to every tool.
+func withoutFormatArgument(t *testing.T, schema json.RawMessage) json.RawMessage {
+	t.Helper()
+	var decoded map[string]any
+	if err := json.Unmarshal(schema, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	delete(decoded["properties"].(map[string]any), runtime.FormatArgument)
+	raw, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+const createItemOverride = `{
+  "type": "object",
+  "properties": {
+    "name": {"type": "string", "description": "Display name, as shown in the catalog."},
+    "labels": {"type": "object", "additionalProperties": {"type": "string"}}
+  },
+  "required": ["name"]
+}`
+
+func TestToolSchemaOverride(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, testServiceClient{server: &testServer{}},
+		runtime.WithToolSchemaOverride(map[string]json.RawMessage{
+			"testdata.TestService.CreateItem": json.RawMessage(createItemOverride),
+		}),
+		runtime.WithStartupValidation(true),
+	)
+
+	schemas := listToolSchemas(t, s)
+	g.Expect(withoutFormatArgument(t, schemas[testdatamcp.TestService_CreateItemTool.Name])).To(MatchJSON(createItemOverride))
+	g.Expect(withoutFormatArgument(t, schemas[testdatamcp.TestService_GetItemTool.Name])).To(MatchJSON(testdatamcp.TestService_GetItemTool.JSONSchema), "other tools keep the generated schema")
+
+	// labels is normalized from a JSON string as the override declares it an
+	// object.
+	resp := callTool(t, s, testdatamcp.TestService_CreateItemTool.Name, map[string]any{
+		"name":   "widget",
+		"labels": `{"color":"red"}`,
+	})
+	g.Expect(resp["result"]).ToNot(HaveKeyWithValue("isError", true), "unexpected response: %v", resp)
+}
+
+func TestToolSchemaOverrideInvalidPanics(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	g.Expect(func() {
+		testdatamcp.ForwardToTestServiceClient(s, testServiceClient{server: &testServer{}},
+			runtime.WithToolSchemaOverride(map[string]json.RawMessage{
+				"testdata.TestService.CreateItem": json.RawMessage(`{"type": 42}`),
+			}),
+			runtime.WithStartupValidation(true),
+		)
+	}).To(PanicWith(MatchError(ContainSubstring(testdatamcp.TestService_CreateItemTool.Name))))
+}
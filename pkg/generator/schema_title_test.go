@@ -0,0 +1,97 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// methodCommentRequest returns the code generator request for file, with
+// comment as the leading comment of method of service, which the compiled
+// descriptors of file do not keep.
+func methodCommentRequest(file protoreflect.FileDescriptor, service, method, comment string) *pluginpb.CodeGeneratorRequest {
+	req := codeGeneratorRequest(file)
+	for _, fdp := range req.ProtoFile {
+		if fdp.GetName() != file.Path() {
+			continue
+		}
+		sd := file.Services().ByName(protoreflect.Name(service))
+		md := sd.Methods().ByName(protoreflect.Name(method))
+		fdp.SourceCodeInfo = &descriptorpb.SourceCodeInfo{Location: []*descriptorpb.SourceCodeInfo_Location{{
+			// FileDescriptorProto.service, ServiceDescriptorProto.method
+			Path:            []int32{6, int32(sd.Index()), 2, int32(md.Index())},
+			Span:            []int32{0, 0, 0},
+			LeadingComments: proto.String(comment),
+		}}}
+	}
+	return req
+}
+
+// schemaTitleInputSchema returns the input schema that schema_out writes for
+// method of service in file, generated with cfg. The method gets comment as
+// its leading comment.
+func schemaTitleInputSchema(t *testing.T, file protoreflect.FileDescriptor, cfg GenerateConfig, service, method, comment string) map[string]any {
+	t.Helper()
+	g := NewWithT(t)
+
+	cfg.PackageSuffix = "mcp"
+	cfg.SchemaOut = "schemas"
+	content, ok := generatedFiles(t, methodCommentRequest(file, service, method, comment), cfg)["schemas/testdata/"+service+"/"+method+".json"]
+	if !ok {
+		t.Fatalf("no schema file for %s.%s", service, method)
+	}
+	var doc struct {
+		InputSchema map[string]any `json:"inputSchema"`
+	}
+	g.Expect(json.Unmarshal([]byte(content), &doc)).To(Succeed())
+	return doc.InputSchema
+}
+
+func TestSchemaTitle(t *testing.T) {
+	g := NewWithT(t)
+
+	cfg := GenerateConfig{SchemaTitle: true, DescriptionPrefix: "[{service}] "}
+	schema := schemaTitleInputSchema(t, testdata.File_testdata_test_service_proto, cfg, "TestService", "GetItem", " GetItem retrieves an item by ID\n")
+	g.Expect(schema).To(HaveKeyWithValue("title", "GetItem"))
+	// The method comment, without the prefix of the tool description.
+	g.Expect(schema).To(HaveKeyWithValue("description", "GetItem retrieves an item by ID"))
+
+	// The description of the tool annotation wins over the comment.
+	schema = schemaTitleInputSchema(t, testdata.File_testdata_tool_annotation_test_proto, cfg, "AnnotatedService", "ListWidgets", " Lists widgets.\n")
+	g.Expect(schema).To(HaveKeyWithValue("title", "ListWidgets"))
+	g.Expect(schema).To(HaveKeyWithValue("description", "Lists every widget visible to the caller. Use get_widget to fetch one widget's details."))
+
+	// Deprecated methods have no marker in the description.
+	schema = schemaTitleInputSchema(t, testdata.File_testdata_deprecated_test_proto, cfg, "InvoiceService", "GetInvoiceV1", " GetInvoiceV1 returns an invoice by number. Use GetInvoice instead.\n")
+	g.Expect(schema).To(HaveKeyWithValue("description", "GetInvoiceV1 returns an invoice by number. Use GetInvoice instead."))
+	g.Expect(schema).To(HaveKeyWithValue("deprecated", true))
+}
+
+func TestSchemaTitleDisabled(t *testing.T) {
+	g := NewWithT(t)
+
+	schema := schemaTitleInputSchema(t, testdata.File_testdata_test_service_proto, GenerateConfig{}, "TestService", "GetItem", " GetItem retrieves an item by ID\n")
+	g.Expect(schema).ToNot(HaveKey("title"))
+	g.Expect(schema).ToNot(HaveKey("description"))
+}
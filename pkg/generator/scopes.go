@@ -0,0 +1,38 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+// toolScopes returns the (mcp.options.tool) scopes of meth, trimmed. An empty
+// scope is an error.
+func toolScopes(meth *protogen.Method, opts *mcpoptions.ToolOptions) ([]string, error) {
+	var scopes []string
+	for _, scope := range opts.GetScopes() {
+		scope = strings.TrimSpace(scope)
+		if scope == "" {
+			return nil, fmt.Errorf("mcpgen: %s has an empty (mcp.options.tool) scope", meth.Desc.FullName())
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
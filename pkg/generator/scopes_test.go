@@ -0,0 +1,97 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func newLedgerTestServer(posted *int, opts ...runtime.Option) *mcpserver.MCPServer {
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToLedgerServiceClient(s, &testdatamcp.MockLedgerServiceHandler{
+		PostEntryFunc: func(context.Context, *testdata.PostEntryRequest) (*testdata.PostEntryResponse, error) {
+			*posted++
+			return &testdata.PostEntryResponse{EntryId: "entry-1"}, nil
+		},
+		ListEntriesFunc: func(context.Context, *testdata.ListEntriesRequest) (*testdata.ListEntriesResponse, error) {
+			return &testdata.ListEntriesResponse{EntryIds: []string{"entry-1"}}, nil
+		},
+	}, opts...)
+	return s
+}
+
+func TestScopeCheckerDenies(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(testdatamcp.LedgerService_PostEntryTool.Scopes).To(Equal([]string{"ledger:write", "ledger:read"}))
+	g.Expect(testdatamcp.LedgerService_ListEntriesTool.Scopes).To(BeEmpty())
+
+	var checked [][]string
+	var posted int
+	s := newLedgerTestServer(&posted, runtime.WithScopeChecker(func(_ context.Context, scopes []string) error {
+		checked = append(checked, scopes)
+		if slices.Contains(scopes, "ledger:write") {
+			return errors.New("caller lacks ledger:write")
+		}
+		return nil
+	}))
+
+	resp := callTool(t, s, testdatamcp.LedgerService_PostEntryTool.Name, map[string]any{"account": "acme", "amount_cents": 100})
+	g.Expect(resp["result"]).To(HaveKeyWithValue("isError", true))
+	text := resultText(g, resp)
+	g.Expect(text).To(ContainSubstring("PERMISSION_DENIED"))
+	g.Expect(text).To(ContainSubstring("caller lacks ledger:write"))
+	g.Expect(posted).To(BeZero())
+	g.Expect(checked).To(Equal([][]string{{"ledger:write", "ledger:read"}}))
+
+	// Tools without scopes do not consult the checker.
+	resp = callTool(t, s, testdatamcp.LedgerService_ListEntriesTool.Name, map[string]any{"account": "acme"})
+	g.Expect(resultText(g, resp)).To(ContainSubstring("entry-1"))
+	g.Expect(checked).To(HaveLen(1))
+}
+
+func TestScopeCheckerAllows(t *testing.T) {
+	g := NewWithT(t)
+
+	var posted int
+	s := newLedgerTestServer(&posted, runtime.WithScopeChecker(func(context.Context, []string) error {
+		return nil
+	}))
+	resp := callTool(t, s, testdatamcp.LedgerService_PostEntryTool.Name, map[string]any{"account": "acme", "amount_cents": 100})
+	g.Expect(resultText(g, resp)).To(ContainSubstring("entry-1"))
+	g.Expect(posted).To(Equal(1))
+}
+
+func TestToolScopesInvalid(t *testing.T) {
+	g := NewWithT(t)
+
+	methods := buildMethod(t, map[string]*mcpoptions.ToolOptions{
+		"PostEntry": {Scopes: []string{"ledger:write", " "}},
+	})
+	meth := methodNamed(methods, "PostEntry")
+	_, err := toolScopes(meth, methodToolOptions(meth))
+	g.Expect(err).To(MatchError(ContainSubstring("has an empty (mcp.options.tool) scope")))
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+type tenantKey struct{}
+
+// tenantBackend is a TestService backend that names the items it returns
+// after its tenant.
+func tenantBackend(tenant string) *testdatamcp.MockTestServiceHandler {
+	return &testdatamcp.MockTestServiceHandler{
+		GetItemFunc: func(_ context.Context, req *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+			return &testdata.GetItemResponse{Item: &testdata.Item{Id: req.GetId(), Name: tenant}}, nil
+		},
+	}
+}
+
+func TestSessionScopedClient(t *testing.T) {
+	g := NewWithT(t)
+
+	backends := map[string]testdatamcp.TestServiceClient{
+		"acme":   tenantBackend("acme"),
+		"globex": tenantBackend("globex"),
+	}
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, tenantBackend("default"), runtime.WithSessionScopedClient(
+		func(ctx context.Context) (testdatamcp.TestServiceClient, error) {
+			tenant, _ := ctx.Value(tenantKey{}).(string)
+			client, ok := backends[tenant]
+			if !ok {
+				return nil, errors.New("unknown tenant " + tenant)
+			}
+			return client, nil
+		},
+	))
+
+	args := map[string]any{"id": "item-1"}
+	for _, tenant := range []string{"acme", "globex"} {
+		ctx := context.WithValue(context.Background(), tenantKey{}, tenant)
+		resp := callToolWithContext(t, ctx, s, testdatamcp.TestService_GetItemTool.Name, args)
+		g.Expect(resultText(g, resp)).To(ContainSubstring(`"name":"` + tenant + `"`))
+	}
+
+	// A resolver error is a tool error, and the fixed client is not used.
+	ctx := context.WithValue(context.Background(), tenantKey{}, "initech")
+	resp := callToolWithContext(t, ctx, s, testdatamcp.TestService_GetItemTool.Name, args)
+	g.Expect(resp["result"]).To(HaveKeyWithValue("isError", true))
+	g.Expect(resultText(g, resp)).To(ContainSubstring("unknown tenant initech"))
+}
+
+func TestSessionScopedClientWrongType(t *testing.T) {
+	g := NewWithT(t)
+
+	// A resolver for another service's client fails the registration.
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	g.Expect(func() {
+		testdatamcp.ForwardToTestServiceClient(s, tenantBackend("default"), runtime.WithSessionScopedClient(
+			func(context.Context) (testdatamcp.DigestServiceClient, error) {
+				return &testdatamcp.MockDigestServiceHandler{}, nil
+			},
+		))
+	}).To(PanicWith(MatchError(ContainSubstring("not a testdatamcp.TestServiceClient"))))
+}
+
+func TestMethodClients(t *testing.T) {
+	g := NewWithT(t)
+
+	var hits []string
+	backendA := &testdatamcp.MockTestServiceHandler{
+		GetItemFunc: func(_ context.Context, req *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+			hits = append(hits, "a:GetItem")
+			return &testdata.GetItemResponse{Item: &testdata.Item{Id: req.GetId()}}, nil
+		},
+	}
+	backendB := &testdatamcp.MockTestServiceHandler{
+		CreateItemFunc: func(context.Context, *testdata.CreateItemRequest) (*testdata.CreateItemResponse, error) {
+			hits = append(hits, "b:CreateItem")
+			return &testdata.CreateItemResponse{Id: "item-1"}, nil
+		},
+	}
+	fallback := &testdatamcp.MockTestServiceHandler{
+		ProcessWellKnownTypesFunc: func(context.Context, *testdata.ProcessWellKnownTypesRequest) (*testdata.ProcessWellKnownTypesResponse, error) {
+			hits = append(hits, "default:ProcessWellKnownTypes")
+			return &testdata.ProcessWellKnownTypesResponse{}, nil
+		},
+	}
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, fallback, runtime.WithMethodClients(map[string]testdatamcp.TestServiceClient{
+		testdatamcp.TestService_GetItemFullMethod:    backendA,
+		testdatamcp.TestService_CreateItemFullMethod: backendB,
+	}))
+
+	resultText(g, callTool(t, s, testdatamcp.TestService_GetItemToolName, map[string]any{"id": "item-1"}))
+	resultText(g, callTool(t, s, testdatamcp.TestService_CreateItemToolName, map[string]any{
+		"name":               "widget",
+		"thumbnail":          "",
+		"item_typeOneOfType": map[string]any{"object_type": "product", "product": map[string]any{"price": 1.5}},
+	}))
+	resultText(g, callTool(t, s, testdatamcp.TestService_ProcessWellKnownTypesToolName, map[string]any{}))
+	g.Expect(hits).To(Equal([]string{"a:GetItem", "b:CreateItem", "default:ProcessWellKnownTypes"}))
+}
+
+func TestMethodClientsWrongType(t *testing.T) {
+	g := NewWithT(t)
+
+	// A client of another service fails the registration.
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	g.Expect(func() {
+		testdatamcp.ForwardToTestServiceClient(s, tenantBackend("default"), runtime.WithMethodClients(map[string]testdatamcp.DigestServiceClient{
+			testdatamcp.TestService_GetItemFullMethod: &testdatamcp.MockDigestServiceHandler{},
+		}))
+	}).To(PanicWith(MatchError(ContainSubstring("for testdata.TestService.GetItem, not a testdatamcp.TestServiceClient"))))
+}
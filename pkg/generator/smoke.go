@@ -0,0 +1,91 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"path"
+	"text/template"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// SmokeTestPackageSuffix is appended to the package name of the generated
+// code to name the package of the SmokeTest<Service> helpers, e.g.
+// testdatamcptest next to testdatamcp.
+const SmokeTestPackageSuffix = "test"
+
+// smokeTemplate is the file of SmokeTest<Service> helpers written under
+// generate_smoke_test. It goes to a package of its own, like net/http/httptest,
+// so that only tests import testing.
+const smokeTemplate = `// Code generated by protoc-gen-mcp-go. DO NOT EDIT.
+// source: {{ .SourcePath }}
+
+package {{ .GoPackage }}
+
+import (
+  "context"
+  "testing"
+
+  mcpserver "github.com/mark3labs/mcp-go/server"
+  "github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+  {{ .GeneratedPackage }} {{ .GeneratedImportPath }}
+)
+
+{{- range $key, $val := .Services }}
+
+// SmokeTest{{$key}} registers client with
+// {{ $.GeneratedPackage }}.ForwardTo{{$key}}Client and calls every tool with the
+// minimal arguments its schema accepts. It fails t when a call panics, is
+// rejected, or returns a result that does not encode. Errors returned by
+// client are reported to the model as tool errors and do not fail t. Panic
+// recovery is off unless opts turn it back on. See runtime.SmokeTest.
+func SmokeTest{{$key}}(t testing.TB, client {{ $.GeneratedPackage }}.{{$key}}Client, opts ...runtime.Option) {
+  t.Helper()
+  s := mcpserver.NewMCPServer("smoke-test", "0.0.0")
+  {{ $.GeneratedPackage }}.ForwardTo{{$key}}Client(s, client, append([]runtime.Option{runtime.WithPanicRecovery(false)}, opts...)...)
+  for _, err := range runtime.SmokeTest(context.Background(), s) {
+    t.Error(err)
+  }
+}
+{{- end }}
+`
+
+// smokeParams are the TplParams of the generated file, seen from the package
+// of its SmokeTest<Service> helpers.
+type smokeParams struct {
+	TplParams
+	GeneratedPackage    string
+	GeneratedImportPath protogen.GoImportPath
+}
+
+// generateSmokeTest writes the SmokeTest<Service> helpers of params to a
+// <package>test package below the generated file at prefix, whose import
+// path is importPath.
+func (g *FileGenerator) generateSmokeTest(prefix, fileSuffix string, importPath protogen.GoImportPath, params TplParams) {
+	tpl, err := template.New("smoke").Parse(smokeTemplate)
+	if err != nil {
+		g.gen.Error(err)
+		return
+	}
+	smoke := smokeParams{TplParams: params, GeneratedPackage: params.GoPackage, GeneratedImportPath: importPath}
+	smoke.GoPackage = params.GoPackage + SmokeTestPackageSuffix
+	gf := g.gen.NewGeneratedFile(
+		path.Join(path.Dir(prefix), smoke.GoPackage, path.Base(prefix))+fileSuffix,
+		protogen.GoImportPath(path.Join(string(importPath), smoke.GoPackage)),
+	)
+	if err := tpl.Execute(gf, smoke); err != nil {
+		g.gen.Error(err)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp/testdatamcptest"
+)
+
+// recordingTB records the errors of a smoke test instead of failing.
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Error(args ...any) {
+	r.errors = append(r.errors, fmt.Sprint(args...))
+}
+
+func TestSmokeTestHelper(t *testing.T) {
+	testdatamcptest.SmokeTestTestService(t, testServiceClient{server: &testServer{}})
+
+	// Client errors are tool errors, so an unimplemented client passes.
+	testdatamcptest.SmokeTestTestService(t, testdatamcp.UnimplementedTestServiceHandler{})
+}
+
+func TestSmokeTestHelperReportsPanics(t *testing.T) {
+	g := NewWithT(t)
+
+	tb := &recordingTB{TB: t}
+	testdatamcptest.SmokeTestTestService(tb, &testdatamcp.MockTestServiceHandler{
+		GetItemFunc: func(context.Context, *testdata.GetItemRequest) (*testdata.GetItemResponse, error) {
+			panic("boom")
+		},
+	})
+	g.Expect(tb.errors).To(ConsistOf(ContainSubstring(testdatamcp.TestService_GetItemToolName + ": panicked: boom")))
+}
+
+func TestSmokeTestPackage(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := generateLayout(t, GenerateConfig{PackageSuffix: "mcp", GenerateSmokeTest: true})
+	g.Expect(resp.Error).To(BeNil())
+	g.Expect(resp.File).To(HaveLen(2))
+	g.Expect(resp.File[0].GetContent()).ToNot(ContainSubstring(`"testing"`))
+	g.Expect(resp.File[1].GetName()).To(HaveSuffix("testdatamcp/testdatamcptest/test_service.pb.mcp.go"))
+	g.Expect(resp.File[1].GetContent()).To(And(
+		ContainSubstring("package testdatamcptest\n"),
+		ContainSubstring("testdatamcp.ForwardToTestServiceClient(s, client,"),
+	))
+
+	// The helpers register clients from another package.
+	resp = generateLayout(t, GenerateConfig{PackageSuffix: "mcp", SkipForward: true, GenerateSmokeTest: true})
+	g.Expect(resp.GetError()).To(ContainSubstring("generate_smoke_test needs generate_forward"))
+}
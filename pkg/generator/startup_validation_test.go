@@ -0,0 +1,53 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestStartupValidationAcceptsGeneratedSchemas(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	g.Expect(func() {
+		testdatamcp.ForwardToTestServiceClient(s, &testdatamcp.MockTestServiceHandler{}, runtime.WithStartupValidation(true))
+		testdatamcp.ForwardToBatchServiceClient(s, &testdatamcp.MockBatchServiceHandler{}, runtime.WithStartupValidation(true))
+		testdatamcp.ForwardToValidatedServiceClient(s, &testdatamcp.MockValidatedServiceHandler{}, runtime.WithStartupValidation(true))
+	}).ToNot(Panic())
+}
+
+func TestStartupValidationCatchesBrokenSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	original := testdatamcp.TestService_GetItemTool
+	t.Cleanup(func() { testdatamcp.TestService_GetItemTool = original })
+	testdatamcp.TestService_GetItemTool.JSONSchema = `{"type": "object", "properties": {"id": {"type": 42}}}`
+
+	// Without the option the broken schema is registered as is.
+	g.Expect(func() {
+		testdatamcp.ForwardToTestServiceClient(mcpserver.NewMCPServer("test-server", "1.0.0"), &testdatamcp.MockTestServiceHandler{})
+	}).ToNot(Panic())
+
+	g.Expect(func() {
+		testdatamcp.ForwardToTestServiceClient(mcpserver.NewMCPServer("test-server", "1.0.0"), &testdatamcp.MockTestServiceHandler{}, runtime.WithStartupValidation(true))
+	}).To(PanicWith(MatchError(ContainSubstring(`tool "testdata_TestService_GetItem": invalid schema`))))
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+// isStreamResource reports whether meth is a server-streaming method
+// annotated with (mcp.options.tool) stream_resource, whose tool publishes the
+// stream as an MCP resource instead of being skipped like other streaming
+// methods. The option is an error on any other method and with batch.
+func isStreamResource(meth *protogen.Method, opts *mcpoptions.ToolOptions) (bool, error) {
+	if !opts.GetStreamResource() {
+		return false, nil
+	}
+	if meth.Desc.IsStreamingClient() || !meth.Desc.IsStreamingServer() {
+		return false, fmt.Errorf("mcpgen: %s has (mcp.options.tool) stream_resource but is not a server-streaming method", meth.Desc.FullName())
+	}
+	if opts.GetBatch() {
+		return false, fmt.Errorf("mcpgen: %s has both (mcp.options.tool) stream_resource and batch", meth.Desc.FullName())
+	}
+	return true, nil
+}
+
+// HasStreamResources reports whether any method publishes its stream as a
+// resource, so that the generated file imports codes and status for the
+// in-process client.
+func (p TplParams) HasStreamResources() bool {
+	for _, methods := range p.Services {
+		for _, m := range methods {
+			if m.StreamResource {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// fakeQuoteStream is a WatchQuotes stream returning the quotes sent on its
+// channel until it is closed.
+type fakeQuoteStream struct {
+	grpc.ClientStream
+	quotes chan *testdata.Quote
+}
+
+func (s *fakeQuoteStream) Recv() (*testdata.Quote, error) {
+	if quote, ok := <-s.quotes; ok {
+		return quote, nil
+	}
+	return nil, io.EOF
+}
+
+// quoteSession is an initialized client session collecting the
+// notifications sent to it.
+type quoteSession struct {
+	notifications chan mcp.JSONRPCNotification
+}
+
+func (s *quoteSession) Initialize()                                         {}
+func (s *quoteSession) Initialized() bool                                   { return true }
+func (s *quoteSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return s.notifications }
+func (s *quoteSession) SessionID() string                                   { return "quotes" }
+
+func TestStreamResourceTool(t *testing.T) {
+	g := NewWithT(t)
+
+	stream := &fakeQuoteStream{quotes: make(chan *testdata.Quote)}
+	var streamCtx context.Context
+	var symbol string
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToQuoteServiceClient(s, &testdatamcp.MockQuoteServiceHandler{
+		WatchQuotesFunc: func(ctx context.Context, req *testdata.WatchQuotesRequest) (grpc.ServerStreamingClient[testdata.Quote], error) {
+			streamCtx, symbol = ctx, req.GetSymbol()
+			return stream, nil
+		},
+	})
+
+	session := &quoteSession{notifications: make(chan mcp.JSONRPCNotification, 10)}
+	g.Expect(s.RegisterSession(context.Background(), session)).To(Succeed())
+	sessionCtx := s.WithContext(context.Background(), session)
+	ctx, cancel := context.WithCancel(sessionCtx)
+	resp := callToolWithContext(t, ctx, s, testdatamcp.QuoteService_WatchQuotesTool.Name, map[string]any{"symbol": "ACME"})
+	// The stream outlives the MCP call.
+	cancel()
+	g.Expect(symbol).To(Equal("ACME"))
+	g.Expect(streamCtx.Err()).ToNot(HaveOccurred())
+
+	var text struct {
+		ResourceURI string `json:"resource_uri"`
+	}
+	content := resp["result"].(map[string]any)["content"].([]any)
+	g.Expect(content).To(HaveLen(2))
+	g.Expect(content[1]).To(HaveKeyWithValue("type", "resource_link"))
+	g.Expect(json.Unmarshal([]byte(content[0].(map[string]any)["text"].(string)), &text)).To(Succeed())
+	uri := text.ResourceURI
+	g.Expect(uri).To(HavePrefix("stream://testdata.QuoteService.WatchQuotes/"))
+	g.Expect(content[1]).To(HaveKeyWithValue("uri", uri))
+
+	read := func() map[string]any {
+		msg, err := json.Marshal(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      2,
+			"method":  "resources/read",
+			"params":  map[string]any{"uri": uri},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		raw, err := json.Marshal(s.HandleMessage(sessionCtx, msg))
+		g.Expect(err).ToNot(HaveOccurred())
+		var out struct {
+			Result struct {
+				Contents []struct {
+					Text string `json:"text"`
+				} `json:"contents"`
+			} `json:"result"`
+		}
+		g.Expect(json.Unmarshal(raw, &out)).To(Succeed())
+		g.Expect(out.Result.Contents).To(HaveLen(1))
+		var state map[string]any
+		g.Expect(json.Unmarshal([]byte(out.Result.Contents[0].Text), &state)).To(Succeed())
+		return state
+	}
+
+	// Every quote fires a resources/updated notification for the resource.
+	for _, cents := range []int64{1250, 1275} {
+		stream.quotes <- &testdata.Quote{Symbol: "ACME", PriceCents: cents}
+		var n mcp.JSONRPCNotification
+		g.Eventually(session.notifications).Should(Receive(&n))
+		g.Expect(n.Method).To(Equal("notifications/resources/updated"))
+		g.Expect(n.Params.AdditionalFields).To(HaveKeyWithValue("uri", uri))
+		g.Expect(read()["message"]).To(HaveKeyWithValue("price_cents", strconv.FormatInt(cents, 10)))
+	}
+
+	close(stream.quotes)
+	g.Eventually(session.notifications).Should(Receive())
+	g.Expect(read()).To(HaveKeyWithValue("done", true))
+	g.Eventually(streamCtx.Done()).Should(BeClosed())
+}
+
+func TestStreamResourceOnlyForServerStreaming(t *testing.T) {
+	g := NewWithT(t)
+
+	// Move the option of WatchQuotes to the unary GetQuote.
+	fdp := protodesc.ToFileDescriptorProto(testdata.File_testdata_stream_resource_test_proto)
+	methods := fdp.GetService()[0].GetMethod()
+	g.Expect(methods[0].GetName()).To(Equal("WatchQuotes"))
+	methods[1].Options, methods[0].Options = methods[0].GetOptions(), nil
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	plugin, _ := runPlugin(t, codeGeneratorRequest(fd), GenerateConfig{PackageSuffix: "mcp"})
+	g.Expect(plugin.Response().GetError()).To(ContainSubstring("testdata.QuoteService.GetQuote has (mcp.options.tool) stream_resource but is not a server-streaming method"))
+}
+
+func TestStreamResourceLimits(t *testing.T) {
+	g := NewWithT(t)
+
+	stream := &fakeQuoteStream{quotes: make(chan *testdata.Quote)}
+	var streamCtx context.Context
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToQuoteServiceClient(s, &testdatamcp.MockQuoteServiceHandler{
+		WatchQuotesFunc: func(ctx context.Context, _ *testdata.WatchQuotesRequest) (grpc.ServerStreamingClient[testdata.Quote], error) {
+			streamCtx = ctx
+			return stream, nil
+		},
+	}, runtime.WithConcurrencyLimit(1), runtime.WithConcurrencyLimitFailFast(true), runtime.WithCallTimeout(time.Hour))
+
+	resp := callTool(t, s, testdatamcp.QuoteService_WatchQuotesTool.Name, map[string]any{"symbol": "ACME"})
+	g.Expect(resp["result"]).ToNot(HaveKeyWithValue("isError", true))
+	// The stream runs under the call timeout.
+	deadline, ok := streamCtx.Deadline()
+	g.Expect(ok).To(BeTrue())
+	g.Expect(time.Until(deadline)).To(BeNumerically("~", time.Hour, time.Minute))
+
+	// The stream keeps its concurrency slot until it ends.
+	resp = callTool(t, s, testdatamcp.QuoteService_WatchQuotesTool.Name, map[string]any{"symbol": "ACME"})
+	g.Expect(resultText(g, resp)).To(ContainSubstring("RESOURCE_EXHAUSTED"))
+	close(stream.quotes)
+	g.Eventually(streamCtx.Done()).Should(BeClosed())
+	g.Eventually(func() any {
+		return callTool(t, s, testdatamcp.QuoteService_WatchQuotesTool.Name, map[string]any{"symbol": "ACME"})["result"]
+	}).ShouldNot(HaveKeyWithValue("isError", true))
+}
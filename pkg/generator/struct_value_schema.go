@@ -0,0 +1,118 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+const structFullName = "google.protobuf.Struct"
+
+// structValueSchema returns the (mcp.options.struct_value_schema) of fd, or
+// nil when it is not set. The schema must be a JSON object or boolean that
+// compiles as JSON Schema, and fd must be a singular or repeated Struct.
+func structValueSchema(fd protoreflect.FieldDescriptor) (any, error) {
+	text, ok, err := getExtension[string](fd, mcpoptions.E_StructValueSchema)
+	if err != nil {
+		return nil, err
+	}
+	text = strings.TrimSpace(text)
+	if !ok || text == "" {
+		return nil, nil
+	}
+	if fd.IsMap() || fd.Message() == nil || fd.Message().FullName() != structFullName {
+		return nil, fmt.Errorf("mcpgen: %s has (mcp.options.struct_value_schema) but is not a google.protobuf.Struct field", fd.FullName())
+	}
+
+	var schema any
+	if err := json.Unmarshal([]byte(text), &schema); err != nil {
+		return nil, fmt.Errorf("mcpgen: %s has an invalid (mcp.options.struct_value_schema): %w", fd.FullName(), err)
+	}
+	switch schema.(type) {
+	case map[string]any, bool:
+	default:
+		return nil, fmt.Errorf("mcpgen: %s has an invalid (mcp.options.struct_value_schema): must be a JSON object or boolean", fd.FullName())
+	}
+	if _, err := compileSchema(schema); err != nil {
+		return nil, fmt.Errorf("mcpgen: %s has an invalid (mcp.options.struct_value_schema): %w", fd.FullName(), err)
+	}
+	return schema, nil
+}
+
+// checkStructValueSchemas reports every invalid
+// (mcp.options.struct_value_schema) in the messages of the file and in every
+// message the methods of its services reach, which may come from other files.
+// Schema generation then only sees valid annotations.
+func (g *FileGenerator) checkStructValueSchemas() bool {
+	ok := true
+	for _, md := range g.reachableMessages() {
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			if _, err := structValueSchema(fields.Get(i)); err != nil {
+				g.gen.Error(err)
+				ok = false
+			}
+		}
+	}
+	return ok
+}
+
+// reachableMessages returns the messages of the file, with their nested
+// messages, followed by those reached from the inputs and outputs of its
+// methods through message fields, lists and maps, each once.
+func (g *FileGenerator) reachableMessages() []protoreflect.MessageDescriptor {
+	var out []protoreflect.MessageDescriptor
+	seen := map[protoreflect.FullName]bool{}
+	var visit func(md protoreflect.MessageDescriptor)
+	visit = func(md protoreflect.MessageDescriptor) {
+		if seen[md.FullName()] {
+			return
+		}
+		seen[md.FullName()] = true
+		out = append(out, md)
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if fd.IsMap() {
+				fd = fd.MapValue()
+			}
+			if fd.Message() != nil {
+				visit(fd.Message())
+			}
+		}
+	}
+	var visitNested func(messages []*protogen.Message)
+	visitNested = func(messages []*protogen.Message) {
+		for _, msg := range messages {
+			visit(msg.Desc)
+			visitNested(msg.Messages)
+		}
+	}
+	visitNested(g.f.Messages)
+	for _, svc := range g.f.Services {
+		for _, meth := range svc.Methods {
+			visit(meth.Input.Desc)
+			visit(meth.Output.Desc)
+		}
+	}
+	return out
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestStructValueSchemaGolden(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema struct {
+		Properties map[string]map[string]any `json:"properties"`
+	}
+	g.Expect(json.Unmarshal([]byte(testdatamcp.StructValueService_TagResourceTool.JSONSchema), &schema)).To(Succeed())
+	g.Expect(schema.Properties["tags"]).To(HaveKeyWithValue("additionalProperties", map[string]any{"type": "string"}))
+	g.Expect(schema.Properties["metadata"]).ToNot(HaveKey("additionalProperties"), "unannotated Structs stay free-form")
+	g.Expect(schema.Properties["limits"]["items"]).To(HaveKeyWithValue("additionalProperties", map[string]any{"type": "integer", "minimum": 0.0}))
+
+	g.Expect(validateAgainstSchema(map[string]any{"properties": schema.Properties}, map[string]any{
+		"tags": map[string]any{"env": "prod"},
+	})).To(Succeed())
+	g.Expect(validateAgainstSchema(map[string]any{"properties": schema.Properties}, map[string]any{
+		"tags": map[string]any{"replicas": 3},
+	})).ToNot(Succeed())
+}
+
+func TestStructValueSchemaForwardsStruct(t *testing.T) {
+	g := NewWithT(t)
+
+	var got *testdata.TagResourceRequest
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToStructValueServiceClient(s, &testdatamcp.MockStructValueServiceHandler{
+		TagResourceFunc: func(_ context.Context, req *testdata.TagResourceRequest) (*testdata.TagResourceResponse, error) {
+			got = req
+			return &testdata.TagResourceResponse{Tags: req.GetTags()}, nil
+		},
+	})
+
+	resp := callTool(t, s, testdatamcp.StructValueService_TagResourceTool.Name, map[string]any{
+		"resource": "db-1",
+		"tags":     map[string]any{"env": "prod", "team": "storage"},
+	})
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"tags":{"env":"prod","team":"storage"}}`))
+
+	want, err := structpb.NewStruct(map[string]any{"env": "prod", "team": "storage"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(proto.Equal(got.GetTags(), want)).To(BeTrue(), "got %v", got.GetTags())
+}
+
+// structValueSchemaField compiles a message with a single field "f" of the
+// given type carrying schema as its (mcp.options.struct_value_schema).
+func structValueSchemaField(t *testing.T, field *descriptorpb.FieldDescriptorProto, schema string) protoreflect.FieldDescriptor {
+	t.Helper()
+	field.Name = proto.String("f")
+	field.Number = proto.Int32(1)
+	if field.Label == nil {
+		field.Label = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	}
+	field.Options = &descriptorpb.FieldOptions{}
+	proto.SetExtension(field.Options, mcpoptions.E_StructValueSchema, schema)
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("svs/test.proto"),
+		Package:    proto.String("svs"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/struct.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:  proto.String("M"),
+			Field: []*descriptorpb.FieldDescriptorProto{field},
+		}},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fd.Messages().Get(0).Fields().Get(0)
+}
+
+func TestStructValueSchemaErrors(t *testing.T) {
+	structField := func() *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String(".google.protobuf.Struct"),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		field   *descriptorpb.FieldDescriptorProto
+		schema  string
+		wantErr string
+	}{
+		{
+			name:    "not a Struct",
+			field:   &descriptorpb.FieldDescriptorProto{Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()},
+			schema:  `{"type": "string"}`,
+			wantErr: "svs.M.f has (mcp.options.struct_value_schema) but is not a google.protobuf.Struct field",
+		},
+		{
+			name:    "invalid JSON",
+			field:   structField(),
+			schema:  `{type: string}`,
+			wantErr: "svs.M.f has an invalid (mcp.options.struct_value_schema)",
+		},
+		{
+			name:    "not an object",
+			field:   structField(),
+			schema:  `["string"]`,
+			wantErr: "must be a JSON object or boolean",
+		},
+		{
+			name:    "invalid schema",
+			field:   structField(),
+			schema:  `{"type": "strng"}`,
+			wantErr: "svs.M.f has an invalid (mcp.options.struct_value_schema)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := structValueSchema(structValueSchemaField(t, tt.field, tt.schema))
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+
+	t.Run("boolean", func(t *testing.T) {
+		g := NewWithT(t)
+		schema, err := structValueSchema(structValueSchemaField(t, structField(), `false`))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(schema).To(BeFalse())
+	})
+}
+
+func TestStructValueSchemaImportedMessage(t *testing.T) {
+	g := NewWithT(t)
+
+	// The invalid annotation is in another file, which has no services and
+	// is not generated itself.
+	meta := structValueSchemaField(t, &descriptorpb.FieldDescriptorProto{
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+		TypeName: proto.String(".google.protobuf.Struct"),
+	}, `{"type": "strng"}`).ParentFile()
+	files := &protoregistry.Files{}
+	g.Expect(files.RegisterFile(structpb.File_google_protobuf_struct_proto)).To(Succeed())
+	g.Expect(files.RegisterFile(meta)).To(Succeed())
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("svs/service.proto"),
+		Package:    proto.String("svs"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"svs/test.proto"},
+		Options:    &descriptorpb.FileOptions{GoPackage: proto.String("example.com/svs")},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Request"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("meta"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+				TypeName: proto.String(".svs.M"),
+			}},
+		}},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("MetaService"),
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       proto.String("Tag"),
+				InputType:  proto.String(".svs.Request"),
+				OutputType: proto.String(".svs.Request"),
+			}},
+		}},
+	}, files)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	req := codeGeneratorRequest(fd)
+	for _, f := range req.GetProtoFile() {
+		if f.GetName() == "svs/test.proto" {
+			f.Options = &descriptorpb.FileOptions{GoPackage: proto.String("example.com/svs")}
+		}
+	}
+	plugin, _ := runPlugin(t, req, GenerateConfig{PackageSuffix: "mcp"})
+	g.Expect(plugin.Response().GetError()).To(ContainSubstring("svs.M.f has an invalid (mcp.options.struct_value_schema)"))
+}
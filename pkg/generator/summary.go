@@ -0,0 +1,48 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+// summaryField returns the name of the field of msg marked with
+// (mcp.options.summary), or "" when there is none. The field must be a
+// singular string, and only one field may be marked.
+func summaryField(msg *protogen.Message) (protoreflect.Name, error) {
+	var found protoreflect.Name
+	for _, field := range msg.Fields {
+		marked, _, err := getExtension[bool](field.Desc, mcpoptions.E_Summary)
+		if err != nil {
+			return "", err
+		}
+		if !marked {
+			continue
+		}
+		if field.Desc.Kind() != protoreflect.StringKind || field.Desc.IsList() || field.Desc.IsMap() {
+			return "", fmt.Errorf("mcpgen: %s has (mcp.options.summary) but is not a singular string field", field.Desc.FullName())
+		}
+		if found != "" {
+			return "", fmt.Errorf("mcpgen: %s has more than one (mcp.options.summary) field: %s and %s", msg.Desc.FullName(), found, field.Desc.Name())
+		}
+		found = field.Desc.Name()
+	}
+	return found, nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func newDigestTestServer(headline string) *mcpserver.MCPServer {
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToDigestServiceClient(s, &testdatamcp.MockDigestServiceHandler{
+		BuildDigestFunc: func(_ context.Context, req *testdata.BuildDigestRequest) (*testdata.BuildDigestResponse, error) {
+			return &testdata.BuildDigestResponse{
+				Headline: headline,
+				Entries:  []string{req.GetTopic() + " rose", req.GetTopic() + " fell"},
+				Total:    2,
+			}, nil
+		},
+	})
+	return s
+}
+
+// resultTexts returns the text of every content block of resp.
+func resultTexts(g *WithT, resp map[string]any) []string {
+	g.Expect(resp).To(HaveKey("result"), "unexpected response: %v", resp)
+	var texts []string
+	for _, content := range resp["result"].(map[string]any)["content"].([]any) {
+		texts = append(texts, content.(map[string]any)["text"].(string))
+	}
+	return texts
+}
+
+func TestSummaryFieldProducesTwoContentBlocks(t *testing.T) {
+	g := NewWithT(t)
+
+	s := newDigestTestServer("Two stocks moved today")
+	texts := resultTexts(g, callTool(t, s, testdatamcp.DigestService_BuildDigestTool.Name, map[string]any{"topic": "stock"}))
+	g.Expect(texts).To(HaveLen(2))
+	g.Expect(texts[0]).To(Equal("Two stocks moved today"))
+
+	var full map[string]any
+	g.Expect(json.Unmarshal([]byte(texts[1]), &full)).To(Succeed())
+	g.Expect(full).To(HaveKeyWithValue("headline", "Two stocks moved today"))
+	g.Expect(full).To(HaveKeyWithValue("entries", ConsistOf("stock rose", "stock fell")))
+}
+
+func TestSummaryFieldEmpty(t *testing.T) {
+	g := NewWithT(t)
+
+	s := newDigestTestServer("")
+	texts := resultTexts(g, callTool(t, s, testdatamcp.DigestService_BuildDigestTool.Name, map[string]any{"topic": "stock"}))
+	g.Expect(texts).To(HaveLen(1))
+	g.Expect(texts[0]).To(ContainSubstring(`"entries"`))
+}
+
+func TestSummaryFieldBatch(t *testing.T) {
+	g := NewWithT(t)
+
+	s := newDigestTestServer("Two stocks moved today")
+	text := resultText(g, callTool(t, s, testdatamcp.DigestService_BuildDigestBatchTool.Name, map[string]any{
+		"requests": []any{map[string]any{"topic": "stock"}},
+	}))
+
+	// Batch results embed the full response, not the summary.
+	var batch struct {
+		Results []struct {
+			Result map[string]any `json:"result"`
+		} `json:"results"`
+	}
+	g.Expect(json.Unmarshal([]byte(text), &batch)).To(Succeed())
+	g.Expect(batch.Results).To(HaveLen(1))
+	g.Expect(batch.Results[0].Result).To(HaveKeyWithValue("total", BeEquivalentTo(2)))
+}
+
+func TestSummaryFieldInvalid(t *testing.T) {
+	for name, tc := range map[string]struct {
+		fields []string
+		err    string
+	}{
+		"not a string": {fields: []string{"total"}, err: "testdata.BuildDigestResponse.total has (mcp.options.summary) but is not a singular string field"},
+		"repeated":     {fields: []string{"entries"}, err: "testdata.BuildDigestResponse.entries has (mcp.options.summary) but is not a singular string field"},
+		"two fields":   {fields: []string{"headline", "topic_note"}, err: "more than one (mcp.options.summary) field"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			file := testdata.File_testdata_summary_test_proto
+			req := codeGeneratorRequest(file)
+			for _, fdp := range req.ProtoFile {
+				if fdp.GetName() != file.Path() {
+					continue
+				}
+				for _, msg := range fdp.MessageType {
+					if msg.GetName() != "BuildDigestResponse" {
+						continue
+					}
+					msg.Field = append(msg.Field, &descriptorpb.FieldDescriptorProto{
+						Name:     proto.String("topic_note"),
+						JsonName: proto.String("topicNote"),
+						Number:   proto.Int32(4),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					})
+					for _, field := range msg.Field {
+						opts := &descriptorpb.FieldOptions{}
+						for _, marked := range tc.fields {
+							if field.GetName() == marked {
+								proto.SetExtension(opts, mcpoptions.E_Summary, true)
+							}
+						}
+						field.Options = opts
+					}
+				}
+			}
+			plugin, _ := runPlugin(t, req, GenerateConfig{})
+			g.Expect(plugin.Response().GetError()).To(ContainSubstring(tc.err))
+		})
+	}
+}
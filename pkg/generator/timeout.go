@@ -0,0 +1,74 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+// methodTimeout parses the (mcp.options.tool) timeout of meth. It returns 0
+// when none is set.
+func methodTimeout(meth *protogen.Method, opts *mcpoptions.ToolOptions) (time.Duration, error) {
+	text := strings.TrimSpace(opts.GetTimeout())
+	if text == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(text)
+	if err != nil {
+		return 0, fmt.Errorf("mcpgen: %s has an invalid (mcp.options.tool) timeout %q: %w", meth.Desc.FullName(), text, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("mcpgen: %s has a (mcp.options.tool) timeout %q that is not positive", meth.Desc.FullName(), text)
+	}
+	return d, nil
+}
+
+// durationLiteral returns Go source for d, such as "30 * time.Second", in the
+// largest unit that represents it exactly.
+func durationLiteral(d time.Duration) string {
+	units := []struct {
+		unit time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"},
+		{time.Microsecond, "time.Microsecond"},
+	}
+	for _, u := range units {
+		if d%u.unit == 0 {
+			return fmt.Sprintf("%d * %s", d/u.unit, u.name)
+		}
+	}
+	return fmt.Sprintf("time.Duration(%d)", int64(d))
+}
+
+// HasTimeouts reports whether any tool has a timeout, so that the generated
+// file imports time.
+func (p TplParams) HasTimeouts() bool {
+	for _, tool := range p.Tools {
+		if tool.Timeout > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestTimeoutGolden(t *testing.T) {
+	g := NewWithT(t)
+
+	raw, err := os.ReadFile("../testdata/gen/go-golden/testdata/testdatamcp/timeout_test.pb.mcp.go")
+	g.Expect(err).ToNot(HaveOccurred())
+	src := string(raw)
+	g.Expect(src).To(ContainSubstring(`"time"`))
+	g.Expect(src).To(ContainSubstring("Timeout: 30 * time.Second}"))
+	g.Expect(src).To(ContainSubstring("Timeout: 20 * time.Millisecond}"))
+	g.Expect(src).To(ContainSubstring("runtime.CallContext(ctx, RunReportToolDef.Timeout, config.CallTimeout)"))
+
+	g.Expect(testdatamcp.AnalyticsService_RunReportTool.Timeout).To(Equal(30 * time.Second))
+	g.Expect(testdatamcp.AnalyticsService_LookupTool.Timeout).To(BeZero())
+}
+
+// blockingReport blocks until ctx is done and reports whether it had a
+// deadline.
+func blockingReport(hadDeadline chan<- bool) func(context.Context, *testdata.RunReportRequest) (*testdata.RunReportResponse, error) {
+	return func(ctx context.Context, _ *testdata.RunReportRequest) (*testdata.RunReportResponse, error) {
+		_, ok := ctx.Deadline()
+		hadDeadline <- ok
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+}
+
+func TestTimeoutBoundsForwardedCall(t *testing.T) {
+	g := NewWithT(t)
+
+	hadDeadline := make(chan bool, 1)
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToAnalyticsServiceClient(s, &testdatamcp.MockAnalyticsServiceHandler{
+		QuickCheckFunc: blockingReport(hadDeadline),
+	})
+
+	resp := callTool(t, s, testdatamcp.AnalyticsService_QuickCheckTool.Name, map[string]any{"query": "ping"})
+	g.Expect(<-hadDeadline).To(BeTrue())
+	g.Expect(resultText(g, resp)).To(ContainSubstring("DEADLINE_EXCEEDED"))
+}
+
+func TestTimeoutFallback(t *testing.T) {
+	g := NewWithT(t)
+
+	hadDeadline := make(chan bool, 1)
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToAnalyticsServiceClient(s, &testdatamcp.MockAnalyticsServiceHandler{
+		LookupFunc: blockingReport(hadDeadline),
+	}, runtime.WithCallTimeout(20*time.Millisecond))
+
+	// Lookup has no timeout of its own and gets the server-wide one.
+	resp := callTool(t, s, testdatamcp.AnalyticsService_LookupTool.Name, map[string]any{"query": "ping"})
+	g.Expect(<-hadDeadline).To(BeTrue())
+	g.Expect(resultText(g, resp)).To(ContainSubstring("DEADLINE_EXCEEDED"))
+}
+
+func TestTimeoutInvalid(t *testing.T) {
+	methods := buildServices(t, map[string]map[string]*mcpoptions.ToolOptions{
+		"Reports": {
+			"Run":   {Timeout: "soon"},
+			"Check": {Timeout: "-5s"},
+			"Plain": nil,
+		},
+	})
+
+	g := NewWithT(t)
+	_, err := methodTimeout(methodNamed(methods, "Run"), &mcpoptions.ToolOptions{Timeout: "soon"})
+	g.Expect(err).To(MatchError(ContainSubstring(`invalid (mcp.options.tool) timeout "soon"`)))
+	_, err = methodTimeout(methodNamed(methods, "Check"), &mcpoptions.ToolOptions{Timeout: "-5s"})
+	g.Expect(err).To(MatchError(ContainSubstring("not positive")))
+	d, err := methodTimeout(methodNamed(methods, "Plain"), nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(d).To(BeZero())
+}
+
+func TestTimeoutInvalidFailsGeneration(t *testing.T) {
+	g := NewWithT(t)
+
+	file := testdata.File_testdata_timeout_test_proto
+	req := codeGeneratorRequest(file)
+	for _, fdp := range req.ProtoFile {
+		if fdp.GetName() != file.Path() {
+			continue
+		}
+		opts := &descriptorpb.MethodOptions{}
+		proto.SetExtension(opts, mcpoptions.E_Tool, &mcpoptions.ToolOptions{Timeout: "half a minute"})
+		fdp.Service[0].Method[0].Options = opts
+	}
+	plugin, _ := runPlugin(t, req, GenerateConfig{})
+	g.Expect(plugin.Response().GetError()).To(ContainSubstring(`testdata.AnalyticsService.RunReport has an invalid (mcp.options.tool) timeout "half a minute"`))
+}
+
+func TestDurationLiteral(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(durationLiteral(30 * time.Second)).To(Equal("30 * time.Second"))
+	g.Expect(durationLiteral(90 * time.Minute)).To(Equal("90 * time.Minute"))
+	g.Expect(durationLiteral(1500 * time.Millisecond)).To(Equal("1500 * time.Millisecond"))
+	g.Expect(durationLiteral(2 * time.Hour)).To(Equal("2 * time.Hour"))
+	g.Expect(durationLiteral(7)).To(Equal("time.Duration(7)"))
+}
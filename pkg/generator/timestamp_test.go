@@ -0,0 +1,127 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// scheduleJobProperties returns the properties of the ScheduleJobRequest
+// schema generated by fg.
+func scheduleJobProperties(fg *FileGenerator) map[string]any {
+	md := (&testdata.ScheduleJobRequest{}).ProtoReflect().Descriptor()
+	return fg.messageSchemaWithDefs(md, nil, directionInput)["properties"].(map[string]any)
+}
+
+func TestTimestampNullability(t *testing.T) {
+	g := NewWithT(t)
+
+	props := scheduleJobProperties(&FileGenerator{timestampFormat: TimestampFormatRFC3339})
+	g.Expect(props["start_time"]).To(Equal(map[string]any{"type": "string", "format": "date-time"}), "required")
+	g.Expect(props["end_time"]).To(HaveKeyWithValue("type", []string{"string", "null"}))
+	g.Expect(props["blackout_times"].(map[string]any)["items"]).To(HaveKeyWithValue("type", []string{"string", "null"}))
+	g.Expect(props["deadlines"].(map[string]any)["additionalProperties"]).To(HaveKeyWithValue("type", []string{"string", "null"}))
+
+	// With optional keyword support a timestamp without the keyword is required.
+	fg := &FileGenerator{optionalKeywordSupport: true, timestampFormat: TimestampFormatRFC3339}
+	schema := fg.getType((&testdata.WktTestMessage{}).ProtoReflect().Descriptor().Fields().ByName("timestamp"))
+	g.Expect(schema).To(HaveKeyWithValue("type", "string"))
+}
+
+func TestTimestampUnixSeconds(t *testing.T) {
+	g := NewWithT(t)
+
+	fg := &FileGenerator{timestampFormat: TimestampFormatUnixSeconds}
+	props := scheduleJobProperties(fg)
+	g.Expect(props["start_time"]).To(Equal(map[string]any{
+		"type":        "integer",
+		"description": unixSecondsNote,
+	}))
+	g.Expect(props["end_time"]).To(Equal(map[string]any{
+		"type":        []string{"integer", "null"},
+		"description": unixSecondsNote,
+	}))
+
+	// A field comment keeps the note, like the int64 one.
+	fd := (&testdata.ScheduleJobRequest{}).ProtoReflect().Descriptor().Fields().ByName("start_time")
+	schema := fg.getTypeWithDefsAndComment(fd, "When the job first runs.", directionInput, map[string]any{}, map[string]bool{})
+	g.Expect(schema).To(HaveKeyWithValue("description", "When the job first runs. (Unix time in seconds)"))
+
+	// Examples are shaped the same way.
+	args, err := fg.exampleArguments((&testdata.ScheduleJobRequest{
+		StartTime: timestamppb.New(time.Unix(1700000000, 0)),
+	}).ProtoReflect())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(args).To(HaveKeyWithValue("start_time", BeEquivalentTo("1700000000")))
+
+	// Responses are still written as RFC 3339 strings, with a field comment
+	// but no note.
+	md := (&testdata.ScheduleJobResponse{}).ProtoReflect().Descriptor()
+	props = fg.messageSchemaWithDefs(md, nil, directionOutput)["properties"].(map[string]any)
+	g.Expect(props["next_run_time"]).To(Equal(map[string]any{"type": []string{"string", "null"}, "format": "date-time"}))
+	schema = fg.getTypeWithDefsAndComment(md.Fields().ByName("next_run_time"), "When the job runs next.", directionOutput, map[string]any{}, map[string]bool{})
+	g.Expect(schema).To(HaveKeyWithValue("description", "When the job runs next."))
+}
+
+// generateTimestampProto generates timestamp_test.proto with the given
+// timestamp_format and returns the generated Go file, or the plugin error.
+func generateTimestampProto(t *testing.T, format string) (string, string) {
+	t.Helper()
+	file := (&testdata.ScheduleJobRequest{}).ProtoReflect().Descriptor().ParentFile()
+	plugin, _ := runPlugin(t, codeGeneratorRequest(file), GenerateConfig{PackageSuffix: "mcp", TimestampFormat: format})
+	resp := plugin.Response()
+	if resp.Error != nil {
+		return "", resp.GetError()
+	}
+	for _, f := range resp.File {
+		if strings.HasSuffix(f.GetName(), GeneratedFilenameExtension) {
+			return f.GetContent(), ""
+		}
+	}
+	t.Fatal("no file generated")
+	return "", ""
+}
+
+func TestTimestampFormatOption(t *testing.T) {
+	const conversion = "runtime.UnixTimestampsToRFC3339(args, req.ProtoReflect().Descriptor())"
+
+	t.Run("default", func(t *testing.T) {
+		g := NewWithT(t)
+		content, errMsg := generateTimestampProto(t, "")
+		g.Expect(errMsg).To(BeEmpty())
+		g.Expect(content).ToNot(ContainSubstring(conversion))
+	})
+
+	t.Run("unix_seconds", func(t *testing.T) {
+		g := NewWithT(t)
+		content, errMsg := generateTimestampProto(t, TimestampFormatUnixSeconds)
+		g.Expect(errMsg).To(BeEmpty())
+		g.Expect(content).To(ContainSubstring(conversion))
+		g.Expect(content).To(ContainSubstring(`\"start_time\":{\"description\":\"Unix time in seconds\",\"type\":\"integer\"}`))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		g := NewWithT(t)
+		_, errMsg := generateTimestampProto(t, "epoch")
+		g.Expect(errMsg).To(ContainSubstring(`timestamp_format "epoch" must be "rfc3339" or "unix_seconds"`))
+	})
+}
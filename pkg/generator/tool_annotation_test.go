@@ -12,6 +12,7 @@ import (
 	"google.golang.org/protobuf/types/pluginpb"
 
 	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
 )
 
 // buildServices compiles a file descriptor with the given services, each
@@ -254,3 +255,27 @@ func TestResolveToolName_LegacyLegacyCollisionStaysSilent(t *testing.T) {
 		t.Fatalf("expected identical mangled names, got %q and %q", first, second)
 	}
 }
+
+func TestToolDescriptionPrecedence(t *testing.T) {
+	meth := &protogen.Method{Comments: protogen.CommentSet{Leading: " Developer-facing comment.\n"}}
+
+	if got := (&FileGenerator{}).toolDescription(meth, &mcpoptions.ToolOptions{Description: "Model-tuned description."}); got != "Model-tuned description." {
+		t.Errorf("annotation must win over the comment, got %q", got)
+	}
+	if got := (&FileGenerator{}).toolDescription(meth, &mcpoptions.ToolOptions{Description: "  "}); got != "Developer-facing comment.\n" {
+		t.Errorf("blank annotation must fall back to the comment, got %q", got)
+	}
+	if got := (&FileGenerator{}).toolDescription(meth, nil); got != "Developer-facing comment.\n" {
+		t.Errorf("unannotated method must use the comment, got %q", got)
+	}
+}
+
+func TestToolDescriptionGolden(t *testing.T) {
+	want := "Lists every widget visible to the caller. Use get_widget to fetch one widget's details."
+	if got := testdatamcp.AnnotatedService_ListWidgetsTool.Description; got != want {
+		t.Errorf("ListWidgets description = %q, want the annotation %q", got, want)
+	}
+	if got := testdatamcp.AnnotatedService_GetWidgetTool.Description; got != "Fetches a widget by id.\n" {
+		t.Errorf("GetWidget description = %q, want its leading comment", got)
+	}
+}
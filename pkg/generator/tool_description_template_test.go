@@ -0,0 +1,57 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestToolDescriptionTemplate(t *testing.T) {
+	g := NewWithT(t)
+
+	tmpl := runtime.WithToolDescriptionTemplate("[{service}.{method}] {comment} Route: {route}.")
+	tools := registeredTools(t, func(s *mcpserver.MCPServer) {
+		testdatamcp.ForwardToTestServiceClient(s, testServiceClient{server: &testServer{}}, tmpl)
+		testdatamcp.ForwardToBatchServiceClient(s, &testdatamcp.MockBatchServiceHandler{}, tmpl)
+	})
+	g.Expect(tools[testdatamcp.TestService_GetItemToolName].Description).To(Equal(
+		"[TestService.GetItem] GetItem retrieves an item by ID Route: /testdata.TestService/GetItem."))
+	// The batch tool of a method is described under the template of its method.
+	g.Expect(tools["lookup_widget_batch"].Description).To(HavePrefix("[BatchService.LookupWidget] Runs lookup_widget for each of up to 100 requests."))
+	g.Expect(tools["lookup_widget_batch"].Description).To(HaveSuffix(" Route: /testdata.BatchService/LookupWidget."))
+
+	// Without the option, descriptions are the generated ones.
+	tools = registeredTools(t, func(s *mcpserver.MCPServer) {
+		testdatamcp.ForwardToTestServiceClient(s, testServiceClient{server: &testServer{}})
+	})
+	g.Expect(tools[testdatamcp.TestService_GetItemToolName].Description).To(Equal(testdatamcp.TestService_GetItemTool.Description))
+}
+
+func TestToolDescriptionTemplateUnknownPlaceholderPanics(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	g.Expect(func() {
+		testdatamcp.ForwardToTestServiceClient(s, testServiceClient{server: &testServer{}},
+			runtime.WithToolDescriptionTemplate("{verb}s an item. {comment}"))
+	}).To(PanicWith(MatchError(ContainSubstring("unknown placeholder {verb}"))))
+	g.Expect(listToolNames(t, s)).To(BeEmpty(), "nothing is registered with an invalid template")
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+// toolProvenance returns the proto package and the version stamped into the
+// _meta of the tools of the file: the (mcp.options.file) version, or else the
+// tool_version parameter. Both are empty unless tool_meta is set or there is
+// a version.
+func (g *FileGenerator) toolProvenance() (pkg, version string) {
+	opts, _, _ := getExtension[*mcpoptions.FileOptions](g.f.Desc, mcpoptions.E_File)
+	version = opts.GetVersion()
+	if version == "" {
+		version = g.toolVersion
+	}
+	if !g.toolMeta && version == "" {
+		return "", ""
+	}
+	return string(g.f.Desc.Package()), version
+}
+
+// grpcMethodPath returns the path of meth as gRPC sends it, e.g.
+// "/testdata.TestService/GetItem".
+func grpcMethodPath(meth *protogen.Method) string {
+	return "/" + string(meth.Parent.Desc.FullName()) + "/" + string(meth.Desc.Name())
+}
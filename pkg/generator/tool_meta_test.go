@@ -0,0 +1,111 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// registeredTools returns the tools of s as registered, before they are
+// encoded for tools/list.
+func registeredTools(t *testing.T, register func(s *mcpserver.MCPServer)) map[string]mcp.Tool {
+	t.Helper()
+	tools := map[string]mcp.Tool{}
+	hooks := &mcpserver.Hooks{}
+	hooks.AddAfterListTools(func(_ context.Context, _ any, _ *mcp.ListToolsRequest, result *mcp.ListToolsResult) {
+		for _, tool := range result.Tools {
+			tools[tool.Name] = tool
+		}
+	})
+	s := mcpserver.NewMCPServer("test-server", "1.0.0", mcpserver.WithHooks(hooks))
+	register(s)
+	s.HandleMessage(context.Background(), json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+	return tools
+}
+
+func TestToolMetaFileVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	tools := registeredTools(t, func(s *mcpserver.MCPServer) {
+		testdatamcp.ForwardToTransferServiceClient(s, &testdatamcp.MockTransferServiceHandler{})
+		testdatamcp.ForwardToTestServiceClient(s, testServiceClient{server: &testServer{}})
+	})
+	want := &mcp.Meta{AdditionalFields: map[string]any{"package": "testdata", "version": "1.4.0"}}
+	g.Expect(tools["record_transfer"].Meta).To(Equal(want))
+	g.Expect(tools["record_transfer_batch"].Meta).To(Equal(want))
+	g.Expect(tools[testdatamcp.TestService_GetItemToolName].Meta).To(BeNil(), "files without a version are not stamped by default")
+}
+
+func TestToolMetaConfig(t *testing.T) {
+	t.Run("tool_meta", func(t *testing.T) {
+		g := NewWithT(t)
+		content := generatedGoFile(t, testdata.File_testdata_test_service_proto, GenerateConfig{ToolMeta: true})
+		g.Expect(content).To(MatchRegexp(`TestService_GetItemTool\s+= runtime.Tool\{.*, Package: "testdata"\}`))
+		g.Expect(content).To(ContainSubstring("Meta:           runtime.ToolMeta(GetItemToolDef),"))
+	})
+
+	t.Run("tool_version", func(t *testing.T) {
+		g := NewWithT(t)
+		content := generatedGoFile(t, testdata.File_testdata_test_service_proto, GenerateConfig{ToolVersion: "2.0.0"})
+		g.Expect(content).To(ContainSubstring(`Package: "testdata", Version: "2.0.0"}`))
+	})
+
+	t.Run("file version wins", func(t *testing.T) {
+		g := NewWithT(t)
+		content := generatedGoFile(t, testdata.File_testdata_tool_meta_test_proto, GenerateConfig{ToolVersion: "2.0.0"})
+		g.Expect(content).To(ContainSubstring(`Package: "testdata", Version: "1.4.0"}`))
+		g.Expect(content).ToNot(ContainSubstring("2.0.0"))
+	})
+
+	t.Run("grpc_method", func(t *testing.T) {
+		g := NewWithT(t)
+		content := generatedGoFile(t, testdata.File_testdata_test_service_proto, GenerateConfig{GRPCMethod: true})
+		for _, path := range []string{testdata.TestService_CreateItem_FullMethodName, testdata.TestService_GetItem_FullMethodName} {
+			g.Expect(content).To(ContainSubstring(`, Method: "` + path + `"}`))
+			g.Expect(content).To(ContainSubstring(`\"x-grpc-method\":\"` + path + `\"`))
+		}
+		g.Expect(content).To(ContainSubstring("Meta:           runtime.ToolMeta(GetItemToolDef),"))
+
+		// The batch tool carries the path at its root, not in its items.
+		content = generatedGoFile(t, testdata.File_testdata_batch_test_proto, GenerateConfig{GRPCMethod: true})
+		match := regexp.MustCompile(`BatchService_LookupWidgetBatchTool\s+= runtime.Tool\{.*?JSONSchema: ("(?:[^"\\]|\\.)*")`).FindStringSubmatch(content)
+		g.Expect(match).To(HaveLen(2))
+		raw, err := strconv.Unquote(match[1])
+		g.Expect(err).ToNot(HaveOccurred())
+		var schema map[string]any
+		g.Expect(json.Unmarshal([]byte(raw), &schema)).To(Succeed())
+		g.Expect(schema).To(HaveKeyWithValue("x-grpc-method", testdata.BatchService_LookupWidget_FullMethodName))
+		g.Expect(schema).To(HaveKeyWithValue("properties", HaveKeyWithValue("requests", HaveKeyWithValue("items", Not(HaveKey("x-grpc-method"))))))
+		g.Expect(content).To(ContainSubstring("Meta:           runtime.ToolMeta(LookupWidgetBatchToolDef),"))
+	})
+
+	t.Run("off", func(t *testing.T) {
+		g := NewWithT(t)
+		content := generatedGoFile(t, testdata.File_testdata_test_service_proto, GenerateConfig{})
+		g.Expect(content).ToNot(ContainSubstring("runtime.ToolMeta"))
+		g.Expect(content).ToNot(ContainSubstring("x-grpc-method"))
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// listToolNames returns the names reported by tools/list.
+func listToolNames(t *testing.T, s *mcpserver.MCPServer) []string {
+	t.Helper()
+	msg := json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`)
+	raw, err := json.Marshal(s.HandleMessage(context.Background(), msg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp struct {
+		Result struct {
+			Tools []struct {
+				Name string `json:"name"`
+			} `json:"tools"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(resp.Result.Tools))
+	for _, tool := range resp.Result.Tools {
+		names = append(names, tool.Name)
+	}
+	return names
+}
+
+func TestToolNameOverride(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, testServiceClient{server: &testServer{}},
+		runtime.WithToolNameOverride(map[string]string{
+			"testdata.TestService.GetItem": "fetch_item",
+		}),
+	)
+
+	names := listToolNames(t, s)
+	g.Expect(names).To(ContainElement("fetch_item"))
+	g.Expect(names).ToNot(ContainElement(testdatamcp.TestService_GetItemTool.Name))
+	g.Expect(names).To(ContainElement(testdatamcp.TestService_CreateItemTool.Name), "other tools keep their names")
+
+	text := resultText(g, callTool(t, s, "fetch_item", map[string]any{"id": "item-7"}))
+	g.Expect(text).To(ContainSubstring("item-7"))
+
+	resp := callTool(t, s, testdatamcp.TestService_GetItemTool.Name, map[string]any{"id": "item-7"})
+	g.Expect(resp).To(HaveKey("error"), "the generated name is no longer registered")
+}
+
+func TestToolNameOverrideCollisionPanics(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	g.Expect(func() {
+		testdatamcp.ForwardToTestServiceClient(s, testServiceClient{server: &testServer{}},
+			runtime.WithToolNameOverride(map[string]string{
+				"testdata.TestService.GetItem":    "item",
+				"testdata.TestService.CreateItem": "item",
+			}),
+		)
+	}).To(PanicWith(MatchError(ContainSubstring(`"item"`))))
+	g.Expect(listToolNames(t, s)).To(BeEmpty(), "nothing is registered on collision")
+}
+
+func TestToolNameConstants(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(testdatamcp.TestService_GetItemToolName).To(Equal(testdatamcp.TestService_GetItemTool.Name))
+	g.Expect(testdatamcp.TestService_GetItemFullMethod).To(Equal("testdata.TestService.GetItem"))
+	g.Expect(testdatamcp.BatchService_LookupWidgetToolName).To(Equal("lookup_widget"))
+	g.Expect(testdatamcp.BatchService_LookupWidgetBatchToolName).To(Equal(testdatamcp.BatchService_LookupWidgetBatchTool.Name))
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, testServiceClient{server: &testServer{}},
+		runtime.WithToolNameOverride(map[string]string{
+			testdatamcp.TestService_GetItemFullMethod: "fetch_item",
+		}),
+	)
+	names := listToolNames(t, s)
+	g.Expect(names).To(ContainElements("fetch_item", testdatamcp.TestService_CreateItemToolName))
+	g.Expect(names).ToNot(ContainElement(testdatamcp.TestService_GetItemToolName))
+}
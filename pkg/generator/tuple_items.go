@@ -0,0 +1,98 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+)
+
+// tupleItems returns the (mcp.options.tuple_items) of fd, one schema per
+// position, or nil when none is set. Each must be a JSON object that compiles
+// as JSON Schema, and fd must be a repeated field.
+func tupleItems(fd protoreflect.FieldDescriptor) ([]map[string]any, error) {
+	texts, ok, err := getExtension[[]string](fd, mcpoptions.E_TupleItems)
+	if err != nil || !ok || len(texts) == 0 {
+		return nil, err
+	}
+	if !fd.IsList() {
+		return nil, fmt.Errorf("mcpgen: %s has (mcp.options.tuple_items) but is not a repeated field", fd.FullName())
+	}
+
+	items := make([]map[string]any, 0, len(texts))
+	for i, text := range texts {
+		var item any
+		if err := json.Unmarshal([]byte(strings.TrimSpace(text)), &item); err != nil {
+			return nil, fmt.Errorf("mcpgen: %s has an invalid (mcp.options.tuple_items) at position %d: %w", fd.FullName(), i, err)
+		}
+		object, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("mcpgen: %s has an invalid (mcp.options.tuple_items) at position %d: must be a JSON object", fd.FullName(), i)
+		}
+		if _, err := compileSchema(object); err != nil {
+			return nil, fmt.Errorf("mcpgen: %s has an invalid (mcp.options.tuple_items) at position %d: %w", fd.FullName(), i, err)
+		}
+		items = append(items, object)
+	}
+	return items, nil
+}
+
+// tupleSchema returns the array schema of a field with the tuple items
+// positions, whose elements have the schema item. Each position is item
+// overlaid with its own keywords. items stays item, so that clients without
+// prefixItems support still learn the element type; with maxItems there is
+// no element it could apply to besides.
+func tupleSchema(item map[string]any, positions []map[string]any) map[string]any {
+	prefixItems := make([]any, 0, len(positions))
+	for _, position := range positions {
+		schema := deepCopySchema(item)
+		for key, value := range position {
+			schema[key] = value
+		}
+		prefixItems = append(prefixItems, schema)
+	}
+	return map[string]any{
+		"type":        "array",
+		"prefixItems": prefixItems,
+		"items":       item,
+		"minItems":    len(positions),
+		"maxItems":    len(positions),
+	}
+}
+
+// checkTupleItems reports every invalid (mcp.options.tuple_items) in
+// messages and their nested messages. Schema generation then only sees valid
+// annotations.
+func (g *FileGenerator) checkTupleItems(messages []*protogen.Message) bool {
+	ok := true
+	for _, msg := range messages {
+		for _, field := range msg.Fields {
+			if _, err := tupleItems(field.Desc); err != nil {
+				g.gen.Error(err)
+				ok = false
+			}
+		}
+		if !g.checkTupleItems(msg.Messages) {
+			ok = false
+		}
+	}
+	return ok
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	mcpoptions "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestTupleItemsGolden(t *testing.T) {
+	g := NewWithT(t)
+
+	var schema struct {
+		Properties map[string]map[string]any `json:"properties"`
+	}
+	g.Expect(json.Unmarshal([]byte(testdatamcp.PlaceService_AddPlaceTool.JSONSchema), &schema)).To(Succeed())
+	g.Expect(schema.Properties["location"]).To(Equal(map[string]any{
+		"type":        "array",
+		"description": "Position of the place.",
+		"items":       map[string]any{"type": "number"},
+		"prefixItems": []any{
+			map[string]any{"type": "number", "description": "Latitude in degrees.", "minimum": -90.0, "maximum": 90.0},
+			map[string]any{"type": "number", "description": "Longitude in degrees.", "minimum": -180.0, "maximum": 180.0},
+		},
+		"minItems": 2.0,
+		"maxItems": 2.0,
+	}))
+	g.Expect(schema.Properties["keywords"]).ToNot(HaveKey("prefixItems"), "unannotated repeated fields stay plain arrays")
+
+	props := map[string]any{"properties": schema.Properties}
+	g.Expect(validateAgainstSchema(props, map[string]any{"location": []any{52.5, 13.4}})).To(Succeed())
+	g.Expect(validateAgainstSchema(props, map[string]any{"location": []any{52.5}})).ToNot(Succeed())
+	g.Expect(validateAgainstSchema(props, map[string]any{"location": []any{152.5, 13.4}})).ToNot(Succeed())
+}
+
+func TestTupleItemsForwardsList(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToPlaceServiceClient(s, &testdatamcp.MockPlaceServiceHandler{
+		AddPlaceFunc: func(_ context.Context, req *testdata.AddPlaceRequest) (*testdata.AddPlaceResponse, error) {
+			return &testdata.AddPlaceResponse{Id: req.GetName(), Location: req.GetLocation()}, nil
+		},
+	})
+	resp := callTool(t, s, testdatamcp.PlaceService_AddPlaceToolName, map[string]any{
+		"name":     "gate",
+		"location": []any{52.5163, 13.3777},
+	})
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"id":"gate","location":[52.5163,13.3777]}`))
+}
+
+// tupleItemsField compiles a message with a single field "f" carrying items
+// as its (mcp.options.tuple_items).
+func tupleItemsField(t *testing.T, label descriptorpb.FieldDescriptorProto_Label, items ...string) protoreflect.FieldDescriptor {
+	t.Helper()
+	field := &descriptorpb.FieldDescriptorProto{
+		Name:    proto.String("f"),
+		Number:  proto.Int32(1),
+		Label:   label.Enum(),
+		Type:    descriptorpb.FieldDescriptorProto_TYPE_DOUBLE.Enum(),
+		Options: &descriptorpb.FieldOptions{},
+	}
+	proto.SetExtension(field.Options, mcpoptions.E_TupleItems, items)
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("tuple/test.proto"),
+		Package: proto.String("tuple"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:  proto.String("M"),
+			Field: []*descriptorpb.FieldDescriptorProto{field},
+		}},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fd.Messages().Get(0).Fields().Get(0)
+}
+
+func TestTupleItemsErrors(t *testing.T) {
+	repeated := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	tests := []struct {
+		name    string
+		field   protoreflect.FieldDescriptor
+		wantErr string
+	}{
+		{
+			name:    "not repeated",
+			field:   tupleItemsField(t, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, `{}`),
+			wantErr: "tuple.M.f has (mcp.options.tuple_items) but is not a repeated field",
+		},
+		{
+			name:    "invalid JSON",
+			field:   tupleItemsField(t, repeated, `{}`, `{minimum: 0}`),
+			wantErr: "tuple.M.f has an invalid (mcp.options.tuple_items) at position 1",
+		},
+		{
+			name:    "not an object",
+			field:   tupleItemsField(t, repeated, `true`),
+			wantErr: "tuple.M.f has an invalid (mcp.options.tuple_items) at position 0: must be a JSON object",
+		},
+		{
+			name:    "invalid schema",
+			field:   tupleItemsField(t, repeated, `{"minimum": "zero"}`),
+			wantErr: "tuple.M.f has an invalid (mcp.options.tuple_items) at position 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := tupleItems(tt.field)
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+// uiHintsSchema returns the properties of the input schema of the message
+// named name in file, generated with ui_hints.
+func uiHintsSchema(t *testing.T, file protoreflect.FileDescriptor, name protoreflect.Name) map[string]any {
+	t.Helper()
+	plugin, err := protogen.Options{}.New(codeGeneratorRequest(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fg := NewFileGenerator(plugin.FilesByPath[file.Path()], plugin)
+	fg.uiHints = true
+	return fg.messageSchema(file.Messages().ByName(name))["properties"].(map[string]any)
+}
+
+func TestUIHintsOrder(t *testing.T) {
+	g := NewWithT(t)
+
+	props := uiHintsSchema(t, testdata.File_testdata_test_service_proto, "CreateItemRequest")
+	order := map[string]any{}
+	for name, prop := range props {
+		order[name] = prop.(map[string]any)["x-order"]
+	}
+	// The oneof wrapper takes the place of its first field, product.
+	g.Expect(order).To(Equal(map[string]any{
+		"name":               0,
+		"description":        1,
+		"labels":             2,
+		"tags":               3,
+		"item_typeOneOfType": 4,
+		"thumbnail":          6,
+	}))
+}
+
+func TestUIHintsDeprecated(t *testing.T) {
+	g := NewWithT(t)
+
+	props := uiHintsSchema(t, testdata.File_testdata_deprecated_test_proto, "GetInvoiceRequest")
+	g.Expect(props["legacy_id"]).To(HaveKeyWithValue("deprecated", true))
+	g.Expect(props["number"]).ToNot(HaveKey("deprecated"))
+}
+
+func TestUIHintsOffByDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(testdatamcp.InvoiceService_GetInvoiceTool.JSONSchema).ToNot(ContainSubstring("x-order"))
+	g.Expect(testdatamcp.InvoiceService_GetInvoiceTool.JSONSchema).ToNot(ContainSubstring("deprecated"))
+}
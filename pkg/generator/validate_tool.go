@@ -0,0 +1,50 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// validateToolSuffix is appended to the name of a service to name its
+// validation tool.
+const validateToolSuffix = "_validate"
+
+// ValidateTool is the tool generated under validate_tool that checks the
+// arguments of the other tools of a service without calling them; see
+// runtime.NewValidationTool.
+type ValidateTool struct {
+	// Name is the tool name as generated.
+	Name string
+	// Key is the runtime.WithToolNameOverride key of the tool.
+	Key string
+	// Service is the fully-qualified name of the service.
+	Service string
+}
+
+// serviceValidateTool names the validation tool of svc, e.g.
+// "testdata_TestService_validate", failing if another tool has the name.
+func (g *FileGenerator) serviceValidateTool(svc *protogen.Service) (*ValidateTool, error) {
+	service := string(svc.Desc.FullName())
+	name := MangleHeadIfTooLong(strings.ReplaceAll(service, ".", "_")+validateToolSuffix, MaxToolNameLength)
+	if prev, dup := g.seenToolNames[name]; dup {
+		return nil, fmt.Errorf("mcpgen: duplicate MCP tool name %q on %s and %s", name, prev.Method, service)
+	}
+	g.seenToolNames[name] = ToolNameEntry{Method: svc.Desc.FullName(), Annotated: true}
+	return &ValidateTool{Name: name, Key: service + "#validate", Service: service}, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+
+	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+	"github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/testdatamcp"
+)
+
+func TestValidateToolConfig(t *testing.T) {
+	t.Run("validate_tool", func(t *testing.T) {
+		g := NewWithT(t)
+		content := generatedGoFile(t, testdata.File_testdata_test_service_proto, GenerateConfig{ValidateTool: true})
+		g.Expect(content).To(MatchRegexp(`TestService_ValidateToolName\s+= "testdata_TestService_validate"`))
+		g.Expect(content).To(MatchRegexp(`"testdata.TestService#validate":\s+TestService_ValidateToolName,`))
+		g.Expect(content).To(ContainSubstring(`_, err := parseTestServiceCreateItemArgs(args, config)`))
+		g.Expect(content).To(ContainSubstring(`runtime.NewValidationTool(toolNames["testdata.TestService#validate"], "testdata.TestService", validationTargets)`))
+	})
+
+	t.Run("default", func(t *testing.T) {
+		g := NewWithT(t)
+		content := generatedGoFile(t, testdata.File_testdata_test_service_proto, GenerateConfig{})
+		g.Expect(content).ToNot(ContainSubstring("ValidateToolName"))
+		g.Expect(content).ToNot(ContainSubstring("validationTargets"))
+	})
+}
+
+// TestValidationTool checks arguments for CreateItem as the generated
+// validation tool does, against the schema and the Parse function of the
+// generated tool.
+func TestValidationTool(t *testing.T) {
+	g := NewWithT(t)
+
+	tools := registeredTools(t, func(s *mcpserver.MCPServer) {
+		testdatamcp.ForwardToTestServiceClient(s, &testdatamcp.MockTestServiceHandler{})
+	})
+	tool, handler, err := runtime.NewValidationTool("testdata_TestService_validate", "testdata.TestService", []runtime.ValidationTarget{{
+		Tool: tools[testdatamcp.TestService_CreateItemToolName],
+		Parse: func(args map[string]interface{}) error {
+			_, err := testdatamcp.ParseTestServiceCreateItemArgs(args)
+			return err
+		},
+	}})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	created := false
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	testdatamcp.ForwardToTestServiceClient(s, &testdatamcp.MockTestServiceHandler{
+		CreateItemFunc: func(context.Context, *testdata.CreateItemRequest) (*testdata.CreateItemResponse, error) {
+			created = true
+			return &testdata.CreateItemResponse{}, nil
+		},
+	})
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handler(ctx, request)
+	})
+
+	resp := callTool(t, s, tool.Name, map[string]any{
+		"tool": testdatamcp.TestService_CreateItemToolName,
+		"arguments": map[string]any{
+			"name":               "lamp",
+			"thumbnail":          "aGk=",
+			"item_typeOneOfType": map[string]any{"object_type": "product", "product": map[string]any{"price": 1.5}},
+		},
+	})
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"valid": true}`))
+
+	resp = callTool(t, s, tool.Name, map[string]any{
+		"tool": testdatamcp.TestService_CreateItemToolName,
+		"arguments": map[string]any{
+			"name":               7,
+			"thumbnail":          "aGk=",
+			"item_typeOneOfType": map[string]any{"object_type": "product", "product": map[string]any{"price": 1.5}},
+		},
+	})
+	g.Expect(resultText(g, resp)).To(MatchJSON(`{"valid": false, "violations": [{"path": "/name", "message": "got number, want string"}]}`))
+	g.Expect(created).To(BeFalse())
+}
@@ -22,11 +22,72 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// RepeatedRendering selects how the items of a repeated message field are
+// rendered in the schema.
+type RepeatedRendering int32
+
+const (
+	// The items render like any other message field: as a $ref into $defs,
+	// or inline under the inline_messages plugin option.
+	RepeatedRendering_REPEATED_RENDERING_UNSPECIFIED RepeatedRendering = 0
+	// The full schema of the message, inline in the items.
+	RepeatedRendering_REPEATED_RENDERING_INLINE RepeatedRendering = 1
+	// A $ref to the schema of the message in $defs.
+	RepeatedRendering_REPEATED_RENDERING_REF RepeatedRendering = 2
+	// An object schema titled with the message name, whose description gives
+	// the number and names of its fields instead of their schemas. Any object
+	// is accepted by the schema.
+	RepeatedRendering_REPEATED_RENDERING_SUMMARY RepeatedRendering = 3
+)
+
+// Enum value maps for RepeatedRendering.
+var (
+	RepeatedRendering_name = map[int32]string{
+		0: "REPEATED_RENDERING_UNSPECIFIED",
+		1: "REPEATED_RENDERING_INLINE",
+		2: "REPEATED_RENDERING_REF",
+		3: "REPEATED_RENDERING_SUMMARY",
+	}
+	RepeatedRendering_value = map[string]int32{
+		"REPEATED_RENDERING_UNSPECIFIED": 0,
+		"REPEATED_RENDERING_INLINE":      1,
+		"REPEATED_RENDERING_REF":         2,
+		"REPEATED_RENDERING_SUMMARY":     3,
+	}
+)
+
+func (x RepeatedRendering) Enum() *RepeatedRendering {
+	p := new(RepeatedRendering)
+	*p = x
+	return p
+}
+
+func (x RepeatedRendering) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RepeatedRendering) Descriptor() protoreflect.EnumDescriptor {
+	return file_mcp_options_options_proto_enumTypes[0].Descriptor()
+}
+
+func (RepeatedRendering) Type() protoreflect.EnumType {
+	return &file_mcp_options_options_proto_enumTypes[0]
+}
+
+func (x RepeatedRendering) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RepeatedRendering.Descriptor instead.
+func (RepeatedRendering) EnumDescriptor() ([]byte, []int) {
+	return file_mcp_options_options_proto_rawDescGZIP(), []int{0}
+}
+
 // ToolOptions carries the first-class MCP tool metadata for an rpc method.
 // It is the single source of truth for the generated tool's name, title and
-// behavioral hints. The tool description is NOT here: it is taken from the
-// method's leading comment, and per-parameter descriptions from the request
-// message field leading comments.
+// behavioral hints. The tool description defaults to the method's leading
+// comment (see description below), and per-parameter descriptions come from
+// the request message field leading comments.
 //
 // The four hints are tri-state (proto3 optional): a hint that is not set is
 // omitted from the generated mcp.ToolAnnotation, so MCP clients keep applying
@@ -54,7 +115,59 @@ type ToolOptions struct {
 	Idempotent *bool `protobuf:"varint,5,opt,name=idempotent,proto3,oneof" json:"idempotent,omitempty"`
 	// If true, the tool may interact with an "open world" of external entities
 	// (e.g. web search, email delivery, third-party APIs).
-	OpenWorld     *bool `protobuf:"varint,6,opt,name=open_world,json=openWorld,proto3,oneof" json:"open_world,omitempty"`
+	OpenWorld *bool `protobuf:"varint,6,opt,name=open_world,json=openWorld,proto3,oneof" json:"open_world,omitempty"`
+	// Optional complete example request, written either as a JSON object
+	// (protojson) or as text format. It is emitted as the top-level "examples"
+	// entry of the tool's input schema, in the same shape the tool accepts
+	// (oneof wrappers, one-based pagination fields). The generator fails if the
+	// example does not parse or does not validate against the schema.
+	ExampleRequest string `protobuf:"bytes,7,opt,name=example_request,json=exampleRequest,proto3" json:"example_request,omitempty"`
+	// Optional model-facing tool description. When set it replaces the
+	// description derived from the method's leading comment, so the prompt can
+	// be tuned without rewriting developer-facing comments.
+	Description string `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	// If true, a companion "<name>_batch" tool is generated whose input is an
+	// array of requests. Each request is forwarded separately and its result or
+	// error is reported per element, so one failure does not fail the batch.
+	Batch bool `protobuf:"varint,9,opt,name=batch,proto3" json:"batch,omitempty"`
+	// Optional deadline for the forwarded call, as a Go duration such as "30s"
+	// or "1m30s". It overrides the server-wide runtime.WithCallTimeout for
+	// this method, e.g. to give slow analytics methods more time than fast
+	// lookups. The generator fails if it does not parse or is not positive.
+	Timeout string `protobuf:"bytes,10,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional authorization scopes the caller must hold, e.g. "orders:write".
+	// The generated handler passes them to the runtime.WithScopeChecker of the
+	// registration before touching the arguments, and fails the call with a
+	// PERMISSION_DENIED tool error when the checker rejects them. The generator
+	// fails on an empty scope.
+	Scopes []string `protobuf:"bytes,11,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Generate a tool for this server-streaming method. Instead of waiting for
+	// the stream to end, the tool opens it and returns the URI of an MCP
+	// resource holding the latest message; a notifications/resources/updated
+	// is sent to the caller as each message arrives. The generator fails when
+	// the method is not server-streaming, or is also a batch tool.
+	StreamResource bool `protobuf:"varint,12,opt,name=stream_resource,json=streamResource,proto3" json:"stream_resource,omitempty"`
+	// Media type of the tool result: "text/plain", "text/markdown" or
+	// "application/json". The generated handler stamps it as "mimeType" into
+	// the _meta of the text content holding the response, so that chat
+	// clients can render e.g. markdown. Results compressed to TOON are left
+	// unmarked. The generator fails on other values.
+	ResultContentType string `protobuf:"bytes,13,opt,name=result_content_type,json=resultContentType,proto3" json:"result_content_type,omitempty"`
+	// Optional Go text/template producing the tool result from the response,
+	// e.g. "# {{.title}}\n\n{{.body}}". It runs over the response as JSON
+	// with proto field names, after response transformers, the response field
+	// allowlist and result post-processors, and its output replaces the JSON
+	// text. It implies result_content_type "text/markdown" when that is not
+	// set. The generator fails when the template does not parse, when
+	// result_content_type is "application/json", or on a stream_resource
+	// method.
+	ResultTemplate string `protobuf:"bytes,14,opt,name=result_template,json=resultTemplate,proto3" json:"result_template,omitempty"`
+	// If true, a companion "<name>_raw" tool is generated whose only argument
+	// is "request", a string holding the arguments of this tool as JSON, for
+	// clients that only pass a single string argument. The handler parses the
+	// string and runs it like a call of this tool. The raw_tools plugin option
+	// generates the companion for every method.
+	Raw           bool `protobuf:"varint,15,opt,name=raw,proto3" json:"raw,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,6 +244,218 @@ func (x *ToolOptions) GetOpenWorld() bool {
 	return false
 }
 
+func (x *ToolOptions) GetExampleRequest() string {
+	if x != nil {
+		return x.ExampleRequest
+	}
+	return ""
+}
+
+func (x *ToolOptions) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ToolOptions) GetBatch() bool {
+	if x != nil {
+		return x.Batch
+	}
+	return false
+}
+
+func (x *ToolOptions) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+func (x *ToolOptions) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ToolOptions) GetStreamResource() bool {
+	if x != nil {
+		return x.StreamResource
+	}
+	return false
+}
+
+func (x *ToolOptions) GetResultContentType() string {
+	if x != nil {
+		return x.ResultContentType
+	}
+	return ""
+}
+
+func (x *ToolOptions) GetResultTemplate() string {
+	if x != nil {
+		return x.ResultTemplate
+	}
+	return ""
+}
+
+func (x *ToolOptions) GetRaw() bool {
+	if x != nil {
+		return x.Raw
+	}
+	return false
+}
+
+// EnumValueOptions carries model-facing metadata for an enum value.
+type EnumValueOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional model-facing description of the value. When set it is used
+	// instead of the value's leading comment in the description of every field
+	// of the enum type.
+	Description   string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnumValueOptions) Reset() {
+	*x = EnumValueOptions{}
+	mi := &file_mcp_options_options_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnumValueOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumValueOptions) ProtoMessage() {}
+
+func (x *EnumValueOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_options_options_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumValueOptions.ProtoReflect.Descriptor instead.
+func (*EnumValueOptions) Descriptor() ([]byte, []int) {
+	return file_mcp_options_options_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnumValueOptions) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// MessageOptions carries schema metadata for a message.
+type MessageOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// If true, the schema of the message sets additionalProperties to true,
+	// telling clients that assume closed objects that properties beyond the
+	// declared fields are accepted. Other messages leave additionalProperties
+	// unset. Only the schema changes: the generated handler decodes arguments
+	// with protojson's DiscardUnknown, so the extra properties never reach the
+	// gRPC request. Those at the top level of the arguments can be read with
+	// runtime.WithExtraProperties; those nested in the message are dropped.
+	AllowAdditional bool `protobuf:"varint,1,opt,name=allow_additional,json=allowAdditional,proto3" json:"allow_additional,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MessageOptions) Reset() {
+	*x = MessageOptions{}
+	mi := &file_mcp_options_options_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageOptions) ProtoMessage() {}
+
+func (x *MessageOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_options_options_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageOptions.ProtoReflect.Descriptor instead.
+func (*MessageOptions) Descriptor() ([]byte, []int) {
+	return file_mcp_options_options_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MessageOptions) GetAllowAdditional() bool {
+	if x != nil {
+		return x.AllowAdditional
+	}
+	return false
+}
+
+// FileOptions carries metadata for every tool generated from a file.
+type FileOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of the service the file defines, e.g. "1.4.0". It is stamped
+	// into the _meta of every tool of the file, next to the proto package, so
+	// that clients and audits can tell which service version a tool came
+	// from. It takes precedence over the tool_version plugin parameter.
+	Version       string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileOptions) Reset() {
+	*x = FileOptions{}
+	mi := &file_mcp_options_options_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileOptions) ProtoMessage() {}
+
+func (x *FileOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_options_options_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileOptions.ProtoReflect.Descriptor instead.
+func (*FileOptions) Descriptor() ([]byte, []int) {
+	return file_mcp_options_options_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FileOptions) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
 var file_mcp_options_options_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
@@ -140,6 +465,46 @@ var file_mcp_options_options_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "varint,52001,opt,name=zero_based_pagination",
 		Filename:      "mcp/options/options.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         52002,
+		Name:          "mcp.options.struct_value_schema",
+		Tag:           "bytes,52002,opt,name=struct_value_schema",
+		Filename:      "mcp/options/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         52003,
+		Name:          "mcp.options.summary",
+		Tag:           "varint,52003,opt,name=summary",
+		Filename:      "mcp/options/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         52004,
+		Name:          "mcp.options.oneof_discriminator",
+		Tag:           "bytes,52004,opt,name=oneof_discriminator",
+		Filename:      "mcp/options/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: ([]string)(nil),
+		Field:         52005,
+		Name:          "mcp.options.tuple_items",
+		Tag:           "bytes,52005,rep,name=tuple_items",
+		Filename:      "mcp/options/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*RepeatedRendering)(nil),
+		Field:         52006,
+		Name:          "mcp.options.repeated_rendering",
+		Tag:           "varint,52006,opt,name=repeated_rendering,enum=mcp.options.RepeatedRendering",
+		Filename:      "mcp/options/options.proto",
+	},
 	{
 		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
 		ExtensionType: (*ToolOptions)(nil),
@@ -148,6 +513,30 @@ var file_mcp_options_options_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,52050,opt,name=tool",
 		Filename:      "mcp/options/options.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*EnumValueOptions)(nil),
+		Field:         52060,
+		Name:          "mcp.options.enum_value",
+		Tag:           "bytes,52060,opt,name=enum_value",
+		Filename:      "mcp/options/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*MessageOptions)(nil),
+		Field:         52070,
+		Name:          "mcp.options.message",
+		Tag:           "bytes,52070,opt,name=message",
+		Filename:      "mcp/options/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*FileOptions)(nil),
+		Field:         52080,
+		Name:          "mcp.options.file",
+		Tag:           "bytes,52080,opt,name=file",
+		Filename:      "mcp/options/options.proto",
+	},
 }
 
 // Extension fields to descriptorpb.FieldOptions.
@@ -160,6 +549,46 @@ var (
 	//
 	// optional bool zero_based_pagination = 52001;
 	E_ZeroBasedPagination = &file_mcp_options_options_proto_extTypes[0]
+	// JSON Schema, written as JSON, for the values of a google.protobuf.Struct
+	// field. It is emitted as the field's additionalProperties instead of
+	// allowing any JSON value, e.g. '{"type": "string"}' for a Struct that is
+	// conventionally a string-to-string map. The request is still forwarded as
+	// a Struct. The generator fails if the text is not a valid schema or the
+	// field is not a Struct.
+	//
+	// optional string struct_value_schema = 52002;
+	E_StructValueSchema = &file_mcp_options_options_proto_extTypes[1]
+	// Marks a string field of a response message as its human-readable
+	// summary. The tool result then has two content blocks: the text of this
+	// field, followed by the full response as JSON. The generator fails if the
+	// field is not a singular string or if more than one field of the message
+	// is marked.
+	//
+	// optional bool summary = 52003;
+	E_Summary = &file_mcp_options_options_proto_extTypes[2]
+	// Value of the "object_type" discriminator that selects this field of a
+	// oneof, in place of the field name, e.g. "text" for a string_value field.
+	// The generated handler maps it back to the field. The generator fails if
+	// the field is not in a oneof or two fields of the oneof share a value.
+	//
+	// optional string oneof_discriminator = 52004;
+	E_OneofDiscriminator = &file_mcp_options_options_proto_extTypes[3]
+	// JSON Schema, written as JSON, for one position of a repeated field that
+	// is conventionally a fixed-length tuple, such as a (latitude, longitude)
+	// pair. Repeat the option once per position. The array schema then has
+	// these as prefixItems, each over the schema of the element type, and
+	// minItems and maxItems set to their number. The generator fails if the
+	// field is not repeated or a text is not a valid JSON Schema object.
+	//
+	// repeated string tuple_items = 52005;
+	E_TupleItems = &file_mcp_options_options_proto_extTypes[4]
+	// How the schema of a repeated message field renders its items, to trade
+	// the detail of large list-heavy requests against their token cost. The
+	// generator fails if the field is not a repeated message field. It has no
+	// effect on well-known types, which have schemas of their own.
+	//
+	// optional mcp.options.RepeatedRendering repeated_rendering = 52006;
+	E_RepeatedRendering = &file_mcp_options_options_proto_extTypes[5]
 )
 
 // Extension fields to descriptorpb.MethodOptions.
@@ -167,14 +596,38 @@ var (
 	// First-class MCP tool metadata for the annotated rpc method.
 	//
 	// optional mcp.options.ToolOptions tool = 52050;
-	E_Tool = &file_mcp_options_options_proto_extTypes[1]
+	E_Tool = &file_mcp_options_options_proto_extTypes[6]
+)
+
+// Extension fields to descriptorpb.EnumValueOptions.
+var (
+	// Model-facing metadata for the annotated enum value.
+	//
+	// optional mcp.options.EnumValueOptions enum_value = 52060;
+	E_EnumValue = &file_mcp_options_options_proto_extTypes[7]
+)
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// Schema metadata for the annotated message.
+	//
+	// optional mcp.options.MessageOptions message = 52070;
+	E_Message = &file_mcp_options_options_proto_extTypes[8]
+)
+
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// Tool metadata for every tool generated from the annotated file.
+	//
+	// optional mcp.options.FileOptions file = 52080;
+	E_File = &file_mcp_options_options_proto_extTypes[9]
 )
 
 var File_mcp_options_options_proto protoreflect.FileDescriptor
 
 const file_mcp_options_options_proto_rawDesc = "" +
 	"\n" +
-	"\x19mcp/options/options.proto\x12\vmcp.options\x1a google/protobuf/descriptor.proto\"\x85\x02\n" +
+	"\x19mcp/options/options.proto\x12\vmcp.options\x1a google/protobuf/descriptor.proto\"\xac\x04\n" +
 	"\vToolOptions\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -184,14 +637,45 @@ const file_mcp_options_options_proto_rawDesc = "" +
 	"idempotent\x18\x05 \x01(\bH\x02R\n" +
 	"idempotent\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"open_world\x18\x06 \x01(\bH\x03R\topenWorld\x88\x01\x01B\f\n" +
+	"open_world\x18\x06 \x01(\bH\x03R\topenWorld\x88\x01\x01\x12'\n" +
+	"\x0fexample_request\x18\a \x01(\tR\x0eexampleRequest\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12\x14\n" +
+	"\x05batch\x18\t \x01(\bR\x05batch\x12\x18\n" +
+	"\atimeout\x18\n" +
+	" \x01(\tR\atimeout\x12\x16\n" +
+	"\x06scopes\x18\v \x03(\tR\x06scopes\x12'\n" +
+	"\x0fstream_resource\x18\f \x01(\bR\x0estreamResource\x12.\n" +
+	"\x13result_content_type\x18\r \x01(\tR\x11resultContentType\x12'\n" +
+	"\x0fresult_template\x18\x0e \x01(\tR\x0eresultTemplate\x12\x10\n" +
+	"\x03raw\x18\x0f \x01(\bR\x03rawB\f\n" +
 	"\n" +
 	"_read_onlyB\x0e\n" +
 	"\f_destructiveB\r\n" +
 	"\v_idempotentB\r\n" +
-	"\v_open_world:S\n" +
-	"\x15zero_based_pagination\x12\x1d.google.protobuf.FieldOptions\x18\xa1\x96\x03 \x01(\bR\x13zeroBasedPagination:N\n" +
-	"\x04tool\x12\x1e.google.protobuf.MethodOptions\x18Җ\x03 \x01(\v2\x18.mcp.options.ToolOptionsR\x04toolB:Z8github.com/shaders/protoc-gen-go-mcp/pkg/options;optionsb\x06proto3"
+	"\v_open_world\"4\n" +
+	"\x10EnumValueOptions\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\";\n" +
+	"\x0eMessageOptions\x12)\n" +
+	"\x10allow_additional\x18\x01 \x01(\bR\x0fallowAdditional\"'\n" +
+	"\vFileOptions\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion*\x92\x01\n" +
+	"\x11RepeatedRendering\x12\"\n" +
+	"\x1eREPEATED_RENDERING_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19REPEATED_RENDERING_INLINE\x10\x01\x12\x1a\n" +
+	"\x16REPEATED_RENDERING_REF\x10\x02\x12\x1e\n" +
+	"\x1aREPEATED_RENDERING_SUMMARY\x10\x03:S\n" +
+	"\x15zero_based_pagination\x12\x1d.google.protobuf.FieldOptions\x18\xa1\x96\x03 \x01(\bR\x13zeroBasedPagination:O\n" +
+	"\x13struct_value_schema\x12\x1d.google.protobuf.FieldOptions\x18\xa2\x96\x03 \x01(\tR\x11structValueSchema:9\n" +
+	"\asummary\x12\x1d.google.protobuf.FieldOptions\x18\xa3\x96\x03 \x01(\bR\asummary:P\n" +
+	"\x13oneof_discriminator\x12\x1d.google.protobuf.FieldOptions\x18\xa4\x96\x03 \x01(\tR\x12oneofDiscriminator:@\n" +
+	"\vtuple_items\x12\x1d.google.protobuf.FieldOptions\x18\xa5\x96\x03 \x03(\tR\n" +
+	"tupleItems:n\n" +
+	"\x12repeated_rendering\x12\x1d.google.protobuf.FieldOptions\x18\xa6\x96\x03 \x01(\x0e2\x1e.mcp.options.RepeatedRenderingR\x11repeatedRendering:N\n" +
+	"\x04tool\x12\x1e.google.protobuf.MethodOptions\x18Җ\x03 \x01(\v2\x18.mcp.options.ToolOptionsR\x04tool:a\n" +
+	"\n" +
+	"enum_value\x12!.google.protobuf.EnumValueOptions\x18ܖ\x03 \x01(\v2\x1d.mcp.options.EnumValueOptionsR\tenumValue:X\n" +
+	"\amessage\x12\x1f.google.protobuf.MessageOptions\x18\xe6\x96\x03 \x01(\v2\x1b.mcp.options.MessageOptionsR\amessage:L\n" +
+	"\x04file\x12\x1c.google.protobuf.FileOptions\x18\xf0\x96\x03 \x01(\v2\x18.mcp.options.FileOptionsR\x04fileB:Z8github.com/shaders/protoc-gen-go-mcp/pkg/options;optionsb\x06proto3"
 
 var (
 	file_mcp_options_options_proto_rawDescOnce sync.Once
@@ -205,21 +689,41 @@ func file_mcp_options_options_proto_rawDescGZIP() []byte {
 	return file_mcp_options_options_proto_rawDescData
 }
 
-var file_mcp_options_options_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_mcp_options_options_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mcp_options_options_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_mcp_options_options_proto_goTypes = []any{
-	(*ToolOptions)(nil),                // 0: mcp.options.ToolOptions
-	(*descriptorpb.FieldOptions)(nil),  // 1: google.protobuf.FieldOptions
-	(*descriptorpb.MethodOptions)(nil), // 2: google.protobuf.MethodOptions
+	(RepeatedRendering)(0),                // 0: mcp.options.RepeatedRendering
+	(*ToolOptions)(nil),                   // 1: mcp.options.ToolOptions
+	(*EnumValueOptions)(nil),              // 2: mcp.options.EnumValueOptions
+	(*MessageOptions)(nil),                // 3: mcp.options.MessageOptions
+	(*FileOptions)(nil),                   // 4: mcp.options.FileOptions
+	(*descriptorpb.FieldOptions)(nil),     // 5: google.protobuf.FieldOptions
+	(*descriptorpb.MethodOptions)(nil),    // 6: google.protobuf.MethodOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 7: google.protobuf.EnumValueOptions
+	(*descriptorpb.MessageOptions)(nil),   // 8: google.protobuf.MessageOptions
+	(*descriptorpb.FileOptions)(nil),      // 9: google.protobuf.FileOptions
 }
 var file_mcp_options_options_proto_depIdxs = []int32{
-	1, // 0: mcp.options.zero_based_pagination:extendee -> google.protobuf.FieldOptions
-	2, // 1: mcp.options.tool:extendee -> google.protobuf.MethodOptions
-	0, // 2: mcp.options.tool:type_name -> mcp.options.ToolOptions
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	2, // [2:3] is the sub-list for extension type_name
-	0, // [0:2] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	5,  // 0: mcp.options.zero_based_pagination:extendee -> google.protobuf.FieldOptions
+	5,  // 1: mcp.options.struct_value_schema:extendee -> google.protobuf.FieldOptions
+	5,  // 2: mcp.options.summary:extendee -> google.protobuf.FieldOptions
+	5,  // 3: mcp.options.oneof_discriminator:extendee -> google.protobuf.FieldOptions
+	5,  // 4: mcp.options.tuple_items:extendee -> google.protobuf.FieldOptions
+	5,  // 5: mcp.options.repeated_rendering:extendee -> google.protobuf.FieldOptions
+	6,  // 6: mcp.options.tool:extendee -> google.protobuf.MethodOptions
+	7,  // 7: mcp.options.enum_value:extendee -> google.protobuf.EnumValueOptions
+	8,  // 8: mcp.options.message:extendee -> google.protobuf.MessageOptions
+	9,  // 9: mcp.options.file:extendee -> google.protobuf.FileOptions
+	0,  // 10: mcp.options.repeated_rendering:type_name -> mcp.options.RepeatedRendering
+	1,  // 11: mcp.options.tool:type_name -> mcp.options.ToolOptions
+	2,  // 12: mcp.options.enum_value:type_name -> mcp.options.EnumValueOptions
+	3,  // 13: mcp.options.message:type_name -> mcp.options.MessageOptions
+	4,  // 14: mcp.options.file:type_name -> mcp.options.FileOptions
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	10, // [10:15] is the sub-list for extension type_name
+	0,  // [0:10] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_mcp_options_options_proto_init() }
@@ -233,13 +737,14 @@ func file_mcp_options_options_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mcp_options_options_proto_rawDesc), len(file_mcp_options_options_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
-			NumExtensions: 2,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 10,
 			NumServices:   0,
 		},
 		GoTypes:           file_mcp_options_options_proto_goTypes,
 		DependencyIndexes: file_mcp_options_options_proto_depIdxs,
+		EnumInfos:         file_mcp_options_options_proto_enumTypes,
 		MessageInfos:      file_mcp_options_options_proto_msgTypes,
 		ExtensionInfos:    file_mcp_options_options_proto_extTypes,
 	}.Build()
@@ -0,0 +1,166 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// anthropicFormats are the string formats Anthropic's tool input schemas
+// accept. Other formats, such as the int64 and byte of the generator, are
+// dropped.
+var anthropicFormats = map[string]bool{
+	"date-time": true,
+	"date":      true,
+	"time":      true,
+	"duration":  true,
+	"email":     true,
+	"hostname":  true,
+	"uri":       true,
+	"ipv4":      true,
+	"ipv6":      true,
+	"uuid":      true,
+}
+
+// anthropicDropped are the keywords ToAnthropicToolSchema removes at every
+// level: identifiers and comments that mean nothing once $refs are inlined,
+// content annotations, and the OpenAPI nullable, which is turned into a null
+// type instead.
+var anthropicDropped = map[string]bool{
+	"$schema":          true,
+	"$id":              true,
+	"$anchor":          true,
+	"$comment":         true,
+	"$defs":            true,
+	"definitions":      true,
+	"nullable":         true,
+	"contentEncoding":  true,
+	"contentMediaType": true,
+}
+
+// ToAnthropicToolSchema adapts schema, the input schema of a generated tool,
+// to the input_schema of an Anthropic tool_use tool definition. The result
+// is an object schema with properties at the top level and no top-level
+// oneOf, anyOf or allOf; its $refs are inlined, and a recursive reference
+// becomes an open object. x- vendor extensions, $-identifiers, comments,
+// content annotations and unsupported formats are dropped, and "nullable":
+// true becomes a "null" type. required lists are kept.
+func ToAnthropicToolSchema(schema json.RawMessage) (json.RawMessage, error) {
+	var root map[string]any
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return nil, fmt.Errorf("invalid input schema: %w", err)
+	}
+	if root == nil {
+		return nil, fmt.Errorf("invalid input schema: not a JSON object")
+	}
+	defs, _ := root["$defs"].(map[string]any)
+	adapted, _ := anthropicValue(root, defs, map[string]bool{}).(map[string]any)
+
+	for _, keyword := range []string{"oneOf", "anyOf", "allOf"} {
+		delete(adapted, keyword)
+	}
+	adapted["type"] = "object"
+	if _, ok := adapted["properties"].(map[string]any); !ok {
+		adapted["properties"] = map[string]any{}
+	}
+	return json.Marshal(adapted)
+}
+
+// anthropicValue returns v, a schema or a value within one, with the $refs
+// into defs inlined and the keywords ToAnthropicToolSchema drops removed.
+// visiting holds the definitions being inlined, to break recursion.
+func anthropicValue(v any, defs map[string]any, visiting map[string]bool) any {
+	switch v := v.(type) {
+	case map[string]any:
+		return anthropicSchema(v, defs, visiting)
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = anthropicValue(item, defs, visiting)
+		}
+		return out
+	}
+	return v
+}
+
+func anthropicSchema(schema map[string]any, defs map[string]any, visiting map[string]bool) map[string]any {
+	if ref, ok := schema["$ref"].(string); ok {
+		name, _ := defName(ref)
+		def, found := defs[name].(map[string]any)
+		merged := map[string]any{}
+		switch {
+		case !found || visiting[name]:
+			// An open object stands in for what cannot be inlined.
+			merged["type"] = "object"
+		default:
+			visiting[name] = true
+			for key, value := range anthropicSchema(def, defs, visiting) {
+				merged[key] = value
+			}
+			delete(visiting, name)
+		}
+		// Keywords next to the $ref, such as the field description, win.
+		for key, value := range schema {
+			if key != "$ref" && key != "type" {
+				merged[key] = anthropicValue(value, defs, visiting)
+			}
+		}
+		return dropAnthropicKeywords(merged)
+	}
+
+	out := make(map[string]any, len(schema))
+	for key, value := range schema {
+		switch key {
+		case "properties", "patternProperties":
+			// Maps of names to schemas; names are not keywords.
+			props, ok := value.(map[string]any)
+			if !ok {
+				out[key] = value
+				continue
+			}
+			adapted := make(map[string]any, len(props))
+			for name, prop := range props {
+				adapted[name] = anthropicValue(prop, defs, visiting)
+			}
+			out[key] = adapted
+		case "enum", "const", "examples", "default", "required":
+			out[key] = value
+		default:
+			out[key] = anthropicValue(value, defs, visiting)
+		}
+	}
+	if nullable, _ := out["nullable"].(bool); nullable {
+		if typ, ok := out["type"].(string); ok {
+			out["type"] = []any{typ, "null"}
+		}
+	}
+	return dropAnthropicKeywords(out)
+}
+
+// dropAnthropicKeywords removes the keywords ToAnthropicToolSchema drops
+// from schema.
+func dropAnthropicKeywords(schema map[string]any) map[string]any {
+	for key := range schema {
+		if anthropicDropped[key] || strings.HasPrefix(key, "x-") {
+			delete(schema, key)
+		}
+	}
+	if format, ok := schema["format"].(string); ok && !anthropicFormats[format] {
+		delete(schema, "format")
+	}
+	return schema
+}
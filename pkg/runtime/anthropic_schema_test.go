@@ -0,0 +1,77 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestToAnthropicToolSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	adapted, err := ToAnthropicToolSchema(json.RawMessage(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"node": {"$ref": "#/$defs/Node", "type": "object", "description": "Root node."},
+			"count": {"type": "integer", "format": "int64", "nullable": true, "x-order": 1},
+			"data": {"type": "string", "format": "byte", "contentEncoding": "base64"},
+			"nullable": {"type": "boolean"}
+		},
+		"required": ["node"],
+		"oneOf": [{"required": ["count"]}, {"required": ["data"]}],
+		"$defs": {
+			"Node": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string", "$comment": "node name"},
+					"children": {"type": "array", "items": {"$ref": "#/$defs/Node", "type": "object"}}
+				},
+				"required": []
+			}
+		}
+	}`))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(adapted).To(MatchJSON(`{
+		"type": "object",
+		"properties": {
+			"node": {
+				"type": "object",
+				"description": "Root node.",
+				"properties": {
+					"name": {"type": "string"},
+					"children": {"type": "array", "items": {"type": "object"}}
+				},
+				"required": []
+			},
+			"count": {"type": ["integer", "null"]},
+			"data": {"type": "string"},
+			"nullable": {"type": "boolean"}
+		},
+		"required": ["node"]
+	}`))
+
+	adapted, err = ToAnthropicToolSchema(json.RawMessage(`{"description": "No arguments."}`))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(adapted).To(MatchJSON(`{"type": "object", "properties": {}, "description": "No arguments."}`))
+
+	_, err = ToAnthropicToolSchema(json.RawMessage(`not json`))
+	g.Expect(err).To(MatchError(ContainSubstring("invalid input schema")))
+	_, err = ToAnthropicToolSchema(json.RawMessage(`null`))
+	g.Expect(err).To(MatchError("invalid input schema: not a JSON object"))
+}
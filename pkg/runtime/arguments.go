@@ -0,0 +1,221 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// NormalizeTopLevelJSONStrings scans m's top level and checks if any fields
+// that should be objects according to the JSON schema are actually strings. If so, it will
+// parse string values that look like JSON and replace them with the parsed value.
+// This handles both OneOf fields and regular object fields.
+func NormalizeTopLevelJSONStrings(
+	m map[string]interface{},
+	toolSchema string,
+) (changed bool) {
+	if m == nil || toolSchema == "" {
+		return false
+	}
+
+	// Parse the tool schema
+	var schema map[string]interface{}
+	if err := json.Unmarshal([]byte(toolSchema), &schema); err != nil {
+		return false
+	}
+
+	// Extract properties from the schema
+	properties, ok := schema["properties"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	// Helper function to check if a schema defines an object type
+	isObjectSchema := func(propSchema map[string]interface{}) bool {
+		// Check if type is "object"
+		if typeVal, ok := propSchema["type"]; ok {
+			if typeStr, ok := typeVal.(string); ok && typeStr == "object" {
+				return true
+			}
+			// Could also be an array of types
+			if typeArr, ok := typeVal.([]interface{}); ok {
+				for _, t := range typeArr {
+					if tStr, ok := t.(string); ok && tStr == "object" {
+						return true
+					}
+				}
+			}
+		}
+
+		// Check if it has properties (inline object)
+		if _, hasProps := propSchema["properties"]; hasProps {
+			return true
+		}
+
+		// Check if it has a $ref (reference to object)
+		if _, hasRef := propSchema["$ref"]; hasRef {
+			return true
+		}
+
+		// Check if it has oneOf (discriminated union - treated as object)
+		if _, hasOneOf := propSchema["oneOf"]; hasOneOf {
+			return true
+		}
+
+		return false
+	}
+
+	// Iterate through all top-level fields in the payload
+	for k, v := range m {
+		// Get the schema for this field
+		propSchema, ok := properties[k]
+		if !ok {
+			continue
+		}
+
+		propSchemaMap, ok := propSchema.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// Check if this field should be an object according to the schema
+		if !isObjectSchema(propSchemaMap) {
+			continue
+		}
+
+		// Check if the actual value is a string
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+
+		// Try to parse it as JSON
+		trim := strings.TrimSpace(s)
+		if trim == "" || !(strings.HasPrefix(trim, "{") || strings.HasPrefix(trim, "[")) {
+			continue
+		}
+
+		var parsed any
+		if err := json.Unmarshal([]byte(trim), &parsed); err != nil {
+			continue // ignore if it's not valid JSON
+		}
+
+		m[k] = parsed
+		changed = true
+	}
+	return changed
+}
+
+// OneOfDiscriminator maps a custom "object_type" value, set with
+// (mcp.options.oneof_discriminator), back to the oneof field it selects.
+type OneOfDiscriminator struct {
+	// Key is the wrapper property of the oneof, e.g. "kindOneOfType".
+	Key string
+	// Value is the "object_type" value sent by the client.
+	Value string
+	// Field is the protobuf name of the selected field.
+	Field string
+}
+
+// OneOfTypeSuffix ends the wrapper property of a oneof in the default naming,
+// e.g. "kindOneOfType".
+const OneOfTypeSuffix = "OneOfType"
+
+// TransformOneOfFields transforms discriminated union fields back to protobuf oneOf format.
+// It fails when m is nested more than maxDepth levels deep; maxDepth <= 0 means no limit.
+// An "object_type" listed in discriminators selects its mapped field; any other
+// value is taken as the field name.
+func TransformOneOfFields(m map[string]interface{}, maxDepth int, discriminators ...OneOfDiscriminator) error {
+	return TransformOneOfFieldsWithKeySuffix(m, maxDepth, OneOfTypeSuffix, discriminators...)
+}
+
+// TransformOneOfFieldsWithKeySuffix is TransformOneOfFields for wrapper
+// properties ending in suffix instead of OneOfTypeSuffix, as generated with
+// the oneof_key option, e.g. "OneOf" for "kindOneOf".
+func TransformOneOfFieldsWithKeySuffix(m map[string]interface{}, maxDepth int, suffix string, discriminators ...OneOfDiscriminator) error {
+	return transformOneOfFields(m, 1, maxDepth, suffix, discriminators)
+}
+
+// oneOfField returns the field selected by the object_type value typeStr of
+// the wrapper key.
+func oneOfField(key, typeStr string, discriminators []OneOfDiscriminator) string {
+	for _, d := range discriminators {
+		if d.Key == key && d.Value == typeStr {
+			return d.Field
+		}
+	}
+	return typeStr
+}
+
+// transformOneOfFields recursively transforms oneOf fields in nested objects
+func transformOneOfFields(obj interface{}, depth, maxDepth int, suffix string, discriminators []OneOfDiscriminator) error {
+	switch v := obj.(type) {
+	case map[string]interface{}:
+		if maxDepth > 0 && depth > maxDepth {
+			return NestingTooDeepError(maxDepth)
+		}
+		// Transform oneOf fields in this object
+		for key, value := range v {
+			// Check if this looks like a oneOf discriminated union (must have the wrapper suffix)
+			if strings.HasSuffix(key, suffix) {
+				if unionObj, ok := value.(map[string]interface{}); ok {
+					if typeField, hasType := unionObj["object_type"]; hasType {
+						if typeStr, ok := typeField.(string); ok {
+							field := oneOfField(key, typeStr, discriminators)
+							// First try to extract the field that matches the object_type
+							// (for message types with $ref)
+							if fieldValue, hasField := unionObj[field]; hasField {
+								// Move the field value directly to the parent level
+								v[field] = fieldValue
+								delete(v, key)
+							} else {
+								// Fall back to old logic: create object without object_type field
+								// (for primitive types or inline objects)
+								variantObj := make(map[string]interface{})
+								for k, val := range unionObj {
+									if k != "object_type" {
+										variantObj[k] = val
+									}
+								}
+								// Replace the union object with the variant object
+								v[field] = variantObj
+								delete(v, key)
+							}
+						}
+					}
+				}
+			}
+		}
+
+		// Recursively process all values
+		for _, value := range v {
+			if err := transformOneOfFields(value, depth+1, maxDepth, suffix, discriminators); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		if maxDepth > 0 && depth > maxDepth {
+			return NestingTooDeepError(maxDepth)
+		}
+		// Process array elements
+		for _, item := range v {
+			if err := transformOneOfFields(item, depth+1, maxDepth, suffix, discriminators); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
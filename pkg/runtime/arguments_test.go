@@ -0,0 +1,75 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestTransformOneOfFields(t *testing.T) {
+	g := NewWithT(t)
+
+	m := map[string]interface{}{
+		"name": "widget",
+		"kindOneOfType": map[string]interface{}{
+			"object_type": "product",
+			"product":     map[string]interface{}{"price": 3.5},
+		},
+		"parts": []interface{}{
+			map[string]interface{}{
+				"sizeOneOfType": map[string]interface{}{"object_type": "dimensions", "width": 2.0},
+			},
+		},
+	}
+	g.Expect(TransformOneOfFields(m, DefaultMaxNestingDepth)).To(Succeed())
+	g.Expect(m).To(Equal(map[string]interface{}{
+		"name":    "widget",
+		"product": map[string]interface{}{"price": 3.5},
+		"parts": []interface{}{
+			map[string]interface{}{"dimensions": map[string]interface{}{"width": 2.0}},
+		},
+	}))
+}
+
+func TestTransformOneOfFieldsDiscriminators(t *testing.T) {
+	g := NewWithT(t)
+
+	discriminators := []OneOfDiscriminator{{Key: "valueOneOfType", Value: "text", Field: "string_value"}}
+	m := map[string]interface{}{
+		"valueOneOfType": map[string]interface{}{"object_type": "text", "string_value": "hi"},
+		"other": map[string]interface{}{
+			"valueOneOfType": map[string]interface{}{"object_type": "bool_value", "bool_value": true},
+		},
+	}
+	g.Expect(TransformOneOfFields(m, DefaultMaxNestingDepth, discriminators...)).To(Succeed())
+	g.Expect(m).To(Equal(map[string]interface{}{
+		"string_value": "hi",
+		"other":        map[string]interface{}{"bool_value": true},
+	}))
+}
+
+func TestNormalizeTopLevelJSONStrings(t *testing.T) {
+	g := NewWithT(t)
+
+	schema := `{"type":"object","properties":{"item":{"$ref":"#/$defs/Item"},"name":{"type":"string"}}}`
+	m := map[string]interface{}{"item": `{"id":"a"}`, "name": `{"id":"b"}`}
+	g.Expect(NormalizeTopLevelJSONStrings(m, schema)).To(BeTrue())
+	g.Expect(m["item"]).To(Equal(map[string]interface{}{"id": "a"}))
+	g.Expect(m["name"]).To(Equal(`{"id":"b"}`), "string fields are left alone")
+
+	g.Expect(NormalizeTopLevelJSONStrings(map[string]interface{}{"item": "not json"}, schema)).To(BeFalse())
+}
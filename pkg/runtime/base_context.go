@@ -0,0 +1,52 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import "context"
+
+// WithBaseContext makes the values of base, such as a logger or server-wide
+// dependencies, visible to every call of the registration: to scope checkers,
+// request interceptors, client resolvers, the forwarded call and response
+// transformers. A value of the MCP request context wins over the value of
+// base for the same key. The deadline and cancellation of a call are those
+// of its MCP request; those of base are ignored.
+func WithBaseContext(base context.Context) Option {
+	return func(c *Config) {
+		c.BaseContext = base
+	}
+}
+
+// MergeBaseContext returns ctx with the values of base as a fallback, or ctx
+// itself when base is nil. See WithBaseContext.
+func MergeBaseContext(ctx, base context.Context) context.Context {
+	if base == nil {
+		return ctx
+	}
+	return baseValuesContext{Context: ctx, base: base}
+}
+
+// baseValuesContext is a request context that looks up missing values in
+// base.
+type baseValuesContext struct {
+	context.Context
+	base context.Context
+}
+
+func (c baseValuesContext) Value(key any) any {
+	if v := c.Context.Value(key); v != nil {
+		return v
+	}
+	return c.base.Value(key)
+}
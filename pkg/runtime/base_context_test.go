@@ -0,0 +1,50 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+type baseKey string
+
+func TestMergeBaseContext(t *testing.T) {
+	g := NewWithT(t)
+
+	base, cancelBase := context.WithCancel(context.WithValue(context.WithValue(context.Background(), baseKey("logger"), "server logger"), baseKey("tenant"), "base"))
+	req, cancelReq := context.WithTimeout(context.WithValue(context.Background(), baseKey("tenant"), "request"), time.Minute)
+	defer cancelReq()
+
+	g.Expect(MergeBaseContext(req, nil)).To(BeIdenticalTo(req))
+
+	ctx := MergeBaseContext(req, base)
+	g.Expect(ctx.Value(baseKey("logger"))).To(Equal("server logger"))
+	g.Expect(ctx.Value(baseKey("tenant"))).To(Equal("request"), "request values win")
+	g.Expect(ctx.Value(baseKey("missing"))).To(BeNil())
+
+	// The deadline and cancellation are those of the request.
+	deadline, ok := ctx.Deadline()
+	g.Expect(ok).To(BeTrue())
+	reqDeadline, _ := req.Deadline()
+	g.Expect(deadline).To(Equal(reqDeadline))
+	cancelBase()
+	g.Expect(ctx.Err()).ToNot(HaveOccurred())
+	cancelReq()
+	g.Expect(ctx.Err()).To(MatchError(context.Canceled))
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// BatchRequestsArgument is the argument of a generated batch tool holding the
+// array of requests.
+const BatchRequestsArgument = "requests"
+
+// MaxBatchSize is the maximum number of requests accepted by one batch tool
+// call.
+const MaxBatchSize = 100
+
+// BatchResult is the outcome of one request of a batch tool call. Exactly one
+// of Result and Error is set; both hold the text the single-request tool would
+// have returned, embedded as JSON when it is JSON.
+type BatchResult struct {
+	Index  int             `json:"index"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+// WithBatchConcurrency sets how many requests of a batch tool call are
+// forwarded at the same time. The default of 1 forwards them one by one.
+func WithBatchConcurrency(n int) Option {
+	return func(c *Config) {
+		c.BatchConcurrency = n
+	}
+}
+
+// RunBatch runs call for every element of the BatchRequestsArgument array in
+// args, with at most concurrency calls in flight, and returns the results in
+// request order. A failing request is reported in its own BatchResult and does
+// not fail the others; only a malformed batch is a protocol error.
+func RunBatch(
+	ctx context.Context,
+	args map[string]interface{},
+	concurrency int,
+	call func(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error),
+) (*mcp.CallToolResult, error) {
+	requests, ok := args[BatchRequestsArgument].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be an array of requests", BatchRequestsArgument)
+	}
+	if len(requests) > MaxBatchSize {
+		return nil, fmt.Errorf("%s has %d elements; max is %d", BatchRequestsArgument, len(requests), MaxBatchSize)
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]BatchResult, len(requests))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, request := range requests {
+		results[i].Index = i
+		element, ok := request.(map[string]interface{})
+		if !ok {
+			results[i].Error = batchText(fmt.Sprintf("request must be an object, got %T", request))
+			continue
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, element map[string]interface{}) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			res, err := call(ctx, element)
+			switch {
+			case err != nil:
+				results[i].Error = batchText(err.Error())
+			case res == nil:
+				results[i].Error = batchText("no result")
+			case res.IsError:
+				results[i].Error = batchText(resultText(res))
+			default:
+				results[i].Result = batchText(payloadText(res))
+			}
+		}(i, element)
+	}
+	wg.Wait()
+
+	marshaled, err := json.Marshal(map[string]interface{}{"results": results})
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(marshaled)), nil
+}
+
+// resultText concatenates the text content of res.
+func resultText(res *mcp.CallToolResult) string {
+	var text string
+	for _, content := range res.Content {
+		if tc, ok := mcp.AsTextContent(content); ok {
+			text += tc.Text
+		}
+	}
+	return text
+}
+
+// payloadText returns the last text content of res, which is the full
+// response when a summary block comes before it (see NewSummaryResult).
+func payloadText(res *mcp.CallToolResult) string {
+	for i := len(res.Content) - 1; i >= 0; i-- {
+		if tc, ok := mcp.AsTextContent(res.Content[i]); ok {
+			return tc.Text
+		}
+	}
+	return ""
+}
+
+// batchText embeds s as is when it is JSON, and as a JSON string otherwise.
+func batchText(s string) json.RawMessage {
+	if json.Valid([]byte(s)) {
+		return json.RawMessage(s)
+	}
+	quoted, _ := json.Marshal(s)
+	return quoted
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+)
+
+func batchResults(g *WithT, res *mcp.CallToolResult) []BatchResult {
+	g.Expect(res.IsError).To(BeFalse())
+	var out struct {
+		Results []BatchResult `json:"results"`
+	}
+	tc, ok := mcp.AsTextContent(res.Content[0])
+	g.Expect(ok).To(BeTrue())
+	g.Expect(json.Unmarshal([]byte(tc.Text), &out)).To(Succeed())
+	return out.Results
+}
+
+func TestRunBatch(t *testing.T) {
+	echo := func(_ context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+		switch args["id"] {
+		case "tool-error":
+			return mcp.NewToolResultError(`{"code":"NOT_FOUND"}`), nil
+		case "protocol-error":
+			return nil, errors.New("bad request")
+		}
+		return mcp.NewToolResultText(fmt.Sprintf(`{"id":%q}`, args["id"])), nil
+	}
+
+	t.Run("results in order with per-request errors", func(t *testing.T) {
+		g := NewWithT(t)
+		res, err := RunBatch(context.Background(), map[string]interface{}{
+			BatchRequestsArgument: []interface{}{
+				map[string]interface{}{"id": "a"},
+				map[string]interface{}{"id": "tool-error"},
+				map[string]interface{}{"id": "protocol-error"},
+				"not an object",
+			},
+		}, 3, echo)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		results := batchResults(g, res)
+		g.Expect(results).To(HaveLen(4))
+		for i, r := range results {
+			g.Expect(r.Index).To(Equal(i))
+		}
+		g.Expect(results[0].Result).To(MatchJSON(`{"id":"a"}`))
+		g.Expect(results[1].Error).To(MatchJSON(`{"code":"NOT_FOUND"}`))
+		g.Expect(results[2].Error).To(MatchJSON(`"bad request"`))
+		g.Expect(results[3].Error).To(MatchJSON(`"request must be an object, got string"`))
+	})
+
+	t.Run("malformed batch", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := RunBatch(context.Background(), map[string]interface{}{}, 1, echo)
+		g.Expect(err).To(MatchError("requests must be an array of requests"))
+
+		_, err = RunBatch(context.Background(), map[string]interface{}{
+			BatchRequestsArgument: make([]interface{}, MaxBatchSize+1),
+		}, 1, echo)
+		g.Expect(err).To(MatchError(ContainSubstring("max is 100")))
+	})
+}
+
+func TestRunBatchConcurrencyLimit(t *testing.T) {
+	for _, limit := range []int{0, 1, 3} {
+		g := NewWithT(t)
+		var inFlight, peak int32
+		requests := make([]interface{}, 10)
+		for i := range requests {
+			requests[i] = map[string]interface{}{}
+		}
+
+		c := NewConfig()
+		WithBatchConcurrency(limit)(c)
+		_, err := RunBatch(context.Background(), map[string]interface{}{BatchRequestsArgument: requests}, c.BatchConcurrency,
+			func(context.Context, map[string]interface{}) (*mcp.CallToolResult, error) {
+				n := atomic.AddInt32(&inFlight, 1)
+				for {
+					p := atomic.LoadInt32(&peak)
+					if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				atomic.AddInt32(&inFlight, -1)
+				return mcp.NewToolResultText("{}"), nil
+			})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		want := int32(limit)
+		if want < 1 {
+			want = 1
+		}
+		g.Expect(peak).To(BeNumerically("<=", want), "limit %d", limit)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// bytesValueFullName is the wrapper whose JSON form is its bytes value.
+const bytesValueFullName = "google.protobuf.BytesValue"
+
+// WithBytesInlineLimit replaces every bytes value of a response longer than
+// n bytes with a summary giving its length and SHA-256, instead of inlining
+// it as base64. The limit applies to each bytes field, list element and map
+// value, at any depth. n <= 0, the default, inlines all bytes.
+func WithBytesInlineLimit(n int) Option {
+	return func(c *Config) {
+		c.BytesInlineLimit = n
+	}
+}
+
+// BytesSummary is the placeholder replacing a bytes value of data that is over
+// the inline limit.
+func BytesSummary(data []byte) string {
+	return fmt.Sprintf("<%d bytes omitted, sha256:%x>", len(data), sha256.Sum256(data))
+}
+
+// SummarizeLargeBytes returns marshaled, the protojson encoding of msg with
+// proto field names, with the bytes values longer than limit replaced by a
+// BytesSummary. marshaled is returned as is when there are none.
+func SummarizeLargeBytes(marshaled []byte, msg proto.Message, limit int) ([]byte, error) {
+	if limit <= 0 || !hasLargeBytes(msg.ProtoReflect(), limit) {
+		return marshaled, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(marshaled))
+	dec.UseNumber()
+	var doc map[string]interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	summarizeMessage(msg.ProtoReflect(), doc, limit)
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// hasLargeBytes reports whether m holds a bytes value longer than limit.
+func hasLargeBytes(m protoreflect.Message, limit int) bool {
+	found := false
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		forEachValue(fd, v, func(v protoreflect.Value, kind protoreflect.Kind, md protoreflect.MessageDescriptor) {
+			switch {
+			case found:
+			case kind == protoreflect.BytesKind:
+				found = len(v.Bytes()) > limit
+			case md != nil && md.FullName() == bytesValueFullName:
+				found = len(wrappedBytes(v.Message())) > limit
+			case md != nil && md.FullName().Parent() != "google.protobuf":
+				found = hasLargeBytes(v.Message(), limit)
+			}
+		})
+		return !found
+	})
+	return found
+}
+
+// forEachValue calls f for v when fd is singular, for every element when fd
+// is a list, and for every value when fd is a map.
+func forEachValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, f func(v protoreflect.Value, kind protoreflect.Kind, md protoreflect.MessageDescriptor)) {
+	switch {
+	case fd.IsMap():
+		vd := fd.MapValue()
+		v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+			f(mv, vd.Kind(), vd.Message())
+			return true
+		})
+	case fd.IsList():
+		list := v.List()
+		for i := 0; i < list.Len(); i++ {
+			f(list.Get(i), fd.Kind(), fd.Message())
+		}
+	default:
+		f(v, fd.Kind(), fd.Message())
+	}
+}
+
+// summarizeMessage replaces the large bytes values of m in doc, its JSON
+// object.
+func summarizeMessage(m protoreflect.Message, doc map[string]interface{}, limit int) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsExtension() {
+			return true
+		}
+		key := string(fd.Name())
+		switch {
+		case fd.IsMap():
+			obj, _ := doc[key].(map[string]interface{})
+			vd := fd.MapValue()
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				if obj != nil {
+					obj[k.String()] = summarizeValue(mv, vd.Kind(), vd.Message(), obj[k.String()], limit)
+				}
+				return true
+			})
+		case fd.IsList():
+			arr, _ := doc[key].([]interface{})
+			list := v.List()
+			for i := 0; i < list.Len() && i < len(arr); i++ {
+				arr[i] = summarizeValue(list.Get(i), fd.Kind(), fd.Message(), arr[i], limit)
+			}
+		default:
+			if jv, ok := doc[key]; ok {
+				doc[key] = summarizeValue(v, fd.Kind(), fd.Message(), jv, limit)
+			}
+		}
+		return true
+	})
+}
+
+// summarizeValue returns jv, the JSON form of v, with its large bytes values
+// replaced.
+func summarizeValue(v protoreflect.Value, kind protoreflect.Kind, md protoreflect.MessageDescriptor, jv interface{}, limit int) interface{} {
+	switch {
+	case kind == protoreflect.BytesKind:
+		if data := v.Bytes(); len(data) > limit {
+			return BytesSummary(data)
+		}
+	case md != nil && md.FullName() == bytesValueFullName:
+		if data := wrappedBytes(v.Message()); len(data) > limit {
+			return BytesSummary(data)
+		}
+	case md != nil && md.FullName().Parent() != "google.protobuf":
+		if obj, ok := jv.(map[string]interface{}); ok {
+			summarizeMessage(v.Message(), obj, limit)
+		}
+	}
+	return jv
+}
+
+// wrappedBytes returns the value of a google.protobuf.BytesValue.
+func wrappedBytes(m protoreflect.Message) []byte {
+	return m.Get(m.Descriptor().Fields().ByName("value")).Bytes()
+}
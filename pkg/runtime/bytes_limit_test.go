@@ -0,0 +1,75 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestSummarizeLargeBytes(t *testing.T) {
+	g := NewWithT(t)
+
+	large := bytes.Repeat([]byte{0xab}, 64)
+	small := []byte("ok")
+	resp := &testdata.GetBlobResponse{
+		Content:     large,
+		Checksum:    small,
+		Parts:       []*testdata.BlobPart{{Name: "head", Data: large}, {Name: "tail", Data: small}},
+		Attachments: map[string][]byte{"big": large, "tiny": small},
+		Preview:     wrapperspb.Bytes(large),
+		Chunks:      [][]byte{small, large},
+	}
+	marshaled, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(resp)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	out, err := SummarizeLargeBytes(marshaled, resp, 16)
+	g.Expect(err).ToNot(HaveOccurred())
+	var doc map[string]interface{}
+	g.Expect(json.Unmarshal(out, &doc)).To(Succeed())
+
+	summary := BytesSummary(large)
+	g.Expect(summary).To(HavePrefix("<64 bytes omitted, sha256:"))
+	g.Expect(doc["content"]).To(Equal(summary))
+	g.Expect(doc["checksum"]).To(Equal("b2s="), "values within the limit stay base64")
+	g.Expect(doc["parts"]).To(Equal([]interface{}{
+		map[string]interface{}{"name": "head", "data": summary},
+		map[string]interface{}{"name": "tail", "data": "b2s="},
+	}))
+	g.Expect(doc["attachments"]).To(Equal(map[string]interface{}{"big": summary, "tiny": "b2s="}))
+	g.Expect(doc["preview"]).To(Equal(summary))
+	g.Expect(doc["chunks"]).To(Equal([]interface{}{"b2s=", summary}))
+}
+
+func TestSummarizeLargeBytesUnchanged(t *testing.T) {
+	g := NewWithT(t)
+
+	resp := &testdata.GetBlobResponse{Content: []byte("short")}
+	marshaled, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(resp)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	for _, limit := range []int{0, 1024} {
+		out, err := SummarizeLargeBytes(marshaled, resp, limit)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(out).To(Equal(marshaled), "limit %d", limit)
+	}
+}
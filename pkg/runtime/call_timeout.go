@@ -0,0 +1,43 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"time"
+)
+
+// WithCallTimeout sets the deadline of every forwarded call whose method has
+// no (mcp.options.tool) timeout. Zero or less, the default, sets none, so
+// calls only end when the MCP client gives up.
+func WithCallTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		c.CallTimeout = timeout
+	}
+}
+
+// CallContext returns the context of a forwarded call: ctx with the deadline
+// toolTimeout, or fallback when toolTimeout is zero, or ctx itself when both
+// are zero. The returned cancel func must be called once the call is done.
+func CallContext(ctx context.Context, toolTimeout, fallback time.Duration) (context.Context, context.CancelFunc) {
+	timeout := toolTimeout
+	if timeout <= 0 {
+		timeout = fallback
+	}
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCallContext(t *testing.T) {
+	g := NewWithT(t)
+
+	start := time.Now()
+	ctx, cancel := CallContext(context.Background(), time.Minute, time.Hour)
+	deadline, ok := ctx.Deadline()
+	g.Expect(ok).To(BeTrue())
+	g.Expect(deadline).To(BeTemporally("~", start.Add(time.Minute), time.Second))
+	cancel()
+	g.Expect(ctx.Err()).To(MatchError(context.Canceled))
+
+	// The fallback applies when the tool has no timeout.
+	ctx, cancel = CallContext(context.Background(), 0, time.Hour)
+	deadline, ok = ctx.Deadline()
+	g.Expect(ok).To(BeTrue())
+	g.Expect(deadline).To(BeTemporally("~", start.Add(time.Hour), time.Second))
+	cancel()
+
+	// Neither leaves the context alone.
+	parent := context.Background()
+	ctx, cancel = CallContext(parent, 0, 0)
+	g.Expect(ctx).To(BeIdenticalTo(parent))
+	cancel()
+}
+
+func TestWithCallTimeout(t *testing.T) {
+	g := NewWithT(t)
+
+	cfg := NewConfig()
+	g.Expect(cfg.CallTimeout).To(BeZero())
+	WithCallTimeout(5 * time.Second)(cfg)
+	g.Expect(cfg.CallTimeout).To(Equal(5 * time.Second))
+}
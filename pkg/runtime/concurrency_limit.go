@@ -0,0 +1,83 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithConcurrencyLimit bounds how many calls of one registration, that is
+// one ForwardTo<Service>Client call, are forwarded to the backend at the same
+// time. Calls beyond the limit wait for a slot, or fail right away with
+// WithConcurrencyLimitFailFast. Zero or less, the default, means no limit.
+func WithConcurrencyLimit(n int) Option {
+	return func(c *Config) {
+		c.ConcurrencyLimit = n
+	}
+}
+
+// WithConcurrencyLimitFailFast sets whether a call beyond the
+// WithConcurrencyLimit limit fails right away with a RESOURCE_EXHAUSTED tool
+// error instead of waiting for a slot.
+func WithConcurrencyLimitFailFast(enable bool) Option {
+	return func(c *Config) {
+		c.ConcurrencyFailFast = enable
+	}
+}
+
+// CallLimiter bounds the number of forwarded calls in flight. Generated code
+// creates one per registration. A nil CallLimiter does not limit.
+type CallLimiter struct {
+	slots    chan struct{}
+	failFast bool
+}
+
+// NewCallLimiter returns a CallLimiter allowing limit calls in flight, or nil
+// when limit is zero or less.
+func NewCallLimiter(limit int, failFast bool) *CallLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return &CallLimiter{slots: make(chan struct{}, limit), failFast: failFast}
+}
+
+// Acquire takes a slot for one call and returns the func that gives it back.
+// It waits for a slot unless the limiter fails fast, in which case it returns
+// a RESOURCE_EXHAUSTED error. While waiting it returns ctx.Err() once ctx is
+// done.
+func (l *CallLimiter) Acquire(ctx context.Context) (release func(), err error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	release = func() { <-l.slots }
+	select {
+	case l.slots <- struct{}{}:
+		return release, nil
+	default:
+	}
+	if l.failFast {
+		return nil, status.Error(codes.ResourceExhausted, fmt.Sprintf("too many tool calls in flight; the limit is %d", cap(l.slots)))
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return release, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCallLimiter(t *testing.T) {
+	t.Run("no limit", func(t *testing.T) {
+		g := NewWithT(t)
+		l := NewCallLimiter(0, false)
+		g.Expect(l).To(BeNil())
+		release, err := l.Acquire(context.Background())
+		g.Expect(err).ToNot(HaveOccurred())
+		release()
+	})
+
+	t.Run("fail fast", func(t *testing.T) {
+		g := NewWithT(t)
+		l := NewCallLimiter(1, true)
+		release, err := l.Acquire(context.Background())
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = l.Acquire(context.Background())
+		g.Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+		g.Expect(err).To(MatchError(ContainSubstring("the limit is 1")))
+
+		release()
+		release, err = l.Acquire(context.Background())
+		g.Expect(err).ToNot(HaveOccurred())
+		release()
+	})
+
+	t.Run("wait until canceled", func(t *testing.T) {
+		g := NewWithT(t)
+		l := NewCallLimiter(1, false)
+		release, err := l.Acquire(context.Background())
+		g.Expect(err).ToNot(HaveOccurred())
+		defer release()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = l.Acquire(ctx)
+		g.Expect(err).To(MatchError(context.Canceled))
+	})
+
+	t.Run("wait for a slot", func(t *testing.T) {
+		g := NewWithT(t)
+		l := NewCallLimiter(1, false)
+		release, err := l.Acquire(context.Background())
+		g.Expect(err).ToNot(HaveOccurred())
+
+		acquired := make(chan struct{})
+		go func() {
+			r, err := l.Acquire(context.Background())
+			if err == nil {
+				r()
+			}
+			close(acquired)
+		}()
+		g.Consistently(acquired).ShouldNot(BeClosed())
+		release()
+		g.Eventually(acquired).Should(BeClosed())
+	})
+}
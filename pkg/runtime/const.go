@@ -0,0 +1,84 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ConstField is a request field pinned to a single value by a protovalidate
+// const rule. Generated code lists them per tool.
+type ConstField struct {
+	// Path is the field path, as protobuf field names.
+	Path []string
+	// JSONPath is the same path, as JSON names, which callers may send
+	// instead.
+	JSONPath []string
+	// Value is the JSON encoding of the const value.
+	Value json.RawMessage
+}
+
+// FillConstFields sets every field of fields that message omits to its const
+// value. A field inside an omitted message is left alone, so filling never
+// makes a message present; a field sent with another value is left for the
+// server to reject. Fields are looked up under their protobuf name, then
+// under their JSON name.
+func FillConstFields(message map[string]interface{}, fields []ConstField) {
+	for _, f := range fields {
+		if len(f.Path) == 0 {
+			continue
+		}
+		m := message
+		for i := range f.Path[:len(f.Path)-1] {
+			next, ok := constFieldValue(m, f, i).(map[string]interface{})
+			if !ok {
+				m = nil
+				break
+			}
+			m = next
+		}
+		if m == nil {
+			continue
+		}
+		last := len(f.Path) - 1
+		if constFieldValue(m, f, last) != nil {
+			continue
+		}
+		dec := json.NewDecoder(bytes.NewReader(f.Value))
+		dec.UseNumber()
+		var v interface{}
+		if err := dec.Decode(&v); err != nil {
+			continue
+		}
+		// A null sent under the JSON name would be a duplicate for protojson.
+		if last < len(f.JSONPath) {
+			delete(m, f.JSONPath[last])
+		}
+		m[f.Path[last]] = v
+	}
+}
+
+// constFieldValue returns the value of the i-th field on the path of f in m,
+// sent under its protobuf or JSON name, or nil.
+func constFieldValue(m map[string]interface{}, f ConstField, i int) interface{} {
+	if v := m[f.Path[i]]; v != nil {
+		return v
+	}
+	if i < len(f.JSONPath) {
+		return m[f.JSONPath[i]]
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestFillConstFields(t *testing.T) {
+	g := NewWithT(t)
+
+	fields := []ConstField{
+		{Path: []string{"version"}, Value: json.RawMessage(`"v2"`)},
+		{Path: []string{"revision"}, Value: json.RawMessage(`9007199254740993`)},
+		{Path: []string{"source", "system"}, Value: json.RawMessage(`"inventory"`)},
+		{Path: []string{"absent", "system"}, Value: json.RawMessage(`"inventory"`)},
+	}
+	message := map[string]interface{}{
+		"revision": nil,
+		"source":   map[string]interface{}{"host": "db-1"},
+	}
+	FillConstFields(message, fields)
+
+	g.Expect(message["version"]).To(Equal("v2"))
+	g.Expect(message["revision"]).To(Equal(json.Number("9007199254740993")), "large integers keep their precision")
+	g.Expect(message["source"]).To(HaveKeyWithValue("system", "inventory"))
+	g.Expect(message).ToNot(HaveKey("absent"))
+
+	// A value the caller sent is kept, even a wrong one.
+	message = map[string]interface{}{"version": "v1"}
+	FillConstFields(message, fields)
+	g.Expect(message["version"]).To(Equal("v1"))
+
+	// Fields sent under their JSON name are found there.
+	fields = []ConstField{
+		{Path: []string{"api_version"}, JSONPath: []string{"apiVersion"}, Value: json.RawMessage(`"v2"`)},
+		{Path: []string{"event_source", "system_name"}, JSONPath: []string{"eventSource", "systemName"}, Value: json.RawMessage(`"inventory"`)},
+	}
+	message = map[string]interface{}{
+		"apiVersion":  "v1",
+		"eventSource": map[string]interface{}{"systemName": nil},
+	}
+	FillConstFields(message, fields)
+	g.Expect(message).To(Equal(map[string]interface{}{
+		"apiVersion":  "v1",
+		"eventSource": map[string]interface{}{"system_name": "inventory"},
+	}))
+
+	// A call without arguments is left alone.
+	g.Expect(func() { FillConstFields(nil, fields) }).ToNot(Panic())
+}
@@ -15,6 +15,9 @@
 package runtime
 
 import (
+	"context"
+	"errors"
+
 	"github.com/mark3labs/mcp-go/mcp"
 	apierrors "github.com/redpanda-data/common-go/api/errors"
 	spb "google.golang.org/genproto/googleapis/rpc/status"
@@ -57,3 +60,30 @@ func HandleError(err error) (*mcp.CallToolResult, error) {
 
 	return mcp.NewToolResultError(string(finalJSON)), nil
 }
+
+// HandleCallError is HandleError for the error of a forwarded call made with
+// ctx. When ctx was canceled by the MCP client, or its deadline passed, the
+// result says so with the CANCELLED or DEADLINE_EXCEEDED code instead of
+// reporting whatever error the interrupted call produced, so the model can
+// tell an interruption from a backend failure. Unless retrySafe is set the
+// message warns that the request may have been applied anyway.
+func HandleCallError(ctx context.Context, err error, retrySafe bool) (*mcp.CallToolResult, error) {
+	if err == nil {
+		return nil, nil
+	}
+
+	var code codes.Code
+	var msg string
+	switch {
+	case errors.Is(ctx.Err(), context.Canceled):
+		code, msg = codes.Canceled, "call canceled by client"
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		code, msg = codes.DeadlineExceeded, "call deadline exceeded before the backend responded"
+	default:
+		return HandleError(err)
+	}
+	if !retrySafe {
+		msg += "; the request may still have been applied"
+	}
+	return HandleError(status.Error(code, msg))
+}
@@ -1,11 +1,14 @@
 package runtime
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -232,3 +235,56 @@ func TestHandleError_NilError(t *testing.T) {
 		t.Fatal("HandleError should return nil result for nil error")
 	}
 }
+
+func TestHandleCallError(t *testing.T) {
+	// errorText returns a function asserting that a result is a tool error
+	// and returning its text.
+	errorText := func(g *WithT) func(*mcp.CallToolResult, error) string {
+		return func(res *mcp.CallToolResult, err error) string {
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res.IsError).To(BeTrue())
+			return res.Content[0].(mcp.TextContent).Text
+		}
+	}
+
+	t.Run("canceled by client", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		text := errorText(g)(HandleCallError(ctx, status.Error(codes.Unavailable, "transport closing"), true))
+		g.Expect(text).To(ContainSubstring(`"code":"CANCELLED"`))
+		g.Expect(text).To(ContainSubstring("call canceled by client"))
+		g.Expect(text).ToNot(ContainSubstring("transport closing"))
+		g.Expect(text).ToNot(ContainSubstring("may still have been applied"))
+
+		text = errorText(g)(HandleCallError(ctx, context.Canceled, false))
+		g.Expect(text).To(ContainSubstring("may still have been applied"))
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+		defer cancel()
+		<-ctx.Done()
+
+		text := errorText(g)(HandleCallError(ctx, context.DeadlineExceeded, true))
+		g.Expect(text).To(ContainSubstring(`"code":"DEADLINE_EXCEEDED"`))
+		g.Expect(text).To(ContainSubstring("call deadline exceeded"))
+		g.Expect(text).ToNot(ContainSubstring("canceled"))
+	})
+
+	t.Run("backend errors pass through", func(t *testing.T) {
+		g := NewWithT(t)
+		text := errorText(g)(HandleCallError(context.Background(), status.Error(codes.DeadlineExceeded, "backend timed out"), true))
+		g.Expect(text).To(ContainSubstring("backend timed out"))
+	})
+}
+
+func TestToolRetrySafe(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(Tool{}.RetrySafe()).To(BeFalse())
+	g.Expect(Tool{ReadOnly: BoolPtr(true)}.RetrySafe()).To(BeTrue())
+	g.Expect(Tool{Idempotent: BoolPtr(true)}.RetrySafe()).To(BeTrue())
+	g.Expect(Tool{ReadOnly: BoolPtr(false), Idempotent: BoolPtr(false)}.RetrySafe()).To(BeFalse())
+}
@@ -1,16 +1,22 @@
 package runtime
 
 import (
+	"context"
 	"encoding/json"
+	"reflect"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/grpc/codes"
 )
 
 // Tool represents an MCP tool definition with essential fields
 type Tool struct {
 	Name        string
 	Description string
-	JSONSchema  string
+	// JSONSchema is the input schema, computed by the generator and embedded
+	// as a string literal, so registering a tool does not walk descriptors.
+	JSONSchema string
 
 	// Title is the human-readable tool title emitted into mcp.ToolAnnotation.
 	// Empty means the method carried no (mcp.options.tool) title.
@@ -25,6 +31,32 @@ type Tool struct {
 	Destructive *bool
 	Idempotent  *bool
 	OpenWorld   *bool
+
+	// Timeout is the deadline of the forwarded call from the
+	// (mcp.options.tool) timeout. Zero falls back to WithCallTimeout.
+	Timeout time.Duration
+	// Scopes are the authorization scopes from the (mcp.options.tool)
+	// scopes, checked by the WithScopeChecker of the registration.
+	Scopes []string
+	// Deprecated is set for the tool of a method marked
+	// [deprecated = true]. Its description starts with "(deprecated)".
+	Deprecated bool
+
+	// Package and Version are the proto package and service version of the
+	// tool under the tool_meta plugin parameter or (mcp.options.file)
+	// version. ToolMeta puts them in the _meta of the registered tool.
+	Package string
+	Version string
+	// Method is the gRPC method path of the tool, e.g.
+	// "/testdata.TestService/GetItem", under the grpc_method plugin
+	// parameter. ToolMeta puts it in the _meta of the registered tool.
+	Method string
+}
+
+// RetrySafe reports whether the tool is annotated read-only or idempotent, so
+// that a call interrupted midway can simply be repeated.
+func (t Tool) RetrySafe() bool {
+	return (t.ReadOnly != nil && *t.ReadOnly) || (t.Idempotent != nil && *t.Idempotent)
 }
 
 // BoolPtr returns a pointer to b. Generated code uses it to emit explicitly
@@ -32,7 +64,7 @@ type Tool struct {
 func BoolPtr(b bool) *bool { return &b }
 
 // Option defines functional options for MCP functions
-type Option func(*config)
+type Option func(*Config)
 
 // ExtraProperty defines an additional property to add to tool schemas
 type ExtraProperty struct {
@@ -42,14 +74,47 @@ type ExtraProperty struct {
 	ContextKey  interface{}
 }
 
-type config struct {
-	ExtraProperties    []ExtraProperty
-	UseToonCompression bool
+// Config is the configuration of a registration, built from its Options.
+// Generated code passes it to the argument parsers of its tools.
+type Config struct {
+	ExtraProperties        []ExtraProperty
+	UseToonCompression     bool
+	ToolNameOverrides      map[string]string
+	ResponseTransformers   []ResponseTransformer
+	RequestInterceptors    []RequestInterceptor
+	BatchConcurrency       int
+	PanicRecovery          bool
+	PanicStackTrace        bool
+	StrictValidation       bool
+	BytesInlineLimit       int
+	MaxNestingDepth        int
+	ConcurrencyLimit       int
+	ConcurrencyFailFast    bool
+	CallTimeout            time.Duration
+	ClientResolver         func(ctx context.Context) (any, error)
+	ClientResolverType     reflect.Type
+	MethodClients          map[string]any
+	ScopeChecker           ScopeChecker
+	StartupValidation      bool
+	Metrics                MetricsFunc
+	BaseContext            context.Context
+	MaxRequestBytes        int
+	ResponseFieldAllowlist map[string][]string
+	ProtocolErrorCodes     []codes.Code
+	ToolSchemaOverrides    map[string]json.RawMessage
+	ResultPostProcessors   []ToolResultPostProcessor
+	FieldCoercers          []FieldCoercer
+	DescriptionTemplate    string
+	RateLimits             map[string]RateLimit
+	DefaultRateLimit       RateLimit
+	RateLimitPerSession    bool
+	InputDefaults          map[string]map[string]any
+	OnRegister             []func(tool *mcp.Tool)
 }
 
 // WithExtraProperties adds extra properties to tool schemas and extracts them from request arguments
 func WithExtraProperties(properties ...ExtraProperty) Option {
-	return func(c *config) {
+	return func(c *Config) {
 		c.ExtraProperties = append(c.ExtraProperties, properties...)
 	}
 }
@@ -57,14 +122,14 @@ func WithExtraProperties(properties ...ExtraProperty) Option {
 // WithToonCompression enables TOON format compression for tool results
 // TOON (Token-Oriented Object Notation) reduces token count by ~40% for LLM inputs
 func WithToonCompression(enable bool) Option {
-	return func(c *config) {
+	return func(c *Config) {
 		c.UseToonCompression = enable
 	}
 }
 
 // NewConfig creates a new config instance
-func NewConfig() *config {
-	return &config{}
+func NewConfig() *Config {
+	return &Config{PanicRecovery: true, MaxNestingDepth: DefaultMaxNestingDepth, ProtocolErrorCodes: DefaultProtocolErrorCodes}
 }
 
 // AddExtraPropertiesToTool modifies a tool's schema to include additional properties
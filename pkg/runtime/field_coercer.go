@@ -0,0 +1,70 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldCoercer rewrites the raw argument value of the request field at
+// fieldPath before the request is unmarshaled, for quirks protojson does not
+// handle, such as an enum sent as a number in a string or a value to trim.
+// It returns raw unchanged for fields it does not coerce. A returned error
+// fails the call with InvalidArgument, unless it is a gRPC status error
+// already.
+//
+// fieldPath is a dot-separated list of proto field names, e.g.
+// "item.name", as in WithResponseFieldAllowlist. raw is a single value:
+// the coercer is called for each element of a repeated field, under the
+// path of the field, and for each value of a map field, under the path of
+// the field followed by the map key.
+type FieldCoercer func(fieldPath string, raw any) (any, error)
+
+// WithFieldCoercer adds a FieldCoercer applied to every field of every
+// request, after the arguments are brought into the protojson shape of the
+// request and before they are unmarshaled. Repeated options run in the order
+// given.
+func WithFieldCoercer(coercer FieldCoercer) Option {
+	return func(c *Config) {
+		c.FieldCoercers = append(c.FieldCoercers, coercer)
+	}
+}
+
+// CoerceFields runs the values of the fields of message, described by md,
+// through coercers in order, in place. A field is coerced before the fields
+// of its message value. Without coercers message is left as is.
+func CoerceFields(message map[string]interface{}, md protoreflect.MessageDescriptor, coercers []FieldCoercer) error {
+	if len(coercers) == 0 {
+		return nil
+	}
+	return walkFields(message, md, "", func(_ protoreflect.FieldDescriptor, path string, v interface{}) (interface{}, error) {
+		return coerceValue(path, v, coercers)
+	})
+}
+
+// coerceValue runs v, a single value of the field at path, through coercers.
+func coerceValue(path string, v interface{}, coercers []FieldCoercer) (interface{}, error) {
+	for _, coerce := range coercers {
+		var err error
+		if v, err = coerce(path, v); err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+			return nil, status.Errorf(codes.InvalidArgument, "%s: %v", path, err)
+		}
+	}
+	return v, nil
+}
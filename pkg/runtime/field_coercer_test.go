@@ -0,0 +1,80 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestCoerceFields(t *testing.T) {
+	g := NewWithT(t)
+
+	var paths []string
+	trim := func(fieldPath string, raw any) (any, error) {
+		paths = append(paths, fieldPath)
+		if s, ok := raw.(string); ok {
+			return strings.TrimSpace(s), nil
+		}
+		return raw, nil
+	}
+	message := map[string]interface{}{
+		"blackoutDates": []interface{}{" 2024-12-24 "}, // JSON name
+		"guest":         map[string]interface{}{"name": " Ada ", "birth_date": " 1815-12-10 "},
+		"not_a_field":   " kept ",
+		"check_in":      nil,
+	}
+	g.Expect(CoerceFields(message, (&testdata.CreateBookingRequest{}).ProtoReflect().Descriptor(), []FieldCoercer{trim})).To(Succeed())
+	g.Expect(message["blackoutDates"]).To(Equal([]interface{}{"2024-12-24"}))
+	g.Expect(message["guest"]).To(Equal(map[string]interface{}{"name": "Ada", "birth_date": "1815-12-10"}))
+	g.Expect(message["not_a_field"]).To(Equal(" kept "))
+	g.Expect(paths).To(ConsistOf("check_in", "blackout_dates", "guest", "guest.name", "guest.birth_date"))
+
+	// Map values are coerced under the path of their key.
+	paths = nil
+	message = map[string]interface{}{"labels": map[string]interface{}{"team": " x "}}
+	g.Expect(CoerceFields(message, (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor(), []FieldCoercer{trim})).To(Succeed())
+	g.Expect(paths).To(Equal([]string{"labels.team"}))
+	g.Expect(message["labels"]).To(Equal(map[string]interface{}{"team": "x"}))
+}
+
+func TestCoerceFieldsErrors(t *testing.T) {
+	g := NewWithT(t)
+
+	md := (&testdata.CreateBookingRequest{}).ProtoReflect().Descriptor()
+	fail := func(string, any) (any, error) { return nil, errors.New("bad value") }
+	err := CoerceFields(map[string]interface{}{"guest": map[string]interface{}{"birth_date": "x"}}, md, []FieldCoercer{
+		func(fieldPath string, raw any) (any, error) {
+			if fieldPath == "guest.birth_date" {
+				return fail(fieldPath, raw)
+			}
+			return raw, nil
+		},
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	g.Expect(err).To(MatchError(ContainSubstring("guest.birth_date: bad value")))
+
+	// Status errors are returned as is.
+	err = CoerceFields(map[string]interface{}{"check_in": "x"}, md, []FieldCoercer{
+		func(string, any) (any, error) { return nil, status.Error(codes.PermissionDenied, "no") },
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import "google.golang.org/protobuf/reflect/protoreflect"
+
+// fieldVisitor returns the value to keep for v, a single value of fd at
+// path: an element of a list field, a value of a map field, whose path ends
+// with the map key, or the value of a singular field.
+type fieldVisitor func(fd protoreflect.FieldDescriptor, path string, v interface{}) (interface{}, error)
+
+// walkFields runs visit over the values of the fields of message, described
+// by md, in place. protojson accepts both the proto and the JSON name of a
+// field, so values under either are visited. A value is visited before the
+// fields of its message, which are walked in turn, except for the
+// well-known types with their own JSON mapping. prefix is prepended to the
+// field paths, which are proto field names joined with dots.
+func walkFields(message map[string]interface{}, md protoreflect.MessageDescriptor, prefix string, visit fieldVisitor) error {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+		keys := []string{string(fd.Name())}
+		if fd.JSONName() != string(fd.Name()) {
+			keys = append(keys, fd.JSONName())
+		}
+		for _, key := range keys {
+			v, ok := message[key]
+			if !ok {
+				continue
+			}
+			var err error
+			switch {
+			case fd.IsMap():
+				if m, ok := v.(map[string]interface{}); ok {
+					for k, elem := range m {
+						if m[k], err = walkValue(fd.MapValue(), path+"."+k, elem, visit); err != nil {
+							return err
+						}
+					}
+				}
+			case fd.IsList():
+				if list, ok := v.([]interface{}); ok {
+					for n, elem := range list {
+						if list[n], err = walkValue(fd, path, elem, visit); err != nil {
+							return err
+						}
+					}
+				}
+			default:
+				if message[key], err = walkValue(fd, path, v, visit); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// walkValue visits v, a single value of fd at path, and then walks the
+// fields of its message.
+func walkValue(fd protoreflect.FieldDescriptor, path string, v interface{}, visit fieldVisitor) (interface{}, error) {
+	v, err := visit(fd, path, v)
+	if err != nil {
+		return nil, err
+	}
+	md := fd.Message()
+	if md == nil || md.FullName().Parent() == "google.protobuf" {
+		return v, nil
+	}
+	if m, ok := v.(map[string]interface{}); ok {
+		if err := walkFields(m, md, path+".", visit); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
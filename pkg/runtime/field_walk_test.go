@@ -0,0 +1,71 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestWalkFields(t *testing.T) {
+	g := NewWithT(t)
+
+	message := map[string]interface{}{
+		"itinerary": map[string]interface{}{
+			"name":     "spring",
+			"firstLeg": map[string]interface{}{"departs_at": "2025-03-01T08:30:00Z", "notes": map[string]interface{}{"seat": "12A"}},
+			"legs_by_city": map[string]interface{}{
+				"Lyon": map[string]interface{}{"stopsAt": []interface{}{"2025-03-01T09:15:00Z"}},
+			},
+		},
+		"alternatives": []interface{}{map[string]interface{}{"name": "autumn"}},
+	}
+	var paths []string
+	err := walkFields(message, (&testdata.PlanTripRequest{}).ProtoReflect().Descriptor(), "", func(_ protoreflect.FieldDescriptor, path string, v interface{}) (interface{}, error) {
+		paths = append(paths, path)
+		if s, ok := v.(string); ok {
+			return s + "!", nil
+		}
+		return v, nil
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	// Values come before the fields of their message, JSON names are
+	// followed, and the well-known types are not walked into.
+	g.Expect(paths).To(Equal([]string{
+		"itinerary",
+		"itinerary.name",
+		"itinerary.first_leg",
+		"itinerary.first_leg.departs_at",
+		"itinerary.first_leg.notes",
+		"itinerary.legs_by_city.Lyon",
+		"itinerary.legs_by_city.Lyon.stops_at",
+		"alternatives",
+		"alternatives.name",
+	}))
+	g.Expect(message["itinerary"]).To(HaveKeyWithValue("name", "spring!"))
+	g.Expect(message["alternatives"]).To(Equal([]interface{}{map[string]interface{}{"name": "autumn!"}}))
+
+	// The first error stops the walk.
+	errStop := errors.New("stop")
+	err = walkFields(message, (&testdata.PlanTripRequest{}).ProtoReflect().Descriptor(), "", func(protoreflect.FieldDescriptor, string, interface{}) (interface{}, error) {
+		return nil, errStop
+	})
+	g.Expect(err).To(MatchError(errStop))
+}
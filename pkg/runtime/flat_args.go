@@ -0,0 +1,45 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+// FlatField is a message field of a request whose own fields were hoisted to
+// the top level of the tool input schema by the flat_args plugin option.
+type FlatField struct {
+	// Name is the protobuf name of the message field.
+	Name string
+	// Keys are the protobuf names of its hoisted fields.
+	Keys []string
+}
+
+// NestFlatFields moves, in place, the hoisted fields sent at the top level of
+// message back into their message field, so message has the shape of the
+// request again. A message field the caller sent nested anyway is kept, with
+// the hoisted fields merged into it.
+func NestFlatFields(message map[string]interface{}, fields []FlatField) {
+	for _, f := range fields {
+		for _, key := range f.Keys {
+			v, ok := message[key]
+			if !ok {
+				continue
+			}
+			nested, ok := message[f.Name].(map[string]interface{})
+			if !ok {
+				nested = map[string]interface{}{}
+				message[f.Name] = nested
+			}
+			nested[key] = v
+			delete(message, key)
+		}
+	}
+}
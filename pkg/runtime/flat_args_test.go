@@ -0,0 +1,45 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNestFlatFields(t *testing.T) {
+	g := NewWithT(t)
+
+	fields := []FlatField{{Name: "profile", Keys: []string{"bio", "display_name"}}}
+
+	message := map[string]interface{}{"display_name": "Ada", "notify": true}
+	NestFlatFields(message, fields)
+	g.Expect(message).To(Equal(map[string]interface{}{
+		"profile": map[string]interface{}{"display_name": "Ada"},
+		"notify":  true,
+	}))
+
+	// A message field sent nested anyway is merged into.
+	message = map[string]interface{}{"bio": "Mathematician", "profile": map[string]interface{}{"display_name": "Ada"}}
+	NestFlatFields(message, fields)
+	g.Expect(message).To(Equal(map[string]interface{}{
+		"profile": map[string]interface{}{"display_name": "Ada", "bio": "Mathematician"},
+	}))
+
+	// Without any hoisted field, the message field stays unset.
+	message = map[string]interface{}{"notify": false}
+	NestFlatFields(message, fields)
+	g.Expect(message).To(Equal(map[string]interface{}{"notify": false}))
+}
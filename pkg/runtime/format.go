@@ -0,0 +1,83 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// FormatArgument is the reserved tool argument with which a caller selects the
+// response format for a single call, overriding WithToonCompression.
+const FormatArgument = "__format"
+
+// Response formats accepted in FormatArgument.
+const (
+	FormatJSON = "json"
+	FormatToon = "toon"
+)
+
+// UseToonForCall removes FormatArgument from args and reports whether the
+// response of this call should be TOON-encoded. Without the argument the server
+// default is returned; an unknown or non-string value is an error.
+func UseToonForCall(args map[string]interface{}, serverDefault bool) (bool, error) {
+	raw, ok := args[FormatArgument]
+	if !ok {
+		return serverDefault, nil
+	}
+	delete(args, FormatArgument)
+
+	format, ok := raw.(string)
+	if !ok {
+		return false, fmt.Errorf("%s must be a string, got %T", FormatArgument, raw)
+	}
+	switch format {
+	case FormatJSON:
+		return false, nil
+	case FormatToon:
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown %s %q: must be %q or %q", FormatArgument, format, FormatJSON, FormatToon)
+	}
+}
+
+// AddFormatArgumentToTool adds FormatArgument to the input schema of tool as
+// an optional property, so that clients see the per-call override next to the
+// other arguments. Generated code adds it to every tool whose result it
+// shapes. A schema that does not parse is left as is.
+func AddFormatArgumentToTool(tool mcp.Tool) mcp.Tool {
+	var schema map[string]interface{}
+	if err := json.Unmarshal(tool.RawInputSchema, &schema); err != nil {
+		return tool
+	}
+	properties, ok := schema["properties"].(map[string]interface{})
+	if !ok {
+		properties = map[string]interface{}{}
+		schema["properties"] = properties
+	}
+	properties[FormatArgument] = map[string]interface{}{
+		"type":        "string",
+		"enum":        []string{FormatJSON, FormatToon},
+		"description": "Format of the result of this call, overriding the server default.",
+	}
+	modified, err := json.Marshal(schema)
+	if err != nil {
+		return tool
+	}
+	tool.RawInputSchema = json.RawMessage(modified)
+	return tool
+}
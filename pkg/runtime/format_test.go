@@ -0,0 +1,73 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+)
+
+func TestUseToonForCall(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		serverDefault bool
+		want          bool
+		wantErr       bool
+	}{
+		{name: "absent keeps json default", args: map[string]interface{}{"id": "1"}, serverDefault: false, want: false},
+		{name: "absent keeps toon default", args: map[string]interface{}{"id": "1"}, serverDefault: true, want: true},
+		{name: "nil args", args: nil, serverDefault: true, want: true},
+		{name: "toon overrides json default", args: map[string]interface{}{FormatArgument: "toon"}, serverDefault: false, want: true},
+		{name: "json overrides toon default", args: map[string]interface{}{FormatArgument: "json"}, serverDefault: true, want: false},
+		{name: "unknown format", args: map[string]interface{}{FormatArgument: "yaml"}, wantErr: true},
+		{name: "wrong case", args: map[string]interface{}{FormatArgument: "TOON"}, wantErr: true},
+		{name: "non-string", args: map[string]interface{}{FormatArgument: true}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, err := UseToonForCall(tt.args, tt.serverDefault)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(FormatArgument))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+			g.Expect(tt.args).ToNot(HaveKey(FormatArgument), "the argument must not be forwarded")
+		})
+	}
+}
+
+func TestAddFormatArgumentToTool(t *testing.T) {
+	g := NewWithT(t)
+
+	tool := AddFormatArgumentToTool(mcp.Tool{Name: "get_item", RawInputSchema: json.RawMessage(`{"type":"object","properties":{"id":{"type":"string"}},"required":["id"]}`)})
+	var schema map[string]interface{}
+	g.Expect(json.Unmarshal(tool.RawInputSchema, &schema)).To(Succeed())
+	g.Expect(schema["properties"]).To(HaveKeyWithValue("id", HaveKeyWithValue("type", "string")))
+	g.Expect(schema["properties"]).To(HaveKeyWithValue(FormatArgument, HaveKeyWithValue("enum", ConsistOf(FormatJSON, FormatToon))))
+	g.Expect(schema["required"]).To(ConsistOf("id"), "the argument is optional")
+
+	// A schema that does not parse is left as is.
+	broken := mcp.Tool{Name: "get_item", RawInputSchema: json.RawMessage(`{`)}
+	g.Expect(AddFormatArgumentToTool(broken)).To(Equal(broken))
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const dateFullName protoreflect.FullName = "google.type.Date"
+
+// DateStringsToObjects rewrites, in place, every google.type.Date in message
+// that was sent as an ISO 8601 date string, such as "2024-03-15", into the
+// {"year", "month", "day"} object protojson expects. md describes message.
+// Dates are found in nested messages, lists and map values; a date already
+// sent as an object is left as is. A string that is not a valid date is an
+// InvalidArgument error.
+func DateStringsToObjects(message map[string]interface{}, md protoreflect.MessageDescriptor) error {
+	return walkFields(message, md, "", dateStringToObject)
+}
+
+// dateStringToObject converts v, a single value of fd at path, if it is a
+// google.type.Date sent as a string.
+func dateStringToObject(fd protoreflect.FieldDescriptor, path string, v interface{}) (interface{}, error) {
+	s, ok := v.(string)
+	if !ok || fd.Message() == nil || fd.Message().FullName() != dateFullName {
+		return v, nil
+	}
+	d, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %q is not a YYYY-MM-DD date", path, s)
+	}
+	return map[string]interface{}{"year": d.Year(), "month": int(d.Month()), "day": d.Day()}, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestDateStringsToObjects(t *testing.T) {
+	g := NewWithT(t)
+
+	message := map[string]interface{}{
+		"checkIn":        "2024-02-29", // JSON name
+		"blackout_dates": []interface{}{"2024-12-24", map[string]interface{}{"year": 2024, "month": 12}},
+		"guest":          map[string]interface{}{"birth_date": "1815-12-10"},
+	}
+	g.Expect(DateStringsToObjects(message, (&testdata.CreateBookingRequest{}).ProtoReflect().Descriptor())).To(Succeed())
+
+	raw, err := json.Marshal(message)
+	g.Expect(err).ToNot(HaveOccurred())
+	var got testdata.CreateBookingRequest
+	g.Expect(protojson.Unmarshal(raw, &got)).To(Succeed(), "converted: %s", raw)
+
+	want := &testdata.CreateBookingRequest{
+		CheckIn: &date.Date{Year: 2024, Month: 2, Day: 29},
+		BlackoutDates: []*date.Date{
+			{Year: 2024, Month: 12, Day: 24},
+			{Year: 2024, Month: 12}, // already an object, kept as is
+		},
+		Guest: &testdata.BookingGuest{BirthDate: &date.Date{Year: 1815, Month: 12, Day: 10}},
+	}
+	g.Expect(proto.Equal(&got, want)).To(BeTrue(), "got %v", &got)
+}
+
+func TestDateStringsToObjectsInvalid(t *testing.T) {
+	g := NewWithT(t)
+
+	md := (&testdata.CreateBookingRequest{}).ProtoReflect().Descriptor()
+	for _, s := range []string{"2023-02-29", "24-01-01", "2024-01-01T00:00:00Z", ""} {
+		err := DateStringsToObjects(map[string]interface{}{"check_in": s}, md)
+		g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument), s)
+		g.Expect(err).To(MatchError(ContainSubstring("is not a YYYY-MM-DD date")), s)
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// WithInputDefaults fills the fields that the arguments of a tool omit with
+// default values, so that the model need not send values that hardly ever
+// change, such as a region. defaults maps registered tool names to field
+// paths, as protobuf field names joined with dots such as
+// "placement.region", and their values as the model would send them. A
+// field sent with a value, even a zero one, keeps it; a field sent as null
+// is filled. An omitted message on the way to a field is created, unless it
+// is a oneof variant. The input schema of the tool shows each value as the
+// default of its property, which is then no longer required. Registration
+// panics on a path that names no field. Calling it again merges the defaults
+// of each tool, later values winning.
+func WithInputDefaults(defaults map[string]map[string]any) Option {
+	return func(c *Config) {
+		if c.InputDefaults == nil {
+			c.InputDefaults = make(map[string]map[string]any, len(defaults))
+		}
+		for tool, fields := range defaults {
+			merged := make(map[string]any, len(c.InputDefaults[tool])+len(fields))
+			for path, v := range c.InputDefaults[tool] {
+				merged[path] = v
+			}
+			for path, v := range fields {
+				merged[path] = v
+			}
+			c.InputDefaults[tool] = merged
+		}
+	}
+}
+
+// ApplyInputDefaults sets every field of defaults that message, the
+// arguments of a request of md, omits to a copy of its default value. Paths
+// that name no field are skipped; see CheckInputDefaults.
+func ApplyInputDefaults(message map[string]interface{}, md protoreflect.MessageDescriptor, defaults map[string]any) {
+	for _, path := range sortedDefaultPaths(defaults) {
+		applyInputDefault(message, md, strings.Split(path, "."), defaults[path])
+	}
+}
+
+func applyInputDefault(m map[string]interface{}, md protoreflect.MessageDescriptor, path []string, value any) {
+	fd := md.Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil {
+		return
+	}
+	v, sent := m[string(fd.Name())]
+	if !sent || v == nil {
+		v, sent = m[fd.JSONName()]
+	}
+	sent = sent && v != nil
+	if len(path) == 1 {
+		if !sent {
+			if copied, err := copyJSONValue(value); err == nil {
+				setDefaultField(m, fd, copied)
+			}
+		}
+		return
+	}
+	next, ok := v.(map[string]interface{})
+	if !ok {
+		if sent || !defaultCreatesMessage(fd) {
+			return
+		}
+		next = map[string]interface{}{}
+		setDefaultField(m, fd, next)
+	}
+	applyInputDefault(next, fd.Message(), path[1:], value)
+}
+
+// setDefaultField sets fd in m under its proto name, dropping a null sent
+// under its JSON name, which protojson would reject as a duplicate of it.
+func setDefaultField(m map[string]interface{}, fd protoreflect.FieldDescriptor, v interface{}) {
+	delete(m, fd.JSONName())
+	m[string(fd.Name())] = v
+}
+
+// CheckInputDefaults returns an error naming tool for the first path of
+// defaults that names no field of md, that passes through a field other
+// than a singular message, or whose value does not encode as JSON.
+func CheckInputDefaults(tool string, md protoreflect.MessageDescriptor, defaults map[string]any) error {
+	for _, path := range sortedDefaultPaths(defaults) {
+		current := md
+		names := strings.Split(path, ".")
+		for i, name := range names {
+			fd := current.Fields().ByName(protoreflect.Name(name))
+			if fd == nil {
+				return fmt.Errorf("tool %q: input default %q: %s has no field %q", tool, path, current.FullName(), name)
+			}
+			if i == len(names)-1 {
+				break
+			}
+			if fd.Message() == nil || fd.IsList() || fd.IsMap() || strings.HasPrefix(string(fd.Message().FullName()), "google.protobuf.") {
+				return fmt.Errorf("tool %q: input default %q: %s is not a message field to set fields of", tool, path, fd.FullName())
+			}
+			current = fd.Message()
+		}
+		if _, err := json.Marshal(defaults[path]); err != nil {
+			return fmt.Errorf("tool %q: input default %q: %w", tool, path, err)
+		}
+	}
+	return nil
+}
+
+// AddInputDefaultsToTool checks defaults against md, the request of tool,
+// with CheckInputDefaults, and shows them in the input schema of tool: each
+// value becomes the default of its property, which is no longer required,
+// and neither are the messages ApplyInputDefaults creates on the way.
+// Properties the schema does not have at their path, such as those moved by
+// flat_args or inside oneof variants, are filled but not shown.
+func AddInputDefaultsToTool(tool mcp.Tool, md protoreflect.MessageDescriptor, defaults map[string]any) (mcp.Tool, error) {
+	return addInputDefaults(tool, md, defaults, func(schema map[string]any) map[string]any { return schema })
+}
+
+// AddInputDefaultsToBatchTool is AddInputDefaultsToTool for the batch tool
+// of a method, whose requests are items of its "requests" property.
+func AddInputDefaultsToBatchTool(tool mcp.Tool, md protoreflect.MessageDescriptor, defaults map[string]any) (mcp.Tool, error) {
+	return addInputDefaults(tool, md, defaults, func(schema map[string]any) map[string]any {
+		props, _ := schema["properties"].(map[string]any)
+		requests, _ := props["requests"].(map[string]any)
+		items, _ := requests["items"].(map[string]any)
+		return items
+	})
+}
+
+func addInputDefaults(tool mcp.Tool, md protoreflect.MessageDescriptor, defaults map[string]any, requestSchema func(schema map[string]any) map[string]any) (mcp.Tool, error) {
+	if len(defaults) == 0 {
+		return tool, nil
+	}
+	if err := CheckInputDefaults(tool.Name, md, defaults); err != nil {
+		return tool, err
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(tool.RawInputSchema, &schema); err != nil {
+		return tool, nil
+	}
+	node := requestSchema(schema)
+	if node == nil {
+		return tool, nil
+	}
+	defs, _ := schema["$defs"].(map[string]any)
+	for _, path := range sortedDefaultPaths(defaults) {
+		showInputDefault(node, defs, md, strings.Split(path, "."), defaults[path])
+	}
+	marshaled, err := json.Marshal(schema)
+	if err != nil {
+		return tool, nil
+	}
+	tool.RawInputSchema = json.RawMessage(marshaled)
+	return tool, nil
+}
+
+// showInputDefault sets value as the default of the property at path of
+// node, the schema of md. A message schema on the way that is a $ref to defs
+// is replaced by a copy of its definition, so that other uses of the message
+// are left alone.
+func showInputDefault(node, defs map[string]any, md protoreflect.MessageDescriptor, path []string, value any) {
+	props, _ := node["properties"].(map[string]any)
+	fd := md.Fields().ByName(protoreflect.Name(path[0]))
+	key := string(fd.Name())
+	if _, ok := props[key]; !ok {
+		key = fd.JSONName()
+	}
+	prop, ok := props[key].(map[string]any)
+	if !ok {
+		return
+	}
+	if len(path) == 1 {
+		prop["default"] = value
+		dropRequired(node, key)
+		return
+	}
+	if !defaultCreatesMessage(fd) {
+		return
+	}
+	if ref, ok := prop["$ref"].(string); ok {
+		name, _ := defName(ref)
+		def, ok := defs[name].(map[string]any)
+		if !ok {
+			return
+		}
+		copied, err := copyJSONValue(def)
+		if err != nil {
+			return
+		}
+		inlined := copied.(map[string]any)
+		for k, v := range prop {
+			if k != "$ref" {
+				inlined[k] = v
+			}
+		}
+		prop = inlined
+		props[key] = prop
+	}
+	dropRequired(node, key)
+	showInputDefault(prop, defs, fd.Message(), path[1:], value)
+}
+
+// defaultCreatesMessage reports whether ApplyInputDefaults creates the
+// message of fd when it is omitted: a oneof variant is not created, so that
+// a default never picks one.
+func defaultCreatesMessage(fd protoreflect.FieldDescriptor) bool {
+	oneof := fd.ContainingOneof()
+	return oneof == nil || oneof.IsSynthetic()
+}
+
+// dropRequired removes key from the required properties of schema.
+func dropRequired(schema map[string]any, key string) {
+	required, ok := schema["required"].([]any)
+	if !ok {
+		return
+	}
+	kept := required[:0:0]
+	for _, name := range required {
+		if name != key {
+			kept = append(kept, name)
+		}
+	}
+	if len(kept) == 0 {
+		delete(schema, "required")
+		return
+	}
+	schema["required"] = kept
+}
+
+// copyJSONValue returns a deep copy of v as decoded from its JSON encoding,
+// with numbers as json.Number, so that later steps may modify it in place.
+func copyJSONValue(v any) (any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(strings.NewReader(string(b)))
+	dec.UseNumber()
+	var copied any
+	if err := dec.Decode(&copied); err != nil {
+		return nil, err
+	}
+	return copied, nil
+}
+
+func sortedDefaultPaths(defaults map[string]any) []string {
+	paths := make([]string, 0, len(defaults))
+	for path := range defaults {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
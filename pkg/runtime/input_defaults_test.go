@@ -0,0 +1,130 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestApplyInputDefaults(t *testing.T) {
+	g := NewWithT(t)
+
+	md := (&testdata.CreateBookingRequest{}).ProtoReflect().Descriptor()
+	defaults := map[string]any{"guest.name": "Walk-in", "check_in": map[string]any{"year": 2025}}
+
+	message := map[string]interface{}{}
+	ApplyInputDefaults(message, md, defaults)
+	g.Expect(message).To(Equal(map[string]interface{}{
+		"guest":    map[string]interface{}{"name": "Walk-in"},
+		"check_in": map[string]interface{}{"year": json.Number("2025")},
+	}))
+
+	// Values are copied, so that later steps may modify them in place.
+	message["check_in"].(map[string]interface{})["year"] = 1999
+	g.Expect(defaults["check_in"]).To(Equal(map[string]any{"year": 2025}))
+
+	// Fields sent under their JSON name are kept; null counts as omitted.
+	message = map[string]interface{}{"checkIn": "2024-12-24", "guest": nil}
+	ApplyInputDefaults(message, md, defaults)
+	g.Expect(message).To(Equal(map[string]interface{}{
+		"checkIn": "2024-12-24",
+		"guest":   map[string]interface{}{"name": "Walk-in"},
+	}))
+
+	// A null sent under the JSON name gives way to the default.
+	message = map[string]interface{}{"checkIn": nil, "guest": map[string]interface{}{}}
+	ApplyInputDefaults(message, md, defaults)
+	g.Expect(message).To(Equal(map[string]interface{}{
+		"check_in": map[string]interface{}{"year": json.Number("2025")},
+		"guest":    map[string]interface{}{"name": "Walk-in"},
+	}))
+	raw, err := json.Marshal(message)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(protojson.Unmarshal(raw, &testdata.CreateBookingRequest{})).To(Succeed())
+
+	// A oneof variant is never created to hold a default.
+	message = map[string]interface{}{}
+	ApplyInputDefaults(message, (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor(), map[string]any{"product.price": 9.5})
+	g.Expect(message).To(BeEmpty())
+	message = map[string]interface{}{"product": map[string]interface{}{}}
+	ApplyInputDefaults(message, (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor(), map[string]any{"product.price": 9.5})
+	g.Expect(message).To(Equal(map[string]interface{}{"product": map[string]interface{}{"price": json.Number("9.5")}}))
+}
+
+func TestCheckInputDefaults(t *testing.T) {
+	g := NewWithT(t)
+
+	md := (&testdata.CreateBookingRequest{}).ProtoReflect().Descriptor()
+	g.Expect(CheckInputDefaults("book", md, map[string]any{"guest.name": "x", "price": map[string]any{"currency_code": "EUR"}})).To(Succeed())
+	g.Expect(CheckInputDefaults("book", md, map[string]any{"guest.nickname": "x"})).To(MatchError(`tool "book": input default "guest.nickname": testdata.BookingGuest has no field "nickname"`))
+	g.Expect(CheckInputDefaults("book", md, map[string]any{"blackout_dates.year": 2025})).To(MatchError(ContainSubstring("testdata.CreateBookingRequest.blackout_dates is not a message field")))
+	g.Expect(CheckInputDefaults("book", md, map[string]any{"guest.name": func() {}})).To(MatchError(ContainSubstring(`tool "book": input default "guest.name": json: unsupported type`)))
+}
+
+func TestAddInputDefaultsToTool(t *testing.T) {
+	g := NewWithT(t)
+
+	md := (&testdata.CreateBookingRequest{}).ProtoReflect().Descriptor()
+	tool := mcp.Tool{Name: "book", RawInputSchema: json.RawMessage(`{
+	  "type": "object",
+	  "properties": {
+	    "guest": {"$ref": "#/$defs/testdata.BookingGuest", "description": "Guest."},
+	    "check_in": {"type": "string"}
+	  },
+	  "required": ["guest", "check_in"],
+	  "$defs": {"testdata.BookingGuest": {"type": "object", "properties": {"name": {"type": "string"}}, "required": ["name"]}}
+	}`)}
+
+	unchanged, err := AddInputDefaultsToTool(tool, md, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(unchanged).To(Equal(tool))
+
+	withDefaults, err := AddInputDefaultsToTool(tool, md, map[string]any{"guest.name": "Walk-in"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(withDefaults.RawInputSchema).To(MatchJSON(`{
+	  "type": "object",
+	  "properties": {
+	    "guest": {"type": "object", "description": "Guest.", "properties": {"name": {"type": "string", "default": "Walk-in"}}},
+	    "check_in": {"type": "string"}
+	  },
+	  "required": ["check_in"],
+	  "$defs": {"testdata.BookingGuest": {"type": "object", "properties": {"name": {"type": "string"}}, "required": ["name"]}}
+	}`))
+
+	_, err = AddInputDefaultsToTool(tool, md, map[string]any{"nickname": "x"})
+	g.Expect(err).To(MatchError(ContainSubstring(`has no field "nickname"`)))
+
+	batch := mcp.Tool{Name: "book_batch", RawInputSchema: json.RawMessage(`{"type": "object", "properties": {"requests": {"type": "array",
+	  "items": {"type": "object", "properties": {"check_in": {"type": "string"}}, "required": ["check_in"]}}}}`)}
+	batch, err = AddInputDefaultsToBatchTool(batch, md, map[string]any{"check_in": "2025-01-01"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(batch.RawInputSchema).To(MatchJSON(`{"type": "object", "properties": {"requests": {"type": "array",
+	  "items": {"type": "object", "properties": {"check_in": {"type": "string", "default": "2025-01-01"}}}}}}`))
+}
+
+func TestWithInputDefaultsMerges(t *testing.T) {
+	g := NewWithT(t)
+
+	c := NewConfig()
+	WithInputDefaults(map[string]map[string]any{"a": {"x": 1, "y": 2}})(c)
+	WithInputDefaults(map[string]map[string]any{"a": {"y": 3}, "b": {"z": 4}})(c)
+	g.Expect(c.InputDefaults).To(Equal(map[string]map[string]any{"a": {"x": 1, "y": 3}, "b": {"z": 4}}))
+}
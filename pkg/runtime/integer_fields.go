@@ -0,0 +1,149 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// NormalizeIntegerFields rewrites the values of the integer fields of
+// message, described by md, that models send with a fraction or exponent,
+// such as 5.0 or "5.0" for an int32 field, to plain integers in place.
+// Numbers stay numbers and strings, the encoding of 64-bit integers, stay
+// strings. A value with a fractional part or outside the range of its field
+// is an InvalidArgument error naming the field, e.g. "count: 5.5 is not an
+// integer". Values that are not numbers are left for protojson to reject.
+func NormalizeIntegerFields(message map[string]interface{}, md protoreflect.MessageDescriptor) error {
+	return walkFields(message, md, "", normalizeIntegerValue)
+}
+
+// normalizeIntegerValue normalizes v, a single value of fd at path. The
+// fields of a message value are left to walkFields.
+func normalizeIntegerValue(fd protoreflect.FieldDescriptor, path string, v interface{}) (interface{}, error) {
+	kind := fd.Kind()
+	if md := fd.Message(); md != nil {
+		// The well-known types have their own JSON mapping; the integer
+		// wrappers are a bare number.
+		value := md.Fields().ByName("value")
+		if md.FullName().Parent() != "google.protobuf" || !strings.HasSuffix(string(md.Name()), "Value") || value == nil {
+			return v, nil
+		}
+		kind = value.Kind()
+	}
+	bits, signed, ok := integerKind(kind)
+	if !ok {
+		return v, nil
+	}
+	switch n := v.(type) {
+	case float64:
+		if math.IsNaN(n) || math.IsInf(n, 0) || math.Trunc(n) != n {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: %v is not an integer", path, n)
+		}
+		if _, err := normalizeIntegerString(strconv.FormatFloat(n, 'f', -1, 64), bits, signed); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: %v is out of range for %s", path, n, kind)
+		}
+		return n, nil
+	case string:
+		s, err := normalizeIntegerString(n, bits, signed)
+		if err != nil {
+			return nil, integerFieldError(path, n, kind, err)
+		}
+		return s, nil
+	case json.Number:
+		s, err := normalizeIntegerString(string(n), bits, signed)
+		if err != nil {
+			return nil, integerFieldError(path, n, kind, err)
+		}
+		return json.Number(s), nil
+	}
+	return v, nil
+}
+
+var errNotInteger = errors.New("not an integer")
+
+// normalizeIntegerString returns s, a decimal number, as a plain integer of
+// the given size. Strings that are not numbers are returned as is.
+func normalizeIntegerString(s string, bits int, signed bool) (string, error) {
+	if strings.ContainsAny(s, ".eE") {
+		f, err := strconv.ParseFloat(s, 64)
+		// Settle exponents of arbitrary size before big.Rat expands them.
+		switch {
+		case err != nil && !errors.Is(err, strconv.ErrRange):
+			return s, nil
+		case math.IsInf(f, 0) || math.Abs(f) >= 1<<64:
+			return "", strconv.ErrRange
+		case f == 0:
+			// Zero, or a fraction too small for a float64.
+			if strings.Trim(strings.Split(strings.ToLower(s), "e")[0], "+-0.") != "" {
+				return "", errNotInteger
+			}
+			return "0", nil
+		}
+		r, ok := new(big.Rat).SetString(s)
+		if !ok {
+			return s, nil
+		}
+		if !r.IsInt() {
+			return "", errNotInteger
+		}
+		s = r.Num().String()
+	}
+	var err error
+	switch {
+	case signed:
+		_, err = strconv.ParseInt(s, 10, bits)
+	case strings.HasPrefix(s, "-"):
+		if n, perr := strconv.ParseUint(s[1:], 10, 64); perr == nil && n != 0 {
+			err = strconv.ErrRange
+		}
+	default:
+		_, err = strconv.ParseUint(s, 10, bits)
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return "", strconv.ErrRange
+	}
+	return s, nil
+}
+
+func integerFieldError(path string, v interface{}, kind protoreflect.Kind, err error) error {
+	if errors.Is(err, errNotInteger) {
+		return status.Errorf(codes.InvalidArgument, "%s: %v is not an integer", path, v)
+	}
+	return status.Errorf(codes.InvalidArgument, "%s: %v is out of range for %s", path, v, kind)
+}
+
+// integerKind returns the size in bits and the signedness of the integer
+// kind k.
+func integerKind(k protoreflect.Kind) (bits int, signed bool, ok bool) {
+	switch k {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return 32, true, true
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return 32, false, true
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return 64, true, true
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return 64, false, true
+	}
+	return 0, false, false
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestNormalizeIntegerFields(t *testing.T) {
+	g := NewWithT(t)
+
+	message := map[string]interface{}{
+		"pageSize":               5.0, // JSON name
+		"unsigned_page":          json.Number("7.00"),
+		"ignored_repeated_pages": []interface{}{1.0, "2.0", "3e2", "-0.0"},
+		"query":                  map[string]interface{}{"inner_page": "4.000", "filter": "1.5"},
+		"ignored_string_page":    "2.5",
+	}
+	g.Expect(NormalizeIntegerFields(message, (&testdata.ListItemsRequest{}).ProtoReflect().Descriptor())).To(Succeed())
+	g.Expect(message).To(Equal(map[string]interface{}{
+		"pageSize":               5.0,
+		"unsigned_page":          json.Number("7"),
+		"ignored_repeated_pages": []interface{}{1.0, "2", "300", "0"},
+		"query":                  map[string]interface{}{"inner_page": "4", "filter": "1.5"},
+		"ignored_string_page":    "2.5",
+	}))
+
+	// Strings that are not numbers are left for protojson.
+	message = map[string]interface{}{"page_size": "five", "page": "1.2.3"}
+	g.Expect(NormalizeIntegerFields(message, (&testdata.ListItemsRequest{}).ProtoReflect().Descriptor())).To(Succeed())
+	g.Expect(message).To(Equal(map[string]interface{}{"page_size": "five", "page": "1.2.3"}))
+
+	// 64-bit integers keep their precision.
+	message = map[string]interface{}{"since_id": "9007199254740993.0"}
+	g.Expect(NormalizeIntegerFields(message, (&testdata.CountWidgetsRequest{}).ProtoReflect().Descriptor())).To(Succeed())
+	g.Expect(message["since_id"]).To(Equal("9007199254740993"))
+
+	// The integer wrappers are checked as their value.
+	message = map[string]interface{}{"priority": 2.0}
+	g.Expect(NormalizeIntegerFields(message, (&testdata.SetReminderRequest{}).ProtoReflect().Descriptor())).To(Succeed())
+	g.Expect(message["priority"]).To(Equal(2.0))
+}
+
+func TestNormalizeIntegerFieldsErrors(t *testing.T) {
+	listItems := (&testdata.ListItemsRequest{}).ProtoReflect().Descriptor()
+	tests := []struct {
+		name    string
+		message map[string]interface{}
+		want    string
+	}{
+		{"fraction", map[string]interface{}{"page_size": 5.5}, "page_size: 5.5 is not an integer"},
+		{"fraction in string", map[string]interface{}{"page_size": "5.5"}, "page_size: 5.5 is not an integer"},
+		{"tiny fraction", map[string]interface{}{"page_size": "1e-400"}, "page_size: 1e-400 is not an integer"},
+		{"list element", map[string]interface{}{"ignored_repeated_pages": []interface{}{1.0, 0.5}}, "ignored_repeated_pages: 0.5 is not an integer"},
+		{"nested", map[string]interface{}{"query": map[string]interface{}{"innerPage": 1.25}}, "query.inner_page: 1.25 is not an integer"},
+		{"int32 range", map[string]interface{}{"page_size": 2147483648.0}, "page_size: 2.147483648e+09 is out of range for int32"},
+		{"uint32 sign", map[string]interface{}{"unsigned_page": -1.0}, "unsigned_page: -1 is out of range for uint32"},
+		{"huge exponent", map[string]interface{}{"page_size": "1e999999999"}, "page_size: 1e999999999 is out of range for int32"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := NormalizeIntegerFields(tt.message, listItems)
+			g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			g.Expect(status.Convert(err).Message()).To(Equal(tt.want))
+		})
+	}
+
+	g := NewWithT(t)
+	err := NormalizeIntegerFields(map[string]interface{}{"priority": 2.5}, (&testdata.SetReminderRequest{}).ProtoReflect().Descriptor())
+	g.Expect(status.Convert(err).Message()).To(Equal("priority: 2.5 is not an integer"))
+}
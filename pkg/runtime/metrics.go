@@ -0,0 +1,90 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MetricsFunc receives the metrics of one tool call: the full gRPC method
+// name, the sizes of the JSON arguments and of the JSON result, the duration
+// of the call and its error, if any.
+type MetricsFunc func(methodName string, reqBytes, respBytes int, dur time.Duration, err error)
+
+// WithMetrics calls fn after every tool call, including failed ones, for
+// example to export Prometheus metrics without wrapping every handler. fn is
+// called on the goroutine of the call, so it should not block.
+func WithMetrics(fn MetricsFunc) Option {
+	return func(c *Config) {
+		c.Metrics = fn
+	}
+}
+
+// RecordMetrics wraps call so that metrics receives the metrics of every
+// call made to the tool of method. reqBytes is the size of the JSON
+// arguments, measured before call modifies them; respBytes is the size of
+// the JSON payload of a successful result and 0 otherwise. A tool error is
+// passed to metrics as a status error with its code and message. It returns
+// call unchanged when metrics is nil.
+func RecordMetrics(
+	call func(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error),
+	method string,
+	metrics MetricsFunc,
+) func(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	if metrics == nil {
+		return call
+	}
+	return func(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+		var reqBytes int
+		if raw, err := json.Marshal(args); err == nil {
+			reqBytes = len(raw)
+		}
+		start := time.Now()
+		result, err := call(ctx, args)
+		dur := time.Since(start)
+
+		var respBytes int
+		callErr := err
+		switch {
+		case err != nil:
+		case result == nil:
+		case result.IsError:
+			callErr = toolError(payloadText(result))
+		default:
+			respBytes = len(payloadText(result))
+		}
+		metrics(method, reqBytes, respBytes, dur, callErr)
+		return result, err
+	}
+}
+
+// toolError turns the text of a tool error made by HandleError back into a
+// status error. Any other text becomes a plain error.
+func toolError(text string) error {
+	var st struct {
+		Code    codes.Code `json:"code"`
+		Message string     `json:"message"`
+	}
+	if err := json.Unmarshal([]byte(text), &st); err != nil || st.Code == codes.OK {
+		return errors.New(text)
+	}
+	return status.Error(st.Code, st.Message)
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecordMetrics(t *testing.T) {
+	g := NewWithT(t)
+
+	var gotErr error
+	var gotReq, gotResp int
+	metrics := func(_ string, reqBytes, respBytes int, _ time.Duration, err error) {
+		gotReq, gotResp, gotErr = reqBytes, respBytes, err
+	}
+
+	// The arguments are measured before the call modifies them.
+	call := RecordMetrics(func(_ context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+		args["added"] = "by the handler"
+		return mcp.NewToolResultText(`{"ok":true}`), nil
+	}, "pkg.Service.Method", metrics)
+	_, err := call(context.Background(), map[string]interface{}{"a": 1})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(gotReq).To(Equal(len(`{"a":1}`)))
+	g.Expect(gotResp).To(Equal(len(`{"ok":true}`)))
+	g.Expect(gotErr).ToNot(HaveOccurred())
+
+	// A tool error is reported, but the result is returned unchanged.
+	call = RecordMetrics(func(context.Context, map[string]interface{}) (*mcp.CallToolResult, error) {
+		return HandleError(status.Error(codes.PermissionDenied, "not yours"))
+	}, "pkg.Service.Method", metrics)
+	res, err := call(context.Background(), nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeTrue())
+	g.Expect(status.Code(gotErr)).To(Equal(codes.PermissionDenied))
+	g.Expect(gotResp).To(BeZero())
+
+	// So is a protocol error.
+	boom := errors.New("boom")
+	call = RecordMetrics(func(context.Context, map[string]interface{}) (*mcp.CallToolResult, error) {
+		return nil, boom
+	}, "pkg.Service.Method", metrics)
+	_, err = call(context.Background(), nil)
+	g.Expect(err).To(MatchError(boom))
+	g.Expect(gotErr).To(MatchError(boom))
+}
+
+func TestRecordMetricsDisabled(t *testing.T) {
+	g := NewWithT(t)
+
+	called := false
+	call := func(context.Context, map[string]interface{}) (*mcp.CallToolResult, error) {
+		called = true
+		return nil, nil
+	}
+	_, _ = RecordMetrics(call, "pkg.Service.Method", nil)(context.Background(), nil)
+	g.Expect(called).To(BeTrue())
+}
+
+func TestToolErrorPlainText(t *testing.T) {
+	g := NewWithT(t)
+
+	err := toolError("Error: something odd")
+	g.Expect(err).To(MatchError("Error: something odd"))
+	g.Expect(status.Code(err)).To(Equal(codes.Unknown))
+}
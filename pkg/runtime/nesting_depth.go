@@ -0,0 +1,40 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultMaxNestingDepth is the default limit on how deeply the objects and
+// arrays of tool arguments may be nested. Real schemas stay far below it.
+const DefaultMaxNestingDepth = 100
+
+// WithMaxNestingDepth sets how deeply the objects and arrays of tool
+// arguments may be nested before generated handlers reject the call with an
+// InvalidArgument tool error, instead of walking attacker-controlled input
+// without bound. Zero or less disables the limit.
+func WithMaxNestingDepth(depth int) Option {
+	return func(c *Config) {
+		c.MaxNestingDepth = depth
+	}
+}
+
+// NestingTooDeepError returns the error of arguments nested deeper than
+// limit.
+func NestingTooDeepError(limit int) error {
+	return status.Errorf(codes.InvalidArgument, "tool arguments are nested more than %d levels deep", limit)
+}
@@ -0,0 +1,36 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMaxNestingDepth(t *testing.T) {
+	g := NewWithT(t)
+
+	c := NewConfig()
+	g.Expect(c.MaxNestingDepth).To(Equal(DefaultMaxNestingDepth))
+	WithMaxNestingDepth(0)(c)
+	g.Expect(c.MaxNestingDepth).To(BeZero())
+
+	err := NestingTooDeepError(8)
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	g.Expect(err).To(MatchError(ContainSubstring("nested more than 8 levels deep")))
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// WithOnRegister adds fn, called by ForwardTo<Service>Client with every tool
+// it registers just before the tool is added to the server, in registration
+// order. fn sees the final tool, with overrides, extra properties and
+// defaults applied, and may adjust it, e.g. its annotations, description or
+// input schema, for instrumentation or last-mile tweaks. Changes to the
+// schema are checked by WithStartupValidation and used by the validation
+// tool. fn must not rename the tool: registration panics if it does. Rename
+// tools with WithToolNameOverride instead, which the rate limits, input
+// defaults and argument parsers follow. Repeated options run in the order
+// given.
+func WithOnRegister(fn func(tool *mcp.Tool)) Option {
+	return func(c *Config) {
+		c.OnRegister = append(c.OnRegister, fn)
+	}
+}
+
+// NotifyRegister passes tool to each of fns in order. It panics if one of
+// them changes the name of tool, which the rest of the registration keys on.
+func NotifyRegister(tool *mcp.Tool, fns []func(tool *mcp.Tool)) {
+	name := tool.Name
+	for _, fn := range fns {
+		fn(tool)
+		if tool.Name != name {
+			panic(fmt.Sprintf("runtime.WithOnRegister callback renamed tool %q to %q; use runtime.WithToolNameOverride instead", name, tool.Name))
+		}
+	}
+}
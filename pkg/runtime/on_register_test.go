@@ -0,0 +1,40 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+)
+
+func TestNotifyRegister(t *testing.T) {
+	g := NewWithT(t)
+
+	c := NewConfig()
+	WithOnRegister(func(tool *mcp.Tool) { tool.Description += " b" })(c)
+	WithOnRegister(func(tool *mcp.Tool) { tool.Description += " c" })(c)
+	tool := mcp.Tool{Name: "get_item", Description: "a"}
+	NotifyRegister(&tool, c.OnRegister)
+	g.Expect(tool.Description).To(Equal("a b c"))
+
+	// Without callbacks the tool is left as is.
+	NotifyRegister(&tool, nil)
+	g.Expect(tool.Description).To(Equal("a b c"))
+
+	// Renaming would split the name the registration keys on.
+	rename := []func(*mcp.Tool){func(tool *mcp.Tool) { tool.Name = "fetch_item" }}
+	g.Expect(func() { NotifyRegister(&tool, rename) }).To(PanicWith(ContainSubstring(`renamed tool "get_item" to "fetch_item"`)))
+}
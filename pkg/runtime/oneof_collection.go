@@ -0,0 +1,80 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// WrapOneOfCollections rewrites, in place, every oneof variant of message
+// whose message holds nothing but one repeated or map field and that was sent
+// as the bare list or map rather than as the message. Such a variant is
+// easily sent that way, since its object has a single property and the field
+// inside often shares the name of the variant, as in
+// {"object_type": "user_ids", "user_ids": ["a", "b"]}. A list is wrapped
+// into the message as its field; a map is wrapped unless it already has the
+// field as a key. md describes message, after TransformOneOfFields; variants
+// are found in nested messages, lists and map values too.
+func WrapOneOfCollections(message map[string]interface{}, md protoreflect.MessageDescriptor) {
+	// The visitor never fails.
+	_ = walkFields(message, md, "", wrapOneOfCollection)
+}
+
+// wrapOneOfCollection wraps v, a single value of fd, if fd is such a variant
+// sent as its bare list or map. The wrapped message is then walked like any
+// other.
+func wrapOneOfCollection(fd protoreflect.FieldDescriptor, _ string, v interface{}) (interface{}, error) {
+	if fd.Message() == nil {
+		return v, nil
+	}
+	if inner := collectionField(fd); inner != nil {
+		return wrapCollection(inner, v), nil
+	}
+	return v, nil
+}
+
+// collectionField returns the only field of the message of fd when fd is a
+// oneof variant and that field is repeated or a map, or nil otherwise.
+func collectionField(fd protoreflect.FieldDescriptor) protoreflect.FieldDescriptor {
+	oneof := fd.ContainingOneof()
+	if oneof == nil || oneof.IsSynthetic() || fd.Message().FullName().Parent() == "google.protobuf" {
+		return nil
+	}
+	fields := fd.Message().Fields()
+	if fields.Len() != 1 || !fields.Get(0).IsList() && !fields.Get(0).IsMap() {
+		return nil
+	}
+	return fields.Get(0)
+}
+
+// wrapCollection returns v as the message holding only inner when v is the
+// bare value of inner.
+func wrapCollection(inner protoreflect.FieldDescriptor, v interface{}) interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		if inner.IsList() {
+			return map[string]interface{}{string(inner.Name()): v}
+		}
+	case map[string]interface{}:
+		if !inner.IsMap() {
+			return v
+		}
+		_, hasName := v[string(inner.Name())]
+		_, hasJSONName := v[inner.JSONName()]
+		if !hasName && !hasJSONName {
+			return map[string]interface{}{string(inner.Name()): v}
+		}
+	}
+	return v
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestWrapOneOfCollections(t *testing.T) {
+	g := NewWithT(t)
+	md := (&testdata.DefineSegmentRequest{}).ProtoReflect().Descriptor()
+
+	message := map[string]interface{}{
+		"userIds": []interface{}{"a"}, // JSON name
+		"rules": []interface{}{
+			map[string]interface{}{"exclude": []interface{}{"b"}},
+			map[string]interface{}{"exclude": map[string]interface{}{"user_ids": []interface{}{"c"}}},
+		},
+	}
+	WrapOneOfCollections(message, md)
+	g.Expect(message).To(Equal(map[string]interface{}{
+		"userIds": map[string]interface{}{"user_ids": []interface{}{"a"}},
+		"rules": []interface{}{
+			map[string]interface{}{"exclude": map[string]interface{}{"user_ids": []interface{}{"b"}}},
+			map[string]interface{}{"exclude": map[string]interface{}{"user_ids": []interface{}{"c"}}},
+		},
+	}))
+
+	// A map is wrapped unless it already holds the field, by either name.
+	for _, filters := range []map[string]interface{}{
+		{"by_attribute": map[string]interface{}{"plan": "pro"}},
+		{"byAttribute": map[string]interface{}{"plan": "pro"}},
+	} {
+		message = map[string]interface{}{"filters": filters}
+		WrapOneOfCollections(message, md)
+		g.Expect(message["filters"]).To(Equal(filters))
+	}
+	message = map[string]interface{}{"filters": map[string]interface{}{"plan": "pro"}}
+	WrapOneOfCollections(message, md)
+	g.Expect(message["filters"]).To(Equal(map[string]interface{}{"by_attribute": map[string]interface{}{"plan": "pro"}}))
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithPanicRecovery sets whether a panic while handling a tool call, e.g. in
+// a response transformer or in the client, is turned into a tool error
+// result instead of unwinding into the MCP server. It is enabled by default.
+func WithPanicRecovery(enable bool) Option {
+	return func(c *Config) {
+		c.PanicRecovery = enable
+	}
+}
+
+// WithPanicStackTrace sets whether the tool error reporting a recovered panic
+// includes the stack trace of the panicking goroutine. The trace exposes
+// server internals to the client, so it is meant for development only.
+func WithPanicStackTrace(enable bool) Option {
+	return func(c *Config) {
+		c.PanicStackTrace = enable
+	}
+}
+
+// RecoverPanics wraps call so that a panic is reported as an Internal tool
+// error, with the stack trace when stackTrace is set. It returns call
+// unchanged when enable is false.
+func RecoverPanics(
+	call func(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error),
+	enable bool,
+	stackTrace bool,
+) func(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	if !enable {
+		return call
+	}
+	return func(ctx context.Context, args map[string]interface{}) (result *mcp.CallToolResult, err error) {
+		defer func() {
+			r := recover()
+			if r == nil {
+				return
+			}
+			msg := fmt.Sprintf("panic while handling tool call: %v", r)
+			if stackTrace {
+				msg += "\n" + string(debug.Stack())
+			}
+			result, err = HandleError(status.Error(codes.Internal, msg))
+		}()
+		return call(ctx, args)
+	}
+}
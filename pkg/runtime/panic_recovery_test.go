@@ -0,0 +1,73 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+)
+
+func TestRecoverPanics(t *testing.T) {
+	panicking := func(context.Context, map[string]interface{}) (*mcp.CallToolResult, error) {
+		panic("transformer exploded")
+	}
+
+	t.Run("panic becomes an internal tool error", func(t *testing.T) {
+		g := NewWithT(t)
+		res, err := RecoverPanics(panicking, true, false)(context.Background(), nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(res.IsError).To(BeTrue())
+		tc, ok := mcp.AsTextContent(res.Content[0])
+		g.Expect(ok).To(BeTrue())
+		g.Expect(tc.Text).To(ContainSubstring("INTERNAL"))
+		g.Expect(tc.Text).To(ContainSubstring("transformer exploded"))
+		g.Expect(tc.Text).ToNot(ContainSubstring("goroutine"))
+	})
+
+	t.Run("stack trace on request", func(t *testing.T) {
+		g := NewWithT(t)
+		res, err := RecoverPanics(panicking, true, true)(context.Background(), nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		tc, _ := mcp.AsTextContent(res.Content[0])
+		g.Expect(tc.Text).To(ContainSubstring("panic_recovery_test.go"))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(func() { _, _ = RecoverPanics(panicking, false, true)(context.Background(), nil) }).To(PanicWith("transformer exploded"))
+	})
+
+	t.Run("results pass through", func(t *testing.T) {
+		g := NewWithT(t)
+		ok := func(context.Context, map[string]interface{}) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultText("fine"), nil
+		}
+		res, err := RecoverPanics(ok, true, true)(context.Background(), nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(res.IsError).To(BeFalse())
+	})
+}
+
+func TestPanicRecoveryDefault(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(NewConfig().PanicRecovery).To(BeTrue())
+
+	c := NewConfig()
+	WithPanicRecovery(false)(c)
+	g.Expect(c.PanicRecovery).To(BeFalse())
+}
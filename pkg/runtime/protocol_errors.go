@@ -0,0 +1,56 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultProtocolErrorCodes are the gRPC codes of a failed forwarded call
+// that are reported as JSON-RPC errors unless WithProtocolErrorCodes says
+// otherwise. They are failures of the backend that the model cannot act on.
+// Any other code, such as NOT_FOUND, INVALID_ARGUMENT or ALREADY_EXISTS, is
+// reported as a tool result with isError set, so the model can reason about
+// it.
+var DefaultProtocolErrorCodes = []codes.Code{codes.Internal, codes.Unavailable, codes.DataLoss}
+
+// WithProtocolErrorCodes sets the gRPC codes of a failed forwarded call that
+// are reported as JSON-RPC errors instead of tool results with isError set,
+// replacing DefaultProtocolErrorCodes. With no codes, every error is a tool
+// result. Errors that are not gRPC statuses have the UNKNOWN code. A call
+// interrupted by the MCP client is always a tool result.
+func WithProtocolErrorCodes(cs ...codes.Code) Option {
+	return func(c *Config) {
+		c.ProtocolErrorCodes = append([]codes.Code{}, cs...)
+	}
+}
+
+// HandleCallErrorWithProtocolCodes is HandleCallError that returns err as a
+// protocol error when the call failed on its own with one of protocolCodes.
+func HandleCallErrorWithProtocolCodes(ctx context.Context, err error, retrySafe bool, protocolCodes []codes.Code) (*mcp.CallToolResult, error) {
+	if err != nil && ctx.Err() == nil {
+		code := status.Code(err)
+		for _, c := range protocolCodes {
+			if c == code {
+				return nil, err
+			}
+		}
+	}
+	return HandleCallError(ctx, err, retrySafe)
+}
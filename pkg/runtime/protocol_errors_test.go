@@ -0,0 +1,79 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHandleCallErrorWithProtocolCodes(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err      error
+		codes    []codes.Code
+		protocol bool
+	}{
+		"not found":          {err: status.Error(codes.NotFound, "no such item"), codes: DefaultProtocolErrorCodes},
+		"invalid argument":   {err: status.Error(codes.InvalidArgument, "bad id"), codes: DefaultProtocolErrorCodes},
+		"already exists":     {err: status.Error(codes.AlreadyExists, "taken"), codes: DefaultProtocolErrorCodes},
+		"plain error":        {err: errors.New("boom"), codes: DefaultProtocolErrorCodes},
+		"internal":           {err: status.Error(codes.Internal, "nil pointer"), codes: DefaultProtocolErrorCodes, protocol: true},
+		"unavailable":        {err: status.Error(codes.Unavailable, "connection refused"), codes: DefaultProtocolErrorCodes, protocol: true},
+		"none configured":    {err: status.Error(codes.Unavailable, "connection refused")},
+		"configured code":    {err: status.Error(codes.NotFound, "no such item"), codes: []codes.Code{codes.NotFound}, protocol: true},
+		"unknown configured": {err: errors.New("boom"), codes: []codes.Code{codes.Unknown}, protocol: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			res, err := HandleCallErrorWithProtocolCodes(context.Background(), tc.err, true, tc.codes)
+			if tc.protocol {
+				g.Expect(err).To(Equal(tc.err))
+				g.Expect(res).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(res.IsError).To(BeTrue())
+		})
+	}
+}
+
+func TestHandleCallErrorWithProtocolCodesInterrupted(t *testing.T) {
+	g := NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The client went away; that is not a backend failure.
+	res, err := HandleCallErrorWithProtocolCodes(ctx, status.Error(codes.Unavailable, "transport closing"), true, DefaultProtocolErrorCodes)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsError).To(BeTrue())
+}
+
+func TestWithProtocolErrorCodes(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(NewConfig().ProtocolErrorCodes).To(Equal(DefaultProtocolErrorCodes))
+
+	c := NewConfig()
+	WithProtocolErrorCodes(codes.Internal)(c)
+	g.Expect(c.ProtocolErrorCodes).To(Equal([]codes.Code{codes.Internal}))
+	WithProtocolErrorCodes()(c)
+	g.Expect(c.ProtocolErrorCodes).To(BeEmpty())
+}
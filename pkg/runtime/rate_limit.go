@@ -0,0 +1,172 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimit is the rate of calls a tool accepts: Rate calls per second on
+// average, in bursts of up to Burst calls. A Burst below 1 counts as 1. A
+// Rate of zero or less does not limit.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// WithRateLimit limits the rate of calls of the tools in limits, keyed by the
+// registered tool name, e.g. testdatamcp.TestService_GetItemToolName, or the
+// override of runtime.WithToolNameOverride. A call over the rate fails with
+// a RESOURCE_EXHAUSTED tool error, without waiting. Unlike
+// WithConcurrencyLimit this bounds calls per second, not calls in flight.
+// Every request of a batch call, and every call of a raw tool, counts
+// against the limit of the single tool. Repeated options are merged.
+func WithRateLimit(limits map[string]RateLimit) Option {
+	return func(c *Config) {
+		if c.RateLimits == nil {
+			c.RateLimits = make(map[string]RateLimit, len(limits))
+		}
+		for tool, limit := range limits {
+			c.RateLimits[tool] = limit
+		}
+	}
+}
+
+// WithDefaultRateLimit limits the rate of calls of every tool without a
+// WithRateLimit of its own.
+func WithDefaultRateLimit(limit RateLimit) Option {
+	return func(c *Config) {
+		c.DefaultRateLimit = limit
+	}
+}
+
+// WithRateLimitPerSession sets whether every MCP session gets rate limits of
+// its own, instead of sharing one per tool. Calls outside a session share
+// theirs.
+func WithRateLimitPerSession(enable bool) Option {
+	return func(c *Config) {
+		c.RateLimitPerSession = enable
+	}
+}
+
+// RateLimiter holds the token buckets of the rate limits of one
+// registration. Generated code creates one per registration. A nil
+// RateLimiter does not limit.
+type RateLimiter struct {
+	limits     map[string]RateLimit
+	fallback   RateLimit
+	perSession bool
+	now        func() time.Time
+
+	mu      sync.Mutex
+	buckets map[rateBucketKey]*rateBucket
+	sweepAt int
+}
+
+type rateBucketKey struct {
+	tool    string
+	session string
+}
+
+type rateBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// minRateSweep is the number of buckets below which idle per-session buckets
+// are not swept.
+const minRateSweep = 1024
+
+// NewRateLimiter returns a RateLimiter for the limits of tools, the fallback
+// limit of other tools and whether sessions are limited apart, or nil when
+// nothing is limited.
+func NewRateLimiter(limits map[string]RateLimit, fallback RateLimit, perSession bool) *RateLimiter {
+	limited := fallback.Rate > 0
+	for _, limit := range limits {
+		limited = limited || limit.Rate > 0
+	}
+	if !limited {
+		return nil
+	}
+	return &RateLimiter{
+		limits:     limits,
+		fallback:   fallback,
+		perSession: perSession,
+		now:        time.Now,
+		buckets:    map[rateBucketKey]*rateBucket{},
+		sweepAt:    minRateSweep,
+	}
+}
+
+// Allow takes a call of tool out of its bucket, or returns a
+// RESOURCE_EXHAUSTED error telling when the next call will be accepted.
+func (l *RateLimiter) Allow(ctx context.Context, tool string) error {
+	if l == nil {
+		return nil
+	}
+	limit, ok := l.limits[tool]
+	if !ok {
+		limit = l.fallback
+	}
+	if limit.Rate <= 0 {
+		return nil
+	}
+	burst := math.Max(float64(limit.Burst), 1)
+	key := rateBucketKey{tool: tool}
+	if session := mcpserver.ClientSessionFromContext(ctx); l.perSession && session != nil {
+		key.session = session.SessionID()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= l.sweepAt {
+			l.sweep(now)
+		}
+		b = &rateBucket{tokens: burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*limit.Rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return nil
+	}
+	wait := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second)).Round(time.Millisecond)
+	return status.Errorf(codes.ResourceExhausted, "too many calls of %s; the limit is %g per second, retry in %s", tool, limit.Rate, wait)
+}
+
+// sweep drops the buckets that have refilled, which are the same as new
+// ones, so that buckets of ended sessions do not pile up.
+func (l *RateLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		limit, ok := l.limits[key.tool]
+		if !ok {
+			limit = l.fallback
+		}
+		if b.tokens+now.Sub(b.last).Seconds()*limit.Rate >= math.Max(float64(limit.Burst), 1) {
+			delete(l.buckets, key)
+		}
+	}
+	l.sweepAt = max(minRateSweep, 2*len(l.buckets))
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// idSession is an initialized client session with the given id.
+type idSession string
+
+func (s idSession) Initialize()                                         {}
+func (s idSession) Initialized() bool                                   { return true }
+func (s idSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return nil }
+func (s idSession) SessionID() string                                   { return string(s) }
+
+func TestRateLimiter(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(NewRateLimiter(nil, RateLimit{}, false)).To(BeNil())
+	g.Expect(NewRateLimiter(map[string]RateLimit{"a": {}}, RateLimit{}, false)).To(BeNil())
+	var none *RateLimiter
+	g.Expect(none.Allow(context.Background(), "a")).To(Succeed())
+
+	now := time.Unix(0, 0)
+	l := NewRateLimiter(map[string]RateLimit{"search": {Rate: 2, Burst: 3}}, RateLimit{}, false)
+	l.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for range 3 {
+		g.Expect(l.Allow(ctx, "search")).To(Succeed())
+	}
+	err := l.Allow(ctx, "search")
+	g.Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+	g.Expect(status.Convert(err).Message()).To(Equal("too many calls of search; the limit is 2 per second, retry in 500ms"))
+	// Tools without a limit, and without a default, are not limited.
+	g.Expect(l.Allow(ctx, "other")).To(Succeed())
+
+	// Two calls a second refill.
+	now = now.Add(time.Second)
+	g.Expect(l.Allow(ctx, "search")).To(Succeed())
+	g.Expect(l.Allow(ctx, "search")).To(Succeed())
+	g.Expect(l.Allow(ctx, "search")).ToNot(Succeed())
+
+	// Sessions share the bucket of a tool unless limited apart.
+	session := mcpserver.NewMCPServer("test-server", "1.0.0").WithContext(ctx, idSession("s-1"))
+	g.Expect(l.Allow(session, "search")).ToNot(Succeed())
+}
+
+func TestRateLimiterDefaultAndSessions(t *testing.T) {
+	g := NewWithT(t)
+
+	now := time.Unix(0, 0)
+	l := NewRateLimiter(map[string]RateLimit{"free": {Rate: 0}}, RateLimit{Rate: 1}, true)
+	l.now = func() time.Time { return now }
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	one := s.WithContext(context.Background(), idSession("s-1"))
+	two := s.WithContext(context.Background(), idSession("s-2"))
+
+	// A Burst below 1 counts as 1.
+	g.Expect(l.Allow(one, "get")).To(Succeed())
+	g.Expect(l.Allow(one, "get")).ToNot(Succeed())
+	g.Expect(l.Allow(two, "get")).To(Succeed())
+	// A limit of its own without a rate exempts a tool from the default.
+	for range 3 {
+		g.Expect(l.Allow(one, "free")).To(Succeed())
+	}
+
+	// Idle buckets of ended sessions are swept as new ones come in.
+	for i := range minRateSweep - 2 {
+		g.Expect(l.Allow(s.WithContext(context.Background(), idSession(fmt.Sprint("bulk-", i))), "get")).To(Succeed())
+	}
+	now = now.Add(time.Second)
+	g.Expect(l.Allow(s.WithContext(context.Background(), idSession("late")), "get")).To(Succeed())
+	g.Expect(l.buckets).To(HaveLen(1))
+}
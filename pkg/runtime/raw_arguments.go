@@ -0,0 +1,44 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RawRequestArgument is the argument of a generated raw tool holding the
+// arguments of its tool as JSON.
+const RawRequestArgument = "request"
+
+// RawArguments decodes the RawRequestArgument string of args, the arguments
+// of a raw tool, into the arguments of its tool. A missing argument, or one
+// that is not a JSON object encoded in a string, is an INVALID_ARGUMENT
+// error.
+func RawArguments(args map[string]interface{}) (map[string]interface{}, error) {
+	raw, ok := args[RawRequestArgument].(string)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "%q must be a string holding the arguments as a JSON object", RawRequestArgument)
+	}
+	var message map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &message); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%q is not a JSON object: %v", RawRequestArgument, err)
+	}
+	if message == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%q is not a JSON object", RawRequestArgument)
+	}
+	return message, nil
+}
@@ -0,0 +1,42 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRawArguments(t *testing.T) {
+	g := NewWithT(t)
+
+	args, err := RawArguments(map[string]interface{}{"request": `{"text": "buy milk", "position": 2}`})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(args).To(Equal(map[string]interface{}{"text": "buy milk", "position": 2.0}))
+
+	for _, args := range []map[string]interface{}{
+		{},
+		{"request": 7},
+		{"request": `{"text": "buy milk"`},
+		{"request": `"buy milk"`},
+		{"request": `null`},
+		{"request": `{} {}`},
+	} {
+		_, err := RawArguments(args)
+		g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument), "args: %v", args)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// RequestInterceptor inspects the typed request of a tool call after it was
+// built from the tool arguments and before it is forwarded to the backend.
+// method is the fully-qualified proto method name, such as
+// "example.v1.UserService.GetUser". The interceptor may mutate req, e.g. to
+// inject a tenant ID, or return an error to fail the call without reaching
+// the backend.
+type RequestInterceptor func(ctx context.Context, method string, req proto.Message) error
+
+// WithRequestInterceptor adds a RequestInterceptor applied to every request.
+// Repeated options run in the order given.
+func WithRequestInterceptor(interceptor RequestInterceptor) Option {
+	return func(c *Config) {
+		c.RequestInterceptors = append(c.RequestInterceptors, interceptor)
+	}
+}
+
+// InterceptRequest runs req through interceptors in order, stopping at the
+// first error.
+func InterceptRequest(ctx context.Context, method string, req proto.Message, interceptors []RequestInterceptor) error {
+	for _, intercept := range interceptors {
+		if err := intercept(ctx, method, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
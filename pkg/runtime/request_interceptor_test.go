@@ -0,0 +1,66 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestInterceptRequest(t *testing.T) {
+	appendSuffix := func(suffix string) RequestInterceptor {
+		return func(_ context.Context, _ string, req proto.Message) error {
+			s := req.(*wrapperspb.StringValue)
+			s.Value += suffix
+			return nil
+		}
+	}
+
+	t.Run("applied in order", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewConfig()
+		WithRequestInterceptor(appendSuffix("b"))(c)
+		WithRequestInterceptor(appendSuffix("c"))(c)
+		req := wrapperspb.String("a")
+		g.Expect(InterceptRequest(context.Background(), "pkg.Svc.Method", req, c.RequestInterceptors)).To(Succeed())
+		g.Expect(req.GetValue()).To(Equal("abc"))
+	})
+
+	t.Run("receives the method", func(t *testing.T) {
+		g := NewWithT(t)
+		var method string
+		err := InterceptRequest(context.Background(), "pkg.Svc.Method", wrapperspb.String("a"), []RequestInterceptor{
+			func(_ context.Context, m string, _ proto.Message) error { method = m; return nil },
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(method).To(Equal("pkg.Svc.Method"))
+	})
+
+	t.Run("error stops the chain", func(t *testing.T) {
+		g := NewWithT(t)
+		called := false
+		err := InterceptRequest(context.Background(), "pkg.Svc.Method", wrapperspb.String("a"), []RequestInterceptor{
+			func(context.Context, string, proto.Message) error { return errors.New("tenant not allowed") },
+			func(context.Context, string, proto.Message) error { called = true; return nil },
+		})
+		g.Expect(err).To(MatchError("tenant not allowed"))
+		g.Expect(called).To(BeFalse())
+	})
+}
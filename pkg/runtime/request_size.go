@@ -0,0 +1,53 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithMaxRequestBytes makes generated handlers reject tool arguments whose
+// JSON encoding is longer than n bytes with an InvalidArgument tool error,
+// before they are transformed or unmarshaled into the request. For a batch
+// tool the limit applies to each request and to the whole batch. Zero or
+// less, the default, disables the limit.
+//
+// The arguments were already read and parsed by the MCP transport, so this
+// bounds the work done on them; limit the size of transport messages as well
+// on internet-facing servers.
+func WithMaxRequestBytes(n int) Option {
+	return func(c *Config) {
+		c.MaxRequestBytes = n
+	}
+}
+
+// CheckRequestSize returns an InvalidArgument error when the JSON encoding of
+// args is longer than limit bytes. limit <= 0 allows any size.
+func CheckRequestSize(args map[string]interface{}, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(marshaled) > limit {
+		return status.Errorf(codes.InvalidArgument, "tool arguments are %d bytes, over the limit of %d bytes", len(marshaled), limit)
+	}
+	return nil
+}
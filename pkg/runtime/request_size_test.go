@@ -0,0 +1,37 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckRequestSize(t *testing.T) {
+	g := NewWithT(t)
+
+	// {"a":"xxxxx"} is 13 bytes.
+	args := map[string]interface{}{"a": strings.Repeat("x", 5)}
+	g.Expect(CheckRequestSize(args, 13)).To(Succeed())
+	g.Expect(CheckRequestSize(args, 0)).To(Succeed(), "0 disables the limit")
+
+	err := CheckRequestSize(args, 12)
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	g.Expect(err).To(MatchError(ContainSubstring("tool arguments are 13 bytes, over the limit of 12 bytes")))
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// WithResponseFieldAllowlist limits the responses of the methods in
+// allowlist, keyed by fully-qualified method name (e.g.
+// "testdata.TestService.GetItem"), to the given field paths. A path is a
+// dot-separated list of proto field names, e.g. "item.name"; it keeps the
+// whole value at it, applies to every element of a list on the way, and
+// takes a map key for a map field. Fields are removed from the marshaled
+// response, after response transformers, so that a deployment can minimize
+// what reaches the model without regenerating. Methods not in allowlist
+// return every field, and a method mapped to no paths returns an empty
+// object. Repeated options are merged.
+func WithResponseFieldAllowlist(allowlist map[string][]string) Option {
+	return func(c *Config) {
+		if c.ResponseFieldAllowlist == nil {
+			c.ResponseFieldAllowlist = make(map[string][]string, len(allowlist))
+		}
+		for method, paths := range allowlist {
+			// Copy, so that no paths stays distinct from no allowlist.
+			c.ResponseFieldAllowlist[method] = append([]string{}, paths...)
+		}
+	}
+}
+
+// PruneResponseFields returns marshaled, the JSON object of a response, with
+// only the values at paths. A nil paths keeps every field.
+func PruneResponseFields(marshaled []byte, paths []string) ([]byte, error) {
+	if paths == nil {
+		return marshaled, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(marshaled))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	doc = pruneValue(doc, newFieldTree(paths))
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// FieldAllowed reports whether paths keep the field at path: paths is nil,
+// or holds path or one of its parents.
+func FieldAllowed(paths []string, path string) bool {
+	if paths == nil {
+		return true
+	}
+	for _, p := range paths {
+		if p == path || strings.HasPrefix(path, p+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldTree is the set of allowlisted paths by segment. A nil subtree keeps
+// the whole value.
+type fieldTree map[string]fieldTree
+
+func newFieldTree(paths []string) fieldTree {
+	root := fieldTree{}
+	for _, path := range paths {
+		node := root
+		segments := strings.Split(path, ".")
+		for i, segment := range segments {
+			child, seen := node[segment]
+			if seen && child == nil {
+				// A shorter path already keeps the whole value.
+				break
+			}
+			if i == len(segments)-1 {
+				node[segment] = nil
+				break
+			}
+			if child == nil {
+				child = fieldTree{}
+				node[segment] = child
+			}
+			node = child
+		}
+	}
+	return root
+}
+
+// pruneValue drops the object keys of v that are not in tree, descending
+// into lists element by element.
+func pruneValue(v interface{}, tree fieldTree) interface{} {
+	if tree == nil {
+		return v
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			subtree, ok := tree[key]
+			if !ok {
+				delete(v, key)
+				continue
+			}
+			v[key] = pruneValue(value, subtree)
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = pruneValue(elem, tree)
+		}
+	}
+	return v
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPruneResponseFields(t *testing.T) {
+	const resp = `{"id":"o-1","total":{"units":"12","currency":"EUR"},"lines":[{"sku":"A","qty":1},{"sku":"B","qty":2}],"labels":{"team":"x","env":"prod"},"note":"<b>"}`
+
+	for name, tc := range map[string]struct {
+		paths []string
+		want  string
+	}{
+		"no allowlist":  {paths: nil, want: resp},
+		"no fields":     {paths: []string{}, want: `{}`},
+		"top level":     {paths: []string{"id", "note"}, want: `{"id":"o-1","note":"<b>"}`},
+		"nested":        {paths: []string{"total.units"}, want: `{"total":{"units":"12"}}`},
+		"list elements": {paths: []string{"lines.sku"}, want: `{"lines":[{"sku":"A"},{"sku":"B"}]}`},
+		"map key":       {paths: []string{"labels.env"}, want: `{"labels":{"env":"prod"}}`},
+		"parent wins":   {paths: []string{"total.units", "total"}, want: `{"total":{"units":"12","currency":"EUR"}}`},
+		"unknown field": {paths: []string{"missing.field"}, want: `{}`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			pruned, err := PruneResponseFields([]byte(resp), tc.paths)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(pruned)).To(MatchJSON(tc.want))
+		})
+	}
+}
+
+func TestPruneResponseFieldsKeepsNumbersAndHTML(t *testing.T) {
+	g := NewWithT(t)
+
+	pruned, err := PruneResponseFields([]byte(`{"big":12345678901234567890,"html":"<b>","x":1}`), []string{"big", "html"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(pruned)).To(Equal(`{"big":12345678901234567890,"html":"<b>"}`))
+}
+
+func TestFieldAllowed(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(FieldAllowed(nil, "summary")).To(BeTrue())
+	g.Expect(FieldAllowed([]string{}, "summary")).To(BeFalse())
+	g.Expect(FieldAllowed([]string{"summary"}, "summary")).To(BeTrue())
+	g.Expect(FieldAllowed([]string{"item"}, "item.name")).To(BeTrue())
+	g.Expect(FieldAllowed([]string{"item.name"}, "item")).To(BeFalse())
+	g.Expect(FieldAllowed([]string{"summary_text"}, "summary")).To(BeFalse())
+}
+
+func TestWithResponseFieldAllowlist(t *testing.T) {
+	g := NewWithT(t)
+
+	c := NewConfig()
+	WithResponseFieldAllowlist(map[string][]string{"pkg.Svc.A": {"id"}, "pkg.Svc.B": nil})(c)
+	WithResponseFieldAllowlist(map[string][]string{"pkg.Svc.C": {"name"}})(c)
+	g.Expect(c.ResponseFieldAllowlist).To(HaveLen(3))
+	g.Expect(c.ResponseFieldAllowlist["pkg.Svc.A"]).To(Equal([]string{"id"}))
+	// A method listed without paths returns an empty object, unlike a
+	// method that is not listed.
+	g.Expect(c.ResponseFieldAllowlist["pkg.Svc.B"]).ToNot(BeNil())
+	g.Expect(c.ResponseFieldAllowlist["pkg.Svc.D"]).To(BeNil())
+}
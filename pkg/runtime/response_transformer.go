@@ -0,0 +1,50 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ResponseTransformer rewrites a gRPC response before it is marshaled into the
+// tool result, e.g. to redact PII or to enrich it. Returning an error fails
+// the call with that error.
+type ResponseTransformer func(ctx context.Context, resp proto.Message) (proto.Message, error)
+
+// WithResponseTransformer adds a ResponseTransformer applied to every
+// response. Repeated options run in the order given.
+func WithResponseTransformer(transformer ResponseTransformer) Option {
+	return func(c *Config) {
+		c.ResponseTransformers = append(c.ResponseTransformers, transformer)
+	}
+}
+
+// TransformResponse runs resp through transformers in order.
+func TransformResponse(ctx context.Context, resp proto.Message, transformers []ResponseTransformer) (proto.Message, error) {
+	for _, transform := range transformers {
+		var err error
+		resp, err = transform(ctx, resp)
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			return nil, errors.New("response transformer returned a nil message")
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestTransformResponse(t *testing.T) {
+	appendSuffix := func(suffix string) ResponseTransformer {
+		return func(_ context.Context, resp proto.Message) (proto.Message, error) {
+			return wrapperspb.String(resp.(*wrapperspb.StringValue).GetValue() + suffix), nil
+		}
+	}
+
+	t.Run("no transformers", func(t *testing.T) {
+		g := NewWithT(t)
+		in := wrapperspb.String("a")
+		out, err := TransformResponse(context.Background(), in, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(out).To(BeIdenticalTo(in))
+	})
+
+	t.Run("applied in order", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewConfig()
+		WithResponseTransformer(appendSuffix("b"))(c)
+		WithResponseTransformer(appendSuffix("c"))(c)
+		out, err := TransformResponse(context.Background(), wrapperspb.String("a"), c.ResponseTransformers)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(out.(*wrapperspb.StringValue).GetValue()).To(Equal("abc"))
+	})
+
+	t.Run("error stops the chain", func(t *testing.T) {
+		g := NewWithT(t)
+		called := false
+		_, err := TransformResponse(context.Background(), wrapperspb.String("a"), []ResponseTransformer{
+			func(context.Context, proto.Message) (proto.Message, error) {
+				return nil, errors.New("redaction failed")
+			},
+			func(_ context.Context, resp proto.Message) (proto.Message, error) { called = true; return resp, nil },
+		})
+		g.Expect(err).To(MatchError("redaction failed"))
+		g.Expect(called).To(BeFalse())
+	})
+
+	t.Run("nil message", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := TransformResponse(context.Background(), wrapperspb.String("a"), []ResponseTransformer{
+			func(context.Context, proto.Message) (proto.Message, error) { return nil, nil },
+		})
+		g.Expect(err).To(MatchError(ContainSubstring("nil message")))
+	})
+}
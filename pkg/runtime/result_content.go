@@ -0,0 +1,75 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"text/template"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ContentTypeMetaKey is the key of the _meta of a text content block that
+// holds its media type under (mcp.options.tool) result_content_type, e.g.
+// "text/markdown".
+const ContentTypeMetaKey = "mimeType"
+
+// WithContentType marks the last content block of result, which holds the
+// response, as of media type contentType, and returns result. A block that
+// is not text is left as is.
+func WithContentType(result *mcp.CallToolResult, contentType string) *mcp.CallToolResult {
+	if len(result.Content) == 0 {
+		return result
+	}
+	last := len(result.Content) - 1
+	text, ok := result.Content[last].(mcp.TextContent)
+	if !ok {
+		return result
+	}
+	if text.Meta == nil {
+		text.Meta = &mcp.Meta{}
+	}
+	if text.Meta.AdditionalFields == nil {
+		text.Meta.AdditionalFields = map[string]any{}
+	}
+	text.Meta.AdditionalFields[ContentTypeMetaKey] = contentType
+	result.Content[last] = text
+	return result
+}
+
+// ParseResultTemplate parses text, the (mcp.options.tool) result_template of
+// methodName.
+func ParseResultTemplate(methodName, text string) (*template.Template, error) {
+	return template.New(methodName).Parse(text)
+}
+
+// RenderResultTemplate executes tpl over marshaled, the JSON of a response,
+// and returns the text it produces. Numbers are passed to the template as
+// written in the JSON. A failing template is an INTERNAL error.
+func RenderResultTemplate(tpl *template.Template, marshaled []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(marshaled))
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return nil, status.Errorf(codes.Internal, "result template %s: %v", tpl.Name(), err)
+	}
+	var out bytes.Buffer
+	if err := tpl.Execute(&out, data); err != nil {
+		return nil, status.Errorf(codes.Internal, "result template %s: %v", tpl.Name(), err)
+	}
+	return out.Bytes(), nil
+}
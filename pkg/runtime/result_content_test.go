@@ -0,0 +1,50 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWithContentType(t *testing.T) {
+	g := NewWithT(t)
+
+	result := WithContentType(mcp.NewToolResultText(`{"a": 1}`), "application/json")
+	g.Expect(result.Content).To(HaveLen(1))
+	g.Expect(result.Content[0].(mcp.TextContent).Meta.AdditionalFields).To(HaveKeyWithValue(ContentTypeMetaKey, "application/json"))
+
+	result = WithContentType(&mcp.CallToolResult{Content: []mcp.Content{mcp.NewTextContent("summary"), mcp.NewTextContent("# Title")}}, "text/markdown")
+	g.Expect(result.Content[0].(mcp.TextContent).Meta).To(BeNil())
+	g.Expect(result.Content[1].(mcp.TextContent).Meta.AdditionalFields).To(HaveKeyWithValue(ContentTypeMetaKey, "text/markdown"))
+}
+
+func TestRenderResultTemplate(t *testing.T) {
+	g := NewWithT(t)
+
+	tpl, err := ParseResultTemplate("testdata.ArticleService.RenderArticle", "{{.title}} ({{.views}} views)")
+	g.Expect(err).ToNot(HaveOccurred())
+	out, err := RenderResultTemplate(tpl, []byte(`{"title": "Release", "views": 9007199254740993}`))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(out)).To(Equal("Release (9007199254740993 views)"))
+
+	tpl, err = ParseResultTemplate("testdata.ArticleService.RenderArticle", "{{.title.text}}")
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = RenderResultTemplate(tpl, []byte(`{"title": "Release"}`))
+	g.Expect(status.Code(err)).To(Equal(codes.Internal))
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ToolResultPostProcessor reshapes the result of a tool, the decoded JSON of
+// the response of methodName (e.g. "testdata.TestService.GetItem"), and
+// returns the value to send instead, such as an envelope around it. Numbers
+// in result are json.Number, so that 64-bit values survive unchanged.
+type ToolResultPostProcessor func(methodName string, result any) any
+
+// WithToolResultPostProcessor adds a ToolResultPostProcessor applied to the
+// result of every tool, after response transformers and the response field
+// allowlist and before TOON compression. Repeated options run in the order
+// given.
+func WithToolResultPostProcessor(processor ToolResultPostProcessor) Option {
+	return func(c *Config) {
+		c.ResultPostProcessors = append(c.ResultPostProcessors, processor)
+	}
+}
+
+// PostProcessResult runs marshaled, the JSON of the response of methodName,
+// through processors in order and returns the JSON of the final value.
+// Without processors marshaled is returned as is.
+func PostProcessResult(methodName string, marshaled []byte, processors []ToolResultPostProcessor) ([]byte, error) {
+	if len(processors) == 0 {
+		return marshaled, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(marshaled))
+	dec.UseNumber()
+	var result any
+	if err := dec.Decode(&result); err != nil {
+		return nil, err
+	}
+	for _, process := range processors {
+		result = process(methodName, result)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(result); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPostProcessResult(t *testing.T) {
+	g := NewWithT(t)
+
+	// Without processors the result is returned as is.
+	out, err := PostProcessResult("pkg.Svc.Get", []byte(`{"id":"a"}`), nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(out)).To(Equal(`{"id":"a"}`))
+
+	var methods []string
+	processors := []ToolResultPostProcessor{
+		func(methodName string, result any) any {
+			methods = append(methods, methodName)
+			return map[string]any{"data": result}
+		},
+		func(_ string, result any) any {
+			result.(map[string]any)["meta"] = map[string]any{"version": 1}
+			return result
+		},
+	}
+	out, err = PostProcessResult("pkg.Svc.Get", []byte(`{"big":12345678901234567890,"html":"<b>"}`), processors)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(methods).To(Equal([]string{"pkg.Svc.Get"}))
+	g.Expect(string(out)).To(Equal(`{"data":{"big":12345678901234567890,"html":"<b>"},"meta":{"version":1}}`))
+
+	_, err = PostProcessResult("pkg.Svc.Get", []byte(`{`), processors)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestPostProcessResultUnencodable(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := PostProcessResult("pkg.Svc.Get", []byte(`{}`), []ToolResultPostProcessor{
+		func(string, any) any { return func() {} },
+	})
+	g.Expect(err).To(BeAssignableToTypeOf(&json.UnsupportedTypeError{}))
+}
@@ -0,0 +1,419 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SchemaChange is a difference between two versions of the input schema of
+// a tool, found by DiffSchemas.
+type SchemaChange struct {
+	// Path locates the change: property names joined with dots, "[]" for
+	// the items of an array, ".*" for the values of a map and "(title)" for
+	// a oneOf variant, e.g. "item.tags[]". The root is "".
+	Path string
+	// Breaking reports whether arguments valid under the old schema may be
+	// rejected under the new one, so that a model or client built against
+	// the old contract can fail.
+	Breaking bool
+	// Description says what changed, e.g. "became required".
+	Description string
+}
+
+func (c SchemaChange) String() string {
+	kind := "non-breaking"
+	if c.Breaking {
+		kind = "breaking"
+	}
+	if c.Path == "" {
+		return kind + ": " + c.Description
+	}
+	return kind + ": " + c.Path + ": " + c.Description
+}
+
+// DiffSchemas compares two versions of the input schema of a tool, as
+// generated, for CI checks of tool contracts. Changes that reject arguments
+// the old schema accepted are breaking: a removed property, a new required
+// property or a property that became required, a narrower type or enum, a
+// tighter bound, pattern or format, and closed additional properties.
+// Loosening changes, such as a new optional property or a wider type or
+// format, are not; an integer that became a number is compatible. $refs are resolved against the $defs of each schema. Descriptions and
+// other annotations are not compared. A schema that is not a JSON object is
+// reported as one breaking change. The changes are sorted by path.
+func DiffSchemas(oldSchema, newSchema json.RawMessage) []SchemaChange {
+	var o, n map[string]any
+	if err := json.Unmarshal(oldSchema, &o); err != nil {
+		return []SchemaChange{{Breaking: true, Description: "old schema is not a JSON object: " + err.Error()}}
+	}
+	if err := json.Unmarshal(newSchema, &n); err != nil {
+		return []SchemaChange{{Breaking: true, Description: "new schema is not a JSON object: " + err.Error()}}
+	}
+	d := &schemaDiff{oldRoot: o, newRoot: n, seen: map[[2]string]bool{}}
+	d.compare("", o, n)
+	sort.SliceStable(d.changes, func(i, j int) bool { return d.changes[i].Path < d.changes[j].Path })
+	return d.changes
+}
+
+type schemaDiff struct {
+	oldRoot, newRoot map[string]any
+	// seen holds the pairs of $refs being compared, so that recursive
+	// messages are compared once.
+	seen    map[[2]string]bool
+	changes []SchemaChange
+}
+
+func (d *schemaDiff) add(path string, breaking bool, format string, args ...any) {
+	d.changes = append(d.changes, SchemaChange{Path: path, Breaking: breaking, Description: fmt.Sprintf(format, args...)})
+}
+
+// lowerBounds and upperBounds are the keywords that bound values from below
+// and above.
+var (
+	lowerBounds = []string{"minimum", "exclusiveMinimum", "minLength", "minItems", "minProperties"}
+	upperBounds = []string{"maximum", "exclusiveMaximum", "maxLength", "maxItems", "maxProperties"}
+)
+
+func (d *schemaDiff) compare(path string, o, n map[string]any) {
+	oldRef, _ := o["$ref"].(string)
+	newRef, _ := n["$ref"].(string)
+	if oldRef != "" && newRef != "" {
+		pair := [2]string{oldRef, newRef}
+		if d.seen[pair] {
+			return
+		}
+		d.seen[pair] = true
+		defer delete(d.seen, pair)
+	}
+	o = resolveSchemaRef(d.oldRoot, o)
+	n = resolveSchemaRef(d.newRoot, n)
+
+	d.compareTypes(path, o, n)
+	d.compareEnums(path, o, n)
+	if oldConst, newConst := jsonText(o["const"]), jsonText(n["const"]); oldConst != newConst {
+		switch {
+		case newConst == "":
+			d.add(path, false, "const %s removed", oldConst)
+		case oldConst == "":
+			d.add(path, true, "const %s added", newConst)
+		default:
+			d.add(path, true, "const changed from %s to %s", oldConst, newConst)
+		}
+	}
+	for _, key := range lowerBounds {
+		d.compareBound(path, key, o, n, true)
+	}
+	for _, key := range upperBounds {
+		d.compareBound(path, key, o, n, false)
+	}
+	for _, key := range []string{"pattern", "format"} {
+		oldValue, _ := o[key].(string)
+		newValue, _ := n[key].(string)
+		switch {
+		case oldValue == newValue:
+		case newValue == "":
+			d.add(path, false, "%s %q removed", key, oldValue)
+		case oldValue == "":
+			d.add(path, true, "%s %q added", key, newValue)
+		default:
+			breaking := key == "pattern" || !containsFormat(newValue, oldValue)
+			d.add(path, breaking, "%s changed from %q to %q", key, oldValue, newValue)
+		}
+	}
+	d.compareProperties(path, o, n)
+	if oldItems, newItems := schemaObject(o["items"]), schemaObject(n["items"]); oldItems != nil && newItems != nil {
+		d.compare(path+"[]", oldItems, newItems)
+	}
+	d.compareVariants(path, "oneOf", o, n)
+	d.compareVariants(path, "anyOf", o, n)
+}
+
+// compareTypes compares the types of o and n. No type accepts any type.
+func (d *schemaDiff) compareTypes(path string, o, n map[string]any) {
+	oldTypes, newTypes := schemaTypes(o), schemaTypes(n)
+	if strings.Join(oldTypes, ",") == strings.Join(newTypes, ",") {
+		return
+	}
+	describe := func(types []string) string {
+		if types == nil {
+			return "any"
+		}
+		return strings.Join(types, " or ")
+	}
+	breaking := newTypes != nil
+	if oldTypes != nil && newTypes != nil {
+		breaking = false
+		for _, t := range oldTypes {
+			if !containsType(newTypes, t) {
+				breaking = true
+			}
+		}
+	}
+	d.add(path, breaking, "type changed from %s to %s", describe(oldTypes), describe(newTypes))
+}
+
+func (d *schemaDiff) compareEnums(path string, o, n map[string]any) {
+	oldValues, oldOK := o["enum"].([]any)
+	newValues, newOK := n["enum"].([]any)
+	switch {
+	case !oldOK && !newOK:
+		return
+	case !newOK:
+		d.add(path, false, "enum removed")
+		return
+	case !oldOK:
+		d.add(path, true, "enum added")
+		return
+	}
+	newSet := map[string]bool{}
+	for _, v := range newValues {
+		newSet[jsonText(v)] = true
+	}
+	oldSet := map[string]bool{}
+	for _, v := range oldValues {
+		oldSet[jsonText(v)] = true
+		if !newSet[jsonText(v)] {
+			d.add(path, true, "enum value %s removed", jsonText(v))
+		}
+	}
+	for _, v := range newValues {
+		if !oldSet[jsonText(v)] {
+			d.add(path, false, "enum value %s added", jsonText(v))
+		}
+	}
+}
+
+// compareBound compares the bound key of o and n. Raising a lower bound or
+// lowering an upper bound is breaking.
+func (d *schemaDiff) compareBound(path, key string, o, n map[string]any, lower bool) {
+	oldValue, oldOK := o[key].(float64)
+	newValue, newOK := n[key].(float64)
+	switch {
+	case !oldOK && !newOK, oldOK && newOK && oldValue == newValue:
+	case !newOK:
+		d.add(path, false, "%s %v removed", key, oldValue)
+	case !oldOK:
+		d.add(path, true, "%s %v added", key, newValue)
+	default:
+		d.add(path, (newValue > oldValue) == lower, "%s changed from %v to %v", key, oldValue, newValue)
+	}
+}
+
+func (d *schemaDiff) compareProperties(path string, o, n map[string]any) {
+	oldProps, _ := o["properties"].(map[string]any)
+	newProps, _ := n["properties"].(map[string]any)
+	oldRequired, newRequired := stringSet(o["required"]), stringSet(n["required"])
+	for _, name := range sortedSchemaKeys(oldProps) {
+		if _, ok := newProps[name]; !ok {
+			d.add(joinSchemaPath(path, name), true, "property removed")
+		}
+	}
+	for _, name := range sortedSchemaKeys(newProps) {
+		if _, ok := oldProps[name]; !ok {
+			if newRequired[name] {
+				d.add(joinSchemaPath(path, name), true, "required property added")
+			} else {
+				d.add(joinSchemaPath(path, name), false, "optional property added")
+			}
+			continue
+		}
+		switch {
+		case newRequired[name] && !oldRequired[name]:
+			d.add(joinSchemaPath(path, name), true, "became required")
+		case oldRequired[name] && !newRequired[name]:
+			d.add(joinSchemaPath(path, name), false, "no longer required")
+		}
+		oldProp, newProp := schemaObject(oldProps[name]), schemaObject(newProps[name])
+		if oldProp != nil && newProp != nil {
+			d.compare(joinSchemaPath(path, name), oldProp, newProp)
+		}
+	}
+
+	// additionalProperties is true when absent.
+	oldAdditional, newAdditional := o["additionalProperties"], n["additionalProperties"]
+	if oldValues, newValues := schemaObject(oldAdditional), schemaObject(newAdditional); oldValues != nil && newValues != nil {
+		d.compare(path+".*", oldValues, newValues)
+		return
+	}
+	oldClosed, newClosed := oldAdditional == false, newAdditional == false
+	oldOpen, newOpen := oldAdditional == nil || oldAdditional == true, newAdditional == nil || newAdditional == true
+	switch {
+	case oldClosed == newClosed && oldOpen == newOpen:
+	case newOpen:
+		d.add(path, false, "additional properties allowed")
+	case newClosed:
+		d.add(path, true, "additional properties no longer allowed")
+	default:
+		d.add(path, true, "additional properties restricted")
+	}
+}
+
+// compareVariants compares the oneOf or anyOf variants of o and n, matched
+// by title, or else by position.
+func (d *schemaDiff) compareVariants(path, key string, o, n map[string]any) {
+	oldVariants, _ := o[key].([]any)
+	newVariants, _ := n[key].([]any)
+	if len(oldVariants) == 0 && len(newVariants) == 0 {
+		return
+	}
+	name := func(i int, v map[string]any) string {
+		if title, ok := v["title"].(string); ok && title != "" {
+			return title
+		}
+		return fmt.Sprint(i)
+	}
+	byName := map[string]map[string]any{}
+	for i, v := range newVariants {
+		if variant := schemaObject(v); variant != nil {
+			byName[name(i, variant)] = variant
+		}
+	}
+	oldNames := map[string]bool{}
+	for i, v := range oldVariants {
+		variant := schemaObject(v)
+		if variant == nil {
+			continue
+		}
+		variantName := name(i, variant)
+		oldNames[variantName] = true
+		variantPath := path + "(" + variantName + ")"
+		if newVariant, ok := byName[variantName]; ok {
+			d.compare(variantPath, variant, newVariant)
+		} else {
+			d.add(variantPath, true, "%s variant removed", key)
+		}
+	}
+	for i, v := range newVariants {
+		if variant := schemaObject(v); variant != nil && !oldNames[name(i, variant)] {
+			d.add(path+"("+name(i, variant)+")", false, "%s variant added", key)
+		}
+	}
+}
+
+// resolveSchemaRef returns the definition in root that schema refers to
+// with a $ref into its $defs, or schema itself.
+func resolveSchemaRef(root, schema map[string]any) map[string]any {
+	ref, _ := schema["$ref"].(string)
+	name, ok := defName(ref)
+	if !ok {
+		return schema
+	}
+	defs, _ := root["$defs"].(map[string]any)
+	if def := schemaObject(defs[name]); def != nil {
+		return def
+	}
+	return schema
+}
+
+// defName returns the name of the $defs entry that ref points to: a local
+// "#/$defs/<name>" ref, or the same ref made absolute against the $id of the
+// schema under the ref_base_uri plugin parameter.
+func defName(ref string) (string, bool) {
+	_, name, ok := strings.Cut(ref, "#/$defs/")
+	return name, ok
+}
+
+// schemaTypes returns the sorted types of schema, or nil when it accepts any
+// type.
+func schemaTypes(schema map[string]any) []string {
+	var types []string
+	switch t := schema["type"].(type) {
+	case string:
+		types = []string{t}
+	case []any:
+		for _, v := range t {
+			if s, ok := v.(string); ok {
+				types = append(types, s)
+			}
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// containsType reports whether types accepts the values of type t. A number
+// accepts integers.
+func containsType(types []string, t string) bool {
+	for _, candidate := range types {
+		if candidate == t || (candidate == "number" && t == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// widerFormats lists, for each numeric format, the formats accepting all of
+// its values, so that an integer field that became a number, e.g. "int32" to
+// "double", is not reported as breaking.
+var widerFormats = map[string][]string{
+	"int32":  {"int64", "float", "double"},
+	"uint32": {"int64", "uint64", "float", "double"},
+	"int64":  {"float", "double"},
+	"uint64": {"float", "double"},
+	"float":  {"double"},
+}
+
+// containsFormat reports whether format accepts the values of format f.
+func containsFormat(format, f string) bool {
+	for _, wider := range widerFormats[f] {
+		if wider == format {
+			return true
+		}
+	}
+	return false
+}
+
+func schemaObject(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	return m
+}
+
+func stringSet(v any) map[string]bool {
+	set := map[string]bool{}
+	values, _ := v.([]any)
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			set[s] = true
+		}
+	}
+	return set
+}
+
+func sortedSchemaKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinSchemaPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// jsonText returns the JSON encoding of v, or "" for nil.
+func jsonText(v any) string {
+	if v == nil {
+		return ""
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
@@ -0,0 +1,227 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+const diffBaseSchema = `{
+  "type": "object",
+  "properties": {
+    "name": {"type": "string", "maxLength": 64},
+    "count": {"type": "integer", "format": "int32", "minimum": 1},
+    "kind": {"type": "string", "enum": ["BOOK", "GAME"]},
+    "tags": {"type": "array", "items": {"type": "string"}},
+    "labels": {"type": "object", "additionalProperties": {"type": "string"}},
+    "owner": {"$ref": "#/$defs/testdata.Owner"}
+  },
+  "required": ["name"],
+  "additionalProperties": false,
+  "$defs": {
+    "testdata.Owner": {
+      "type": "object",
+      "properties": {"email": {"type": "string", "format": "email"}}
+    }
+  }
+}`
+
+// diffSchema returns diffBaseSchema with edit applied to its decoded form.
+func diffSchema(t *testing.T, edit func(schema map[string]any)) json.RawMessage {
+	t.Helper()
+	var schema map[string]any
+	if err := json.Unmarshal([]byte(diffBaseSchema), &schema); err != nil {
+		t.Fatal(err)
+	}
+	edit(schema)
+	b, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func diffProperty(schema map[string]any, name string) map[string]any {
+	return schema["properties"].(map[string]any)[name].(map[string]any)
+}
+
+func TestDiffSchemas(t *testing.T) {
+	base := json.RawMessage(diffBaseSchema)
+	for _, tc := range []struct {
+		name string
+		edit func(schema map[string]any)
+		want []SchemaChange
+	}{
+		{
+			name: "identical",
+			edit: func(map[string]any) {},
+		},
+		{
+			name: "description only",
+			edit: func(s map[string]any) { diffProperty(s, "name")["description"] = "Item name." },
+		},
+		{
+			name: "optional property added",
+			edit: func(s map[string]any) { s["properties"].(map[string]any)["note"] = map[string]any{"type": "string"} },
+			want: []SchemaChange{{Path: "note", Description: "optional property added"}},
+		},
+		{
+			name: "required property added",
+			edit: func(s map[string]any) {
+				s["properties"].(map[string]any)["note"] = map[string]any{"type": "string"}
+				s["required"] = []any{"name", "note"}
+			},
+			want: []SchemaChange{{Path: "note", Breaking: true, Description: "required property added"}},
+		},
+		{
+			name: "property removed",
+			edit: func(s map[string]any) { delete(s["properties"].(map[string]any), "tags") },
+			want: []SchemaChange{{Path: "tags", Breaking: true, Description: "property removed"}},
+		},
+		{
+			name: "became required",
+			edit: func(s map[string]any) { s["required"] = []any{"name", "count"} },
+			want: []SchemaChange{{Path: "count", Breaking: true, Description: "became required"}},
+		},
+		{
+			name: "no longer required",
+			edit: func(s map[string]any) { delete(s, "required") },
+			want: []SchemaChange{{Path: "name", Description: "no longer required"}},
+		},
+		{
+			name: "type changed",
+			edit: func(s map[string]any) { diffProperty(s, "count")["type"] = "string" },
+			want: []SchemaChange{{Path: "count", Breaking: true, Description: "type changed from integer to string"}},
+		},
+		{
+			name: "type widened",
+			edit: func(s map[string]any) { diffProperty(s, "count")["type"] = []any{"number", "string"} },
+			want: []SchemaChange{{Path: "count", Description: "type changed from integer to number or string"}},
+		},
+		{
+			name: "integer became number",
+			edit: func(s map[string]any) {
+				diffProperty(s, "count")["type"] = "number"
+				diffProperty(s, "count")["format"] = "double"
+			},
+			want: []SchemaChange{
+				{Path: "count", Description: "type changed from integer to number"},
+				{Path: "count", Description: `format changed from "int32" to "double"`},
+			},
+		},
+		{
+			name: "integer format narrowed",
+			edit: func(s map[string]any) { diffProperty(s, "count")["format"] = "uint32" },
+			want: []SchemaChange{{Path: "count", Breaking: true, Description: `format changed from "int32" to "uint32"`}},
+		},
+		{
+			name: "enum narrowed and widened",
+			edit: func(s map[string]any) { diffProperty(s, "kind")["enum"] = []any{"BOOK", "TOY"} },
+			want: []SchemaChange{
+				{Path: "kind", Breaking: true, Description: `enum value "GAME" removed`},
+				{Path: "kind", Description: `enum value "TOY" added`},
+			},
+		},
+		{
+			name: "bounds",
+			edit: func(s map[string]any) {
+				diffProperty(s, "name")["maxLength"] = 128
+				diffProperty(s, "count")["minimum"] = 5
+			},
+			want: []SchemaChange{
+				{Path: "count", Breaking: true, Description: "minimum changed from 1 to 5"},
+				{Path: "name", Description: "maxLength changed from 64 to 128"},
+			},
+		},
+		{
+			name: "array items",
+			edit: func(s map[string]any) { diffProperty(s, "tags")["items"] = map[string]any{"type": "integer"} },
+			want: []SchemaChange{{Path: "tags[]", Breaking: true, Description: "type changed from string to integer"}},
+		},
+		{
+			name: "map values",
+			edit: func(s map[string]any) {
+				diffProperty(s, "labels")["additionalProperties"] = map[string]any{"type": "string", "pattern": "^[a-z]+$"}
+			},
+			want: []SchemaChange{{Path: "labels.*", Breaking: true, Description: `pattern "^[a-z]+$" added`}},
+		},
+		{
+			name: "additional properties allowed",
+			edit: func(s map[string]any) { delete(s, "additionalProperties") },
+			want: []SchemaChange{{Path: "", Description: "additional properties allowed"}},
+		},
+		{
+			name: "ref resolved",
+			edit: func(s map[string]any) {
+				owner := s["$defs"].(map[string]any)["testdata.Owner"].(map[string]any)
+				delete(owner["properties"].(map[string]any)["email"].(map[string]any), "format")
+				owner["required"] = []any{"email"}
+			},
+			want: []SchemaChange{
+				{Path: "owner.email", Breaking: true, Description: "became required"},
+				{Path: "owner.email", Description: `format "email" removed`},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(DiffSchemas(base, diffSchema(t, tc.edit))).To(Equal(tc.want))
+		})
+	}
+}
+
+func TestDiffSchemasVariants(t *testing.T) {
+	g := NewWithT(t)
+
+	oldSchema := json.RawMessage(`{"type":"object","properties":{"item":{"oneOf":[
+	  {"title":"product","type":"object","properties":{"product":{"type":"string"}},"required":["product"]},
+	  {"title":"service","type":"object","properties":{"service":{"type":"string"}},"required":["service"]}
+	]}}}`)
+	newSchema := json.RawMessage(`{"type":"object","properties":{"item":{"oneOf":[
+	  {"title":"product","type":"object","properties":{"product":{"type":"integer"}},"required":["product"]},
+	  {"title":"bundle","type":"object","properties":{"bundle":{"type":"string"}},"required":["bundle"]}
+	]}}}`)
+	g.Expect(DiffSchemas(oldSchema, newSchema)).To(Equal([]SchemaChange{
+		{Path: "item(bundle)", Description: "oneOf variant added"},
+		{Path: "item(product).product", Breaking: true, Description: "type changed from string to integer"},
+		{Path: "item(service)", Breaking: true, Description: "oneOf variant removed"},
+	}))
+}
+
+func TestDiffSchemasRecursive(t *testing.T) {
+	g := NewWithT(t)
+
+	// A message that contains itself is compared once.
+	schema := json.RawMessage(`{"$ref":"#/$defs/Node","$defs":{"Node":{"type":"object","properties":{
+	  "value":{"type":"string"},"children":{"type":"array","items":{"$ref":"#/$defs/Node"}}}}}}`)
+	g.Expect(DiffSchemas(schema, schema)).To(BeEmpty())
+
+	// $refs made absolute under ref_base_uri resolve the same way.
+	based := json.RawMessage(`{"$id":"https://schemas.example.com/pkg.Svc/M","$ref":"https://schemas.example.com/pkg.Svc/M#/$defs/Node",
+	  "$defs":{"Node":{"type":"object","properties":{"value":{"type":"integer"}}}}}`)
+	g.Expect(DiffSchemas(schema, based)).To(ContainElement(SchemaChange{Path: "value", Breaking: true, Description: "type changed from string to integer"}))
+}
+
+func TestDiffSchemasInvalid(t *testing.T) {
+	g := NewWithT(t)
+
+	changes := DiffSchemas(json.RawMessage(`{}`), json.RawMessage(`[`))
+	g.Expect(changes).To(HaveLen(1))
+	g.Expect(changes[0].Breaking).To(BeTrue())
+	g.Expect(changes[0].String()).To(HavePrefix("breaking: new schema is not a JSON object"))
+	g.Expect(SchemaChange{Path: "name", Description: "no longer required"}.String()).To(Equal("non-breaking: name: no longer required"))
+}
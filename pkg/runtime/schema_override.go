@@ -0,0 +1,45 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import "encoding/json"
+
+// WithToolSchemaOverride replaces the generated input schema of the tools in
+// overrides, keyed like WithToolNameOverride by fully-qualified method name
+// (e.g. "testdata.TestService.GetItem"), with "#batch" appended for the batch
+// tool of a method. It is an escape hatch for the few tools whose generated
+// schema does not suit a client: the override is listed in tools/list, is
+// checked under WithStartupValidation, and drives the normalization of the
+// arguments of the tool. Arguments must still unmarshal into the request
+// message. Repeated options are merged.
+func WithToolSchemaOverride(overrides map[string]json.RawMessage) Option {
+	return func(c *Config) {
+		if c.ToolSchemaOverrides == nil {
+			c.ToolSchemaOverrides = make(map[string]json.RawMessage, len(overrides))
+		}
+		for key, schema := range overrides {
+			c.ToolSchemaOverrides[key] = append(json.RawMessage{}, schema...)
+		}
+	}
+}
+
+// OverrideToolSchema returns tool with the input schema of key in overrides,
+// or tool itself if key has no override.
+func OverrideToolSchema(tool Tool, key string, overrides map[string]json.RawMessage) Tool {
+	if schema, ok := overrides[key]; ok {
+		tool.JSONSchema = string(schema)
+	}
+	return tool
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestWithToolSchemaOverride(t *testing.T) {
+	g := NewWithT(t)
+
+	schema := json.RawMessage(`{"type":"object"}`)
+	c := NewConfig()
+	WithToolSchemaOverride(map[string]json.RawMessage{"pkg.Svc.Get": schema})(c)
+	WithToolSchemaOverride(map[string]json.RawMessage{"pkg.Svc.Get#batch": json.RawMessage(`{}`)})(c)
+	schema[0] = '['
+	g.Expect(c.ToolSchemaOverrides).To(Equal(map[string]json.RawMessage{
+		"pkg.Svc.Get":       json.RawMessage(`{"type":"object"}`),
+		"pkg.Svc.Get#batch": json.RawMessage(`{}`),
+	}))
+
+	tool := Tool{Name: "pkg_Svc_Get", JSONSchema: `{"type":"object","properties":{}}`}
+	g.Expect(OverrideToolSchema(tool, "pkg.Svc.Get", c.ToolSchemaOverrides).JSONSchema).To(Equal(`{"type":"object"}`))
+	g.Expect(OverrideToolSchema(tool, "pkg.Svc.List", c.ToolSchemaOverrides)).To(Equal(tool))
+	g.Expect(OverrideToolSchema(tool, "pkg.Svc.Get", nil)).To(Equal(tool))
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ScopeChecker authorizes a call to a tool annotated with (mcp.options.tool)
+// scopes. It returns nil when the caller, typically identified by ctx, holds
+// all of scopes.
+type ScopeChecker func(ctx context.Context, scopes []string) error
+
+// WithScopeChecker sets the ScopeChecker of the tools with scopes. Without
+// one, scopes are not enforced.
+func WithScopeChecker(checker ScopeChecker) Option {
+	return func(c *Config) {
+		c.ScopeChecker = checker
+	}
+}
+
+// CheckScopes runs checker for a tool requiring scopes. A plain error from
+// the checker becomes a PermissionDenied status; a gRPC status error, such as
+// Unauthenticated, is kept as it is.
+func CheckScopes(ctx context.Context, scopes []string, checker ScopeChecker) error {
+	if checker == nil || len(scopes) == 0 {
+		return nil
+	}
+	err := checker(ctx, scopes)
+	if err == nil {
+		return nil
+	}
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		return err
+	}
+	return status.Error(codes.PermissionDenied, err.Error())
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckScopes(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	// Nothing to check without a checker or without scopes.
+	g.Expect(CheckScopes(ctx, []string{"a"}, nil)).To(Succeed())
+	g.Expect(CheckScopes(ctx, nil, func(context.Context, []string) error {
+		return errors.New("unreachable")
+	})).To(Succeed())
+
+	err := CheckScopes(ctx, []string{"a"}, func(context.Context, []string) error {
+		return errors.New("missing scope a")
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	g.Expect(status.Convert(err).Message()).To(Equal("missing scope a"))
+
+	// A status from the checker is kept.
+	err = CheckScopes(ctx, []string{"a"}, func(context.Context, []string) error {
+		return status.Error(codes.Unauthenticated, "no token")
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+	cfg := NewConfig()
+	WithScopeChecker(func(context.Context, []string) error { return nil })(cfg)
+	g.Expect(cfg.ScopeChecker).ToNot(BeNil())
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// WithSessionScopedClient resolves the client of every forwarded call with
+// resolve, instead of using the client passed to ForwardTo<Service>Client.
+// Multi-tenant deployments use it to route each MCP session to its own
+// backend, e.g. by a tenant stored in the context, without a server per
+// tenant. C is the <Service>Client interface of the registration, or a type
+// implementing it; registration panics otherwise. An error from resolve is
+// returned as a tool error, and nothing is forwarded.
+func WithSessionScopedClient[C any](resolve func(ctx context.Context) (C, error)) Option {
+	return func(c *Config) {
+		c.ClientResolver = func(ctx context.Context) (any, error) {
+			return resolve(ctx)
+		}
+		c.ClientResolverType = reflect.TypeOf((*C)(nil)).Elem()
+	}
+}
+
+// CheckClientResolver checks that the clients of a WithSessionScopedClient
+// resolver of type resolverType are a C, the <Service>Client interface of the
+// registration. Generated code calls it at registration, so that a resolver
+// for another service fails there rather than on every call.
+func CheckClientResolver[C any](resolverType reflect.Type) error {
+	want := reflect.TypeOf((*C)(nil)).Elem()
+	if resolverType != nil && !resolverType.AssignableTo(want) {
+		return fmt.Errorf("WithSessionScopedClient resolves a %s, not a %s", resolverType, want)
+	}
+	return nil
+}
+
+// ResolveClient returns the client of a forwarded call: the one returned by
+// the WithSessionScopedClient resolver when configured, or else client.
+func ResolveClient[C any](ctx context.Context, client C, resolver func(ctx context.Context) (any, error)) (C, error) {
+	if resolver == nil {
+		return client, nil
+	}
+	var zero C
+	resolved, err := resolver(ctx)
+	if err != nil {
+		return zero, err
+	}
+	typed, ok := resolved.(C)
+	if !ok {
+		return zero, fmt.Errorf("WithSessionScopedClient resolved a %T, not a %s", resolved, reflect.TypeOf((*C)(nil)).Elem())
+	}
+	return typed, nil
+}
+
+// WithMethodClients forwards the methods in clients to their own client
+// instead of the one passed to ForwardTo<Service>Client, which stays the
+// client of every other method. It lets the methods of one service live on
+// different backends, e.g. during a migration. Keys are fully-qualified
+// method names, e.g. "testdata.TestService.GetItem", as in the generated
+// <Service>_<Method>FullMethod constants. C is the <Service>Client interface
+// of the registration, or a type implementing it; registration panics
+// otherwise. WithSessionScopedClient takes precedence.
+func WithMethodClients[C any](clients map[string]C) Option {
+	return func(c *Config) {
+		if c.MethodClients == nil {
+			c.MethodClients = map[string]any{}
+		}
+		for method, client := range clients {
+			c.MethodClients[method] = client
+		}
+	}
+}
+
+// CheckMethodClients checks that the WithMethodClients clients are each a C,
+// the <Service>Client interface of the registration. Generated code calls it
+// at registration, so that a client for another service fails there rather
+// than on every call of its method.
+func CheckMethodClients[C any](clients map[string]any) error {
+	for _, method := range sortedKeys(clients) {
+		if _, ok := clients[method].(C); !ok {
+			return fmt.Errorf("WithMethodClients has a %T for %s, not a %s", clients[method], method, reflect.TypeOf((*C)(nil)).Elem())
+		}
+	}
+	return nil
+}
+
+// MethodClient returns the client of method under WithMethodClients, or
+// client when method has none.
+func MethodClient[C any](method string, client C, clients map[string]any) (C, error) {
+	resolved, ok := clients[method]
+	if !ok {
+		return client, nil
+	}
+	typed, ok := resolved.(C)
+	if !ok {
+		var zero C
+		return zero, fmt.Errorf("WithMethodClients has a %T for %s, not a %s", resolved, method, reflect.TypeOf((*C)(nil)).Elem())
+	}
+	return typed, nil
+}
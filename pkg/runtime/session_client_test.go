@@ -0,0 +1,106 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestResolveClient(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	// Without a resolver, the fixed client is used.
+	client, err := ResolveClient[fmt.Stringer](ctx, stringer("fixed"), nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(client.String()).To(Equal("fixed"))
+
+	cfg := NewConfig()
+	WithSessionScopedClient(func(context.Context) (fmt.Stringer, error) {
+		return stringer("resolved"), nil
+	})(cfg)
+	client, err = ResolveClient[fmt.Stringer](ctx, stringer("fixed"), cfg.ClientResolver)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(client.String()).To(Equal("resolved"))
+
+	WithSessionScopedClient(func(context.Context) (fmt.Stringer, error) {
+		return nil, errors.New("no backend")
+	})(cfg)
+	_, err = ResolveClient[fmt.Stringer](ctx, stringer("fixed"), cfg.ClientResolver)
+	g.Expect(err).To(MatchError("no backend"))
+
+	WithSessionScopedClient(func(context.Context) (error, error) {
+		return errors.New("not a client"), nil
+	})(cfg)
+	_, err = ResolveClient[fmt.Stringer](ctx, stringer("fixed"), cfg.ClientResolver)
+	g.Expect(err).To(MatchError(ContainSubstring("not a fmt.Stringer")))
+}
+
+func TestCheckClientResolver(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(CheckClientResolver[fmt.Stringer](nil)).To(Succeed())
+
+	cfg := NewConfig()
+	WithSessionScopedClient(func(context.Context) (stringer, error) {
+		return "resolved", nil
+	})(cfg)
+	g.Expect(CheckClientResolver[fmt.Stringer](cfg.ClientResolverType)).To(Succeed())
+
+	WithSessionScopedClient(func(context.Context) (error, error) {
+		return errors.New("not a client"), nil
+	})(cfg)
+	g.Expect(CheckClientResolver[fmt.Stringer](cfg.ClientResolverType)).To(MatchError("WithSessionScopedClient resolves a error, not a fmt.Stringer"))
+}
+
+type stringer string
+
+func (s stringer) String() string { return string(s) }
+
+func TestMethodClient(t *testing.T) {
+	g := NewWithT(t)
+
+	cfg := NewConfig()
+	WithMethodClients(map[string]fmt.Stringer{"pkg.Svc.Get": stringer("backend-a")})(cfg)
+
+	client, err := MethodClient[fmt.Stringer]("pkg.Svc.Get", stringer("default"), cfg.MethodClients)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(client.String()).To(Equal("backend-a"))
+
+	client, err = MethodClient[fmt.Stringer]("pkg.Svc.Create", stringer("default"), cfg.MethodClients)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(client.String()).To(Equal("default"))
+
+	WithMethodClients(map[string]error{"pkg.Svc.Get": errors.New("not a client")})(cfg)
+	_, err = MethodClient[fmt.Stringer]("pkg.Svc.Get", stringer("default"), cfg.MethodClients)
+	g.Expect(err).To(MatchError(ContainSubstring("not a fmt.Stringer")))
+}
+
+func TestCheckMethodClients(t *testing.T) {
+	g := NewWithT(t)
+
+	cfg := NewConfig()
+	g.Expect(CheckMethodClients[fmt.Stringer](cfg.MethodClients)).To(Succeed())
+
+	WithMethodClients(map[string]fmt.Stringer{"pkg.Svc.Get": stringer("backend-a")})(cfg)
+	g.Expect(CheckMethodClients[fmt.Stringer](cfg.MethodClients)).To(Succeed())
+
+	WithMethodClients(map[string]error{"pkg.Svc.Create": errors.New("not a client")})(cfg)
+	g.Expect(CheckMethodClients[fmt.Stringer](cfg.MethodClients)).To(MatchError(ContainSubstring("for pkg.Svc.Create, not a fmt.Stringer")))
+}
@@ -0,0 +1,220 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+)
+
+// maxMinimalDepth bounds MinimalArguments on recursive schemas that require
+// a message of their own type.
+const maxMinimalDepth = 32
+
+// SmokeTest calls every tool registered on s with the minimal arguments its
+// input schema accepts, and returns an error for each tool that could not be
+// listed or whose call panicked, was rejected with a protocol error, or
+// returned a result that does not encode. A tool error result, such as an
+// Unimplemented error of the client, is not a failure: the smoke test
+// exercises registration and marshaling, not the client.
+func SmokeTest(ctx context.Context, s *mcpserver.MCPServer) []error {
+	tools, err := ListServerTools(ctx, s)
+	if err != nil {
+		return []error{fmt.Errorf("listing tools: %w", err)}
+	}
+	var errs []error
+	for _, tool := range tools {
+		schema, err := json.Marshal(tool.InputSchema)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: encoding input schema: %w", tool.Name, err))
+			continue
+		}
+		args, err := MinimalArguments(schema)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", tool.Name, err))
+			continue
+		}
+		if err := smokeCall(ctx, s, tool.Name, args); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", tool.Name, err))
+		}
+	}
+	return errs
+}
+
+// smokeCall calls the tool name of s with args through tools/call.
+func smokeCall(ctx context.Context, s *mcpserver.MCPServer, name string, args map[string]any) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panicked: %v", r)
+		}
+	}()
+	msg, err := json.Marshal(map[string]any{
+		"jsonrpc": mcp.JSONRPC_VERSION,
+		"id":      1,
+		"method":  string(mcp.MethodToolsCall),
+		"params":  map[string]any{"name": name, "arguments": args},
+	})
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(s.HandleMessage(ctx, msg))
+	if err != nil {
+		return fmt.Errorf("result does not encode: %w", err)
+	}
+	var resp struct {
+		Result *mcp.CallToolResult `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return fmt.Errorf("result does not decode: %w", err)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("rejected: %s", resp.Error.Message)
+	}
+	if resp.Result == nil {
+		return fmt.Errorf("no result")
+	}
+	return nil
+}
+
+// MinimalArguments returns the smallest arguments the JSON Schema schema of
+// a tool input accepts: only the required properties, each set to its const,
+// its first enum value, the first oneOf variant, null where allowed, or the
+// zero value of its type raised to its minimum length, size or value. A JSON
+// string with a contentSchema, such as the request of a raw tool, holds the
+// encoded minimal value of that schema. Patterns are not honored.
+func MinimalArguments(schema json.RawMessage) (map[string]any, error) {
+	var root map[string]any
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return nil, fmt.Errorf("invalid input schema: %w", err)
+	}
+	defs, _ := root["$defs"].(map[string]any)
+	args, _ := minimalValue(root, defs, 0).(map[string]any)
+	if args == nil {
+		args = map[string]any{}
+	}
+	return args, nil
+}
+
+func minimalValue(schema map[string]any, defs map[string]any, depth int) any {
+	if depth > maxMinimalDepth {
+		return nil
+	}
+	if ref, ok := schema["$ref"].(string); ok {
+		name, _ := defName(ref)
+		if def, ok := defs[name].(map[string]any); ok {
+			return minimalValue(def, defs, depth+1)
+		}
+	}
+	if v, ok := schema["const"]; ok {
+		return v
+	}
+	if enum, ok := schema["enum"].([]any); ok && len(enum) > 0 {
+		return enum[0]
+	}
+	if variants, ok := schema["oneOf"].([]any); ok && len(variants) > 0 {
+		if variant, ok := variants[0].(map[string]any); ok {
+			return minimalValue(variant, defs, depth+1)
+		}
+	}
+	if nullable, _ := schema["nullable"].(bool); nullable {
+		return nil
+	}
+
+	var typ string
+	switch t := schema["type"].(type) {
+	case string:
+		typ = t
+	case []any:
+		for _, name := range t {
+			if name == "null" {
+				return nil
+			}
+		}
+		if len(t) > 0 {
+			typ, _ = t[0].(string)
+		}
+	}
+
+	switch typ {
+	case "object":
+		obj := map[string]any{}
+		props, _ := schema["properties"].(map[string]any)
+		required, _ := schema["required"].([]any)
+		for _, name := range required {
+			key, _ := name.(string)
+			prop, ok := props[key].(map[string]any)
+			if !ok {
+				continue
+			}
+			obj[key] = minimalValue(prop, defs, depth+1)
+		}
+		return obj
+	case "array":
+		items, _ := schema["items"].(map[string]any)
+		prefix, _ := schema["prefixItems"].([]any)
+		list := []any{}
+		for i := 0; i < int(schemaNumber(schema, "minItems")); i++ {
+			item := items
+			if i < len(prefix) {
+				item, _ = prefix[i].(map[string]any)
+			}
+			list = append(list, minimalValue(item, defs, depth+1))
+		}
+		return list
+	case "string":
+		if content, ok := schema["contentSchema"].(map[string]any); ok && schema["contentMediaType"] == "application/json" {
+			if encoded, err := json.Marshal(minimalValue(content, defs, depth+1)); err == nil {
+				return string(encoded)
+			}
+		}
+		switch schema["format"] {
+		case "date-time":
+			return "1970-01-01T00:00:00Z"
+		case "date":
+			return "1970-01-01"
+		}
+		return strings.Repeat("a", int(schemaNumber(schema, "minLength")))
+	case "integer", "number":
+		n := 0.0
+		if min, ok := schema["minimum"].(float64); ok && min > n {
+			n = min
+		}
+		if min, ok := schema["exclusiveMinimum"].(float64); ok && min >= n {
+			n = math.Floor(min) + 1
+		}
+		if max, ok := schema["maximum"].(float64); ok && max < n {
+			n = max
+		}
+		return n
+	case "boolean":
+		return false
+	}
+	return nil
+}
+
+// schemaNumber returns the numeric keyword key of schema, or 0.
+func schemaNumber(schema map[string]any, key string) float64 {
+	n, _ := schema[key].(float64)
+	return n
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+)
+
+func TestMinimalArguments(t *testing.T) {
+	g := NewWithT(t)
+
+	args, err := MinimalArguments([]byte(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 2},
+			"page": {"type": "integer", "minimum": 1},
+			"ratio": {"type": "number", "exclusiveMinimum": 0},
+			"state": {"type": "string", "enum": ["STATE_UNSPECIFIED", "STATE_OPEN"]},
+			"kind": {"type": "string", "const": "fixed"},
+			"at": {"type": ["string", "null"], "format": "date-time"},
+			"tags": {"type": "array", "items": {"type": "string"}, "minItems": 1},
+			"span": {"type": "array", "items": {"type": "integer"}, "prefixItems": [{"type": "integer", "minimum": 1}, {"type": "integer", "minimum": 5}], "minItems": 2, "maxItems": 2},
+			"item": {"$ref": "#/$defs/Item"},
+			"valueOneOfType": {"type": "object", "oneOf": [
+				{"type": "object", "properties": {"object_type": {"type": "string", "const": "text"}, "text": {"type": "string"}}, "required": ["object_type", "text"]}
+			]},
+			"note": {"type": "string"},
+			"raw": {"type": "string", "contentMediaType": "application/json", "contentSchema": {"$ref": "#/$defs/Item"}}
+		},
+		"required": ["name", "page", "ratio", "state", "kind", "at", "tags", "span", "item", "valueOneOfType", "raw"],
+		"$defs": {"Item": {"type": "object", "properties": {"done": {"type": "boolean"}}, "required": ["done"]}}
+	}`))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(args).To(Equal(map[string]any{
+		"name":           "aa",
+		"page":           1.0,
+		"ratio":          1.0,
+		"state":          "STATE_UNSPECIFIED",
+		"kind":           "fixed",
+		"at":             nil,
+		"tags":           []any{""},
+		"span":           []any{1.0, 5.0},
+		"item":           map[string]any{"done": false},
+		"valueOneOfType": map[string]any{"object_type": "text", "text": ""},
+		"raw":            `{"done":false}`,
+	}))
+
+	_, err = MinimalArguments([]byte(`not json`))
+	g.Expect(err).To(MatchError(ContainSubstring("invalid input schema")))
+}
+
+func TestSmokeTest(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	s.AddTool(mcp.NewTool("ok"), func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("fine"), nil
+	})
+	s.AddTool(mcp.NewTool("tool_error"), func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultError("unimplemented"), nil
+	})
+	s.AddTool(mcp.NewTool("invalid"), func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return nil, errors.New("bad arguments")
+	})
+	s.AddTool(mcp.NewTool("panics"), func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		panic("boom")
+	})
+
+	errs := SmokeTest(context.Background(), s)
+	g.Expect(errs).To(ConsistOf(
+		MatchError("invalid: rejected: bad arguments"),
+		MatchError("panics: panicked: boom"),
+	))
+}
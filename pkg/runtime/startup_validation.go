@@ -0,0 +1,68 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// WithStartupValidation sets whether ForwardTo<Service>Client checks the
+// input schema of every tool it registers, and panics naming the first tool
+// whose schema is not a JSON object that compiles as JSON Schema 2020-12.
+// It catches broken schemas, e.g. from a generator bug or a bad
+// WithExtraProperties, at deployment instead of at the first tool call. The
+// default is false, as compiling every schema slows down startup.
+func WithStartupValidation(enable bool) Option {
+	return func(c *Config) {
+		c.StartupValidation = enable
+	}
+}
+
+// ValidateToolSchema checks, when enable is set, that the input schema of tool
+// is a JSON object and compiles as JSON Schema 2020-12, which validates it
+// against the meta-schema. The error names the tool.
+func ValidateToolSchema(tool mcp.Tool, enable bool) error {
+	if !enable {
+		return nil
+	}
+	raw := []byte(tool.RawInputSchema)
+	if len(raw) == 0 {
+		var err error
+		if raw, err = json.Marshal(tool.InputSchema); err != nil {
+			return fmt.Errorf("tool %q: schema does not marshal: %w", tool.Name, err)
+		}
+	}
+	var object map[string]any
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return fmt.Errorf("tool %q: schema is not a JSON object: %w", tool.Name, err)
+	}
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+	if err != nil {
+		return fmt.Errorf("tool %q: schema is not valid JSON: %w", tool.Name, err)
+	}
+	c := jsonschema.NewCompiler()
+	c.DefaultDraft(jsonschema.Draft2020)
+	if err := c.AddResource("schema.json", doc); err != nil {
+		return fmt.Errorf("tool %q: invalid schema: %w", tool.Name, err)
+	}
+	if _, err := c.Compile("schema.json"); err != nil {
+		return fmt.Errorf("tool %q: invalid schema: %w", tool.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+)
+
+func TestValidateToolSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	tool := func(schema string) mcp.Tool {
+		return mcp.Tool{Name: "get_item", RawInputSchema: json.RawMessage(schema)}
+	}
+
+	g.Expect(ValidateToolSchema(tool(`{"type": "object", "properties": {"id": {"type": "string"}}}`), true)).To(Succeed())
+	g.Expect(ValidateToolSchema(mcp.NewTool("list_items", mcp.WithString("filter")), true)).To(Succeed())
+
+	g.Expect(ValidateToolSchema(tool(`{"type": "object"`), true)).To(MatchError(ContainSubstring(`tool "get_item": schema is not a JSON object`)))
+	g.Expect(ValidateToolSchema(tool(`["object"]`), true)).To(MatchError(ContainSubstring(`tool "get_item": schema is not a JSON object`)))
+	g.Expect(ValidateToolSchema(tool(`{"type": "objet"}`), true)).To(MatchError(ContainSubstring(`tool "get_item": invalid schema`)))
+	g.Expect(ValidateToolSchema(tool(`{"$ref": "#/$defs/Missing"}`), true)).To(MatchError(ContainSubstring(`tool "get_item": invalid schema`)))
+
+	// Disabled, nothing is checked.
+	g.Expect(ValidateToolSchema(tool(`{"type": "object"`), false)).To(Succeed())
+}
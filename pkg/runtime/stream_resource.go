@@ -0,0 +1,229 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// StreamResourceScheme is the URI scheme of the resources that
+// StreamToResource publishes streams under.
+const StreamResourceScheme = "stream"
+
+// streamSeq numbers the streams of the process, to give each its own URI.
+var streamSeq atomic.Uint64
+
+// StreamState is the content of a stream resource: the latest message of the
+// stream, and whether and how the stream ended.
+type StreamState struct {
+	// Sequence counts the messages received so far; the latest has this
+	// number, starting at 1.
+	Sequence uint64 `json:"sequence"`
+	// Message is the latest message, as the response of a unary tool would
+	// be, or null before the first one.
+	Message json.RawMessage `json:"message"`
+	// Done is set once the stream ended, successfully or not.
+	Done bool `json:"done"`
+	// Error is the status of a failed stream.
+	Error *StreamError `json:"error,omitempty"`
+}
+
+// StreamError is the gRPC status a stream failed with.
+type StreamError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// StreamResourceTemplate is the URI template of the resources that
+// StreamToResource publishes streams under.
+const StreamResourceTemplate = StreamResourceScheme + "://{method}/{id}"
+
+// finishedStreamTTL is how long the resource of a stream that ended is kept
+// when its final state is not read.
+var finishedStreamTTL = 5 * time.Minute
+
+// streamResource is a stream published by StreamToResource.
+type streamResource struct {
+	server    *mcpserver.MCPServer
+	sessionID string
+	close     func()
+
+	mu    sync.Mutex
+	state StreamState
+}
+
+// openStreams holds the streams whose resources can still be read, by URI.
+var openStreams = struct {
+	sync.Mutex
+	byURI map[string]*streamResource
+}{byURI: map[string]*streamResource{}}
+
+// AddStreamResourceTemplate adds to s the resource template that serves the
+// resources of StreamToResource. Generated code adds it when registering a
+// service with stream_resource methods. Stream resources are only served
+// through the template, so resources/list does not show the streams of other
+// sessions.
+func AddStreamResourceTemplate(s *mcpserver.MCPServer) {
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate(StreamResourceTemplate, "Stream",
+			mcp.WithTemplateDescription("Latest message of a stream opened by a tool, updated as messages arrive"),
+			mcp.WithTemplateMIMEType("application/json"),
+		),
+		func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			uri := request.Params.URI
+			openStreams.Lock()
+			st := openStreams.byURI[uri]
+			openStreams.Unlock()
+			// Other sessions are told the resource does not exist.
+			var reader string
+			if session := mcpserver.ClientSessionFromContext(ctx); session != nil {
+				reader = session.SessionID()
+			}
+			if st == nil || st.server != s || reader != st.sessionID {
+				return nil, fmt.Errorf("resource %s not found", uri)
+			}
+			st.mu.Lock()
+			raw, err := json.Marshal(st.state)
+			done := st.state.Done
+			st.mu.Unlock()
+			if err != nil {
+				return nil, err
+			}
+			if done {
+				st.close()
+			}
+			return []mcp.ResourceContents{mcp.TextResourceContents{URI: uri, MIMEType: "application/json", Text: string(raw)}}, nil
+		},
+	)
+}
+
+// AddStreamResourceHooks adds to hooks what closes the stream resources of a
+// session when it is unregistered: their streams are canceled and their
+// resources removed. Pass hooks to the server with mcpserver.WithHooks.
+// Without them, the stream of a closed session ends at its next message, or
+// at its timeout.
+func AddStreamResourceHooks(hooks *mcpserver.Hooks) {
+	hooks.AddOnUnregisterSession(func(_ context.Context, session mcpserver.ClientSession) {
+		var closers []func()
+		openStreams.Lock()
+		for _, st := range openStreams.byURI {
+			if st.sessionID == session.SessionID() {
+				closers = append(closers, st.close)
+			}
+		}
+		openStreams.Unlock()
+		for _, closeStream := range closers {
+			closeStream()
+		}
+	})
+}
+
+// StreamToResource publishes a server stream as an MCP resource of s and
+// returns the tool result pointing at it. recv is the Recv method of the
+// stream, which was opened with ctx; cancel cancels ctx and releases what the
+// stream holds, and is called once, when the stream ends or its resource is
+// removed. Each message is run through transformers, replaces the content of
+// the resource, and is announced with a notifications/resources/updated to
+// the session of ctx. A message failing a transformer ends the stream. s
+// serves the resource through AddStreamResourceTemplate.
+//
+// Only the session of ctx can read the resource. Once the stream ended, the
+// resource is removed after its final state was read, or five minutes later.
+// When the session ends, the stream is canceled and the resource removed; see
+// AddStreamResourceHooks.
+func StreamToResource[M proto.Message](ctx context.Context, cancel context.CancelFunc, s *mcpserver.MCPServer, method string, recv func() (M, error), transformers []ResponseTransformer) *mcp.CallToolResult {
+	uri := fmt.Sprintf("%s://%s/%d", StreamResourceScheme, method, streamSeq.Add(1))
+	cancel = sync.OnceFunc(cancel)
+	res := &streamResource{server: s, state: StreamState{Message: json.RawMessage("null")}}
+	if session := mcpserver.ClientSessionFromContext(ctx); session != nil {
+		res.sessionID = session.SessionID()
+	}
+	res.close = sync.OnceFunc(func() {
+		cancel()
+		openStreams.Lock()
+		delete(openStreams.byURI, uri)
+		openStreams.Unlock()
+	})
+	openStreams.Lock()
+	openStreams.byURI[uri] = res
+	openStreams.Unlock()
+
+	update := func(apply func(*StreamState)) {
+		res.mu.Lock()
+		apply(&res.state)
+		res.mu.Unlock()
+		if res.sessionID == "" {
+			return
+		}
+		// Best effort, but a session that is gone will read nothing more.
+		err := s.SendNotificationToSpecificClient(res.sessionID, mcp.MethodNotificationResourceUpdated, map[string]any{"uri": uri})
+		if errors.Is(err, mcpserver.ErrSessionNotFound) {
+			res.close()
+		}
+	}
+	go func() {
+		defer cancel()
+		// Drop the final state if nobody reads it.
+		defer time.AfterFunc(finishedStreamTTL, res.close)
+		for {
+			msg, err := recv()
+			if errors.Is(err, io.EOF) {
+				update(func(st *StreamState) { st.Done = true })
+				return
+			}
+			var marshaled []byte
+			if err == nil {
+				var transformed proto.Message
+				transformed, err = TransformResponse(ctx, msg, transformers)
+				if err == nil {
+					marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
+				}
+			}
+			if err != nil {
+				st := status.Convert(err)
+				update(func(state *StreamState) {
+					state.Done = true
+					state.Error = &StreamError{Code: st.Code().String(), Message: st.Message()}
+				})
+				return
+			}
+			update(func(st *StreamState) {
+				st.Sequence++
+				st.Message = marshaled
+			})
+		}
+	}()
+
+	text, _ := json.Marshal(map[string]string{"resource_uri": uri})
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(string(text)),
+			mcp.NewResourceLink(uri, method+" stream", "Latest message of the stream; read it again on notifications/resources/updated", "application/json"),
+		},
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// notifiedSession is an initialized client session collecting the
+// notifications sent to it.
+type notifiedSession struct {
+	id            string
+	notifications chan mcp.JSONRPCNotification
+}
+
+func (s *notifiedSession) Initialize()       {}
+func (s *notifiedSession) Initialized() bool { return true }
+func (s *notifiedSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return s.notifications
+}
+func (s *notifiedSession) SessionID() string { return s.id }
+
+// newNotifiedSession registers a session with ID id on s and returns it with
+// its context.
+func newNotifiedSession(g *WithT, s *mcpserver.MCPServer, id string) (*notifiedSession, context.Context) {
+	session := &notifiedSession{id: id, notifications: make(chan mcp.JSONRPCNotification, 10)}
+	g.Expect(s.RegisterSession(context.Background(), session)).To(Succeed())
+	return session, s.WithContext(context.Background(), session)
+}
+
+// readResource sends a resources/read of uri to s in the session of ctx.
+func readResource(g *WithT, ctx context.Context, s *mcpserver.MCPServer, uri string) mcp.JSONRPCMessage {
+	msg, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "resources/read",
+		"params":  map[string]any{"uri": uri},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	return s.HandleMessage(ctx, msg)
+}
+
+// readStreamState reads the resource uri of s in the session of ctx.
+func readStreamState(g *WithT, ctx context.Context, s *mcpserver.MCPServer, uri string) StreamState {
+	resp, ok := readResource(g, ctx, s, uri).(mcp.JSONRPCResponse)
+	g.Expect(ok).To(BeTrue())
+	result := resp.Result.(mcp.ReadResourceResult)
+	g.Expect(result.Contents).To(HaveLen(1))
+	var state StreamState
+	g.Expect(json.Unmarshal([]byte(result.Contents[0].(mcp.TextResourceContents).Text), &state)).To(Succeed())
+	return state
+}
+
+func TestStreamToResource(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	AddStreamResourceTemplate(s)
+	session, sessionCtx := newNotifiedSession(g, s, "session-1")
+	ctx, cancel := context.WithCancel(sessionCtx)
+
+	messages := make(chan *wrapperspb.StringValue)
+	recv := func() (*wrapperspb.StringValue, error) {
+		if msg, ok := <-messages; ok {
+			return msg, nil
+		}
+		return nil, io.EOF
+	}
+	upper := func(_ context.Context, resp proto.Message) (proto.Message, error) {
+		return wrapperspb.String(resp.(*wrapperspb.StringValue).GetValue() + "!"), nil
+	}
+	result := StreamToResource(ctx, cancel, s, "test.Service.Watch", recv, []ResponseTransformer{upper})
+
+	g.Expect(result.IsError).To(BeFalse())
+	g.Expect(result.Content).To(HaveLen(2))
+	var text struct {
+		ResourceURI string `json:"resource_uri"`
+	}
+	g.Expect(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &text)).To(Succeed())
+	uri := text.ResourceURI
+	g.Expect(uri).To(HavePrefix("stream://test.Service.Watch/"))
+	g.Expect(result.Content[1].(mcp.ResourceLink).URI).To(Equal(uri))
+
+	state := readStreamState(g, sessionCtx, s, uri)
+	g.Expect(state.Sequence).To(BeZero())
+	g.Expect(string(state.Message)).To(Equal("null"))
+
+	// Each message replaces the content and is announced to the session.
+	for i, value := range []string{"a", "b"} {
+		messages <- wrapperspb.String(value)
+		var n mcp.JSONRPCNotification
+		g.Eventually(session.notifications).Should(Receive(&n))
+		g.Expect(n.Method).To(Equal(mcp.MethodNotificationResourceUpdated))
+		g.Expect(n.Params.AdditionalFields).To(HaveKeyWithValue("uri", uri))
+		state = readStreamState(g, sessionCtx, s, uri)
+		g.Expect(state.Sequence).To(BeEquivalentTo(i + 1))
+		g.Expect(string(state.Message)).To(Equal(`"` + value + `!"`))
+		g.Expect(state.Done).To(BeFalse())
+	}
+
+	// The end of the stream is announced too, and cancels its context.
+	close(messages)
+	g.Eventually(session.notifications).Should(Receive())
+	state = readStreamState(g, sessionCtx, s, uri)
+	g.Expect(state.Done).To(BeTrue())
+	g.Expect(state.Error).To(BeNil())
+	g.Expect(state.Sequence).To(BeEquivalentTo(2))
+	g.Eventually(ctx.Done()).Should(BeClosed())
+
+	// The final state is read once, then the resource is gone.
+	g.Expect(readResource(g, sessionCtx, s, uri)).To(BeAssignableToTypeOf(mcp.JSONRPCError{}))
+}
+
+func TestStreamToResourceError(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	AddStreamResourceTemplate(s)
+	session, sessionCtx := newNotifiedSession(g, s, "session-1")
+	ctx, cancel := context.WithCancel(sessionCtx)
+
+	recv := func() (*wrapperspb.StringValue, error) {
+		return nil, status.Error(codes.Unavailable, "backend went away")
+	}
+	result := StreamToResource(ctx, cancel, s, "test.Service.Watch", recv, nil)
+	uri := result.Content[1].(mcp.ResourceLink).URI
+
+	g.Eventually(session.notifications).Should(Receive())
+	state := readStreamState(g, sessionCtx, s, uri)
+	g.Expect(state.Done).To(BeTrue())
+	g.Expect(state.Error).To(Equal(&StreamError{Code: "Unavailable", Message: "backend went away"}))
+
+	// A failing transformer ends the stream like an error of the stream.
+	ctx, cancel = context.WithCancel(sessionCtx)
+	recv = func() (*wrapperspb.StringValue, error) {
+		return wrapperspb.String("secret"), nil
+	}
+	reject := func(context.Context, proto.Message) (proto.Message, error) {
+		return nil, status.Error(codes.PermissionDenied, "redacted")
+	}
+	uri = StreamToResource(ctx, cancel, s, "test.Service.Watch", recv, []ResponseTransformer{reject}).Content[1].(mcp.ResourceLink).URI
+	g.Eventually(session.notifications).Should(Receive())
+	state = readStreamState(g, sessionCtx, s, uri)
+	g.Expect(state.Error).To(Equal(&StreamError{Code: "PermissionDenied", Message: "redacted"}))
+	g.Expect(state.Sequence).To(BeZero())
+	g.Eventually(ctx.Done()).Should(BeClosed())
+}
+
+func TestStreamToResourceOtherSession(t *testing.T) {
+	g := NewWithT(t)
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	AddStreamResourceTemplate(s)
+	_, ctx1 := newNotifiedSession(g, s, "session-1")
+	other, ctx2 := newNotifiedSession(g, s, "session-2")
+
+	messages := make(chan *wrapperspb.StringValue)
+	recv := func() (*wrapperspb.StringValue, error) {
+		if msg, ok := <-messages; ok {
+			return msg, nil
+		}
+		return nil, io.EOF
+	}
+	ctx, cancel := context.WithCancel(ctx1)
+	defer close(messages)
+	uri := StreamToResource(ctx, cancel, s, "test.Service.Watch", recv, nil).Content[1].(mcp.ResourceLink).URI
+
+	messages <- wrapperspb.String("private")
+	g.Eventually(func() uint64 { return readStreamState(g, ctx1, s, uri).Sequence }).Should(BeEquivalentTo(1))
+	g.Expect(readResource(g, ctx2, s, uri)).To(BeAssignableToTypeOf(mcp.JSONRPCError{}))
+	g.Expect(other.notifications).ToNot(Receive())
+
+	// The stream is not listed, only the template serving it.
+	for _, ctx := range []context.Context{ctx1, ctx2} {
+		list, err := json.Marshal(s.HandleMessage(ctx, []byte(`{"jsonrpc": "2.0", "id": 1, "method": "resources/list"}`)))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(list)).ToNot(ContainSubstring(uri))
+	}
+	templates, err := json.Marshal(s.HandleMessage(ctx1, []byte(`{"jsonrpc": "2.0", "id": 1, "method": "resources/templates/list"}`)))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(templates)).To(ContainSubstring(StreamResourceTemplate))
+}
+
+func TestStreamToResourceSessionEnd(t *testing.T) {
+	g := NewWithT(t)
+
+	hooks := &mcpserver.Hooks{}
+	AddStreamResourceHooks(hooks)
+	s := mcpserver.NewMCPServer("test-server", "1.0.0", mcpserver.WithHooks(hooks))
+	AddStreamResourceTemplate(s)
+	_, sessionCtx := newNotifiedSession(g, s, "session-1")
+
+	ctx, cancel := context.WithCancel(sessionCtx)
+	recv := func() (*wrapperspb.StringValue, error) {
+		<-ctx.Done()
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	uri := StreamToResource(ctx, cancel, s, "test.Service.Watch", recv, nil).Content[1].(mcp.ResourceLink).URI
+
+	// Ending the session cancels the stream and removes the resource.
+	s.UnregisterSession(context.Background(), "session-1")
+	g.Eventually(ctx.Done()).Should(BeClosed())
+	g.Expect(readResource(g, sessionCtx, s, uri)).To(BeAssignableToTypeOf(mcp.JSONRPCError{}))
+}
+
+func TestStreamToResourceUnreadFinalState(t *testing.T) {
+	g := NewWithT(t)
+
+	ttl := finishedStreamTTL
+	finishedStreamTTL = 10 * time.Millisecond
+	defer func() { finishedStreamTTL = ttl }()
+
+	s := mcpserver.NewMCPServer("test-server", "1.0.0")
+	AddStreamResourceTemplate(s)
+	session, sessionCtx := newNotifiedSession(g, s, "session-1")
+	ctx, cancel := context.WithCancel(sessionCtx)
+	recv := func() (*wrapperspb.StringValue, error) {
+		return nil, io.EOF
+	}
+	uri := StreamToResource(ctx, cancel, s, "test.Service.Watch", recv, nil).Content[1].(mcp.ResourceLink).URI
+	g.Eventually(session.notifications).Should(Receive())
+
+	// The final state is dropped once it has gone unread for a while.
+	g.Eventually(func() bool {
+		openStreams.Lock()
+		defer openStreams.Unlock()
+		_, ok := openStreams.byURI[uri]
+		return ok
+	}).Should(BeFalse())
+	g.Expect(readResource(g, sessionCtx, s, uri)).To(BeAssignableToTypeOf(mcp.JSONRPCError{}))
+}
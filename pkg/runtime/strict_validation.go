@@ -0,0 +1,81 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithStrictValidation sets whether generated handlers check the protovalidate
+// constraints that the generator knows how to check, and reject a violating
+// request with an InvalidArgument tool error instead of forwarding it.
+// Currently these are the min_pairs and max_pairs rules of map fields.
+func WithStrictValidation(enable bool) Option {
+	return func(c *Config) {
+		c.StrictValidation = enable
+	}
+}
+
+// MapPairLimit bounds the number of entries of a map field. Generated code
+// lists them per tool.
+type MapPairLimit struct {
+	// Path is the field path, as protobuf field names.
+	Path []string
+	// Min and Max bound the number of entries; Max is 0 when unbounded.
+	Min, Max uint64
+}
+
+// CheckMapPairLimits returns an InvalidArgument error for the first map of
+// message whose number of entries is out of its limits. A map that was not
+// sent has no entries.
+func CheckMapPairLimits(message map[string]interface{}, limits []MapPairLimit) error {
+	for _, l := range limits {
+		if len(l.Path) == 0 {
+			continue
+		}
+		m, sent := message, true
+		for _, key := range l.Path[:len(l.Path)-1] {
+			if m, sent = m[key].(map[string]interface{}); !sent {
+				break
+			}
+		}
+		if !sent {
+			// The message holding the map was not sent.
+			continue
+		}
+		entries, _ := m[l.Path[len(l.Path)-1]].(map[string]interface{})
+		n := uint64(len(entries))
+		field := strings.Join(l.Path, ".")
+		if n < l.Min {
+			return status.Errorf(codes.InvalidArgument, "%s must have at least %s, got %d", field, countEntries(l.Min), n)
+		}
+		if l.Max > 0 && n > l.Max {
+			return status.Errorf(codes.InvalidArgument, "%s must have at most %s, got %d", field, countEntries(l.Max), n)
+		}
+	}
+	return nil
+}
+
+// countEntries returns n followed by "entry" or "entries", e.g. "1 entry".
+func countEntries(n uint64) string {
+	if n == 1 {
+		return "1 entry"
+	}
+	return strconv.FormatUint(n, 10) + " entries"
+}
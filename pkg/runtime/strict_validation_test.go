@@ -0,0 +1,52 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckMapPairLimits(t *testing.T) {
+	g := NewWithT(t)
+
+	limits := []MapPairLimit{
+		{Path: []string{"labels"}, Min: 1, Max: 2},
+		{Path: []string{"options", "tags"}, Max: 1},
+	}
+
+	g.Expect(CheckMapPairLimits(map[string]interface{}{"labels": map[string]interface{}{"a": "1"}}, limits)).To(Succeed())
+
+	err := CheckMapPairLimits(nil, limits)
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	g.Expect(err).To(MatchError(ContainSubstring("labels must have at least 1 entry, got 0")))
+
+	err = CheckMapPairLimits(map[string]interface{}{
+		"labels":  map[string]interface{}{"a": "1"},
+		"options": map[string]interface{}{"tags": map[string]interface{}{"x": "1", "y": "2"}},
+	}, limits)
+	g.Expect(err).To(MatchError(ContainSubstring("options.tags must have at most 1 entry, got 2")))
+
+	err = CheckMapPairLimits(map[string]interface{}{"labels": map[string]interface{}{"a": "1", "b": "2", "c": "3"}}, limits)
+	g.Expect(err).To(MatchError(ContainSubstring("labels must have at most 2 entries, got 3")))
+
+	// Max 0 is unbounded, and a map inside an omitted message is not checked.
+	g.Expect(CheckMapPairLimits(map[string]interface{}{
+		"labels": map[string]interface{}{"a": "1", "b": "2"},
+	}, append(limits, MapPairLimit{Path: []string{"labels"}}))).To(Succeed())
+}
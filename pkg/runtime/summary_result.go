@@ -0,0 +1,44 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// NewSummaryResult returns the result of a method whose response has a
+// (mcp.options.summary) field: a text block with the value of the string
+// field summaryField of resp, followed by a text block with payload, the full
+// response. When the summary is empty, for example because a response
+// transformer cleared it, the result only has the payload block.
+func NewSummaryResult(resp proto.Message, summaryField protoreflect.Name, payload string) *mcp.CallToolResult {
+	var summary string
+	if resp != nil {
+		m := resp.ProtoReflect()
+		if fd := m.Descriptor().Fields().ByName(summaryField); fd != nil && fd.Kind() == protoreflect.StringKind && !fd.IsList() {
+			summary = m.Get(fd).String()
+		}
+	}
+	if summary == "" {
+		return mcp.NewToolResultText(payload)
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(summary),
+			mcp.NewTextContent(payload),
+		},
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/apipb"
+)
+
+func TestNewSummaryResult(t *testing.T) {
+	g := NewWithT(t)
+
+	res := NewSummaryResult(&apipb.Api{Name: "library"}, "name", `{"name":"library"}`)
+	g.Expect(res.Content).To(HaveLen(2))
+	g.Expect(res.Content[0]).To(Equal(mcp.NewTextContent("library")))
+	g.Expect(res.Content[1]).To(Equal(mcp.NewTextContent(`{"name":"library"}`)))
+
+	// An empty summary, or a field that is not a string, leaves only the payload.
+	for _, res := range []*mcp.CallToolResult{
+		NewSummaryResult(&apipb.Api{}, "name", "{}"),
+		NewSummaryResult(&apipb.Api{Methods: []*apipb.Method{{}}}, "methods", "{}"),
+		NewSummaryResult(&apipb.Api{Name: "library"}, "missing", "{}"),
+	} {
+		g.Expect(res.Content).To(HaveLen(1))
+		g.Expect(res.Content[0]).To(Equal(mcp.NewTextContent("{}")))
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const timestampFullName protoreflect.FullName = "google.protobuf.Timestamp"
+
+// UnixTimestampsToRFC3339 rewrites, in place, every google.protobuf.Timestamp
+// in message that was sent as a number of seconds since the Unix epoch into
+// the RFC 3339 string protojson expects. md describes message. Timestamps are
+// found in nested messages, lists and map values; any other value is left for
+// protojson to accept or reject.
+func UnixTimestampsToRFC3339(message map[string]interface{}, md protoreflect.MessageDescriptor) {
+	// The visitor never fails.
+	_ = walkFields(message, md, "", unixTimestampToRFC3339)
+}
+
+// unixTimestampToRFC3339 converts v, a single value of fd, if it is a
+// google.protobuf.Timestamp sent as a number.
+func unixTimestampToRFC3339(fd protoreflect.FieldDescriptor, _ string, v interface{}) (interface{}, error) {
+	if fd.Message() == nil || fd.Message().FullName() != timestampFullName {
+		return v, nil
+	}
+	if ts, ok := unixSecondsToRFC3339(v); ok {
+		return ts, nil
+	}
+	return v, nil
+}
+
+// unixSecondsToRFC3339 formats v, a number of seconds since the Unix epoch, as
+// an RFC 3339 timestamp in UTC. Fractional seconds are kept.
+func unixSecondsToRFC3339(v interface{}) (string, bool) {
+	var seconds float64
+	switch n := v.(type) {
+	case float64:
+		seconds = n
+	case int:
+		return time.Unix(int64(n), 0).UTC().Format(time.RFC3339Nano), true
+	case int64:
+		return time.Unix(n, 0).UTC().Format(time.RFC3339Nano), true
+	case json.Number:
+		if i, err := n.Int64(); err == nil {
+			return time.Unix(i, 0).UTC().Format(time.RFC3339Nano), true
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return "", false
+		}
+		seconds = f
+	default:
+		return "", false
+	}
+	whole, frac := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(math.Round(frac*1e9))).UTC().Format(time.RFC3339Nano), true
+}
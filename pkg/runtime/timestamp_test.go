@@ -0,0 +1,78 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+func TestUnixTimestampsToRFC3339(t *testing.T) {
+	g := NewWithT(t)
+
+	message := map[string]interface{}{
+		"start_time":     float64(1700000000),
+		"endTime":        1700000000.5, // JSON name
+		"blackout_times": []interface{}{json.Number("0"), "2024-01-01T00:00:00Z"},
+		"deadlines":      map[string]interface{}{"a": float64(86400)},
+		"window":         map[string]interface{}{"opens_at": float64(-1)},
+		"run_at":         float64(1700000000), // oneof member, already unwrapped
+	}
+	UnixTimestampsToRFC3339(message, (&testdata.ScheduleJobRequest{}).ProtoReflect().Descriptor())
+
+	raw, err := json.Marshal(message)
+	g.Expect(err).ToNot(HaveOccurred())
+	var got testdata.ScheduleJobRequest
+	g.Expect(protojson.Unmarshal(raw, &got)).To(Succeed(), "converted: %s", raw)
+
+	want := &testdata.ScheduleJobRequest{
+		StartTime: timestamppb.New(time.Unix(1700000000, 0)),
+		EndTime:   timestamppb.New(time.Unix(1700000000, 500000000)),
+		BlackoutTimes: []*timestamppb.Timestamp{
+			timestamppb.New(time.Unix(0, 0)),
+			timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		Deadlines: map[string]*timestamppb.Timestamp{"a": timestamppb.New(time.Unix(86400, 0))},
+		Window:    &testdata.JobWindow{OpensAt: timestamppb.New(time.Unix(-1, 0))},
+		Trigger:   &testdata.ScheduleJobRequest_RunAt{RunAt: timestamppb.New(time.Unix(1700000000, 0))},
+	}
+	g.Expect(proto.Equal(&got, want)).To(BeTrue(), "got %v", &got)
+}
+
+func TestUnixTimestampsToRFC3339LeavesOtherValues(t *testing.T) {
+	g := NewWithT(t)
+
+	message := map[string]interface{}{
+		"start_time": "2024-01-01T00:00:00Z",
+		"end_time":   nil,
+		"cron":       "0 * * * *",
+		"window":     "not an object",
+	}
+	UnixTimestampsToRFC3339(message, (&testdata.ScheduleJobRequest{}).ProtoReflect().Descriptor())
+	g.Expect(message).To(Equal(map[string]interface{}{
+		"start_time": "2024-01-01T00:00:00Z",
+		"end_time":   nil,
+		"cron":       "0 * * * *",
+		"window":     "not an object",
+	}))
+}
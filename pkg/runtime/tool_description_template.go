@@ -0,0 +1,85 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// descriptionPlaceholder matches a placeholder of a tool description
+// template. Braces around anything else are literal text.
+var descriptionPlaceholder = regexp.MustCompile(`\{(\w+)\}`)
+
+// WithToolDescriptionTemplate replaces the description of every tool of the
+// registration with tmpl, for a house style of descriptions without editing
+// the protos. tmpl may hold these placeholders:
+//
+//   - {method}: the simple name of the RPC method, e.g. "GetItem"
+//   - {service}: the simple name of its service, e.g. "TestService"
+//   - {route}: its gRPC route, e.g. "/testdata.TestService/GetItem"
+//   - {comment}: the generated description, i.e. the (mcp.options.tool)
+//     description or method comment
+//
+// e.g. "{comment} Calls {route}.". Any other {name} makes the registration
+// panic, as invalid tool name overrides do.
+func WithToolDescriptionTemplate(tmpl string) Option {
+	return func(c *Config) {
+		c.DescriptionTemplate = tmpl
+	}
+}
+
+// ValidateToolDescriptionTemplate returns an error naming the first unknown
+// placeholder of tmpl.
+func ValidateToolDescriptionTemplate(tmpl string) error {
+	for _, m := range descriptionPlaceholder.FindAllStringSubmatch(tmpl, -1) {
+		switch m[1] {
+		case "method", "service", "route", "comment":
+		default:
+			return fmt.Errorf("tool description template %q has unknown placeholder %s; use {method}, {service}, {route} or {comment}", tmpl, m[0])
+		}
+	}
+	return nil
+}
+
+// ApplyToolDescriptionTemplate returns the description of the tool of
+// fullMethod, the fully-qualified name of its RPC method, under tmpl, with
+// description as its {comment}. An empty tmpl leaves description as is.
+func ApplyToolDescriptionTemplate(tmpl, fullMethod, description string) string {
+	if tmpl == "" {
+		return description
+	}
+	service, method := fullMethod, fullMethod
+	if i := strings.LastIndex(fullMethod, "."); i >= 0 {
+		service, method = fullMethod[:i], fullMethod[i+1:]
+	}
+	route := "/" + service + "/" + method
+	if i := strings.LastIndex(service, "."); i >= 0 {
+		service = service[i+1:]
+	}
+	return descriptionPlaceholder.ReplaceAllStringFunc(tmpl, func(placeholder string) string {
+		switch placeholder {
+		case "{method}":
+			return method
+		case "{service}":
+			return service
+		case "{route}":
+			return route
+		case "{comment}":
+			return strings.TrimSpace(description)
+		}
+		return placeholder
+	})
+}
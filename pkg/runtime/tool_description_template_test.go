@@ -0,0 +1,39 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestApplyToolDescriptionTemplate(t *testing.T) {
+	g := NewWithT(t)
+
+	const method = "acme.shop.v1.OrderService.CancelOrder"
+	g.Expect(ApplyToolDescriptionTemplate("", method, "Cancels an order.\n")).To(Equal("Cancels an order.\n"))
+	g.Expect(ApplyToolDescriptionTemplate("{service}: {comment}", method, " Cancels an order.\n")).To(Equal("OrderService: Cancels an order."))
+	g.Expect(ApplyToolDescriptionTemplate("{method} via {route}", method, "")).To(Equal("CancelOrder via /acme.shop.v1.OrderService/CancelOrder"))
+	// Braces around anything but a word are literal.
+	g.Expect(ApplyToolDescriptionTemplate(`{comment} Example: {"id": "o-1"}`, method, "Cancels.")).To(Equal(`Cancels. Example: {"id": "o-1"}`))
+}
+
+func TestValidateToolDescriptionTemplate(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ValidateToolDescriptionTemplate("")).To(Succeed())
+	g.Expect(ValidateToolDescriptionTemplate("{method} {service} {route} {comment} { }")).To(Succeed())
+	g.Expect(ValidateToolDescriptionTemplate("{comment} ({Service})")).To(MatchError(ContainSubstring("unknown placeholder {Service}")))
+}
@@ -0,0 +1,37 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import "github.com/mark3labs/mcp-go/mcp"
+
+// ToolMeta returns the _meta of the registered tool of t, carrying its proto
+// package, version and gRPC method path under "package", "version" and
+// "grpcMethod", or nil when t has none of them.
+func ToolMeta(t Tool) *mcp.Meta {
+	if t.Package == "" && t.Version == "" && t.Method == "" {
+		return nil
+	}
+	fields := map[string]any{}
+	if t.Package != "" {
+		fields["package"] = t.Package
+	}
+	if t.Version != "" {
+		fields["version"] = t.Version
+	}
+	if t.Method != "" {
+		fields["grpcMethod"] = t.Method
+	}
+	return &mcp.Meta{AdditionalFields: fields}
+}
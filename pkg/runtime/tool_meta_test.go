@@ -0,0 +1,37 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+)
+
+func TestToolMeta(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ToolMeta(Tool{Name: "t"})).To(BeNil())
+	g.Expect(ToolMeta(Tool{Package: "acme.v1", Version: "1.4.0"})).To(Equal(&mcp.Meta{
+		AdditionalFields: map[string]any{"package": "acme.v1", "version": "1.4.0"},
+	}))
+	g.Expect(ToolMeta(Tool{Package: "acme.v1"})).To(Equal(&mcp.Meta{
+		AdditionalFields: map[string]any{"package": "acme.v1"},
+	}))
+	g.Expect(ToolMeta(Tool{Method: "/acme.v1.Widgets/GetWidget"})).To(Equal(&mcp.Meta{
+		AdditionalFields: map[string]any{"grpcMethod": "/acme.v1.Widgets/GetWidget"},
+	}))
+}
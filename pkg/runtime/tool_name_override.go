@@ -0,0 +1,110 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// MaxToolNameLength is the longest tool name MCP allows.
+const MaxToolNameLength = 128
+
+// toolNameRe matches the characters MCP allows in a tool name.
+var toolNameRe = regexp.MustCompile(`^[A-Za-z0-9_.-]*$`)
+
+// WithToolNameOverride renames generated tools without touching the proto.
+// overrides maps fully-qualified RPC method names (e.g.
+// "testdata.TestService.GetItem") to the tool name to register instead of the
+// generated one. The batch tool of a method is keyed by the method name with a
+// "#batch" suffix. Repeated options are merged.
+func WithToolNameOverride(overrides map[string]string) Option {
+	return func(c *Config) {
+		if c.ToolNameOverrides == nil {
+			c.ToolNameOverrides = make(map[string]string, len(overrides))
+		}
+		for method, name := range overrides {
+			c.ToolNameOverrides[method] = name
+		}
+	}
+}
+
+// ToolName returns the name registered for the tool of method, generated as
+// generated, under overrides.
+func ToolName(method, generated string, overrides map[string]string) string {
+	if name, ok := overrides[method]; ok && name != "" {
+		return name
+	}
+	return generated
+}
+
+// ResolveToolNames returns the tool name to register for every method in
+// generated (fully-qualified method name -> generated tool name) after applying
+// overrides. It fails if an override is not a valid MCP tool name, if two
+// overrides share a name, or if an override collides with another tool in
+// generated.
+func ResolveToolNames(generated map[string]string, overrides map[string]string) (map[string]string, error) {
+	overridden := make(map[string]string, len(overrides))
+	for _, method := range sortedKeys(overrides) {
+		name := overrides[method]
+		if err := validateToolName(name); err != nil {
+			return nil, fmt.Errorf("tool name override for %s %w", method, err)
+		}
+		if other, dup := overridden[name]; dup {
+			return nil, fmt.Errorf("tool name override %q is used for both %s and %s", name, other, method)
+		}
+		overridden[name] = method
+	}
+
+	resolved := make(map[string]string, len(generated))
+	owner := make(map[string]string, len(generated))
+	for _, method := range sortedKeys(generated) {
+		name := generated[method]
+		if override, ok := overrides[method]; ok {
+			name = override
+		}
+		if other, dup := owner[name]; dup {
+			return nil, fmt.Errorf("tool name %q is used for both %s and %s after applying overrides", name, other, method)
+		}
+		owner[name] = method
+		resolved[method] = name
+	}
+	return resolved, nil
+}
+
+// validateToolName checks name against the MCP rules for tool names: 1 to
+// MaxToolNameLength ASCII letters, digits, '_', '-' and '.'.
+func validateToolName(name string) error {
+	switch {
+	case name == "":
+		return errors.New("is empty")
+	case len(name) > MaxToolNameLength:
+		return fmt.Errorf("%q is %d characters long; max is %d", name, len(name), MaxToolNameLength)
+	case !toolNameRe.MatchString(name):
+		return fmt.Errorf("%q may only contain ASCII letters, digits, '_', '-' and '.'", name)
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestResolveToolNames(t *testing.T) {
+	generated := map[string]string{
+		"pkg.Svc.Get":    "pkg_Svc_Get",
+		"pkg.Svc.List":   "pkg_Svc_List",
+		"pkg.Svc.Delete": "pkg_Svc_Delete",
+	}
+
+	t.Run("no overrides", func(t *testing.T) {
+		g := NewWithT(t)
+		got, err := ResolveToolNames(generated, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(Equal(generated))
+	})
+
+	t.Run("override applied", func(t *testing.T) {
+		g := NewWithT(t)
+		got, err := ResolveToolNames(generated, map[string]string{
+			"pkg.Svc.Get":   "get_thing",
+			"other.Svc.Foo": "foo", // belongs to another service
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(Equal(map[string]string{
+			"pkg.Svc.Get":    "get_thing",
+			"pkg.Svc.List":   "pkg_Svc_List",
+			"pkg.Svc.Delete": "pkg_Svc_Delete",
+		}))
+	})
+
+	t.Run("two overrides with the same name", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := ResolveToolNames(generated, map[string]string{
+			"pkg.Svc.Get":   "thing",
+			"other.Svc.Foo": "thing",
+		})
+		g.Expect(err).To(MatchError(ContainSubstring(`"thing" is used for both other.Svc.Foo and pkg.Svc.Get`)))
+	})
+
+	t.Run("override collides with a generated name", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := ResolveToolNames(generated, map[string]string{"pkg.Svc.Get": "pkg_Svc_List"})
+		g.Expect(err).To(MatchError(ContainSubstring(`"pkg_Svc_List"`)))
+	})
+
+	t.Run("empty override", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := ResolveToolNames(generated, map[string]string{"pkg.Svc.Get": ""})
+		g.Expect(err).To(MatchError("tool name override for pkg.Svc.Get is empty"))
+	})
+
+	t.Run("invalid override", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := ResolveToolNames(generated, map[string]string{"pkg.Svc.Get": "get thing"})
+		g.Expect(err).To(MatchError(`tool name override for pkg.Svc.Get "get thing" may only contain ASCII letters, digits, '_', '-' and '.'`))
+
+		_, err = ResolveToolNames(generated, map[string]string{"pkg.Svc.Get": "get_caf\u00e9"})
+		g.Expect(err).To(MatchError(ContainSubstring("may only contain ASCII letters")))
+
+		_, err = ResolveToolNames(generated, map[string]string{"pkg.Svc.Get": strings.Repeat("a", MaxToolNameLength+1)})
+		g.Expect(err).To(MatchError(ContainSubstring("is 129 characters long; max is 128")))
+
+		got, err := ResolveToolNames(generated, map[string]string{"pkg.Svc.Get": "Things.get-v2"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(HaveKeyWithValue("pkg.Svc.Get", "Things.get-v2"))
+	})
+}
+
+func TestWithToolNameOverrideMerges(t *testing.T) {
+	g := NewWithT(t)
+
+	c := NewConfig()
+	WithToolNameOverride(map[string]string{"a.B.C": "c"})(c)
+	WithToolNameOverride(map[string]string{"a.B.D": "d"})(c)
+	g.Expect(c.ToolNameOverrides).To(Equal(map[string]string{"a.B.C": "c", "a.B.D": "d"}))
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+)
+
+// PaginateTools returns the page of tools that follows cursor, with at most
+// pageSize tools, and the cursor of the next page, which is empty on the last
+// page. Tools are ordered by name, so page boundaries do not depend on
+// registration order. Cursors are the base64-encoded name of the last tool of
+// the previous page, as in the tools/list pagination of mcp-go, so cursors of
+// either can be passed to the other. A pageSize of zero or less returns every
+// remaining tool.
+func PaginateTools(tools []mcp.Tool, cursor mcp.Cursor, pageSize int) ([]mcp.Tool, mcp.Cursor, error) {
+	sorted := make([]mcp.Tool, len(tools))
+	copy(sorted, tools)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	start := 0
+	if cursor != "" {
+		after, err := base64.StdEncoding.DecodeString(string(cursor))
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid cursor %q: %w", cursor, err)
+		}
+		start = sort.Search(len(sorted), func(i int) bool { return sorted[i].Name > string(after) })
+	}
+	end := len(sorted)
+	if pageSize > 0 && start+pageSize < end {
+		end = start + pageSize
+	}
+	page := sorted[start:end]
+	if end == len(sorted) {
+		return page, "", nil
+	}
+	return page, mcp.Cursor(base64.StdEncoding.EncodeToString([]byte(page[len(page)-1].Name))), nil
+}
+
+// ListServerTools returns every tool registered on s by paging through
+// tools/list until the last page, e.g. to export the tool surface of a
+// server or to test it.
+func ListServerTools(ctx context.Context, s *mcpserver.MCPServer) ([]mcp.Tool, error) {
+	var tools []mcp.Tool
+	var cursor mcp.Cursor
+	for {
+		params := map[string]any{}
+		if cursor != "" {
+			params["cursor"] = cursor
+		}
+		msg, err := json.Marshal(map[string]any{"jsonrpc": mcp.JSONRPC_VERSION, "id": 1, "method": string(mcp.MethodToolsList), "params": params})
+		if err != nil {
+			return nil, err
+		}
+		raw, err := json.Marshal(s.HandleMessage(ctx, msg))
+		if err != nil {
+			return nil, err
+		}
+		var resp struct {
+			Result *mcp.ListToolsResult `json:"result"`
+			Error  *struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal(raw, &resp); err != nil {
+			return nil, err
+		}
+		if resp.Error != nil {
+			return nil, fmt.Errorf("tools/list: %s", resp.Error.Message)
+		}
+		if resp.Result == nil {
+			return nil, fmt.Errorf("tools/list: no result")
+		}
+		tools = append(tools, resp.Result.Tools...)
+		if resp.Result.NextCursor == "" || len(resp.Result.Tools) == 0 {
+			return tools, nil
+		}
+		cursor = resp.Result.NextCursor
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/gomega"
+)
+
+// syntheticTools returns n tools named tool_000 to tool_<n-1>, shuffled.
+func syntheticTools(n int) []mcp.Tool {
+	tools := make([]mcp.Tool, n)
+	for i := range tools {
+		tools[i] = mcp.NewTool(fmt.Sprintf("tool_%03d", i))
+	}
+	rand.New(rand.NewSource(1)).Shuffle(n, func(i, j int) { tools[i], tools[j] = tools[j], tools[i] })
+	return tools
+}
+
+func toolNames(tools []mcp.Tool) []string {
+	names := make([]string, len(tools))
+	for i, tool := range tools {
+		names[i] = tool.Name
+	}
+	return names
+}
+
+func TestPaginateTools(t *testing.T) {
+	g := NewWithT(t)
+
+	tools := syntheticTools(500)
+	var pages [][]string
+	var cursor mcp.Cursor
+	for {
+		page, next, err := PaginateTools(tools, cursor, 64)
+		g.Expect(err).ToNot(HaveOccurred())
+		pages = append(pages, toolNames(page))
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+
+	g.Expect(pages).To(HaveLen(8))
+	var all []string
+	for i, page := range pages {
+		if i < 7 {
+			g.Expect(page).To(HaveLen(64))
+		}
+		all = append(all, page...)
+	}
+	g.Expect(pages[7]).To(HaveLen(500 - 7*64))
+	g.Expect(all).To(HaveLen(500))
+	for i, name := range all {
+		g.Expect(name).To(Equal(fmt.Sprintf("tool_%03d", i)))
+	}
+
+	// Boundaries do not depend on the order of the tools.
+	reversed := make([]mcp.Tool, len(tools))
+	for i, tool := range tools {
+		reversed[len(tools)-1-i] = tool
+	}
+	page, next, err := PaginateTools(reversed, "", 64)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(toolNames(page)).To(Equal(pages[0]))
+	again, _, err := PaginateTools(tools, next, 64)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(toolNames(again)).To(Equal(pages[1]))
+}
+
+func TestPaginateToolsEdges(t *testing.T) {
+	g := NewWithT(t)
+
+	page, next, err := PaginateTools(syntheticTools(10), "", 0)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(page).To(HaveLen(10))
+	g.Expect(next).To(BeEmpty())
+
+	page, next, err = PaginateTools(syntheticTools(10), "", 10)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(page).To(HaveLen(10))
+	g.Expect(next).To(BeEmpty(), "no cursor to an empty page")
+
+	_, _, err = PaginateTools(syntheticTools(10), "not base64!", 5)
+	g.Expect(err).To(MatchError(ContainSubstring("invalid cursor")))
+}
+
+// TestPaginateToolsMatchesServer checks that the pages and cursors are those
+// of the tools/list pagination of an mcp-go server.
+func TestPaginateToolsMatchesServer(t *testing.T) {
+	g := NewWithT(t)
+
+	tools := syntheticTools(500)
+	s := mcpserver.NewMCPServer("test-server", "1.0.0", mcpserver.WithPaginationLimit(64))
+	for _, tool := range tools {
+		s.AddTool(tool, func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) { return nil, nil })
+	}
+
+	listed, err := ListServerTools(context.Background(), s)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(listed).To(HaveLen(500))
+
+	var cursor mcp.Cursor
+	for i := 0; ; i++ {
+		page, next, err := PaginateTools(tools, cursor, 64)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(toolNames(page)).To(Equal(toolNames(listed[i*64 : i*64+len(page)])))
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+}
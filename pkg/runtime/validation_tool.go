@@ -0,0 +1,206 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ValidationTarget is a tool whose arguments a validation tool checks.
+type ValidationTarget struct {
+	// Tool is the tool as registered, whose input schema the arguments are
+	// checked against.
+	Tool mcp.Tool
+	// Parse builds the request of the tool from the arguments as its handler
+	// does, without calling the backend. Generated code passes the
+	// Parse<Service><Method>Args function of the tool.
+	Parse func(args map[string]interface{}) error
+}
+
+// Violation is one reason a validation tool rejects arguments.
+type Violation struct {
+	// Path is the JSON pointer of the offending value in the arguments, ""
+	// for the arguments themselves.
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// ValidationResult is the text result of a validation tool call.
+type ValidationResult struct {
+	Valid      bool        `json:"valid"`
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+// validationTool is a ValidationTarget with its compiled input schema.
+type validationTool struct {
+	ValidationTarget
+	schema *jsonschema.Schema
+}
+
+// violationPrinter renders the messages of schema violations.
+var violationPrinter = message.NewPrinter(language.English)
+
+// NewValidationTool returns the tool called name that checks arguments for the
+// tools of targets, all of service, without calling them, and its handler.
+// The arguments are checked against the input schema of the tool and, when
+// they match it, parsed into the request as by the tool itself, which applies
+// input defaults and the checks of the request conversion. The result is a
+// ValidationResult; a call naming a tool that is not a target is a tool error.
+// It fails when the input schema of a target does not compile.
+func NewValidationTool(name, service string, targets []ValidationTarget) (mcp.Tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error), error) {
+	tools := map[string]validationTool{}
+	names := make([]string, 0, len(targets))
+	for _, target := range targets {
+		schema, err := compileInputSchema(target.Tool)
+		if err != nil {
+			return mcp.Tool{}, nil, err
+		}
+		tools[target.Tool.Name] = validationTool{ValidationTarget: target, schema: schema}
+		names = append(names, target.Tool.Name)
+	}
+	sort.Strings(names)
+
+	inputSchema, err := json.Marshal(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"tool": map[string]any{
+				"type":        "string",
+				"enum":        names,
+				"description": "Name of the tool whose arguments to check.",
+			},
+			"arguments": map[string]any{
+				"type":        "object",
+				"description": "Arguments to check, as they would be passed to the tool.",
+			},
+		},
+		"required": []string{"tool", "arguments"},
+	})
+	if err != nil {
+		return mcp.Tool{}, nil, fmt.Errorf("validation tool %q: %w", name, err)
+	}
+	tool := mcp.NewToolWithRawSchema(name,
+		"Checks arguments for a tool of "+service+" against its input schema and request checks, without calling it. "+
+			`Returns {"valid": true}, or {"valid": false, "violations": [...]} with the JSON pointer and reason of each violation.`,
+		inputSchema)
+	tool.Annotations.ReadOnlyHint = mcp.ToBoolPtr(true)
+	tool.Annotations.IdempotentHint = mcp.ToBoolPtr(true)
+
+	handler := func(_ context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := request.GetArguments()
+		toolName, _ := args["tool"].(string)
+		target, ok := tools[toolName]
+		if !ok {
+			return HandleError(status.Errorf(codes.InvalidArgument, "unknown tool %q, want one of %s", toolName, strings.Join(names, ", ")))
+		}
+		arguments, ok := args["arguments"].(map[string]interface{})
+		if !ok {
+			return HandleError(status.Error(codes.InvalidArgument, "arguments must be an object"))
+		}
+		result, err := target.validate(arguments)
+		if err != nil {
+			return HandleError(err)
+		}
+		text, err := json.Marshal(result)
+		if err != nil {
+			return HandleError(err)
+		}
+		return mcp.NewToolResultText(string(text)), nil
+	}
+	return tool, handler, nil
+}
+
+// validate checks arguments against the input schema of the tool and, when
+// they match it, parses a copy of them into the request.
+func (t validationTool) validate(arguments map[string]interface{}) (ValidationResult, error) {
+	raw, err := json.Marshal(arguments)
+	if err != nil {
+		return ValidationResult{}, err
+	}
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+	if err != nil {
+		return ValidationResult{}, err
+	}
+	var violations []Violation
+	if err := t.schema.Validate(instance); err != nil {
+		verr, ok := err.(*jsonschema.ValidationError)
+		if !ok {
+			return ValidationResult{}, err
+		}
+		violations = schemaViolations(verr, violations)
+	} else {
+		// The request conversion rewrites the arguments it is given.
+		var args map[string]interface{}
+		if err := json.Unmarshal(raw, &args); err != nil {
+			return ValidationResult{}, err
+		}
+		if err := t.Parse(args); err != nil {
+			violations = append(violations, Violation{Path: "", Message: status.Convert(err).Message()})
+		}
+	}
+	return ValidationResult{Valid: len(violations) == 0, Violations: violations}, nil
+}
+
+// compileInputSchema compiles the input schema of tool as JSON Schema
+// 2020-12.
+func compileInputSchema(tool mcp.Tool) (*jsonschema.Schema, error) {
+	raw := []byte(tool.RawInputSchema)
+	if len(raw) == 0 {
+		var err error
+		if raw, err = json.Marshal(tool.InputSchema); err != nil {
+			return nil, fmt.Errorf("tool %q: schema does not marshal: %w", tool.Name, err)
+		}
+	}
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("tool %q: schema is not valid JSON: %w", tool.Name, err)
+	}
+	c := jsonschema.NewCompiler()
+	c.DefaultDraft(jsonschema.Draft2020)
+	if err := c.AddResource("schema.json", doc); err != nil {
+		return nil, fmt.Errorf("tool %q: invalid schema: %w", tool.Name, err)
+	}
+	schema, err := c.Compile("schema.json")
+	if err != nil {
+		return nil, fmt.Errorf("tool %q: invalid schema: %w", tool.Name, err)
+	}
+	return schema, nil
+}
+
+// schemaViolations appends the leaf causes of err, the ones that name what is
+// wrong rather than which part of the schema failed, to violations.
+func schemaViolations(err *jsonschema.ValidationError, violations []Violation) []Violation {
+	if len(err.Causes) == 0 {
+		path := ""
+		for _, token := range err.InstanceLocation {
+			path += "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+		}
+		return append(violations, Violation{Path: path, Message: err.ErrorKind.LocalizedString(violationPrinter)})
+	}
+	for _, cause := range err.Causes {
+		violations = schemaViolations(cause, violations)
+	}
+	return violations
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/onsi/gomega"
+)
+
+func TestNewValidationTool(t *testing.T) {
+	g := NewWithT(t)
+
+	var parsed map[string]interface{}
+	target := ValidationTarget{
+		Tool: mcp.Tool{Name: "create_item", RawInputSchema: json.RawMessage(`{
+			"type": "object",
+			"properties": {
+				"name": {"type": "string", "minLength": 1},
+				"labels": {"type": "object", "additionalProperties": {"type": "string"}}
+			},
+			"required": ["name"]
+		}`)},
+		Parse: func(args map[string]interface{}) error {
+			parsed = args
+			if args["name"] == "taken" {
+				return errors.New("name is taken")
+			}
+			args["name"] = "rewritten"
+			return nil
+		},
+	}
+	tool, handler, err := NewValidationTool("items_validate", "testdata.ItemService", []ValidationTarget{target})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(tool.Name).To(Equal("items_validate"))
+	g.Expect(tool.Description).To(ContainSubstring("testdata.ItemService"))
+	g.Expect(*tool.Annotations.ReadOnlyHint).To(BeTrue())
+	g.Expect(ValidateToolSchema(tool, true)).To(Succeed())
+	g.Expect(string(tool.RawInputSchema)).To(ContainSubstring(`"enum":["create_item"]`))
+
+	call := func(args map[string]any) (string, bool) {
+		var request mcp.CallToolRequest
+		request.Params.Arguments = args
+		result, err := handler(context.Background(), request)
+		g.Expect(err).ToNot(HaveOccurred())
+		return result.Content[0].(mcp.TextContent).Text, result.IsError
+	}
+
+	// Valid arguments are parsed from a copy, without touching the call.
+	arguments := map[string]any{"name": "lamp", "labels": map[string]any{"color": "red"}}
+	text, isError := call(map[string]any{"tool": "create_item", "arguments": arguments})
+	g.Expect(isError).To(BeFalse())
+	g.Expect(text).To(MatchJSON(`{"valid": true}`))
+	g.Expect(parsed).To(HaveKeyWithValue("name", "rewritten"))
+	g.Expect(arguments).To(HaveKeyWithValue("name", "lamp"))
+
+	// Schema violations are reported by JSON pointer, without parsing.
+	parsed = nil
+	text, isError = call(map[string]any{"tool": "create_item", "arguments": map[string]any{"labels": map[string]any{"a/b": 1}}})
+	g.Expect(isError).To(BeFalse())
+	g.Expect(text).To(MatchJSON(`{"valid": false, "violations": [
+		{"path": "", "message": "missing property 'name'"},
+		{"path": "/labels/a~1b", "message": "got number, want string"}
+	]}`))
+	g.Expect(parsed).To(BeNil())
+
+	// So are the errors of the request conversion.
+	text, _ = call(map[string]any{"tool": "create_item", "arguments": map[string]any{"name": "taken"}})
+	g.Expect(text).To(MatchJSON(`{"valid": false, "violations": [{"path": "", "message": "name is taken"}]}`))
+
+	text, isError = call(map[string]any{"tool": "delete_item", "arguments": map[string]any{}})
+	g.Expect(isError).To(BeTrue())
+	g.Expect(text).To(ContainSubstring(`unknown tool \"delete_item\", want one of create_item`))
+
+	_, isError = call(map[string]any{"tool": "create_item", "arguments": "name=lamp"})
+	g.Expect(isError).To(BeTrue())
+}
+
+func TestNewValidationToolInvalidSchema(t *testing.T) {
+	g := NewWithT(t)
+
+	_, _, err := NewValidationTool("items_validate", "testdata.ItemService", []ValidationTarget{
+		{Tool: mcp.Tool{Name: "create_item", RawInputSchema: json.RawMessage(`{"type": "objet"}`)}},
+	})
+	g.Expect(err).To(MatchError(ContainSubstring(`tool "create_item": invalid schema`)))
+}
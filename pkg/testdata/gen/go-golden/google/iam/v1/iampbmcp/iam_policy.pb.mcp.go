@@ -5,9 +5,6 @@ package iampbmcp
 
 import (
 	iampb "cloud.google.com/go/iam/apiv1/iampb"
-)
-
-import (
 	"context"
 	"encoding/json"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,7 +12,18 @@ import (
 	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
-	"strings"
+)
+
+// Tool names as generated, and the fully-qualified method names that
+// runtime.WithToolNameOverride keys on, for references that must not drift
+// from the proto. Overrides apply at registration and are not reflected here.
+const (
+	IAMPolicy_GetIamPolicyToolName         = "google_iam_v1_IAMPolicy_GetIamPolicy"
+	IAMPolicy_GetIamPolicyFullMethod       = "google.iam.v1.IAMPolicy.GetIamPolicy"
+	IAMPolicy_SetIamPolicyToolName         = "google_iam_v1_IAMPolicy_SetIamPolicy"
+	IAMPolicy_SetIamPolicyFullMethod       = "google.iam.v1.IAMPolicy.SetIamPolicy"
+	IAMPolicy_TestIamPermissionsToolName   = "google_iam_v1_IAMPolicy_TestIamPermissions"
+	IAMPolicy_TestIamPermissionsFullMethod = "google.iam.v1.IAMPolicy.TestIamPermissions"
 )
 
 var (
@@ -37,171 +45,283 @@ type IAMPolicyClient interface {
 	TestIamPermissions(ctx context.Context, req *iampb.TestIamPermissionsRequest, opts ...grpc.CallOption) (*iampb.TestIamPermissionsResponse, error)
 }
 
-// IAMPolicyNormalizeTopLevelJSONStrings scans m's top level and checks if any fields
-// that should be objects according to the JSON schema are actually strings. If so, it will
-// parse string values that look like JSON and replace them with the parsed value.
-// This handles both OneOf fields and regular object fields.
-func IAMPolicyNormalizeTopLevelJSONStrings(
-	m map[string]interface{},
-	toolSchema string,
-) (changed bool) {
-	if m == nil || toolSchema == "" {
-		return false
+// IAMPolicyNormalizeTopLevelJSONStrings parses top-level string values of m that
+// should be objects according to the JSON schema. See runtime.NormalizeTopLevelJSONStrings.
+func IAMPolicyNormalizeTopLevelJSONStrings(m map[string]interface{}, toolSchema string) (changed bool) {
+	return runtime.NormalizeTopLevelJSONStrings(m, toolSchema)
+}
+
+// IAMPolicyTransformOneOfFields transforms discriminated union fields back to protobuf
+// oneOf format. See runtime.TransformOneOfFields. It knows no custom
+// (mcp.options.oneof_discriminator) values; TransformIAMPolicy<Method>OneOfFields does.
+func IAMPolicyTransformOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
+
+// TransformIAMPolicyGetIamPolicyOneOfFields transforms the discriminated union fields
+// of the arguments of the GetIamPolicy tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformIAMPolicyGetIamPolicyOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
+
+// TransformIAMPolicySetIamPolicyOneOfFields transforms the discriminated union fields
+// of the arguments of the SetIamPolicy tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformIAMPolicySetIamPolicyOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
+
+// TransformIAMPolicyTestIamPermissionsOneOfFields transforms the discriminated union fields
+// of the arguments of the TestIamPermissions tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformIAMPolicyTestIamPermissionsOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
+
+// ParseIAMPolicyGetIamPolicyArgs builds the typed request of the GetIamPolicy tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseIAMPolicyGetIamPolicyArgs(args map[string]interface{}, opts ...runtime.Option) (*iampb.GetIamPolicyRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
 	}
+	return parseIAMPolicyGetIamPolicyArgs(args, config)
+}
 
-	// Parse the tool schema
-	var schema map[string]interface{}
-	if err := json.Unmarshal([]byte(toolSchema), &schema); err != nil {
-		return false
+// parseIAMPolicyGetIamPolicyArgs runs the arguments of the GetIamPolicy tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseIAMPolicyGetIamPolicyArgs.
+func parseIAMPolicyGetIamPolicyArgs(args map[string]interface{}, config *runtime.Config) (*iampb.GetIamPolicyRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
 	}
 
-	// Extract properties from the schema
-	properties, ok := schema["properties"].(map[string]interface{})
-	if !ok {
-		return false
+	var req iampb.GetIamPolicyRequest
+
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(IAMPolicy_GetIamPolicyTool, "google.iam.v1.IAMPolicy.GetIamPolicy", config.ToolSchemaOverrides).JSONSchema)
+
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
 	}
 
-	// Helper function to check if a schema defines an object type
-	isObjectSchema := func(propSchema map[string]interface{}) bool {
-		// Check if type is "object"
-		if typeVal, ok := propSchema["type"]; ok {
-			if typeStr, ok := typeVal.(string); ok && typeStr == "object" {
-				return true
-			}
-			// Could also be an array of types
-			if typeArr, ok := typeVal.([]interface{}); ok {
-				for _, t := range typeArr {
-					if tStr, ok := t.(string); ok && tStr == "object" {
-						return true
-					}
-				}
-			}
-		}
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		// Check if it has properties (inline object)
-		if _, hasProps := propSchema["properties"]; hasProps {
-			return true
-		}
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(IAMPolicy_GetIamPolicyFullMethod, IAMPolicy_GetIamPolicyTool.Name, config.ToolNameOverrides)])
 
-		// Check if it has a $ref (reference to object)
-		if _, hasRef := propSchema["$ref"]; hasRef {
-			return true
-		}
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
 
-		// Check if it has oneOf (discriminated union - treated as object)
-		if _, hasOneOf := propSchema["oneOf"]; hasOneOf {
-			return true
-		}
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, IAMPolicy_GetIamPolicyZeroBasedPaginationPaths)
 
-		return false
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
 	}
 
-	// Iterate through all top-level fields in the payload
-	for k, v := range m {
-		// Get the schema for this field
-		propSchema, ok := properties[k]
-		if !ok {
-			continue
-		}
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
+}
 
-		propSchemaMap, ok := propSchema.(map[string]interface{})
-		if !ok {
-			continue
-		}
+// ParseIAMPolicySetIamPolicyArgs builds the typed request of the SetIamPolicy tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseIAMPolicySetIamPolicyArgs(args map[string]interface{}, opts ...runtime.Option) (*iampb.SetIamPolicyRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return parseIAMPolicySetIamPolicyArgs(args, config)
+}
 
-		// Check if this field should be an object according to the schema
-		if !isObjectSchema(propSchemaMap) {
-			continue
-		}
+// parseIAMPolicySetIamPolicyArgs runs the arguments of the SetIamPolicy tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseIAMPolicySetIamPolicyArgs.
+func parseIAMPolicySetIamPolicyArgs(args map[string]interface{}, config *runtime.Config) (*iampb.SetIamPolicyRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
 
-		// Check if the actual value is a string
-		s, ok := v.(string)
-		if !ok {
-			continue
-		}
+	var req iampb.SetIamPolicyRequest
 
-		// Try to parse it as JSON
-		trim := strings.TrimSpace(s)
-		if trim == "" || !(strings.HasPrefix(trim, "{") || strings.HasPrefix(trim, "[")) {
-			continue
-		}
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(IAMPolicy_SetIamPolicyTool, "google.iam.v1.IAMPolicy.SetIamPolicy", config.ToolSchemaOverrides).JSONSchema)
 
-		var parsed any
-		if err := json.Unmarshal([]byte(trim), &parsed); err != nil {
-			continue // ignore if it's not valid JSON
-		}
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
+
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(IAMPolicy_SetIamPolicyFullMethod, IAMPolicy_SetIamPolicyTool.Name, config.ToolNameOverrides)])
+
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
+
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, IAMPolicy_SetIamPolicyZeroBasedPaginationPaths)
+
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
 
-		m[k] = parsed
-		changed = true
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	return changed
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
 }
 
-// IAMPolicyTransformOneOfFields transforms discriminated union fields back to protobuf oneOf format
-func IAMPolicyTransformOneOfFields(m map[string]interface{}) {
-	IAMPolicyTransformOneOfFieldsRecursive(m)
+// ParseIAMPolicyTestIamPermissionsArgs builds the typed request of the TestIamPermissions tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseIAMPolicyTestIamPermissionsArgs(args map[string]interface{}, opts ...runtime.Option) (*iampb.TestIamPermissionsRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return parseIAMPolicyTestIamPermissionsArgs(args, config)
 }
 
-// IAMPolicyTransformOneOfFieldsRecursive recursively transforms oneOf fields in nested objects
-func IAMPolicyTransformOneOfFieldsRecursive(obj interface{}) {
-	switch v := obj.(type) {
-	case map[string]interface{}:
-		// Transform oneOf fields in this object
-		for key, value := range v {
-			// Check if this looks like a oneOf discriminated union (must have OneOfType postfix)
-			if strings.HasSuffix(key, "OneOfType") {
-				if unionObj, ok := value.(map[string]interface{}); ok {
-					if typeField, hasType := unionObj["object_type"]; hasType {
-						if typeStr, ok := typeField.(string); ok {
-							// First try to extract the field that matches the object_type
-							// (for message types with $ref)
-							if fieldValue, hasField := unionObj[typeStr]; hasField {
-								// Move the field value directly to the parent level
-								v[typeStr] = fieldValue
-								delete(v, key)
-							} else {
-								// Fall back to old logic: create object without object_type field
-								// (for primitive types or inline objects)
-								variantObj := make(map[string]interface{})
-								for k, val := range unionObj {
-									if k != "object_type" {
-										variantObj[k] = val
-									}
-								}
-								// Replace the union object with the variant object
-								v[typeStr] = variantObj
-								delete(v, key)
-							}
-						}
-					}
-				}
-			}
-		}
+// parseIAMPolicyTestIamPermissionsArgs runs the arguments of the TestIamPermissions tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseIAMPolicyTestIamPermissionsArgs.
+func parseIAMPolicyTestIamPermissionsArgs(args map[string]interface{}, config *runtime.Config) (*iampb.TestIamPermissionsRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
 
-		// Recursively process all values
-		for _, value := range v {
-			IAMPolicyTransformOneOfFieldsRecursive(value)
-		}
-	case []interface{}:
-		// Process array elements
-		for _, item := range v {
-			IAMPolicyTransformOneOfFieldsRecursive(item)
-		}
+	var req iampb.TestIamPermissionsRequest
+
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(IAMPolicy_TestIamPermissionsTool, "google.iam.v1.IAMPolicy.TestIamPermissions", config.ToolSchemaOverrides).JSONSchema)
+
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
+
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(IAMPolicy_TestIamPermissionsFullMethod, IAMPolicy_TestIamPermissionsTool.Name, config.ToolNameOverrides)])
+
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
 	}
+
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, IAMPolicy_TestIamPermissionsZeroBasedPaginationPaths)
+
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
+
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
 }
 
 // ForwardToIAMPolicyClient registers a gRPC client, to forward MCP calls to it.
+// It panics if runtime.WithToolNameOverride yields invalid or conflicting tool
+// names, if runtime.WithStartupValidation finds an invalid tool schema, if
+// runtime.WithSessionScopedClient or runtime.WithMethodClients has clients of
+// another service, or if a runtime.WithOnRegister callback renames a tool.
 func ForwardToIAMPolicyClient(s *mcpserver.MCPServer, client IAMPolicyClient, opts ...runtime.Option) {
 	config := runtime.NewConfig()
 	for _, opt := range opts {
 		opt(config)
 	}
-	GetIamPolicyToolDef := IAMPolicy_GetIamPolicyTool
+
+	toolNames, err := runtime.ResolveToolNames(map[string]string{
+		"google.iam.v1.IAMPolicy.GetIamPolicy":       IAMPolicy_GetIamPolicyTool.Name,
+		"google.iam.v1.IAMPolicy.SetIamPolicy":       IAMPolicy_SetIamPolicyTool.Name,
+		"google.iam.v1.IAMPolicy.TestIamPermissions": IAMPolicy_TestIamPermissionsTool.Name,
+	}, config.ToolNameOverrides)
+	if err != nil {
+		panic(err)
+	}
+	if err := runtime.ValidateToolDescriptionTemplate(config.DescriptionTemplate); err != nil {
+		panic(err)
+	}
+
+	// Reject a runtime.WithSessionScopedClient resolver of another client type
+	if err := runtime.CheckClientResolver[IAMPolicyClient](config.ClientResolverType); err != nil {
+		panic(err)
+	}
+
+	// Reject runtime.WithMethodClients clients of another client type
+	if err := runtime.CheckMethodClients[IAMPolicyClient](config.MethodClients); err != nil {
+		panic(err)
+	}
+
+	// Shared by every tool of this registration under runtime.WithConcurrencyLimit
+	limiter := runtime.NewCallLimiter(config.ConcurrencyLimit, config.ConcurrencyFailFast)
+
+	// Shared by every tool of this registration under runtime.WithRateLimit
+	rateLimiter := runtime.NewRateLimiter(config.RateLimits, config.DefaultRateLimit, config.RateLimitPerSession)
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	GetIamPolicyToolDef := runtime.OverrideToolSchema(IAMPolicy_GetIamPolicyTool, "google.iam.v1.IAMPolicy.GetIamPolicy", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	GetIamPolicyTool := mcp.Tool{
-		Name:           GetIamPolicyToolDef.Name,
-		Description:    GetIamPolicyToolDef.Description,
+		Name:           toolNames["google.iam.v1.IAMPolicy.GetIamPolicy"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "google.iam.v1.IAMPolicy.GetIamPolicy", GetIamPolicyToolDef.Description),
 		RawInputSchema: json.RawMessage(GetIamPolicyToolDef.JSONSchema),
 	}
 
@@ -210,19 +330,32 @@ func ForwardToIAMPolicyClient(s *mcpserver.MCPServer, client IAMPolicyClient, op
 		GetIamPolicyTool = runtime.AddExtraPropertiesToTool(GetIamPolicyTool, config.ExtraProperties)
 	}
 
-	s.AddTool(GetIamPolicyTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req iampb.GetIamPolicyRequest
+	// Offer the per-call "__format" override of the response format
+	GetIamPolicyTool = runtime.AddFormatArgumentToTool(GetIamPolicyTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	GetIamPolicyTool, err = runtime.AddInputDefaultsToTool(GetIamPolicyTool, (&iampb.GetIamPolicyRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[GetIamPolicyTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = IAMPolicyNormalizeTopLevelJSONStrings(message, GetIamPolicyToolDef.JSONSchema)
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&GetIamPolicyTool, config.OnRegister)
 
-		// Transform oneOf discriminated unions back to protobuf format
-		IAMPolicyTransformOneOfFields(message)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(GetIamPolicyTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, IAMPolicy_GetIamPolicyZeroBasedPaginationPaths)
+	GetIamPolicyHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
+
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -231,27 +364,79 @@ func ForwardToIAMPolicyClient(s *mcpserver.MCPServer, client IAMPolicyClient, op
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseIAMPolicyGetIamPolicyArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "google.iam.v1.IAMPolicy.GetIamPolicy", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(IAMPolicy_GetIamPolicyFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, GetIamPolicyToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.GetIamPolicy(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, GetIamPolicyToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.GetIamPolicy(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["google.iam.v1.IAMPolicy.GetIamPolicy"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("google.iam.v1.IAMPolicy.GetIamPolicy", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -259,13 +444,28 @@ func ForwardToIAMPolicyClient(s *mcpserver.MCPServer, client IAMPolicyClient, op
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	GetIamPolicyHandler = runtime.RecoverPanics(GetIamPolicyHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	GetIamPolicyHandler = runtime.RecordMetrics(GetIamPolicyHandler, "google.iam.v1.IAMPolicy.GetIamPolicy", config.Metrics)
+
+	s.AddTool(GetIamPolicyTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, GetIamPolicyTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return GetIamPolicyHandler(ctx, request.GetArguments())
 	})
-	SetIamPolicyToolDef := IAMPolicy_SetIamPolicyTool
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	SetIamPolicyToolDef := runtime.OverrideToolSchema(IAMPolicy_SetIamPolicyTool, "google.iam.v1.IAMPolicy.SetIamPolicy", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	SetIamPolicyTool := mcp.Tool{
-		Name:           SetIamPolicyToolDef.Name,
-		Description:    SetIamPolicyToolDef.Description,
+		Name:           toolNames["google.iam.v1.IAMPolicy.SetIamPolicy"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "google.iam.v1.IAMPolicy.SetIamPolicy", SetIamPolicyToolDef.Description),
 		RawInputSchema: json.RawMessage(SetIamPolicyToolDef.JSONSchema),
 	}
 
@@ -274,19 +474,32 @@ func ForwardToIAMPolicyClient(s *mcpserver.MCPServer, client IAMPolicyClient, op
 		SetIamPolicyTool = runtime.AddExtraPropertiesToTool(SetIamPolicyTool, config.ExtraProperties)
 	}
 
-	s.AddTool(SetIamPolicyTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req iampb.SetIamPolicyRequest
+	// Offer the per-call "__format" override of the response format
+	SetIamPolicyTool = runtime.AddFormatArgumentToTool(SetIamPolicyTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	SetIamPolicyTool, err = runtime.AddInputDefaultsToTool(SetIamPolicyTool, (&iampb.SetIamPolicyRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[SetIamPolicyTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = IAMPolicyNormalizeTopLevelJSONStrings(message, SetIamPolicyToolDef.JSONSchema)
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&SetIamPolicyTool, config.OnRegister)
 
-		// Transform oneOf discriminated unions back to protobuf format
-		IAMPolicyTransformOneOfFields(message)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(SetIamPolicyTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, IAMPolicy_SetIamPolicyZeroBasedPaginationPaths)
+	SetIamPolicyHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
+
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -295,27 +508,79 @@ func ForwardToIAMPolicyClient(s *mcpserver.MCPServer, client IAMPolicyClient, op
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseIAMPolicySetIamPolicyArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "google.iam.v1.IAMPolicy.SetIamPolicy", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(IAMPolicy_SetIamPolicyFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, SetIamPolicyToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.SetIamPolicy(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, SetIamPolicyToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.SetIamPolicy(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["google.iam.v1.IAMPolicy.SetIamPolicy"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("google.iam.v1.IAMPolicy.SetIamPolicy", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -323,13 +588,28 @@ func ForwardToIAMPolicyClient(s *mcpserver.MCPServer, client IAMPolicyClient, op
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	SetIamPolicyHandler = runtime.RecoverPanics(SetIamPolicyHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	SetIamPolicyHandler = runtime.RecordMetrics(SetIamPolicyHandler, "google.iam.v1.IAMPolicy.SetIamPolicy", config.Metrics)
+
+	s.AddTool(SetIamPolicyTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, SetIamPolicyTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return SetIamPolicyHandler(ctx, request.GetArguments())
 	})
-	TestIamPermissionsToolDef := IAMPolicy_TestIamPermissionsTool
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	TestIamPermissionsToolDef := runtime.OverrideToolSchema(IAMPolicy_TestIamPermissionsTool, "google.iam.v1.IAMPolicy.TestIamPermissions", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	TestIamPermissionsTool := mcp.Tool{
-		Name:           TestIamPermissionsToolDef.Name,
-		Description:    TestIamPermissionsToolDef.Description,
+		Name:           toolNames["google.iam.v1.IAMPolicy.TestIamPermissions"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "google.iam.v1.IAMPolicy.TestIamPermissions", TestIamPermissionsToolDef.Description),
 		RawInputSchema: json.RawMessage(TestIamPermissionsToolDef.JSONSchema),
 	}
 
@@ -338,19 +618,32 @@ func ForwardToIAMPolicyClient(s *mcpserver.MCPServer, client IAMPolicyClient, op
 		TestIamPermissionsTool = runtime.AddExtraPropertiesToTool(TestIamPermissionsTool, config.ExtraProperties)
 	}
 
-	s.AddTool(TestIamPermissionsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req iampb.TestIamPermissionsRequest
+	// Offer the per-call "__format" override of the response format
+	TestIamPermissionsTool = runtime.AddFormatArgumentToTool(TestIamPermissionsTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	TestIamPermissionsTool, err = runtime.AddInputDefaultsToTool(TestIamPermissionsTool, (&iampb.TestIamPermissionsRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[TestIamPermissionsTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = IAMPolicyNormalizeTopLevelJSONStrings(message, TestIamPermissionsToolDef.JSONSchema)
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&TestIamPermissionsTool, config.OnRegister)
 
-		// Transform oneOf discriminated unions back to protobuf format
-		IAMPolicyTransformOneOfFields(message)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(TestIamPermissionsTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, IAMPolicy_TestIamPermissionsZeroBasedPaginationPaths)
+	TestIamPermissionsHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
+
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -359,27 +652,79 @@ func ForwardToIAMPolicyClient(s *mcpserver.MCPServer, client IAMPolicyClient, op
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseIAMPolicyTestIamPermissionsArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "google.iam.v1.IAMPolicy.TestIamPermissions", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(IAMPolicy_TestIamPermissionsFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, TestIamPermissionsToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.TestIamPermissions(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, TestIamPermissionsToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.TestIamPermissions(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["google.iam.v1.IAMPolicy.TestIamPermissions"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("google.iam.v1.IAMPolicy.TestIamPermissions", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -387,5 +732,52 @@ func ForwardToIAMPolicyClient(s *mcpserver.MCPServer, client IAMPolicyClient, op
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	TestIamPermissionsHandler = runtime.RecoverPanics(TestIamPermissionsHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	TestIamPermissionsHandler = runtime.RecordMetrics(TestIamPermissionsHandler, "google.iam.v1.IAMPolicy.TestIamPermissions", config.Metrics)
+
+	s.AddTool(TestIamPermissionsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, TestIamPermissionsTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return TestIamPermissionsHandler(ctx, request.GetArguments())
 	})
 }
+
+// IAMPolicyInProcessServer is the server side of IAMPolicy. Every grpc-go
+// IAMPolicyServer implementation satisfies it.
+type IAMPolicyInProcessServer interface {
+	GetIamPolicy(ctx context.Context, req *iampb.GetIamPolicyRequest) (*iampb.Policy, error)
+	SetIamPolicy(ctx context.Context, req *iampb.SetIamPolicyRequest) (*iampb.Policy, error)
+	TestIamPermissions(ctx context.Context, req *iampb.TestIamPermissionsRequest) (*iampb.TestIamPermissionsResponse, error)
+}
+
+// inProcessIAMPolicyClient implements IAMPolicyClient by calling a
+// IAMPolicyInProcessServer directly. Call options have no effect.
+type inProcessIAMPolicyClient struct {
+	impl IAMPolicyInProcessServer
+}
+
+func (c inProcessIAMPolicyClient) GetIamPolicy(ctx context.Context, req *iampb.GetIamPolicyRequest, _ ...grpc.CallOption) (*iampb.Policy, error) {
+	return c.impl.GetIamPolicy(ctx, req)
+}
+
+func (c inProcessIAMPolicyClient) SetIamPolicy(ctx context.Context, req *iampb.SetIamPolicyRequest, _ ...grpc.CallOption) (*iampb.Policy, error) {
+	return c.impl.SetIamPolicy(ctx, req)
+}
+
+func (c inProcessIAMPolicyClient) TestIamPermissions(ctx context.Context, req *iampb.TestIamPermissionsRequest, _ ...grpc.CallOption) (*iampb.TestIamPermissionsResponse, error) {
+	return c.impl.TestIamPermissions(ctx, req)
+}
+
+// RegisterInProcessIAMPolicyServer registers impl so that MCP calls reach it
+// in-process, through the same pipeline as ForwardToIAMPolicyClient but
+// without a gRPC connection. gRPC interceptors do not run.
+func RegisterInProcessIAMPolicyServer(s *mcpserver.MCPServer, impl IAMPolicyInProcessServer, opts ...runtime.Option) {
+	ForwardToIAMPolicyClient(s, inProcessIAMPolicyClient{impl: impl}, opts...)
+}
@@ -5,10 +5,6 @@ package longrunningpbmcp
 
 import (
 	longrunningpb "cloud.google.com/go/longrunning/autogen/longrunningpb"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
-)
-
-import (
 	"context"
 	"encoding/json"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -16,7 +12,23 @@ import (
 	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
-	"strings"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Tool names as generated, and the fully-qualified method names that
+// runtime.WithToolNameOverride keys on, for references that must not drift
+// from the proto. Overrides apply at registration and are not reflected here.
+const (
+	Operations_CancelOperationToolName   = "google_longrunning_Operations_CancelOperation"
+	Operations_CancelOperationFullMethod = "google.longrunning.Operations.CancelOperation"
+	Operations_DeleteOperationToolName   = "google_longrunning_Operations_DeleteOperation"
+	Operations_DeleteOperationFullMethod = "google.longrunning.Operations.DeleteOperation"
+	Operations_GetOperationToolName      = "google_longrunning_Operations_GetOperation"
+	Operations_GetOperationFullMethod    = "google.longrunning.Operations.GetOperation"
+	Operations_ListOperationsToolName    = "google_longrunning_Operations_ListOperations"
+	Operations_ListOperationsFullMethod  = "google.longrunning.Operations.ListOperations"
+	Operations_WaitOperationToolName     = "google_longrunning_Operations_WaitOperation"
+	Operations_WaitOperationFullMethod   = "google.longrunning.Operations.WaitOperation"
 )
 
 var (
@@ -44,171 +56,431 @@ type OperationsClient interface {
 	WaitOperation(ctx context.Context, req *longrunningpb.WaitOperationRequest, opts ...grpc.CallOption) (*longrunningpb.Operation, error)
 }
 
-// OperationsNormalizeTopLevelJSONStrings scans m's top level and checks if any fields
-// that should be objects according to the JSON schema are actually strings. If so, it will
-// parse string values that look like JSON and replace them with the parsed value.
-// This handles both OneOf fields and regular object fields.
-func OperationsNormalizeTopLevelJSONStrings(
-	m map[string]interface{},
-	toolSchema string,
-) (changed bool) {
-	if m == nil || toolSchema == "" {
-		return false
+// OperationsNormalizeTopLevelJSONStrings parses top-level string values of m that
+// should be objects according to the JSON schema. See runtime.NormalizeTopLevelJSONStrings.
+func OperationsNormalizeTopLevelJSONStrings(m map[string]interface{}, toolSchema string) (changed bool) {
+	return runtime.NormalizeTopLevelJSONStrings(m, toolSchema)
+}
+
+// OperationsTransformOneOfFields transforms discriminated union fields back to protobuf
+// oneOf format. See runtime.TransformOneOfFields. It knows no custom
+// (mcp.options.oneof_discriminator) values; TransformOperations<Method>OneOfFields does.
+func OperationsTransformOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
+
+// TransformOperationsCancelOperationOneOfFields transforms the discriminated union fields
+// of the arguments of the CancelOperation tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformOperationsCancelOperationOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
+
+// TransformOperationsDeleteOperationOneOfFields transforms the discriminated union fields
+// of the arguments of the DeleteOperation tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformOperationsDeleteOperationOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
+
+// TransformOperationsGetOperationOneOfFields transforms the discriminated union fields
+// of the arguments of the GetOperation tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformOperationsGetOperationOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
+
+// TransformOperationsListOperationsOneOfFields transforms the discriminated union fields
+// of the arguments of the ListOperations tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformOperationsListOperationsOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
+
+// TransformOperationsWaitOperationOneOfFields transforms the discriminated union fields
+// of the arguments of the WaitOperation tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformOperationsWaitOperationOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
+
+// ParseOperationsCancelOperationArgs builds the typed request of the CancelOperation tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseOperationsCancelOperationArgs(args map[string]interface{}, opts ...runtime.Option) (*longrunningpb.CancelOperationRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
 	}
+	return parseOperationsCancelOperationArgs(args, config)
+}
 
-	// Parse the tool schema
-	var schema map[string]interface{}
-	if err := json.Unmarshal([]byte(toolSchema), &schema); err != nil {
-		return false
+// parseOperationsCancelOperationArgs runs the arguments of the CancelOperation tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseOperationsCancelOperationArgs.
+func parseOperationsCancelOperationArgs(args map[string]interface{}, config *runtime.Config) (*longrunningpb.CancelOperationRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
 	}
 
-	// Extract properties from the schema
-	properties, ok := schema["properties"].(map[string]interface{})
-	if !ok {
-		return false
+	var req longrunningpb.CancelOperationRequest
+
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(Operations_CancelOperationTool, "google.longrunning.Operations.CancelOperation", config.ToolSchemaOverrides).JSONSchema)
+
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
 	}
 
-	// Helper function to check if a schema defines an object type
-	isObjectSchema := func(propSchema map[string]interface{}) bool {
-		// Check if type is "object"
-		if typeVal, ok := propSchema["type"]; ok {
-			if typeStr, ok := typeVal.(string); ok && typeStr == "object" {
-				return true
-			}
-			// Could also be an array of types
-			if typeArr, ok := typeVal.([]interface{}); ok {
-				for _, t := range typeArr {
-					if tStr, ok := t.(string); ok && tStr == "object" {
-						return true
-					}
-				}
-			}
-		}
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		// Check if it has properties (inline object)
-		if _, hasProps := propSchema["properties"]; hasProps {
-			return true
-		}
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(Operations_CancelOperationFullMethod, Operations_CancelOperationTool.Name, config.ToolNameOverrides)])
 
-		// Check if it has a $ref (reference to object)
-		if _, hasRef := propSchema["$ref"]; hasRef {
-			return true
-		}
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
 
-		// Check if it has oneOf (discriminated union - treated as object)
-		if _, hasOneOf := propSchema["oneOf"]; hasOneOf {
-			return true
-		}
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, Operations_CancelOperationZeroBasedPaginationPaths)
 
-		return false
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
 	}
 
-	// Iterate through all top-level fields in the payload
-	for k, v := range m {
-		// Get the schema for this field
-		propSchema, ok := properties[k]
-		if !ok {
-			continue
-		}
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
+}
 
-		propSchemaMap, ok := propSchema.(map[string]interface{})
-		if !ok {
-			continue
-		}
+// ParseOperationsDeleteOperationArgs builds the typed request of the DeleteOperation tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseOperationsDeleteOperationArgs(args map[string]interface{}, opts ...runtime.Option) (*longrunningpb.DeleteOperationRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return parseOperationsDeleteOperationArgs(args, config)
+}
 
-		// Check if this field should be an object according to the schema
-		if !isObjectSchema(propSchemaMap) {
-			continue
-		}
+// parseOperationsDeleteOperationArgs runs the arguments of the DeleteOperation tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseOperationsDeleteOperationArgs.
+func parseOperationsDeleteOperationArgs(args map[string]interface{}, config *runtime.Config) (*longrunningpb.DeleteOperationRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
 
-		// Check if the actual value is a string
-		s, ok := v.(string)
-		if !ok {
-			continue
-		}
+	var req longrunningpb.DeleteOperationRequest
 
-		// Try to parse it as JSON
-		trim := strings.TrimSpace(s)
-		if trim == "" || !(strings.HasPrefix(trim, "{") || strings.HasPrefix(trim, "[")) {
-			continue
-		}
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(Operations_DeleteOperationTool, "google.longrunning.Operations.DeleteOperation", config.ToolSchemaOverrides).JSONSchema)
 
-		var parsed any
-		if err := json.Unmarshal([]byte(trim), &parsed); err != nil {
-			continue // ignore if it's not valid JSON
-		}
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
+
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(Operations_DeleteOperationFullMethod, Operations_DeleteOperationTool.Name, config.ToolNameOverrides)])
+
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
+
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, Operations_DeleteOperationZeroBasedPaginationPaths)
 
-		m[k] = parsed
-		changed = true
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
 	}
-	return changed
+
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
 }
 
-// OperationsTransformOneOfFields transforms discriminated union fields back to protobuf oneOf format
-func OperationsTransformOneOfFields(m map[string]interface{}) {
-	OperationsTransformOneOfFieldsRecursive(m)
+// ParseOperationsGetOperationArgs builds the typed request of the GetOperation tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseOperationsGetOperationArgs(args map[string]interface{}, opts ...runtime.Option) (*longrunningpb.GetOperationRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return parseOperationsGetOperationArgs(args, config)
 }
 
-// OperationsTransformOneOfFieldsRecursive recursively transforms oneOf fields in nested objects
-func OperationsTransformOneOfFieldsRecursive(obj interface{}) {
-	switch v := obj.(type) {
-	case map[string]interface{}:
-		// Transform oneOf fields in this object
-		for key, value := range v {
-			// Check if this looks like a oneOf discriminated union (must have OneOfType postfix)
-			if strings.HasSuffix(key, "OneOfType") {
-				if unionObj, ok := value.(map[string]interface{}); ok {
-					if typeField, hasType := unionObj["object_type"]; hasType {
-						if typeStr, ok := typeField.(string); ok {
-							// First try to extract the field that matches the object_type
-							// (for message types with $ref)
-							if fieldValue, hasField := unionObj[typeStr]; hasField {
-								// Move the field value directly to the parent level
-								v[typeStr] = fieldValue
-								delete(v, key)
-							} else {
-								// Fall back to old logic: create object without object_type field
-								// (for primitive types or inline objects)
-								variantObj := make(map[string]interface{})
-								for k, val := range unionObj {
-									if k != "object_type" {
-										variantObj[k] = val
-									}
-								}
-								// Replace the union object with the variant object
-								v[typeStr] = variantObj
-								delete(v, key)
-							}
-						}
-					}
-				}
-			}
-		}
+// parseOperationsGetOperationArgs runs the arguments of the GetOperation tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseOperationsGetOperationArgs.
+func parseOperationsGetOperationArgs(args map[string]interface{}, config *runtime.Config) (*longrunningpb.GetOperationRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
 
-		// Recursively process all values
-		for _, value := range v {
-			OperationsTransformOneOfFieldsRecursive(value)
-		}
-	case []interface{}:
-		// Process array elements
-		for _, item := range v {
-			OperationsTransformOneOfFieldsRecursive(item)
-		}
+	var req longrunningpb.GetOperationRequest
+
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(Operations_GetOperationTool, "google.longrunning.Operations.GetOperation", config.ToolSchemaOverrides).JSONSchema)
+
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
+
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(Operations_GetOperationFullMethod, Operations_GetOperationTool.Name, config.ToolNameOverrides)])
+
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
+
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, Operations_GetOperationZeroBasedPaginationPaths)
+
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
+
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
+}
+
+// ParseOperationsListOperationsArgs builds the typed request of the ListOperations tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseOperationsListOperationsArgs(args map[string]interface{}, opts ...runtime.Option) (*longrunningpb.ListOperationsRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return parseOperationsListOperationsArgs(args, config)
+}
+
+// parseOperationsListOperationsArgs runs the arguments of the ListOperations tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseOperationsListOperationsArgs.
+func parseOperationsListOperationsArgs(args map[string]interface{}, config *runtime.Config) (*longrunningpb.ListOperationsRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
+
+	var req longrunningpb.ListOperationsRequest
+
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(Operations_ListOperationsTool, "google.longrunning.Operations.ListOperations", config.ToolSchemaOverrides).JSONSchema)
+
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
+
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(Operations_ListOperationsFullMethod, Operations_ListOperationsTool.Name, config.ToolNameOverrides)])
+
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
+
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, Operations_ListOperationsZeroBasedPaginationPaths)
+
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
+
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
+}
+
+// ParseOperationsWaitOperationArgs builds the typed request of the WaitOperation tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseOperationsWaitOperationArgs(args map[string]interface{}, opts ...runtime.Option) (*longrunningpb.WaitOperationRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return parseOperationsWaitOperationArgs(args, config)
+}
+
+// parseOperationsWaitOperationArgs runs the arguments of the WaitOperation tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseOperationsWaitOperationArgs.
+func parseOperationsWaitOperationArgs(args map[string]interface{}, config *runtime.Config) (*longrunningpb.WaitOperationRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
+
+	var req longrunningpb.WaitOperationRequest
+
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(Operations_WaitOperationTool, "google.longrunning.Operations.WaitOperation", config.ToolSchemaOverrides).JSONSchema)
+
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
+
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(Operations_WaitOperationFullMethod, Operations_WaitOperationTool.Name, config.ToolNameOverrides)])
+
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
+
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, Operations_WaitOperationZeroBasedPaginationPaths)
+
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
+
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
 }
 
 // ForwardToOperationsClient registers a gRPC client, to forward MCP calls to it.
+// It panics if runtime.WithToolNameOverride yields invalid or conflicting tool
+// names, if runtime.WithStartupValidation finds an invalid tool schema, if
+// runtime.WithSessionScopedClient or runtime.WithMethodClients has clients of
+// another service, or if a runtime.WithOnRegister callback renames a tool.
 func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient, opts ...runtime.Option) {
 	config := runtime.NewConfig()
 	for _, opt := range opts {
 		opt(config)
 	}
-	CancelOperationToolDef := Operations_CancelOperationTool
+
+	toolNames, err := runtime.ResolveToolNames(map[string]string{
+		"google.longrunning.Operations.CancelOperation": Operations_CancelOperationTool.Name,
+		"google.longrunning.Operations.DeleteOperation": Operations_DeleteOperationTool.Name,
+		"google.longrunning.Operations.GetOperation":    Operations_GetOperationTool.Name,
+		"google.longrunning.Operations.ListOperations":  Operations_ListOperationsTool.Name,
+		"google.longrunning.Operations.WaitOperation":   Operations_WaitOperationTool.Name,
+	}, config.ToolNameOverrides)
+	if err != nil {
+		panic(err)
+	}
+	if err := runtime.ValidateToolDescriptionTemplate(config.DescriptionTemplate); err != nil {
+		panic(err)
+	}
+
+	// Reject a runtime.WithSessionScopedClient resolver of another client type
+	if err := runtime.CheckClientResolver[OperationsClient](config.ClientResolverType); err != nil {
+		panic(err)
+	}
+
+	// Reject runtime.WithMethodClients clients of another client type
+	if err := runtime.CheckMethodClients[OperationsClient](config.MethodClients); err != nil {
+		panic(err)
+	}
+
+	// Shared by every tool of this registration under runtime.WithConcurrencyLimit
+	limiter := runtime.NewCallLimiter(config.ConcurrencyLimit, config.ConcurrencyFailFast)
+
+	// Shared by every tool of this registration under runtime.WithRateLimit
+	rateLimiter := runtime.NewRateLimiter(config.RateLimits, config.DefaultRateLimit, config.RateLimitPerSession)
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	CancelOperationToolDef := runtime.OverrideToolSchema(Operations_CancelOperationTool, "google.longrunning.Operations.CancelOperation", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	CancelOperationTool := mcp.Tool{
-		Name:           CancelOperationToolDef.Name,
-		Description:    CancelOperationToolDef.Description,
+		Name:           toolNames["google.longrunning.Operations.CancelOperation"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "google.longrunning.Operations.CancelOperation", CancelOperationToolDef.Description),
 		RawInputSchema: json.RawMessage(CancelOperationToolDef.JSONSchema),
 	}
 
@@ -217,19 +489,32 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		CancelOperationTool = runtime.AddExtraPropertiesToTool(CancelOperationTool, config.ExtraProperties)
 	}
 
-	s.AddTool(CancelOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req longrunningpb.CancelOperationRequest
+	// Offer the per-call "__format" override of the response format
+	CancelOperationTool = runtime.AddFormatArgumentToTool(CancelOperationTool)
+
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	CancelOperationTool, err = runtime.AddInputDefaultsToTool(CancelOperationTool, (&longrunningpb.CancelOperationRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[CancelOperationTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		message := request.GetArguments()
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&CancelOperationTool, config.OnRegister)
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = OperationsNormalizeTopLevelJSONStrings(message, CancelOperationToolDef.JSONSchema)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(CancelOperationTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Transform oneOf discriminated unions back to protobuf format
-		OperationsTransformOneOfFields(message)
+	CancelOperationHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, Operations_CancelOperationZeroBasedPaginationPaths)
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -238,27 +523,79 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseOperationsCancelOperationArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "google.longrunning.Operations.CancelOperation", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(Operations_CancelOperationFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, CancelOperationToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.CancelOperation(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, CancelOperationToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.CancelOperation(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["google.longrunning.Operations.CancelOperation"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("google.longrunning.Operations.CancelOperation", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -266,13 +603,28 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	CancelOperationHandler = runtime.RecoverPanics(CancelOperationHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	CancelOperationHandler = runtime.RecordMetrics(CancelOperationHandler, "google.longrunning.Operations.CancelOperation", config.Metrics)
+
+	s.AddTool(CancelOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, CancelOperationTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return CancelOperationHandler(ctx, request.GetArguments())
 	})
-	DeleteOperationToolDef := Operations_DeleteOperationTool
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	DeleteOperationToolDef := runtime.OverrideToolSchema(Operations_DeleteOperationTool, "google.longrunning.Operations.DeleteOperation", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	DeleteOperationTool := mcp.Tool{
-		Name:           DeleteOperationToolDef.Name,
-		Description:    DeleteOperationToolDef.Description,
+		Name:           toolNames["google.longrunning.Operations.DeleteOperation"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "google.longrunning.Operations.DeleteOperation", DeleteOperationToolDef.Description),
 		RawInputSchema: json.RawMessage(DeleteOperationToolDef.JSONSchema),
 	}
 
@@ -281,19 +633,32 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		DeleteOperationTool = runtime.AddExtraPropertiesToTool(DeleteOperationTool, config.ExtraProperties)
 	}
 
-	s.AddTool(DeleteOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req longrunningpb.DeleteOperationRequest
+	// Offer the per-call "__format" override of the response format
+	DeleteOperationTool = runtime.AddFormatArgumentToTool(DeleteOperationTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	DeleteOperationTool, err = runtime.AddInputDefaultsToTool(DeleteOperationTool, (&longrunningpb.DeleteOperationRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[DeleteOperationTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = OperationsNormalizeTopLevelJSONStrings(message, DeleteOperationToolDef.JSONSchema)
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&DeleteOperationTool, config.OnRegister)
 
-		// Transform oneOf discriminated unions back to protobuf format
-		OperationsTransformOneOfFields(message)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(DeleteOperationTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
+
+	DeleteOperationHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, Operations_DeleteOperationZeroBasedPaginationPaths)
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -302,27 +667,79 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseOperationsDeleteOperationArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "google.longrunning.Operations.DeleteOperation", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(Operations_DeleteOperationFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, DeleteOperationToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.DeleteOperation(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, DeleteOperationToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.DeleteOperation(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["google.longrunning.Operations.DeleteOperation"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("google.longrunning.Operations.DeleteOperation", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -330,13 +747,28 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	DeleteOperationHandler = runtime.RecoverPanics(DeleteOperationHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	DeleteOperationHandler = runtime.RecordMetrics(DeleteOperationHandler, "google.longrunning.Operations.DeleteOperation", config.Metrics)
+
+	s.AddTool(DeleteOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, DeleteOperationTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return DeleteOperationHandler(ctx, request.GetArguments())
 	})
-	GetOperationToolDef := Operations_GetOperationTool
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	GetOperationToolDef := runtime.OverrideToolSchema(Operations_GetOperationTool, "google.longrunning.Operations.GetOperation", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	GetOperationTool := mcp.Tool{
-		Name:           GetOperationToolDef.Name,
-		Description:    GetOperationToolDef.Description,
+		Name:           toolNames["google.longrunning.Operations.GetOperation"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "google.longrunning.Operations.GetOperation", GetOperationToolDef.Description),
 		RawInputSchema: json.RawMessage(GetOperationToolDef.JSONSchema),
 	}
 
@@ -345,19 +777,32 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		GetOperationTool = runtime.AddExtraPropertiesToTool(GetOperationTool, config.ExtraProperties)
 	}
 
-	s.AddTool(GetOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req longrunningpb.GetOperationRequest
+	// Offer the per-call "__format" override of the response format
+	GetOperationTool = runtime.AddFormatArgumentToTool(GetOperationTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	GetOperationTool, err = runtime.AddInputDefaultsToTool(GetOperationTool, (&longrunningpb.GetOperationRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[GetOperationTool.Name])
+	if err != nil {
+		panic(err)
+	}
+
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&GetOperationTool, config.OnRegister)
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = OperationsNormalizeTopLevelJSONStrings(message, GetOperationToolDef.JSONSchema)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(GetOperationTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Transform oneOf discriminated unions back to protobuf format
-		OperationsTransformOneOfFields(message)
+	GetOperationHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, Operations_GetOperationZeroBasedPaginationPaths)
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -366,27 +811,79 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseOperationsGetOperationArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "google.longrunning.Operations.GetOperation", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(Operations_GetOperationFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, GetOperationToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.GetOperation(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, GetOperationToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.GetOperation(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["google.longrunning.Operations.GetOperation"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("google.longrunning.Operations.GetOperation", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -394,13 +891,28 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	GetOperationHandler = runtime.RecoverPanics(GetOperationHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	GetOperationHandler = runtime.RecordMetrics(GetOperationHandler, "google.longrunning.Operations.GetOperation", config.Metrics)
+
+	s.AddTool(GetOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, GetOperationTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return GetOperationHandler(ctx, request.GetArguments())
 	})
-	ListOperationsToolDef := Operations_ListOperationsTool
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	ListOperationsToolDef := runtime.OverrideToolSchema(Operations_ListOperationsTool, "google.longrunning.Operations.ListOperations", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	ListOperationsTool := mcp.Tool{
-		Name:           ListOperationsToolDef.Name,
-		Description:    ListOperationsToolDef.Description,
+		Name:           toolNames["google.longrunning.Operations.ListOperations"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "google.longrunning.Operations.ListOperations", ListOperationsToolDef.Description),
 		RawInputSchema: json.RawMessage(ListOperationsToolDef.JSONSchema),
 	}
 
@@ -409,19 +921,32 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		ListOperationsTool = runtime.AddExtraPropertiesToTool(ListOperationsTool, config.ExtraProperties)
 	}
 
-	s.AddTool(ListOperationsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req longrunningpb.ListOperationsRequest
+	// Offer the per-call "__format" override of the response format
+	ListOperationsTool = runtime.AddFormatArgumentToTool(ListOperationsTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	ListOperationsTool, err = runtime.AddInputDefaultsToTool(ListOperationsTool, (&longrunningpb.ListOperationsRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[ListOperationsTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = OperationsNormalizeTopLevelJSONStrings(message, ListOperationsToolDef.JSONSchema)
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&ListOperationsTool, config.OnRegister)
 
-		// Transform oneOf discriminated unions back to protobuf format
-		OperationsTransformOneOfFields(message)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(ListOperationsTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, Operations_ListOperationsZeroBasedPaginationPaths)
+	ListOperationsHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
+
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -430,27 +955,79 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseOperationsListOperationsArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "google.longrunning.Operations.ListOperations", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(Operations_ListOperationsFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, ListOperationsToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.ListOperations(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, ListOperationsToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.ListOperations(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["google.longrunning.Operations.ListOperations"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("google.longrunning.Operations.ListOperations", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -458,13 +1035,28 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	ListOperationsHandler = runtime.RecoverPanics(ListOperationsHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	ListOperationsHandler = runtime.RecordMetrics(ListOperationsHandler, "google.longrunning.Operations.ListOperations", config.Metrics)
+
+	s.AddTool(ListOperationsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, ListOperationsTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return ListOperationsHandler(ctx, request.GetArguments())
 	})
-	WaitOperationToolDef := Operations_WaitOperationTool
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	WaitOperationToolDef := runtime.OverrideToolSchema(Operations_WaitOperationTool, "google.longrunning.Operations.WaitOperation", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	WaitOperationTool := mcp.Tool{
-		Name:           WaitOperationToolDef.Name,
-		Description:    WaitOperationToolDef.Description,
+		Name:           toolNames["google.longrunning.Operations.WaitOperation"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "google.longrunning.Operations.WaitOperation", WaitOperationToolDef.Description),
 		RawInputSchema: json.RawMessage(WaitOperationToolDef.JSONSchema),
 	}
 
@@ -473,19 +1065,32 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		WaitOperationTool = runtime.AddExtraPropertiesToTool(WaitOperationTool, config.ExtraProperties)
 	}
 
-	s.AddTool(WaitOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req longrunningpb.WaitOperationRequest
+	// Offer the per-call "__format" override of the response format
+	WaitOperationTool = runtime.AddFormatArgumentToTool(WaitOperationTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	WaitOperationTool, err = runtime.AddInputDefaultsToTool(WaitOperationTool, (&longrunningpb.WaitOperationRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[WaitOperationTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = OperationsNormalizeTopLevelJSONStrings(message, WaitOperationToolDef.JSONSchema)
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&WaitOperationTool, config.OnRegister)
 
-		// Transform oneOf discriminated unions back to protobuf format
-		OperationsTransformOneOfFields(message)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(WaitOperationTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
+
+	WaitOperationHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, Operations_WaitOperationZeroBasedPaginationPaths)
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -494,27 +1099,79 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseOperationsWaitOperationArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "google.longrunning.Operations.WaitOperation", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(Operations_WaitOperationFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, WaitOperationToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.WaitOperation(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, WaitOperationToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.WaitOperation(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["google.longrunning.Operations.WaitOperation"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("google.longrunning.Operations.WaitOperation", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -522,5 +1179,62 @@ func ForwardToOperationsClient(s *mcpserver.MCPServer, client OperationsClient,
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	WaitOperationHandler = runtime.RecoverPanics(WaitOperationHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	WaitOperationHandler = runtime.RecordMetrics(WaitOperationHandler, "google.longrunning.Operations.WaitOperation", config.Metrics)
+
+	s.AddTool(WaitOperationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, WaitOperationTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return WaitOperationHandler(ctx, request.GetArguments())
 	})
 }
+
+// OperationsInProcessServer is the server side of Operations. Every grpc-go
+// OperationsServer implementation satisfies it.
+type OperationsInProcessServer interface {
+	CancelOperation(ctx context.Context, req *longrunningpb.CancelOperationRequest) (*emptypb.Empty, error)
+	DeleteOperation(ctx context.Context, req *longrunningpb.DeleteOperationRequest) (*emptypb.Empty, error)
+	GetOperation(ctx context.Context, req *longrunningpb.GetOperationRequest) (*longrunningpb.Operation, error)
+	ListOperations(ctx context.Context, req *longrunningpb.ListOperationsRequest) (*longrunningpb.ListOperationsResponse, error)
+	WaitOperation(ctx context.Context, req *longrunningpb.WaitOperationRequest) (*longrunningpb.Operation, error)
+}
+
+// inProcessOperationsClient implements OperationsClient by calling a
+// OperationsInProcessServer directly. Call options have no effect.
+type inProcessOperationsClient struct {
+	impl OperationsInProcessServer
+}
+
+func (c inProcessOperationsClient) CancelOperation(ctx context.Context, req *longrunningpb.CancelOperationRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return c.impl.CancelOperation(ctx, req)
+}
+
+func (c inProcessOperationsClient) DeleteOperation(ctx context.Context, req *longrunningpb.DeleteOperationRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return c.impl.DeleteOperation(ctx, req)
+}
+
+func (c inProcessOperationsClient) GetOperation(ctx context.Context, req *longrunningpb.GetOperationRequest, _ ...grpc.CallOption) (*longrunningpb.Operation, error) {
+	return c.impl.GetOperation(ctx, req)
+}
+
+func (c inProcessOperationsClient) ListOperations(ctx context.Context, req *longrunningpb.ListOperationsRequest, _ ...grpc.CallOption) (*longrunningpb.ListOperationsResponse, error) {
+	return c.impl.ListOperations(ctx, req)
+}
+
+func (c inProcessOperationsClient) WaitOperation(ctx context.Context, req *longrunningpb.WaitOperationRequest, _ ...grpc.CallOption) (*longrunningpb.Operation, error) {
+	return c.impl.WaitOperation(ctx, req)
+}
+
+// RegisterInProcessOperationsServer registers impl so that MCP calls reach it
+// in-process, through the same pipeline as ForwardToOperationsClient but
+// without a gRPC connection. gRPC interceptors do not run.
+func RegisterInProcessOperationsServer(s *mcpserver.MCPServer, impl OperationsInProcessServer, opts ...runtime.Option) {
+	ForwardToOperationsClient(s, inProcessOperationsClient{impl: impl}, opts...)
+}
@@ -0,0 +1,290 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/allow_additional_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConfigurePluginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PluginId      string                 `protobuf:"bytes,1,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	Settings      *PluginSettings        `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	Owner         *PluginOwner           `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigurePluginRequest) Reset() {
+	*x = ConfigurePluginRequest{}
+	mi := &file_testdata_allow_additional_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigurePluginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurePluginRequest) ProtoMessage() {}
+
+func (x *ConfigurePluginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_allow_additional_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurePluginRequest.ProtoReflect.Descriptor instead.
+func (*ConfigurePluginRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_allow_additional_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfigurePluginRequest) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
+	}
+	return ""
+}
+
+func (x *ConfigurePluginRequest) GetSettings() *PluginSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *ConfigurePluginRequest) GetOwner() *PluginOwner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+// PluginSettings accepts settings beyond the declared ones.
+type PluginSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginSettings) Reset() {
+	*x = PluginSettings{}
+	mi := &file_testdata_allow_additional_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginSettings) ProtoMessage() {}
+
+func (x *PluginSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_allow_additional_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginSettings.ProtoReflect.Descriptor instead.
+func (*PluginSettings) Descriptor() ([]byte, []int) {
+	return file_testdata_allow_additional_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PluginSettings) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type PluginOwner struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          string                 `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginOwner) Reset() {
+	*x = PluginOwner{}
+	mi := &file_testdata_allow_additional_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginOwner) ProtoMessage() {}
+
+func (x *PluginOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_allow_additional_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginOwner.ProtoReflect.Descriptor instead.
+func (*PluginOwner) Descriptor() ([]byte, []int) {
+	return file_testdata_allow_additional_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PluginOwner) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+type ConfigurePluginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigurePluginResponse) Reset() {
+	*x = ConfigurePluginResponse{}
+	mi := &file_testdata_allow_additional_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigurePluginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurePluginResponse) ProtoMessage() {}
+
+func (x *ConfigurePluginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_allow_additional_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurePluginResponse.ProtoReflect.Descriptor instead.
+func (*ConfigurePluginResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_allow_additional_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ConfigurePluginResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+var File_testdata_allow_additional_test_proto protoreflect.FileDescriptor
+
+const file_testdata_allow_additional_test_proto_rawDesc = "" +
+	"\n" +
+	"$testdata/allow_additional_test.proto\x12\btestdata\x1a\x19mcp/options/options.proto\"\x98\x01\n" +
+	"\x16ConfigurePluginRequest\x12\x1b\n" +
+	"\tplugin_id\x18\x01 \x01(\tR\bpluginId\x124\n" +
+	"\bsettings\x18\x02 \x01(\v2\x18.testdata.PluginSettingsR\bsettings\x12+\n" +
+	"\x05owner\x18\x03 \x01(\v2\x15.testdata.PluginOwnerR\x05owner\"2\n" +
+	"\x0ePluginSettings\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled:\x06\xb2\xb6\x19\x02\b\x01\"!\n" +
+	"\vPluginOwner\x12\x12\n" +
+	"\x04team\x18\x01 \x01(\tR\x04team\"3\n" +
+	"\x17ConfigurePluginResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied2g\n" +
+	"\rPluginService\x12V\n" +
+	"\x0fConfigurePlugin\x12 .testdata.ConfigurePluginRequest\x1a!.testdata.ConfigurePluginResponseB\xb2\x01\n" +
+	"\fcom.testdataB\x18AllowAdditionalTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_allow_additional_test_proto_rawDescOnce sync.Once
+	file_testdata_allow_additional_test_proto_rawDescData []byte
+)
+
+func file_testdata_allow_additional_test_proto_rawDescGZIP() []byte {
+	file_testdata_allow_additional_test_proto_rawDescOnce.Do(func() {
+		file_testdata_allow_additional_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_allow_additional_test_proto_rawDesc), len(file_testdata_allow_additional_test_proto_rawDesc)))
+	})
+	return file_testdata_allow_additional_test_proto_rawDescData
+}
+
+var file_testdata_allow_additional_test_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_testdata_allow_additional_test_proto_goTypes = []any{
+	(*ConfigurePluginRequest)(nil),  // 0: testdata.ConfigurePluginRequest
+	(*PluginSettings)(nil),          // 1: testdata.PluginSettings
+	(*PluginOwner)(nil),             // 2: testdata.PluginOwner
+	(*ConfigurePluginResponse)(nil), // 3: testdata.ConfigurePluginResponse
+}
+var file_testdata_allow_additional_test_proto_depIdxs = []int32{
+	1, // 0: testdata.ConfigurePluginRequest.settings:type_name -> testdata.PluginSettings
+	2, // 1: testdata.ConfigurePluginRequest.owner:type_name -> testdata.PluginOwner
+	0, // 2: testdata.PluginService.ConfigurePlugin:input_type -> testdata.ConfigurePluginRequest
+	3, // 3: testdata.PluginService.ConfigurePlugin:output_type -> testdata.ConfigurePluginResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_testdata_allow_additional_test_proto_init() }
+func file_testdata_allow_additional_test_proto_init() {
+	if File_testdata_allow_additional_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_allow_additional_test_proto_rawDesc), len(file_testdata_allow_additional_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_allow_additional_test_proto_goTypes,
+		DependencyIndexes: file_testdata_allow_additional_test_proto_depIdxs,
+		MessageInfos:      file_testdata_allow_additional_test_proto_msgTypes,
+	}.Build()
+	File_testdata_allow_additional_test_proto = out.File
+	file_testdata_allow_additional_test_proto_goTypes = nil
+	file_testdata_allow_additional_test_proto_depIdxs = nil
+}
@@ -0,0 +1,339 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/batch_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LookupWidgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Options       *WidgetLookupOptions   `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupWidgetRequest) Reset() {
+	*x = LookupWidgetRequest{}
+	mi := &file_testdata_batch_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupWidgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupWidgetRequest) ProtoMessage() {}
+
+func (x *LookupWidgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_batch_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupWidgetRequest.ProtoReflect.Descriptor instead.
+func (*LookupWidgetRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_batch_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LookupWidgetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LookupWidgetRequest) GetOptions() *WidgetLookupOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type WidgetLookupOptions struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeDeleted bool                   `protobuf:"varint,1,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WidgetLookupOptions) Reset() {
+	*x = WidgetLookupOptions{}
+	mi := &file_testdata_batch_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WidgetLookupOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WidgetLookupOptions) ProtoMessage() {}
+
+func (x *WidgetLookupOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_batch_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WidgetLookupOptions.ProtoReflect.Descriptor instead.
+func (*WidgetLookupOptions) Descriptor() ([]byte, []int) {
+	return file_testdata_batch_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WidgetLookupOptions) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type LookupWidgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupWidgetResponse) Reset() {
+	*x = LookupWidgetResponse{}
+	mi := &file_testdata_batch_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupWidgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupWidgetResponse) ProtoMessage() {}
+
+func (x *LookupWidgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_batch_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupWidgetResponse.ProtoReflect.Descriptor instead.
+func (*LookupWidgetResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_batch_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LookupWidgetResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LookupWidgetResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameWidgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameWidgetRequest) Reset() {
+	*x = RenameWidgetRequest{}
+	mi := &file_testdata_batch_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameWidgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameWidgetRequest) ProtoMessage() {}
+
+func (x *RenameWidgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_batch_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameWidgetRequest.ProtoReflect.Descriptor instead.
+func (*RenameWidgetRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_batch_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RenameWidgetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenameWidgetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameWidgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameWidgetResponse) Reset() {
+	*x = RenameWidgetResponse{}
+	mi := &file_testdata_batch_test_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameWidgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameWidgetResponse) ProtoMessage() {}
+
+func (x *RenameWidgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_batch_test_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameWidgetResponse.ProtoReflect.Descriptor instead.
+func (*RenameWidgetResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_batch_test_proto_rawDescGZIP(), []int{4}
+}
+
+var File_testdata_batch_test_proto protoreflect.FileDescriptor
+
+const file_testdata_batch_test_proto_rawDesc = "" +
+	"\n" +
+	"\x19testdata/batch_test.proto\x12\btestdata\x1a\x19mcp/options/options.proto\"^\n" +
+	"\x13LookupWidgetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\aoptions\x18\x02 \x01(\v2\x1d.testdata.WidgetLookupOptionsR\aoptions\">\n" +
+	"\x13WidgetLookupOptions\x12'\n" +
+	"\x0finclude_deleted\x18\x01 \x01(\bR\x0eincludeDeleted\":\n" +
+	"\x14LookupWidgetResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"9\n" +
+	"\x13RenameWidgetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x16\n" +
+	"\x14RenameWidgetResponse2\xd5\x01\n" +
+	"\fBatchService\x12v\n" +
+	"\fLookupWidget\x12\x1d.testdata.LookupWidgetRequest\x1a\x1e.testdata.LookupWidgetResponse\"'\x92\xb5\x19#\n" +
+	"\rlookup_widget\x12\x0eLook up widget\x18\x01H\x01\x12M\n" +
+	"\fRenameWidget\x12\x1d.testdata.RenameWidgetRequest\x1a\x1e.testdata.RenameWidgetResponseB\xa8\x01\n" +
+	"\fcom.testdataB\x0eBatchTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_batch_test_proto_rawDescOnce sync.Once
+	file_testdata_batch_test_proto_rawDescData []byte
+)
+
+func file_testdata_batch_test_proto_rawDescGZIP() []byte {
+	file_testdata_batch_test_proto_rawDescOnce.Do(func() {
+		file_testdata_batch_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_batch_test_proto_rawDesc), len(file_testdata_batch_test_proto_rawDesc)))
+	})
+	return file_testdata_batch_test_proto_rawDescData
+}
+
+var file_testdata_batch_test_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_testdata_batch_test_proto_goTypes = []any{
+	(*LookupWidgetRequest)(nil),  // 0: testdata.LookupWidgetRequest
+	(*WidgetLookupOptions)(nil),  // 1: testdata.WidgetLookupOptions
+	(*LookupWidgetResponse)(nil), // 2: testdata.LookupWidgetResponse
+	(*RenameWidgetRequest)(nil),  // 3: testdata.RenameWidgetRequest
+	(*RenameWidgetResponse)(nil), // 4: testdata.RenameWidgetResponse
+}
+var file_testdata_batch_test_proto_depIdxs = []int32{
+	1, // 0: testdata.LookupWidgetRequest.options:type_name -> testdata.WidgetLookupOptions
+	0, // 1: testdata.BatchService.LookupWidget:input_type -> testdata.LookupWidgetRequest
+	3, // 2: testdata.BatchService.RenameWidget:input_type -> testdata.RenameWidgetRequest
+	2, // 3: testdata.BatchService.LookupWidget:output_type -> testdata.LookupWidgetResponse
+	4, // 4: testdata.BatchService.RenameWidget:output_type -> testdata.RenameWidgetResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_testdata_batch_test_proto_init() }
+func file_testdata_batch_test_proto_init() {
+	if File_testdata_batch_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_batch_test_proto_rawDesc), len(file_testdata_batch_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_batch_test_proto_goTypes,
+		DependencyIndexes: file_testdata_batch_test_proto_depIdxs,
+		MessageInfos:      file_testdata_batch_test_proto_msgTypes,
+	}.Build()
+	File_testdata_batch_test_proto = out.File
+	file_testdata_batch_test_proto_goTypes = nil
+	file_testdata_batch_test_proto_depIdxs = nil
+}
@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/batch_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BatchService_LookupWidget_FullMethodName = "/testdata.BatchService/LookupWidget"
+	BatchService_RenameWidget_FullMethodName = "/testdata.BatchService/RenameWidget"
+)
+
+// BatchServiceClient is the client API for BatchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BatchService exercises the (mcp.options.tool) batch option.
+type BatchServiceClient interface {
+	// Looks up a widget by id.
+	LookupWidget(ctx context.Context, in *LookupWidgetRequest, opts ...grpc.CallOption) (*LookupWidgetResponse, error)
+	// Not annotated with batch: no batch tool is generated.
+	RenameWidget(ctx context.Context, in *RenameWidgetRequest, opts ...grpc.CallOption) (*RenameWidgetResponse, error)
+}
+
+type batchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBatchServiceClient(cc grpc.ClientConnInterface) BatchServiceClient {
+	return &batchServiceClient{cc}
+}
+
+func (c *batchServiceClient) LookupWidget(ctx context.Context, in *LookupWidgetRequest, opts ...grpc.CallOption) (*LookupWidgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupWidgetResponse)
+	err := c.cc.Invoke(ctx, BatchService_LookupWidget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *batchServiceClient) RenameWidget(ctx context.Context, in *RenameWidgetRequest, opts ...grpc.CallOption) (*RenameWidgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameWidgetResponse)
+	err := c.cc.Invoke(ctx, BatchService_RenameWidget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BatchServiceServer is the server API for BatchService service.
+// All implementations must embed UnimplementedBatchServiceServer
+// for forward compatibility.
+//
+// BatchService exercises the (mcp.options.tool) batch option.
+type BatchServiceServer interface {
+	// Looks up a widget by id.
+	LookupWidget(context.Context, *LookupWidgetRequest) (*LookupWidgetResponse, error)
+	// Not annotated with batch: no batch tool is generated.
+	RenameWidget(context.Context, *RenameWidgetRequest) (*RenameWidgetResponse, error)
+	mustEmbedUnimplementedBatchServiceServer()
+}
+
+// UnimplementedBatchServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBatchServiceServer struct{}
+
+func (UnimplementedBatchServiceServer) LookupWidget(context.Context, *LookupWidgetRequest) (*LookupWidgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupWidget not implemented")
+}
+func (UnimplementedBatchServiceServer) RenameWidget(context.Context, *RenameWidgetRequest) (*RenameWidgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameWidget not implemented")
+}
+func (UnimplementedBatchServiceServer) mustEmbedUnimplementedBatchServiceServer() {}
+func (UnimplementedBatchServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeBatchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BatchServiceServer will
+// result in compilation errors.
+type UnsafeBatchServiceServer interface {
+	mustEmbedUnimplementedBatchServiceServer()
+}
+
+func RegisterBatchServiceServer(s grpc.ServiceRegistrar, srv BatchServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBatchServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BatchService_ServiceDesc, srv)
+}
+
+func _BatchService_LookupWidget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupWidgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BatchServiceServer).LookupWidget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BatchService_LookupWidget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BatchServiceServer).LookupWidget(ctx, req.(*LookupWidgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BatchService_RenameWidget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameWidgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BatchServiceServer).RenameWidget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BatchService_RenameWidget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BatchServiceServer).RenameWidget(ctx, req.(*RenameWidgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BatchService_ServiceDesc is the grpc.ServiceDesc for BatchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BatchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.BatchService",
+	HandlerType: (*BatchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LookupWidget",
+			Handler:    _BatchService_LookupWidget_Handler,
+		},
+		{
+			MethodName: "RenameWidget",
+			Handler:    _BatchService_RenameWidget_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/batch_test.proto",
+}
@@ -0,0 +1,140 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/catalog_proxy_test.proto
+
+package testdata
+
+import (
+	catalog "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata/catalog"
+	status "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DescribeSkuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           *catalog.Sku           `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeSkuRequest) Reset() {
+	*x = DescribeSkuRequest{}
+	mi := &file_testdata_catalog_proxy_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeSkuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeSkuRequest) ProtoMessage() {}
+
+func (x *DescribeSkuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_catalog_proxy_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeSkuRequest.ProtoReflect.Descriptor instead.
+func (*DescribeSkuRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_catalog_proxy_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DescribeSkuRequest) GetSku() *catalog.Sku {
+	if x != nil {
+		return x.Sku
+	}
+	return nil
+}
+
+var File_testdata_catalog_proxy_test_proto protoreflect.FileDescriptor
+
+const file_testdata_catalog_proxy_test_proto_rawDesc = "" +
+	"\n" +
+	"!testdata/catalog_proxy_test.proto\x12\btestdata\x1a\x1etestdata/catalog/catalog.proto\x1a\x1ctestdata/status/status.proto\"=\n" +
+	"\x12DescribeSkuRequest\x12'\n" +
+	"\x03sku\x18\x01 \x01(\v2\x15.testdata.catalog.SkuR\x03sku2\xff\x01\n" +
+	"\x13CatalogProxyService\x12T\n" +
+	"\tLookupSku\x12\".testdata.catalog.LookupSkuRequest\x1a#.testdata.catalog.LookupSkuResponse\x12B\n" +
+	"\vDescribeSku\x12\x1c.testdata.DescribeSkuRequest\x1a\x15.testdata.catalog.Sku\x12N\n" +
+	"\fGetSkuStatus\x12\".testdata.catalog.LookupSkuRequest\x1a\x1a.testdata.status.SkuStatusB\xaf\x01\n" +
+	"\fcom.testdataB\x15CatalogProxyTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_catalog_proxy_test_proto_rawDescOnce sync.Once
+	file_testdata_catalog_proxy_test_proto_rawDescData []byte
+)
+
+func file_testdata_catalog_proxy_test_proto_rawDescGZIP() []byte {
+	file_testdata_catalog_proxy_test_proto_rawDescOnce.Do(func() {
+		file_testdata_catalog_proxy_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_catalog_proxy_test_proto_rawDesc), len(file_testdata_catalog_proxy_test_proto_rawDesc)))
+	})
+	return file_testdata_catalog_proxy_test_proto_rawDescData
+}
+
+var file_testdata_catalog_proxy_test_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_testdata_catalog_proxy_test_proto_goTypes = []any{
+	(*DescribeSkuRequest)(nil),        // 0: testdata.DescribeSkuRequest
+	(*catalog.Sku)(nil),               // 1: testdata.catalog.Sku
+	(*catalog.LookupSkuRequest)(nil),  // 2: testdata.catalog.LookupSkuRequest
+	(*catalog.LookupSkuResponse)(nil), // 3: testdata.catalog.LookupSkuResponse
+	(*status.SkuStatus)(nil),          // 4: testdata.status.SkuStatus
+}
+var file_testdata_catalog_proxy_test_proto_depIdxs = []int32{
+	1, // 0: testdata.DescribeSkuRequest.sku:type_name -> testdata.catalog.Sku
+	2, // 1: testdata.CatalogProxyService.LookupSku:input_type -> testdata.catalog.LookupSkuRequest
+	0, // 2: testdata.CatalogProxyService.DescribeSku:input_type -> testdata.DescribeSkuRequest
+	2, // 3: testdata.CatalogProxyService.GetSkuStatus:input_type -> testdata.catalog.LookupSkuRequest
+	3, // 4: testdata.CatalogProxyService.LookupSku:output_type -> testdata.catalog.LookupSkuResponse
+	1, // 5: testdata.CatalogProxyService.DescribeSku:output_type -> testdata.catalog.Sku
+	4, // 6: testdata.CatalogProxyService.GetSkuStatus:output_type -> testdata.status.SkuStatus
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_testdata_catalog_proxy_test_proto_init() }
+func file_testdata_catalog_proxy_test_proto_init() {
+	if File_testdata_catalog_proxy_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_catalog_proxy_test_proto_rawDesc), len(file_testdata_catalog_proxy_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_catalog_proxy_test_proto_goTypes,
+		DependencyIndexes: file_testdata_catalog_proxy_test_proto_depIdxs,
+		MessageInfos:      file_testdata_catalog_proxy_test_proto_msgTypes,
+	}.Build()
+	File_testdata_catalog_proxy_test_proto = out.File
+	file_testdata_catalog_proxy_test_proto_goTypes = nil
+	file_testdata_catalog_proxy_test_proto_depIdxs = nil
+}
@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/custom_option_defs_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuditOptions is a sample custom option, declared apart from the files that
+// use it, for exercising extension discovery in the generator.
+type AuditOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Sensitive     bool                   `protobuf:"varint,2,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditOptions) Reset() {
+	*x = AuditOptions{}
+	mi := &file_testdata_custom_option_defs_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditOptions) ProtoMessage() {}
+
+func (x *AuditOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_custom_option_defs_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditOptions.ProtoReflect.Descriptor instead.
+func (*AuditOptions) Descriptor() ([]byte, []int) {
+	return file_testdata_custom_option_defs_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditOptions) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AuditOptions) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+var file_testdata_custom_option_defs_test_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*AuditOptions)(nil),
+		Field:         53000,
+		Name:          "testdata.audit",
+		Tag:           "bytes,53000,opt,name=audit",
+		Filename:      "testdata/custom_option_defs_test.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         53001,
+		Name:          "testdata.label",
+		Tag:           "bytes,53001,opt,name=label",
+		Filename:      "testdata/custom_option_defs_test.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional testdata.AuditOptions audit = 53000;
+	E_Audit = &file_testdata_custom_option_defs_test_proto_extTypes[0]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional string label = 53001;
+	E_Label = &file_testdata_custom_option_defs_test_proto_extTypes[1]
+)
+
+var File_testdata_custom_option_defs_test_proto protoreflect.FileDescriptor
+
+const file_testdata_custom_option_defs_test_proto_rawDesc = "" +
+	"\n" +
+	"&testdata/custom_option_defs_test.proto\x12\btestdata\x1a google/protobuf/descriptor.proto\"H\n" +
+	"\fAuditOptions\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1c\n" +
+	"\tsensitive\x18\x02 \x01(\bR\tsensitive:N\n" +
+	"\x05audit\x12\x1e.google.protobuf.MethodOptions\x18\x88\x9e\x03 \x01(\v2\x16.testdata.AuditOptionsR\x05audit:5\n" +
+	"\x05label\x12\x1d.google.protobuf.FieldOptions\x18\x89\x9e\x03 \x01(\tR\x05labelB\xb3\x01\n" +
+	"\fcom.testdataB\x19CustomOptionDefsTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_custom_option_defs_test_proto_rawDescOnce sync.Once
+	file_testdata_custom_option_defs_test_proto_rawDescData []byte
+)
+
+func file_testdata_custom_option_defs_test_proto_rawDescGZIP() []byte {
+	file_testdata_custom_option_defs_test_proto_rawDescOnce.Do(func() {
+		file_testdata_custom_option_defs_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_custom_option_defs_test_proto_rawDesc), len(file_testdata_custom_option_defs_test_proto_rawDesc)))
+	})
+	return file_testdata_custom_option_defs_test_proto_rawDescData
+}
+
+var file_testdata_custom_option_defs_test_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_testdata_custom_option_defs_test_proto_goTypes = []any{
+	(*AuditOptions)(nil),               // 0: testdata.AuditOptions
+	(*descriptorpb.MethodOptions)(nil), // 1: google.protobuf.MethodOptions
+	(*descriptorpb.FieldOptions)(nil),  // 2: google.protobuf.FieldOptions
+}
+var file_testdata_custom_option_defs_test_proto_depIdxs = []int32{
+	1, // 0: testdata.audit:extendee -> google.protobuf.MethodOptions
+	2, // 1: testdata.label:extendee -> google.protobuf.FieldOptions
+	0, // 2: testdata.audit:type_name -> testdata.AuditOptions
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	2, // [2:3] is the sub-list for extension type_name
+	0, // [0:2] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_testdata_custom_option_defs_test_proto_init() }
+func file_testdata_custom_option_defs_test_proto_init() {
+	if File_testdata_custom_option_defs_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_custom_option_defs_test_proto_rawDesc), len(file_testdata_custom_option_defs_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 2,
+			NumServices:   0,
+		},
+		GoTypes:           file_testdata_custom_option_defs_test_proto_goTypes,
+		DependencyIndexes: file_testdata_custom_option_defs_test_proto_depIdxs,
+		MessageInfos:      file_testdata_custom_option_defs_test_proto_msgTypes,
+		ExtensionInfos:    file_testdata_custom_option_defs_test_proto_extTypes,
+	}.Build()
+	File_testdata_custom_option_defs_test_proto = out.File
+	file_testdata_custom_option_defs_test_proto_goTypes = nil
+	file_testdata_custom_option_defs_test_proto_depIdxs = nil
+}
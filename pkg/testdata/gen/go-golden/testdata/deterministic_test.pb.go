@@ -0,0 +1,555 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/deterministic_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConfigureMode int32
+
+const (
+	ConfigureMode_CONFIGURE_MODE_UNSPECIFIED ConfigureMode = 0
+	ConfigureMode_CONFIGURE_MODE_APPEND      ConfigureMode = 1
+	ConfigureMode_CONFIGURE_MODE_REPLACE     ConfigureMode = 2
+)
+
+// Enum value maps for ConfigureMode.
+var (
+	ConfigureMode_name = map[int32]string{
+		0: "CONFIGURE_MODE_UNSPECIFIED",
+		1: "CONFIGURE_MODE_APPEND",
+		2: "CONFIGURE_MODE_REPLACE",
+	}
+	ConfigureMode_value = map[string]int32{
+		"CONFIGURE_MODE_UNSPECIFIED": 0,
+		"CONFIGURE_MODE_APPEND":      1,
+		"CONFIGURE_MODE_REPLACE":     2,
+	}
+)
+
+func (x ConfigureMode) Enum() *ConfigureMode {
+	p := new(ConfigureMode)
+	*p = x
+	return p
+}
+
+func (x ConfigureMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigureMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_testdata_deterministic_test_proto_enumTypes[0].Descriptor()
+}
+
+func (ConfigureMode) Type() protoreflect.EnumType {
+	return &file_testdata_deterministic_test_proto_enumTypes[0]
+}
+
+func (x ConfigureMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigureMode.Descriptor instead.
+func (ConfigureMode) EnumDescriptor() ([]byte, []int) {
+	return file_testdata_deterministic_test_proto_rawDescGZIP(), []int{0}
+}
+
+type ConfigureRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*ConfigureRequest_Url
+	//	*ConfigureRequest_Blob
+	Source isConfigureRequest_Source `protobuf_oneof:"source"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*ConfigureRequest_Bucket
+	//	*ConfigureRequest_Table
+	Target isConfigureRequest_Target `protobuf_oneof:"target"`
+	// Types that are valid to be assigned to Schedule:
+	//
+	//	*ConfigureRequest_Cron
+	//	*ConfigureRequest_IntervalSeconds
+	Schedule isConfigureRequest_Schedule `protobuf_oneof:"schedule"`
+	// Types that are valid to be assigned to Mode:
+	//
+	//	*ConfigureRequest_Preset
+	//	*ConfigureRequest_Custom
+	Mode          isConfigureRequest_Mode `protobuf_oneof:"mode"`
+	Labels        []string                `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureRequest) Reset() {
+	*x = ConfigureRequest{}
+	mi := &file_testdata_deterministic_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureRequest) ProtoMessage() {}
+
+func (x *ConfigureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_deterministic_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_deterministic_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfigureRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigureRequest) GetSource() isConfigureRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *ConfigureRequest) GetUrl() string {
+	if x != nil {
+		if x, ok := x.Source.(*ConfigureRequest_Url); ok {
+			return x.Url
+		}
+	}
+	return ""
+}
+
+func (x *ConfigureRequest) GetBlob() *SourceBlob {
+	if x != nil {
+		if x, ok := x.Source.(*ConfigureRequest_Blob); ok {
+			return x.Blob
+		}
+	}
+	return nil
+}
+
+func (x *ConfigureRequest) GetTarget() isConfigureRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ConfigureRequest) GetBucket() string {
+	if x != nil {
+		if x, ok := x.Target.(*ConfigureRequest_Bucket); ok {
+			return x.Bucket
+		}
+	}
+	return ""
+}
+
+func (x *ConfigureRequest) GetTable() *TargetTable {
+	if x != nil {
+		if x, ok := x.Target.(*ConfigureRequest_Table); ok {
+			return x.Table
+		}
+	}
+	return nil
+}
+
+func (x *ConfigureRequest) GetSchedule() isConfigureRequest_Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *ConfigureRequest) GetCron() string {
+	if x != nil {
+		if x, ok := x.Schedule.(*ConfigureRequest_Cron); ok {
+			return x.Cron
+		}
+	}
+	return ""
+}
+
+func (x *ConfigureRequest) GetIntervalSeconds() int32 {
+	if x != nil {
+		if x, ok := x.Schedule.(*ConfigureRequest_IntervalSeconds); ok {
+			return x.IntervalSeconds
+		}
+	}
+	return 0
+}
+
+func (x *ConfigureRequest) GetMode() isConfigureRequest_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return nil
+}
+
+func (x *ConfigureRequest) GetPreset() ConfigureMode {
+	if x != nil {
+		if x, ok := x.Mode.(*ConfigureRequest_Preset); ok {
+			return x.Preset
+		}
+	}
+	return ConfigureMode_CONFIGURE_MODE_UNSPECIFIED
+}
+
+func (x *ConfigureRequest) GetCustom() string {
+	if x != nil {
+		if x, ok := x.Mode.(*ConfigureRequest_Custom); ok {
+			return x.Custom
+		}
+	}
+	return ""
+}
+
+func (x *ConfigureRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type isConfigureRequest_Source interface {
+	isConfigureRequest_Source()
+}
+
+type ConfigureRequest_Url struct {
+	Url string `protobuf:"bytes,2,opt,name=url,proto3,oneof"`
+}
+
+type ConfigureRequest_Blob struct {
+	Blob *SourceBlob `protobuf:"bytes,3,opt,name=blob,proto3,oneof"`
+}
+
+func (*ConfigureRequest_Url) isConfigureRequest_Source() {}
+
+func (*ConfigureRequest_Blob) isConfigureRequest_Source() {}
+
+type isConfigureRequest_Target interface {
+	isConfigureRequest_Target()
+}
+
+type ConfigureRequest_Bucket struct {
+	Bucket string `protobuf:"bytes,4,opt,name=bucket,proto3,oneof"`
+}
+
+type ConfigureRequest_Table struct {
+	Table *TargetTable `protobuf:"bytes,5,opt,name=table,proto3,oneof"`
+}
+
+func (*ConfigureRequest_Bucket) isConfigureRequest_Target() {}
+
+func (*ConfigureRequest_Table) isConfigureRequest_Target() {}
+
+type isConfigureRequest_Schedule interface {
+	isConfigureRequest_Schedule()
+}
+
+type ConfigureRequest_Cron struct {
+	Cron string `protobuf:"bytes,6,opt,name=cron,proto3,oneof"`
+}
+
+type ConfigureRequest_IntervalSeconds struct {
+	IntervalSeconds int32 `protobuf:"varint,7,opt,name=interval_seconds,json=intervalSeconds,proto3,oneof"`
+}
+
+func (*ConfigureRequest_Cron) isConfigureRequest_Schedule() {}
+
+func (*ConfigureRequest_IntervalSeconds) isConfigureRequest_Schedule() {}
+
+type isConfigureRequest_Mode interface {
+	isConfigureRequest_Mode()
+}
+
+type ConfigureRequest_Preset struct {
+	Preset ConfigureMode `protobuf:"varint,8,opt,name=preset,proto3,enum=testdata.ConfigureMode,oneof"`
+}
+
+type ConfigureRequest_Custom struct {
+	Custom string `protobuf:"bytes,9,opt,name=custom,proto3,oneof"`
+}
+
+func (*ConfigureRequest_Preset) isConfigureRequest_Mode() {}
+
+func (*ConfigureRequest_Custom) isConfigureRequest_Mode() {}
+
+type SourceBlob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SourceBlob) Reset() {
+	*x = SourceBlob{}
+	mi := &file_testdata_deterministic_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceBlob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceBlob) ProtoMessage() {}
+
+func (x *SourceBlob) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_deterministic_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceBlob.ProtoReflect.Descriptor instead.
+func (*SourceBlob) Descriptor() ([]byte, []int) {
+	return file_testdata_deterministic_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SourceBlob) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type TargetTable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dataset       string                 `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	Table         string                 `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	Mode          ConfigureMode          `protobuf:"varint,3,opt,name=mode,proto3,enum=testdata.ConfigureMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetTable) Reset() {
+	*x = TargetTable{}
+	mi := &file_testdata_deterministic_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetTable) ProtoMessage() {}
+
+func (x *TargetTable) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_deterministic_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetTable.ProtoReflect.Descriptor instead.
+func (*TargetTable) Descriptor() ([]byte, []int) {
+	return file_testdata_deterministic_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TargetTable) GetDataset() string {
+	if x != nil {
+		return x.Dataset
+	}
+	return ""
+}
+
+func (x *TargetTable) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *TargetTable) GetMode() ConfigureMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ConfigureMode_CONFIGURE_MODE_UNSPECIFIED
+}
+
+type ConfigureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureResponse) Reset() {
+	*x = ConfigureResponse{}
+	mi := &file_testdata_deterministic_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureResponse) ProtoMessage() {}
+
+func (x *ConfigureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_deterministic_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureResponse.ProtoReflect.Descriptor instead.
+func (*ConfigureResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_deterministic_test_proto_rawDescGZIP(), []int{3}
+}
+
+var File_testdata_deterministic_test_proto protoreflect.FileDescriptor
+
+const file_testdata_deterministic_test_proto_rawDesc = "" +
+	"\n" +
+	"!testdata/deterministic_test.proto\x12\btestdata\"\xff\x02\n" +
+	"\x10ConfigureRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x03url\x18\x02 \x01(\tH\x00R\x03url\x12*\n" +
+	"\x04blob\x18\x03 \x01(\v2\x14.testdata.SourceBlobH\x00R\x04blob\x12\x18\n" +
+	"\x06bucket\x18\x04 \x01(\tH\x01R\x06bucket\x12-\n" +
+	"\x05table\x18\x05 \x01(\v2\x15.testdata.TargetTableH\x01R\x05table\x12\x14\n" +
+	"\x04cron\x18\x06 \x01(\tH\x02R\x04cron\x12+\n" +
+	"\x10interval_seconds\x18\a \x01(\x05H\x02R\x0fintervalSeconds\x121\n" +
+	"\x06preset\x18\b \x01(\x0e2\x17.testdata.ConfigureModeH\x03R\x06preset\x12\x18\n" +
+	"\x06custom\x18\t \x01(\tH\x03R\x06custom\x12\x16\n" +
+	"\x06labels\x18\n" +
+	" \x03(\tR\x06labelsB\b\n" +
+	"\x06sourceB\b\n" +
+	"\x06targetB\n" +
+	"\n" +
+	"\bscheduleB\x06\n" +
+	"\x04mode\" \n" +
+	"\n" +
+	"SourceBlob\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"j\n" +
+	"\vTargetTable\x12\x18\n" +
+	"\adataset\x18\x01 \x01(\tR\adataset\x12\x14\n" +
+	"\x05table\x18\x02 \x01(\tR\x05table\x12+\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x17.testdata.ConfigureModeR\x04mode\"\x13\n" +
+	"\x11ConfigureResponse*f\n" +
+	"\rConfigureMode\x12\x1e\n" +
+	"\x1aCONFIGURE_MODE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15CONFIGURE_MODE_APPEND\x10\x01\x12\x1a\n" +
+	"\x16CONFIGURE_MODE_REPLACE\x10\x022\\\n" +
+	"\x14DeterministicService\x12D\n" +
+	"\tConfigure\x12\x1a.testdata.ConfigureRequest\x1a\x1b.testdata.ConfigureResponseB\xb0\x01\n" +
+	"\fcom.testdataB\x16DeterministicTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_deterministic_test_proto_rawDescOnce sync.Once
+	file_testdata_deterministic_test_proto_rawDescData []byte
+)
+
+func file_testdata_deterministic_test_proto_rawDescGZIP() []byte {
+	file_testdata_deterministic_test_proto_rawDescOnce.Do(func() {
+		file_testdata_deterministic_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_deterministic_test_proto_rawDesc), len(file_testdata_deterministic_test_proto_rawDesc)))
+	})
+	return file_testdata_deterministic_test_proto_rawDescData
+}
+
+var file_testdata_deterministic_test_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_testdata_deterministic_test_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_testdata_deterministic_test_proto_goTypes = []any{
+	(ConfigureMode)(0),        // 0: testdata.ConfigureMode
+	(*ConfigureRequest)(nil),  // 1: testdata.ConfigureRequest
+	(*SourceBlob)(nil),        // 2: testdata.SourceBlob
+	(*TargetTable)(nil),       // 3: testdata.TargetTable
+	(*ConfigureResponse)(nil), // 4: testdata.ConfigureResponse
+}
+var file_testdata_deterministic_test_proto_depIdxs = []int32{
+	2, // 0: testdata.ConfigureRequest.blob:type_name -> testdata.SourceBlob
+	3, // 1: testdata.ConfigureRequest.table:type_name -> testdata.TargetTable
+	0, // 2: testdata.ConfigureRequest.preset:type_name -> testdata.ConfigureMode
+	0, // 3: testdata.TargetTable.mode:type_name -> testdata.ConfigureMode
+	1, // 4: testdata.DeterministicService.Configure:input_type -> testdata.ConfigureRequest
+	4, // 5: testdata.DeterministicService.Configure:output_type -> testdata.ConfigureResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_testdata_deterministic_test_proto_init() }
+func file_testdata_deterministic_test_proto_init() {
+	if File_testdata_deterministic_test_proto != nil {
+		return
+	}
+	file_testdata_deterministic_test_proto_msgTypes[0].OneofWrappers = []any{
+		(*ConfigureRequest_Url)(nil),
+		(*ConfigureRequest_Blob)(nil),
+		(*ConfigureRequest_Bucket)(nil),
+		(*ConfigureRequest_Table)(nil),
+		(*ConfigureRequest_Cron)(nil),
+		(*ConfigureRequest_IntervalSeconds)(nil),
+		(*ConfigureRequest_Preset)(nil),
+		(*ConfigureRequest_Custom)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_deterministic_test_proto_rawDesc), len(file_testdata_deterministic_test_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_deterministic_test_proto_goTypes,
+		DependencyIndexes: file_testdata_deterministic_test_proto_depIdxs,
+		EnumInfos:         file_testdata_deterministic_test_proto_enumTypes,
+		MessageInfos:      file_testdata_deterministic_test_proto_msgTypes,
+	}.Build()
+	File_testdata_deterministic_test_proto = out.File
+	file_testdata_deterministic_test_proto_goTypes = nil
+	file_testdata_deterministic_test_proto_depIdxs = nil
+}
@@ -0,0 +1,129 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/editions_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EditionsService_UpdateProfile_FullMethodName = "/testdata.EditionsService/UpdateProfile"
+)
+
+// EditionsServiceClient is the client API for EditionsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// EditionsService exercises required detection under editions, where field
+// presence comes from features rather than the optional keyword.
+type EditionsServiceClient interface {
+	// Updates a profile.
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+}
+
+type editionsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEditionsServiceClient(cc grpc.ClientConnInterface) EditionsServiceClient {
+	return &editionsServiceClient{cc}
+}
+
+func (c *editionsServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileResponse)
+	err := c.cc.Invoke(ctx, EditionsService_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EditionsServiceServer is the server API for EditionsService service.
+// All implementations must embed UnimplementedEditionsServiceServer
+// for forward compatibility.
+//
+// EditionsService exercises required detection under editions, where field
+// presence comes from features rather than the optional keyword.
+type EditionsServiceServer interface {
+	// Updates a profile.
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	mustEmbedUnimplementedEditionsServiceServer()
+}
+
+// UnimplementedEditionsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEditionsServiceServer struct{}
+
+func (UnimplementedEditionsServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedEditionsServiceServer) mustEmbedUnimplementedEditionsServiceServer() {}
+func (UnimplementedEditionsServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeEditionsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EditionsServiceServer will
+// result in compilation errors.
+type UnsafeEditionsServiceServer interface {
+	mustEmbedUnimplementedEditionsServiceServer()
+}
+
+func RegisterEditionsServiceServer(s grpc.ServiceRegistrar, srv EditionsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEditionsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EditionsService_ServiceDesc, srv)
+}
+
+func _EditionsService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EditionsServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EditionsService_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EditionsServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EditionsService_ServiceDesc is the grpc.ServiceDesc for EditionsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EditionsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.EditionsService",
+	HandlerType: (*EditionsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _EditionsService_UpdateProfile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/editions_test.proto",
+}
@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/enum_alias_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ShipmentService_UpdateShipment_FullMethodName = "/testdata.ShipmentService/UpdateShipment"
+)
+
+// ShipmentServiceClient is the client API for ShipmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ShipmentService takes an enum with aliases.
+type ShipmentServiceClient interface {
+	UpdateShipment(ctx context.Context, in *UpdateShipmentRequest, opts ...grpc.CallOption) (*UpdateShipmentResponse, error)
+}
+
+type shipmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShipmentServiceClient(cc grpc.ClientConnInterface) ShipmentServiceClient {
+	return &shipmentServiceClient{cc}
+}
+
+func (c *shipmentServiceClient) UpdateShipment(ctx context.Context, in *UpdateShipmentRequest, opts ...grpc.CallOption) (*UpdateShipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateShipmentResponse)
+	err := c.cc.Invoke(ctx, ShipmentService_UpdateShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShipmentServiceServer is the server API for ShipmentService service.
+// All implementations must embed UnimplementedShipmentServiceServer
+// for forward compatibility.
+//
+// ShipmentService takes an enum with aliases.
+type ShipmentServiceServer interface {
+	UpdateShipment(context.Context, *UpdateShipmentRequest) (*UpdateShipmentResponse, error)
+	mustEmbedUnimplementedShipmentServiceServer()
+}
+
+// UnimplementedShipmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedShipmentServiceServer struct{}
+
+func (UnimplementedShipmentServiceServer) UpdateShipment(context.Context, *UpdateShipmentRequest) (*UpdateShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateShipment not implemented")
+}
+func (UnimplementedShipmentServiceServer) mustEmbedUnimplementedShipmentServiceServer() {}
+func (UnimplementedShipmentServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeShipmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShipmentServiceServer will
+// result in compilation errors.
+type UnsafeShipmentServiceServer interface {
+	mustEmbedUnimplementedShipmentServiceServer()
+}
+
+func RegisterShipmentServiceServer(s grpc.ServiceRegistrar, srv ShipmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedShipmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ShipmentService_ServiceDesc, srv)
+}
+
+func _ShipmentService_UpdateShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShipmentServiceServer).UpdateShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShipmentService_UpdateShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShipmentServiceServer).UpdateShipment(ctx, req.(*UpdateShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShipmentService_ServiceDesc is the grpc.ServiceDesc for ShipmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShipmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.ShipmentService",
+	HandlerType: (*ShipmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateShipment",
+			Handler:    _ShipmentService_UpdateShipment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/enum_alias_test.proto",
+}
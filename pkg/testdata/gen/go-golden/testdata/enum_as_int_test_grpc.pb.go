@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/enum_as_int_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AlarmService_RaiseAlarm_FullMethodName = "/testdata.AlarmService/RaiseAlarm"
+)
+
+// AlarmServiceClient is the client API for AlarmService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AlarmService raises alarms.
+type AlarmServiceClient interface {
+	RaiseAlarm(ctx context.Context, in *RaiseAlarmRequest, opts ...grpc.CallOption) (*RaiseAlarmResponse, error)
+}
+
+type alarmServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAlarmServiceClient(cc grpc.ClientConnInterface) AlarmServiceClient {
+	return &alarmServiceClient{cc}
+}
+
+func (c *alarmServiceClient) RaiseAlarm(ctx context.Context, in *RaiseAlarmRequest, opts ...grpc.CallOption) (*RaiseAlarmResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RaiseAlarmResponse)
+	err := c.cc.Invoke(ctx, AlarmService_RaiseAlarm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AlarmServiceServer is the server API for AlarmService service.
+// All implementations must embed UnimplementedAlarmServiceServer
+// for forward compatibility.
+//
+// AlarmService raises alarms.
+type AlarmServiceServer interface {
+	RaiseAlarm(context.Context, *RaiseAlarmRequest) (*RaiseAlarmResponse, error)
+	mustEmbedUnimplementedAlarmServiceServer()
+}
+
+// UnimplementedAlarmServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAlarmServiceServer struct{}
+
+func (UnimplementedAlarmServiceServer) RaiseAlarm(context.Context, *RaiseAlarmRequest) (*RaiseAlarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RaiseAlarm not implemented")
+}
+func (UnimplementedAlarmServiceServer) mustEmbedUnimplementedAlarmServiceServer() {}
+func (UnimplementedAlarmServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAlarmServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AlarmServiceServer will
+// result in compilation errors.
+type UnsafeAlarmServiceServer interface {
+	mustEmbedUnimplementedAlarmServiceServer()
+}
+
+func RegisterAlarmServiceServer(s grpc.ServiceRegistrar, srv AlarmServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAlarmServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AlarmService_ServiceDesc, srv)
+}
+
+func _AlarmService_RaiseAlarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaiseAlarmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlarmServiceServer).RaiseAlarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlarmService_RaiseAlarm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlarmServiceServer).RaiseAlarm(ctx, req.(*RaiseAlarmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AlarmService_ServiceDesc is the grpc.ServiceDesc for AlarmService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AlarmService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.AlarmService",
+	HandlerType: (*AlarmServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RaiseAlarm",
+			Handler:    _AlarmService_RaiseAlarm_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/enum_as_int_test.proto",
+}
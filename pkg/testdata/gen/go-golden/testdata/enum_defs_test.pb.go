@@ -0,0 +1,656 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/enum_defs_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IncidentSeverity int32
+
+const (
+	IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED   IncidentSeverity = 0
+	IncidentSeverity_INCIDENT_SEVERITY_INFORMATIONAL IncidentSeverity = 1
+	IncidentSeverity_INCIDENT_SEVERITY_LOW           IncidentSeverity = 2
+	IncidentSeverity_INCIDENT_SEVERITY_MODERATE      IncidentSeverity = 3
+	IncidentSeverity_INCIDENT_SEVERITY_ELEVATED      IncidentSeverity = 4
+	IncidentSeverity_INCIDENT_SEVERITY_HIGH          IncidentSeverity = 5
+	IncidentSeverity_INCIDENT_SEVERITY_SEVERE        IncidentSeverity = 6
+	IncidentSeverity_INCIDENT_SEVERITY_CRITICAL      IncidentSeverity = 7
+	IncidentSeverity_INCIDENT_SEVERITY_CATASTROPHIC  IncidentSeverity = 8
+)
+
+// Enum value maps for IncidentSeverity.
+var (
+	IncidentSeverity_name = map[int32]string{
+		0: "INCIDENT_SEVERITY_UNSPECIFIED",
+		1: "INCIDENT_SEVERITY_INFORMATIONAL",
+		2: "INCIDENT_SEVERITY_LOW",
+		3: "INCIDENT_SEVERITY_MODERATE",
+		4: "INCIDENT_SEVERITY_ELEVATED",
+		5: "INCIDENT_SEVERITY_HIGH",
+		6: "INCIDENT_SEVERITY_SEVERE",
+		7: "INCIDENT_SEVERITY_CRITICAL",
+		8: "INCIDENT_SEVERITY_CATASTROPHIC",
+	}
+	IncidentSeverity_value = map[string]int32{
+		"INCIDENT_SEVERITY_UNSPECIFIED":   0,
+		"INCIDENT_SEVERITY_INFORMATIONAL": 1,
+		"INCIDENT_SEVERITY_LOW":           2,
+		"INCIDENT_SEVERITY_MODERATE":      3,
+		"INCIDENT_SEVERITY_ELEVATED":      4,
+		"INCIDENT_SEVERITY_HIGH":          5,
+		"INCIDENT_SEVERITY_SEVERE":        6,
+		"INCIDENT_SEVERITY_CRITICAL":      7,
+		"INCIDENT_SEVERITY_CATASTROPHIC":  8,
+	}
+)
+
+func (x IncidentSeverity) Enum() *IncidentSeverity {
+	p := new(IncidentSeverity)
+	*p = x
+	return p
+}
+
+func (x IncidentSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IncidentSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_testdata_enum_defs_test_proto_enumTypes[0].Descriptor()
+}
+
+func (IncidentSeverity) Type() protoreflect.EnumType {
+	return &file_testdata_enum_defs_test_proto_enumTypes[0]
+}
+
+func (x IncidentSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IncidentSeverity.Descriptor instead.
+func (IncidentSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_testdata_enum_defs_test_proto_rawDescGZIP(), []int{0}
+}
+
+type IncidentPage_State int32
+
+const (
+	IncidentPage_STATE_UNSPECIFIED  IncidentPage_State = 0
+	IncidentPage_STATE_SENT         IncidentPage_State = 1
+	IncidentPage_STATE_ACKNOWLEDGED IncidentPage_State = 2
+)
+
+// Enum value maps for IncidentPage_State.
+var (
+	IncidentPage_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "STATE_SENT",
+		2: "STATE_ACKNOWLEDGED",
+	}
+	IncidentPage_State_value = map[string]int32{
+		"STATE_UNSPECIFIED":  0,
+		"STATE_SENT":         1,
+		"STATE_ACKNOWLEDGED": 2,
+	}
+)
+
+func (x IncidentPage_State) Enum() *IncidentPage_State {
+	p := new(IncidentPage_State)
+	*p = x
+	return p
+}
+
+func (x IncidentPage_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IncidentPage_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_testdata_enum_defs_test_proto_enumTypes[1].Descriptor()
+}
+
+func (IncidentPage_State) Type() protoreflect.EnumType {
+	return &file_testdata_enum_defs_test_proto_enumTypes[1]
+}
+
+func (x IncidentPage_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IncidentPage_State.Descriptor instead.
+func (IncidentPage_State) EnumDescriptor() ([]byte, []int) {
+	return file_testdata_enum_defs_test_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type IncidentTicket_State int32
+
+const (
+	IncidentTicket_STATE_UNSPECIFIED IncidentTicket_State = 0
+	IncidentTicket_STATE_OPEN        IncidentTicket_State = 1
+	IncidentTicket_STATE_CLOSED      IncidentTicket_State = 2
+)
+
+// Enum value maps for IncidentTicket_State.
+var (
+	IncidentTicket_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "STATE_OPEN",
+		2: "STATE_CLOSED",
+	}
+	IncidentTicket_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"STATE_OPEN":        1,
+		"STATE_CLOSED":      2,
+	}
+)
+
+func (x IncidentTicket_State) Enum() *IncidentTicket_State {
+	p := new(IncidentTicket_State)
+	*p = x
+	return p
+}
+
+func (x IncidentTicket_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IncidentTicket_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_testdata_enum_defs_test_proto_enumTypes[2].Descriptor()
+}
+
+func (IncidentTicket_State) Type() protoreflect.EnumType {
+	return &file_testdata_enum_defs_test_proto_enumTypes[2]
+}
+
+func (x IncidentTicket_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IncidentTicket_State.Descriptor instead.
+func (IncidentTicket_State) EnumDescriptor() ([]byte, []int) {
+	return file_testdata_enum_defs_test_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type Incident struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current severity.
+	Severity        IncidentSeverity `protobuf:"varint,1,opt,name=severity,proto3,enum=testdata.IncidentSeverity" json:"severity,omitempty"`
+	InitialSeverity IncidentSeverity `protobuf:"varint,2,opt,name=initial_severity,json=initialSeverity,proto3,enum=testdata.IncidentSeverity" json:"initial_severity,omitempty"`
+	// Every severity the incident has had, oldest first.
+	History             []IncidentSeverity          `protobuf:"varint,3,rep,packed,name=history,proto3,enum=testdata.IncidentSeverity" json:"history,omitempty"`
+	SeverityByTeam      map[string]IncidentSeverity `protobuf:"bytes,4,rep,name=severity_by_team,json=severityByTeam,proto3" json:"severity_by_team,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=testdata.IncidentSeverity"`
+	Escalation          *Escalation                 `protobuf:"bytes,5,opt,name=escalation,proto3" json:"escalation,omitempty"`
+	PreviousEscalations []*Escalation               `protobuf:"bytes,6,rep,name=previous_escalations,json=previousEscalations,proto3" json:"previous_escalations,omitempty"`
+	Thread              *IncidentThread             `protobuf:"bytes,7,opt,name=thread,proto3" json:"thread,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Incident) Reset() {
+	*x = Incident{}
+	mi := &file_testdata_enum_defs_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Incident) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Incident) ProtoMessage() {}
+
+func (x *Incident) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_enum_defs_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Incident.ProtoReflect.Descriptor instead.
+func (*Incident) Descriptor() ([]byte, []int) {
+	return file_testdata_enum_defs_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Incident) GetSeverity() IncidentSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *Incident) GetInitialSeverity() IncidentSeverity {
+	if x != nil {
+		return x.InitialSeverity
+	}
+	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *Incident) GetHistory() []IncidentSeverity {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Incident) GetSeverityByTeam() map[string]IncidentSeverity {
+	if x != nil {
+		return x.SeverityByTeam
+	}
+	return nil
+}
+
+func (x *Incident) GetEscalation() *Escalation {
+	if x != nil {
+		return x.Escalation
+	}
+	return nil
+}
+
+func (x *Incident) GetPreviousEscalations() []*Escalation {
+	if x != nil {
+		return x.PreviousEscalations
+	}
+	return nil
+}
+
+func (x *Incident) GetThread() *IncidentThread {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+type Escalation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          IncidentSeverity       `protobuf:"varint,1,opt,name=from,proto3,enum=testdata.IncidentSeverity" json:"from,omitempty"`
+	To            IncidentSeverity       `protobuf:"varint,2,opt,name=to,proto3,enum=testdata.IncidentSeverity" json:"to,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Escalation) Reset() {
+	*x = Escalation{}
+	mi := &file_testdata_enum_defs_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Escalation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Escalation) ProtoMessage() {}
+
+func (x *Escalation) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_enum_defs_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Escalation.ProtoReflect.Descriptor instead.
+func (*Escalation) Descriptor() ([]byte, []int) {
+	return file_testdata_enum_defs_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Escalation) GetFrom() IncidentSeverity {
+	if x != nil {
+		return x.From
+	}
+	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *Escalation) GetTo() IncidentSeverity {
+	if x != nil {
+		return x.To
+	}
+	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *Escalation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// IncidentThread is recursive and therefore cannot be fully inlined.
+type IncidentThread struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          string                 `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	Severity      IncidentSeverity       `protobuf:"varint,2,opt,name=severity,proto3,enum=testdata.IncidentSeverity" json:"severity,omitempty"`
+	Replies       []*IncidentThread      `protobuf:"bytes,3,rep,name=replies,proto3" json:"replies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncidentThread) Reset() {
+	*x = IncidentThread{}
+	mi := &file_testdata_enum_defs_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncidentThread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncidentThread) ProtoMessage() {}
+
+func (x *IncidentThread) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_enum_defs_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncidentThread.ProtoReflect.Descriptor instead.
+func (*IncidentThread) Descriptor() ([]byte, []int) {
+	return file_testdata_enum_defs_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IncidentThread) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *IncidentThread) GetSeverity() IncidentSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
+}
+
+func (x *IncidentThread) GetReplies() []*IncidentThread {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+// IncidentAction refers to two enums named State, nested in different
+// messages.
+type IncidentAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageState     IncidentPage_State     `protobuf:"varint,1,opt,name=page_state,json=pageState,proto3,enum=testdata.IncidentPage_State" json:"page_state,omitempty"`
+	TicketState   IncidentTicket_State   `protobuf:"varint,2,opt,name=ticket_state,json=ticketState,proto3,enum=testdata.IncidentTicket_State" json:"ticket_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncidentAction) Reset() {
+	*x = IncidentAction{}
+	mi := &file_testdata_enum_defs_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncidentAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncidentAction) ProtoMessage() {}
+
+func (x *IncidentAction) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_enum_defs_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncidentAction.ProtoReflect.Descriptor instead.
+func (*IncidentAction) Descriptor() ([]byte, []int) {
+	return file_testdata_enum_defs_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IncidentAction) GetPageState() IncidentPage_State {
+	if x != nil {
+		return x.PageState
+	}
+	return IncidentPage_STATE_UNSPECIFIED
+}
+
+func (x *IncidentAction) GetTicketState() IncidentTicket_State {
+	if x != nil {
+		return x.TicketState
+	}
+	return IncidentTicket_STATE_UNSPECIFIED
+}
+
+type IncidentPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncidentPage) Reset() {
+	*x = IncidentPage{}
+	mi := &file_testdata_enum_defs_test_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncidentPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncidentPage) ProtoMessage() {}
+
+func (x *IncidentPage) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_enum_defs_test_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncidentPage.ProtoReflect.Descriptor instead.
+func (*IncidentPage) Descriptor() ([]byte, []int) {
+	return file_testdata_enum_defs_test_proto_rawDescGZIP(), []int{4}
+}
+
+type IncidentTicket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncidentTicket) Reset() {
+	*x = IncidentTicket{}
+	mi := &file_testdata_enum_defs_test_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncidentTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncidentTicket) ProtoMessage() {}
+
+func (x *IncidentTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_enum_defs_test_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncidentTicket.ProtoReflect.Descriptor instead.
+func (*IncidentTicket) Descriptor() ([]byte, []int) {
+	return file_testdata_enum_defs_test_proto_rawDescGZIP(), []int{5}
+}
+
+var File_testdata_enum_defs_test_proto protoreflect.FileDescriptor
+
+const file_testdata_enum_defs_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1dtestdata/enum_defs_test.proto\x12\btestdata\"\xa1\x04\n" +
+	"\bIncident\x126\n" +
+	"\bseverity\x18\x01 \x01(\x0e2\x1a.testdata.IncidentSeverityR\bseverity\x12E\n" +
+	"\x10initial_severity\x18\x02 \x01(\x0e2\x1a.testdata.IncidentSeverityR\x0finitialSeverity\x124\n" +
+	"\ahistory\x18\x03 \x03(\x0e2\x1a.testdata.IncidentSeverityR\ahistory\x12P\n" +
+	"\x10severity_by_team\x18\x04 \x03(\v2&.testdata.Incident.SeverityByTeamEntryR\x0eseverityByTeam\x124\n" +
+	"\n" +
+	"escalation\x18\x05 \x01(\v2\x14.testdata.EscalationR\n" +
+	"escalation\x12G\n" +
+	"\x14previous_escalations\x18\x06 \x03(\v2\x14.testdata.EscalationR\x13previousEscalations\x120\n" +
+	"\x06thread\x18\a \x01(\v2\x18.testdata.IncidentThreadR\x06thread\x1a]\n" +
+	"\x13SeverityByTeamEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\x0e2\x1a.testdata.IncidentSeverityR\x05value:\x028\x01\"\x80\x01\n" +
+	"\n" +
+	"Escalation\x12.\n" +
+	"\x04from\x18\x01 \x01(\x0e2\x1a.testdata.IncidentSeverityR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\x0e2\x1a.testdata.IncidentSeverityR\x02to\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x90\x01\n" +
+	"\x0eIncidentThread\x12\x12\n" +
+	"\x04note\x18\x01 \x01(\tR\x04note\x126\n" +
+	"\bseverity\x18\x02 \x01(\x0e2\x1a.testdata.IncidentSeverityR\bseverity\x122\n" +
+	"\areplies\x18\x03 \x03(\v2\x18.testdata.IncidentThreadR\areplies\"\x90\x01\n" +
+	"\x0eIncidentAction\x12;\n" +
+	"\n" +
+	"page_state\x18\x01 \x01(\x0e2\x1c.testdata.IncidentPage.StateR\tpageState\x12A\n" +
+	"\fticket_state\x18\x02 \x01(\x0e2\x1e.testdata.IncidentTicket.StateR\vticketState\"V\n" +
+	"\fIncidentPage\"F\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"STATE_SENT\x10\x01\x12\x16\n" +
+	"\x12STATE_ACKNOWLEDGED\x10\x02\"R\n" +
+	"\x0eIncidentTicket\"@\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"STATE_OPEN\x10\x01\x12\x10\n" +
+	"\fSTATE_CLOSED\x10\x02*\xb3\x02\n" +
+	"\x10IncidentSeverity\x12!\n" +
+	"\x1dINCIDENT_SEVERITY_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fINCIDENT_SEVERITY_INFORMATIONAL\x10\x01\x12\x19\n" +
+	"\x15INCIDENT_SEVERITY_LOW\x10\x02\x12\x1e\n" +
+	"\x1aINCIDENT_SEVERITY_MODERATE\x10\x03\x12\x1e\n" +
+	"\x1aINCIDENT_SEVERITY_ELEVATED\x10\x04\x12\x1a\n" +
+	"\x16INCIDENT_SEVERITY_HIGH\x10\x05\x12\x1c\n" +
+	"\x18INCIDENT_SEVERITY_SEVERE\x10\x06\x12\x1e\n" +
+	"\x1aINCIDENT_SEVERITY_CRITICAL\x10\a\x12\"\n" +
+	"\x1eINCIDENT_SEVERITY_CATASTROPHIC\x10\bB\xab\x01\n" +
+	"\fcom.testdataB\x11EnumDefsTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_enum_defs_test_proto_rawDescOnce sync.Once
+	file_testdata_enum_defs_test_proto_rawDescData []byte
+)
+
+func file_testdata_enum_defs_test_proto_rawDescGZIP() []byte {
+	file_testdata_enum_defs_test_proto_rawDescOnce.Do(func() {
+		file_testdata_enum_defs_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_enum_defs_test_proto_rawDesc), len(file_testdata_enum_defs_test_proto_rawDesc)))
+	})
+	return file_testdata_enum_defs_test_proto_rawDescData
+}
+
+var file_testdata_enum_defs_test_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_testdata_enum_defs_test_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_testdata_enum_defs_test_proto_goTypes = []any{
+	(IncidentSeverity)(0),     // 0: testdata.IncidentSeverity
+	(IncidentPage_State)(0),   // 1: testdata.IncidentPage.State
+	(IncidentTicket_State)(0), // 2: testdata.IncidentTicket.State
+	(*Incident)(nil),          // 3: testdata.Incident
+	(*Escalation)(nil),        // 4: testdata.Escalation
+	(*IncidentThread)(nil),    // 5: testdata.IncidentThread
+	(*IncidentAction)(nil),    // 6: testdata.IncidentAction
+	(*IncidentPage)(nil),      // 7: testdata.IncidentPage
+	(*IncidentTicket)(nil),    // 8: testdata.IncidentTicket
+	nil,                       // 9: testdata.Incident.SeverityByTeamEntry
+}
+var file_testdata_enum_defs_test_proto_depIdxs = []int32{
+	0,  // 0: testdata.Incident.severity:type_name -> testdata.IncidentSeverity
+	0,  // 1: testdata.Incident.initial_severity:type_name -> testdata.IncidentSeverity
+	0,  // 2: testdata.Incident.history:type_name -> testdata.IncidentSeverity
+	9,  // 3: testdata.Incident.severity_by_team:type_name -> testdata.Incident.SeverityByTeamEntry
+	4,  // 4: testdata.Incident.escalation:type_name -> testdata.Escalation
+	4,  // 5: testdata.Incident.previous_escalations:type_name -> testdata.Escalation
+	5,  // 6: testdata.Incident.thread:type_name -> testdata.IncidentThread
+	0,  // 7: testdata.Escalation.from:type_name -> testdata.IncidentSeverity
+	0,  // 8: testdata.Escalation.to:type_name -> testdata.IncidentSeverity
+	0,  // 9: testdata.IncidentThread.severity:type_name -> testdata.IncidentSeverity
+	5,  // 10: testdata.IncidentThread.replies:type_name -> testdata.IncidentThread
+	1,  // 11: testdata.IncidentAction.page_state:type_name -> testdata.IncidentPage.State
+	2,  // 12: testdata.IncidentAction.ticket_state:type_name -> testdata.IncidentTicket.State
+	0,  // 13: testdata.Incident.SeverityByTeamEntry.value:type_name -> testdata.IncidentSeverity
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_testdata_enum_defs_test_proto_init() }
+func file_testdata_enum_defs_test_proto_init() {
+	if File_testdata_enum_defs_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_enum_defs_test_proto_rawDesc), len(file_testdata_enum_defs_test_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testdata_enum_defs_test_proto_goTypes,
+		DependencyIndexes: file_testdata_enum_defs_test_proto_depIdxs,
+		EnumInfos:         file_testdata_enum_defs_test_proto_enumTypes,
+		MessageInfos:      file_testdata_enum_defs_test_proto_msgTypes,
+	}.Build()
+	File_testdata_enum_defs_test_proto = out.File
+	file_testdata_enum_defs_test_proto_goTypes = nil
+	file_testdata_enum_defs_test_proto_depIdxs = nil
+}
@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/enum_order_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TaskPriority is declared neither in alphabetical nor in numeric order.
+type TaskPriority int32
+
+const (
+	TaskPriority_TASK_PRIORITY_UNSPECIFIED TaskPriority = 0
+	// Drop everything else.
+	TaskPriority_TASK_PRIORITY_URGENT TaskPriority = 4
+	// Within the day.
+	TaskPriority_TASK_PRIORITY_HIGH   TaskPriority = 3
+	TaskPriority_TASK_PRIORITY_LOW    TaskPriority = 1
+	TaskPriority_TASK_PRIORITY_MEDIUM TaskPriority = 2
+)
+
+// Enum value maps for TaskPriority.
+var (
+	TaskPriority_name = map[int32]string{
+		0: "TASK_PRIORITY_UNSPECIFIED",
+		4: "TASK_PRIORITY_URGENT",
+		3: "TASK_PRIORITY_HIGH",
+		1: "TASK_PRIORITY_LOW",
+		2: "TASK_PRIORITY_MEDIUM",
+	}
+	TaskPriority_value = map[string]int32{
+		"TASK_PRIORITY_UNSPECIFIED": 0,
+		"TASK_PRIORITY_URGENT":      4,
+		"TASK_PRIORITY_HIGH":        3,
+		"TASK_PRIORITY_LOW":         1,
+		"TASK_PRIORITY_MEDIUM":      2,
+	}
+)
+
+func (x TaskPriority) Enum() *TaskPriority {
+	p := new(TaskPriority)
+	*p = x
+	return p
+}
+
+func (x TaskPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_testdata_enum_order_test_proto_enumTypes[0].Descriptor()
+}
+
+func (TaskPriority) Type() protoreflect.EnumType {
+	return &file_testdata_enum_order_test_proto_enumTypes[0]
+}
+
+func (x TaskPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskPriority.Descriptor instead.
+func (TaskPriority) EnumDescriptor() ([]byte, []int) {
+	return file_testdata_enum_order_test_proto_rawDescGZIP(), []int{0}
+}
+
+type ScheduleTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Priority      TaskPriority           `protobuf:"varint,2,opt,name=priority,proto3,enum=testdata.TaskPriority" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleTaskRequest) Reset() {
+	*x = ScheduleTaskRequest{}
+	mi := &file_testdata_enum_order_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTaskRequest) ProtoMessage() {}
+
+func (x *ScheduleTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_enum_order_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTaskRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleTaskRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_enum_order_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScheduleTaskRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ScheduleTaskRequest) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+type ScheduleTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleTaskResponse) Reset() {
+	*x = ScheduleTaskResponse{}
+	mi := &file_testdata_enum_order_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTaskResponse) ProtoMessage() {}
+
+func (x *ScheduleTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_enum_order_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTaskResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleTaskResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_enum_order_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScheduleTaskResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_testdata_enum_order_test_proto protoreflect.FileDescriptor
+
+const file_testdata_enum_order_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1etestdata/enum_order_test.proto\x12\btestdata\"_\n" +
+	"\x13ScheduleTaskRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x122\n" +
+	"\bpriority\x18\x02 \x01(\x0e2\x16.testdata.TaskPriorityR\bpriority\"&\n" +
+	"\x14ScheduleTaskResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id*\x90\x01\n" +
+	"\fTaskPriority\x12\x1d\n" +
+	"\x19TASK_PRIORITY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14TASK_PRIORITY_URGENT\x10\x04\x12\x16\n" +
+	"\x12TASK_PRIORITY_HIGH\x10\x03\x12\x15\n" +
+	"\x11TASK_PRIORITY_LOW\x10\x01\x12\x18\n" +
+	"\x14TASK_PRIORITY_MEDIUM\x10\x022\\\n" +
+	"\vTaskService\x12M\n" +
+	"\fScheduleTask\x12\x1d.testdata.ScheduleTaskRequest\x1a\x1e.testdata.ScheduleTaskResponseB\xac\x01\n" +
+	"\fcom.testdataB\x12EnumOrderTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_enum_order_test_proto_rawDescOnce sync.Once
+	file_testdata_enum_order_test_proto_rawDescData []byte
+)
+
+func file_testdata_enum_order_test_proto_rawDescGZIP() []byte {
+	file_testdata_enum_order_test_proto_rawDescOnce.Do(func() {
+		file_testdata_enum_order_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_enum_order_test_proto_rawDesc), len(file_testdata_enum_order_test_proto_rawDesc)))
+	})
+	return file_testdata_enum_order_test_proto_rawDescData
+}
+
+var file_testdata_enum_order_test_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_testdata_enum_order_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_enum_order_test_proto_goTypes = []any{
+	(TaskPriority)(0),            // 0: testdata.TaskPriority
+	(*ScheduleTaskRequest)(nil),  // 1: testdata.ScheduleTaskRequest
+	(*ScheduleTaskResponse)(nil), // 2: testdata.ScheduleTaskResponse
+}
+var file_testdata_enum_order_test_proto_depIdxs = []int32{
+	0, // 0: testdata.ScheduleTaskRequest.priority:type_name -> testdata.TaskPriority
+	1, // 1: testdata.TaskService.ScheduleTask:input_type -> testdata.ScheduleTaskRequest
+	2, // 2: testdata.TaskService.ScheduleTask:output_type -> testdata.ScheduleTaskResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_testdata_enum_order_test_proto_init() }
+func file_testdata_enum_order_test_proto_init() {
+	if File_testdata_enum_order_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_enum_order_test_proto_rawDesc), len(file_testdata_enum_order_test_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_enum_order_test_proto_goTypes,
+		DependencyIndexes: file_testdata_enum_order_test_proto_depIdxs,
+		EnumInfos:         file_testdata_enum_order_test_proto_enumTypes,
+		MessageInfos:      file_testdata_enum_order_test_proto_msgTypes,
+	}.Build()
+	File_testdata_enum_order_test_proto = out.File
+	file_testdata_enum_order_test_proto_goTypes = nil
+	file_testdata_enum_order_test_proto_depIdxs = nil
+}
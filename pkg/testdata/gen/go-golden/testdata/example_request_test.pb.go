@@ -0,0 +1,483 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/example_request_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WidgetColor int32
+
+const (
+	WidgetColor_WIDGET_COLOR_UNSPECIFIED WidgetColor = 0
+	WidgetColor_WIDGET_COLOR_RED         WidgetColor = 1
+	WidgetColor_WIDGET_COLOR_BLUE        WidgetColor = 2
+)
+
+// Enum value maps for WidgetColor.
+var (
+	WidgetColor_name = map[int32]string{
+		0: "WIDGET_COLOR_UNSPECIFIED",
+		1: "WIDGET_COLOR_RED",
+		2: "WIDGET_COLOR_BLUE",
+	}
+	WidgetColor_value = map[string]int32{
+		"WIDGET_COLOR_UNSPECIFIED": 0,
+		"WIDGET_COLOR_RED":         1,
+		"WIDGET_COLOR_BLUE":        2,
+	}
+)
+
+func (x WidgetColor) Enum() *WidgetColor {
+	p := new(WidgetColor)
+	*p = x
+	return p
+}
+
+func (x WidgetColor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WidgetColor) Descriptor() protoreflect.EnumDescriptor {
+	return file_testdata_example_request_test_proto_enumTypes[0].Descriptor()
+}
+
+func (WidgetColor) Type() protoreflect.EnumType {
+	return &file_testdata_example_request_test_proto_enumTypes[0]
+}
+
+func (x WidgetColor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WidgetColor.Descriptor instead.
+func (WidgetColor) EnumDescriptor() ([]byte, []int) {
+	return file_testdata_example_request_test_proto_rawDescGZIP(), []int{0}
+}
+
+type WidgetOwner struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Owning team.
+	Team          string `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WidgetOwner) Reset() {
+	*x = WidgetOwner{}
+	mi := &file_testdata_example_request_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WidgetOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WidgetOwner) ProtoMessage() {}
+
+func (x *WidgetOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_example_request_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WidgetOwner.ProtoReflect.Descriptor instead.
+func (*WidgetOwner) Descriptor() ([]byte, []int) {
+	return file_testdata_example_request_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WidgetOwner) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+type SearchWidgetsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Free-text query.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Page to return.
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// Types that are valid to be assigned to Filter:
+	//
+	//	*SearchWidgetsRequest_Color
+	//	*SearchWidgetsRequest_Owner
+	Filter isSearchWidgetsRequest_Filter `protobuf_oneof:"filter"`
+	// Upper bound on the widget size.
+	MaxSizeBytes int64 `protobuf:"varint,5,opt,name=max_size_bytes,json=maxSizeBytes,proto3" json:"max_size_bytes,omitempty"`
+	// Tags that must all be present.
+	Tags          []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchWidgetsRequest) Reset() {
+	*x = SearchWidgetsRequest{}
+	mi := &file_testdata_example_request_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWidgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWidgetsRequest) ProtoMessage() {}
+
+func (x *SearchWidgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_example_request_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWidgetsRequest.ProtoReflect.Descriptor instead.
+func (*SearchWidgetsRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_example_request_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchWidgetsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchWidgetsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchWidgetsRequest) GetFilter() isSearchWidgetsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchWidgetsRequest) GetColor() WidgetColor {
+	if x != nil {
+		if x, ok := x.Filter.(*SearchWidgetsRequest_Color); ok {
+			return x.Color
+		}
+	}
+	return WidgetColor_WIDGET_COLOR_UNSPECIFIED
+}
+
+func (x *SearchWidgetsRequest) GetOwner() *WidgetOwner {
+	if x != nil {
+		if x, ok := x.Filter.(*SearchWidgetsRequest_Owner); ok {
+			return x.Owner
+		}
+	}
+	return nil
+}
+
+func (x *SearchWidgetsRequest) GetMaxSizeBytes() int64 {
+	if x != nil {
+		return x.MaxSizeBytes
+	}
+	return 0
+}
+
+func (x *SearchWidgetsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type isSearchWidgetsRequest_Filter interface {
+	isSearchWidgetsRequest_Filter()
+}
+
+type SearchWidgetsRequest_Color struct {
+	// Only widgets of this color.
+	Color WidgetColor `protobuf:"varint,3,opt,name=color,proto3,enum=testdata.WidgetColor,oneof"`
+}
+
+type SearchWidgetsRequest_Owner struct {
+	// Only widgets owned by this owner.
+	Owner *WidgetOwner `protobuf:"bytes,4,opt,name=owner,proto3,oneof"`
+}
+
+func (*SearchWidgetsRequest_Color) isSearchWidgetsRequest_Filter() {}
+
+func (*SearchWidgetsRequest_Owner) isSearchWidgetsRequest_Filter() {}
+
+type SearchWidgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchWidgetsResponse) Reset() {
+	*x = SearchWidgetsResponse{}
+	mi := &file_testdata_example_request_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWidgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWidgetsResponse) ProtoMessage() {}
+
+func (x *SearchWidgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_example_request_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWidgetsResponse.ProtoReflect.Descriptor instead.
+func (*SearchWidgetsResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_example_request_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchWidgetsResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type CountWidgetsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Free-text query.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Only count widgets created after this id.
+	SinceId       int64 `protobuf:"varint,2,opt,name=since_id,json=sinceId,proto3" json:"since_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountWidgetsRequest) Reset() {
+	*x = CountWidgetsRequest{}
+	mi := &file_testdata_example_request_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountWidgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountWidgetsRequest) ProtoMessage() {}
+
+func (x *CountWidgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_example_request_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountWidgetsRequest.ProtoReflect.Descriptor instead.
+func (*CountWidgetsRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_example_request_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CountWidgetsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *CountWidgetsRequest) GetSinceId() int64 {
+	if x != nil {
+		return x.SinceId
+	}
+	return 0
+}
+
+type CountWidgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountWidgetsResponse) Reset() {
+	*x = CountWidgetsResponse{}
+	mi := &file_testdata_example_request_test_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountWidgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountWidgetsResponse) ProtoMessage() {}
+
+func (x *CountWidgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_example_request_test_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountWidgetsResponse.ProtoReflect.Descriptor instead.
+func (*CountWidgetsResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_example_request_test_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CountWidgetsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_testdata_example_request_test_proto protoreflect.FileDescriptor
+
+const file_testdata_example_request_test_proto_rawDesc = "" +
+	"\n" +
+	"#testdata/example_request_test.proto\x12\btestdata\x1a\x19mcp/options/options.proto\"!\n" +
+	"\vWidgetOwner\x12\x12\n" +
+	"\x04team\x18\x01 \x01(\tR\x04team\"\xe8\x01\n" +
+	"\x14SearchWidgetsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x18\n" +
+	"\x04page\x18\x02 \x01(\x05B\x04\x88\xb2\x19\x01R\x04page\x12-\n" +
+	"\x05color\x18\x03 \x01(\x0e2\x15.testdata.WidgetColorH\x00R\x05color\x12-\n" +
+	"\x05owner\x18\x04 \x01(\v2\x15.testdata.WidgetOwnerH\x00R\x05owner\x12$\n" +
+	"\x0emax_size_bytes\x18\x05 \x01(\x03R\fmaxSizeBytes\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tagsB\b\n" +
+	"\x06filter\")\n" +
+	"\x15SearchWidgetsResponse\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"F\n" +
+	"\x13CountWidgetsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x19\n" +
+	"\bsince_id\x18\x02 \x01(\x03R\asinceId\",\n" +
+	"\x14CountWidgetsResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count*X\n" +
+	"\vWidgetColor\x12\x1c\n" +
+	"\x18WIDGET_COLOR_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10WIDGET_COLOR_RED\x10\x01\x12\x15\n" +
+	"\x11WIDGET_COLOR_BLUE\x10\x022\xe6\x02\n" +
+	"\x0eExampleService\x12\xca\x01\n" +
+	"\rSearchWidgets\x12\x1e.testdata.SearchWidgetsRequest\x1a\x1f.testdata.SearchWidgetsResponse\"x\x92\xb5\x19t\n" +
+	"\x0esearch_widgets:bquery: \"blue\"\n" +
+	"page: 1\n" +
+	"max_size_bytes: 1048576\n" +
+	"owner { team: \"platform\" }\n" +
+	"tags: \"sale\"\n" +
+	"tags: \"new\"\n" +
+	"\x12\x86\x01\n" +
+	"\fCountWidgets\x12\x1d.testdata.CountWidgetsRequest\x1a\x1e.testdata.CountWidgetsResponse\"7\x92\xb5\x193\n" +
+	"\rcount_widgets:\"{\"query\": \"red\", \"since_id\": \"42\"}B\xb1\x01\n" +
+	"\fcom.testdataB\x17ExampleRequestTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_example_request_test_proto_rawDescOnce sync.Once
+	file_testdata_example_request_test_proto_rawDescData []byte
+)
+
+func file_testdata_example_request_test_proto_rawDescGZIP() []byte {
+	file_testdata_example_request_test_proto_rawDescOnce.Do(func() {
+		file_testdata_example_request_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_example_request_test_proto_rawDesc), len(file_testdata_example_request_test_proto_rawDesc)))
+	})
+	return file_testdata_example_request_test_proto_rawDescData
+}
+
+var file_testdata_example_request_test_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_testdata_example_request_test_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_testdata_example_request_test_proto_goTypes = []any{
+	(WidgetColor)(0),              // 0: testdata.WidgetColor
+	(*WidgetOwner)(nil),           // 1: testdata.WidgetOwner
+	(*SearchWidgetsRequest)(nil),  // 2: testdata.SearchWidgetsRequest
+	(*SearchWidgetsResponse)(nil), // 3: testdata.SearchWidgetsResponse
+	(*CountWidgetsRequest)(nil),   // 4: testdata.CountWidgetsRequest
+	(*CountWidgetsResponse)(nil),  // 5: testdata.CountWidgetsResponse
+}
+var file_testdata_example_request_test_proto_depIdxs = []int32{
+	0, // 0: testdata.SearchWidgetsRequest.color:type_name -> testdata.WidgetColor
+	1, // 1: testdata.SearchWidgetsRequest.owner:type_name -> testdata.WidgetOwner
+	2, // 2: testdata.ExampleService.SearchWidgets:input_type -> testdata.SearchWidgetsRequest
+	4, // 3: testdata.ExampleService.CountWidgets:input_type -> testdata.CountWidgetsRequest
+	3, // 4: testdata.ExampleService.SearchWidgets:output_type -> testdata.SearchWidgetsResponse
+	5, // 5: testdata.ExampleService.CountWidgets:output_type -> testdata.CountWidgetsResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_testdata_example_request_test_proto_init() }
+func file_testdata_example_request_test_proto_init() {
+	if File_testdata_example_request_test_proto != nil {
+		return
+	}
+	file_testdata_example_request_test_proto_msgTypes[1].OneofWrappers = []any{
+		(*SearchWidgetsRequest_Color)(nil),
+		(*SearchWidgetsRequest_Owner)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_example_request_test_proto_rawDesc), len(file_testdata_example_request_test_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_example_request_test_proto_goTypes,
+		DependencyIndexes: file_testdata_example_request_test_proto_depIdxs,
+		EnumInfos:         file_testdata_example_request_test_proto_enumTypes,
+		MessageInfos:      file_testdata_example_request_test_proto_msgTypes,
+	}.Build()
+	File_testdata_example_request_test_proto = out.File
+	file_testdata_example_request_test_proto_goTypes = nil
+	file_testdata_example_request_test_proto_depIdxs = nil
+}
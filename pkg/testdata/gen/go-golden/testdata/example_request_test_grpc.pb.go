@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/example_request_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ExampleService_SearchWidgets_FullMethodName = "/testdata.ExampleService/SearchWidgets"
+	ExampleService_CountWidgets_FullMethodName  = "/testdata.ExampleService/CountWidgets"
+)
+
+// ExampleServiceClient is the client API for ExampleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ExampleService exercises the (mcp.options.tool) example_request option in
+// both of its accepted syntaxes.
+type ExampleServiceClient interface {
+	// Searches widgets. The example is written in text format.
+	SearchWidgets(ctx context.Context, in *SearchWidgetsRequest, opts ...grpc.CallOption) (*SearchWidgetsResponse, error)
+	// Counts widgets. The example is written as JSON.
+	CountWidgets(ctx context.Context, in *CountWidgetsRequest, opts ...grpc.CallOption) (*CountWidgetsResponse, error)
+}
+
+type exampleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExampleServiceClient(cc grpc.ClientConnInterface) ExampleServiceClient {
+	return &exampleServiceClient{cc}
+}
+
+func (c *exampleServiceClient) SearchWidgets(ctx context.Context, in *SearchWidgetsRequest, opts ...grpc.CallOption) (*SearchWidgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchWidgetsResponse)
+	err := c.cc.Invoke(ctx, ExampleService_SearchWidgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleServiceClient) CountWidgets(ctx context.Context, in *CountWidgetsRequest, opts ...grpc.CallOption) (*CountWidgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountWidgetsResponse)
+	err := c.cc.Invoke(ctx, ExampleService_CountWidgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExampleServiceServer is the server API for ExampleService service.
+// All implementations must embed UnimplementedExampleServiceServer
+// for forward compatibility.
+//
+// ExampleService exercises the (mcp.options.tool) example_request option in
+// both of its accepted syntaxes.
+type ExampleServiceServer interface {
+	// Searches widgets. The example is written in text format.
+	SearchWidgets(context.Context, *SearchWidgetsRequest) (*SearchWidgetsResponse, error)
+	// Counts widgets. The example is written as JSON.
+	CountWidgets(context.Context, *CountWidgetsRequest) (*CountWidgetsResponse, error)
+	mustEmbedUnimplementedExampleServiceServer()
+}
+
+// UnimplementedExampleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExampleServiceServer struct{}
+
+func (UnimplementedExampleServiceServer) SearchWidgets(context.Context, *SearchWidgetsRequest) (*SearchWidgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchWidgets not implemented")
+}
+func (UnimplementedExampleServiceServer) CountWidgets(context.Context, *CountWidgetsRequest) (*CountWidgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountWidgets not implemented")
+}
+func (UnimplementedExampleServiceServer) mustEmbedUnimplementedExampleServiceServer() {}
+func (UnimplementedExampleServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeExampleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExampleServiceServer will
+// result in compilation errors.
+type UnsafeExampleServiceServer interface {
+	mustEmbedUnimplementedExampleServiceServer()
+}
+
+func RegisterExampleServiceServer(s grpc.ServiceRegistrar, srv ExampleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedExampleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExampleService_ServiceDesc, srv)
+}
+
+func _ExampleService_SearchWidgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchWidgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).SearchWidgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_SearchWidgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).SearchWidgets(ctx, req.(*SearchWidgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExampleService_CountWidgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountWidgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).CountWidgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_CountWidgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).CountWidgets(ctx, req.(*CountWidgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExampleService_ServiceDesc is the grpc.ServiceDesc for ExampleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExampleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.ExampleService",
+	HandlerType: (*ExampleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchWidgets",
+			Handler:    _ExampleService_SearchWidgets_Handler,
+		},
+		{
+			MethodName: "CountWidgets",
+			Handler:    _ExampleService_CountWidgets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/example_request_test.proto",
+}
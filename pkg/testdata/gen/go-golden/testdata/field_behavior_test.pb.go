@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/field_behavior_test.proto
+
+package testdata
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Account struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Account name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Initial password. Never returned.
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Server-assigned identifier.
+	Uid string `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Contact details.
+	Contact       *AccountContact `protobuf:"bytes,4,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_testdata_field_behavior_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_field_behavior_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_testdata_field_behavior_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Account) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Account) GetContact() *AccountContact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+type AccountContact struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Contact email.
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// Set by the server once the email has been verified.
+	Verified bool `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	// One-time verification code sent by the caller.
+	VerificationCode string `protobuf:"bytes,3,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AccountContact) Reset() {
+	*x = AccountContact{}
+	mi := &file_testdata_field_behavior_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountContact) ProtoMessage() {}
+
+func (x *AccountContact) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_field_behavior_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountContact.ProtoReflect.Descriptor instead.
+func (*AccountContact) Descriptor() ([]byte, []int) {
+	return file_testdata_field_behavior_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccountContact) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AccountContact) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *AccountContact) GetVerificationCode() string {
+	if x != nil {
+		return x.VerificationCode
+	}
+	return ""
+}
+
+var File_testdata_field_behavior_test_proto protoreflect.FileDescriptor
+
+const file_testdata_field_behavior_test_proto_rawDesc = "" +
+	"\n" +
+	"\"testdata/field_behavior_test.proto\x12\btestdata\x1a\x1fgoogle/api/field_behavior.proto\"\x91\x01\n" +
+	"\aAccount\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12\"\n" +
+	"\bpassword\x18\x02 \x01(\tB\x06\xe0A\x04\xe0A\x02R\bpassword\x12\x15\n" +
+	"\x03uid\x18\x03 \x01(\tB\x03\xe0A\x03R\x03uid\x122\n" +
+	"\acontact\x18\x04 \x01(\v2\x18.testdata.AccountContactR\acontact\"y\n" +
+	"\x0eAccountContact\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1f\n" +
+	"\bverified\x18\x02 \x01(\bB\x03\xe0A\x03R\bverified\x120\n" +
+	"\x11verification_code\x18\x03 \x01(\tB\x03\xe0A\x04R\x10verificationCode2M\n" +
+	"\x14FieldBehaviorService\x125\n" +
+	"\rUpsertAccount\x12\x11.testdata.Account\x1a\x11.testdata.AccountB\xb0\x01\n" +
+	"\fcom.testdataB\x16FieldBehaviorTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_field_behavior_test_proto_rawDescOnce sync.Once
+	file_testdata_field_behavior_test_proto_rawDescData []byte
+)
+
+func file_testdata_field_behavior_test_proto_rawDescGZIP() []byte {
+	file_testdata_field_behavior_test_proto_rawDescOnce.Do(func() {
+		file_testdata_field_behavior_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_field_behavior_test_proto_rawDesc), len(file_testdata_field_behavior_test_proto_rawDesc)))
+	})
+	return file_testdata_field_behavior_test_proto_rawDescData
+}
+
+var file_testdata_field_behavior_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_field_behavior_test_proto_goTypes = []any{
+	(*Account)(nil),        // 0: testdata.Account
+	(*AccountContact)(nil), // 1: testdata.AccountContact
+}
+var file_testdata_field_behavior_test_proto_depIdxs = []int32{
+	1, // 0: testdata.Account.contact:type_name -> testdata.AccountContact
+	0, // 1: testdata.FieldBehaviorService.UpsertAccount:input_type -> testdata.Account
+	0, // 2: testdata.FieldBehaviorService.UpsertAccount:output_type -> testdata.Account
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_testdata_field_behavior_test_proto_init() }
+func file_testdata_field_behavior_test_proto_init() {
+	if File_testdata_field_behavior_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_field_behavior_test_proto_rawDesc), len(file_testdata_field_behavior_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_field_behavior_test_proto_goTypes,
+		DependencyIndexes: file_testdata_field_behavior_test_proto_depIdxs,
+		MessageInfos:      file_testdata_field_behavior_test_proto_msgTypes,
+	}.Build()
+	File_testdata_field_behavior_test_proto = out.File
+	file_testdata_field_behavior_test_proto_goTypes = nil
+	file_testdata_field_behavior_test_proto_depIdxs = nil
+}
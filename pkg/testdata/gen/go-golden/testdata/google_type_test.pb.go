@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/google_type_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	date "google.golang.org/genproto/googleapis/type/date"
+	latlng "google.golang.org/genproto/googleapis/type/latlng"
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheckIn       *date.Date             `protobuf:"bytes,1,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	BlackoutDates []*date.Date           `protobuf:"bytes,2,rep,name=blackout_dates,json=blackoutDates,proto3" json:"blackout_dates,omitempty"`
+	Price         *money.Money           `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	Location      *latlng.LatLng         `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Guest         *BookingGuest          `protobuf:"bytes,5,opt,name=guest,proto3" json:"guest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingRequest) Reset() {
+	*x = CreateBookingRequest{}
+	mi := &file_testdata_google_type_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingRequest) ProtoMessage() {}
+
+func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_google_type_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_google_type_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateBookingRequest) GetCheckIn() *date.Date {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetBlackoutDates() []*date.Date {
+	if x != nil {
+		return x.BlackoutDates
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetLocation() *latlng.LatLng {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetGuest() *BookingGuest {
+	if x != nil {
+		return x.Guest
+	}
+	return nil
+}
+
+type BookingGuest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BirthDate     *date.Date             `protobuf:"bytes,2,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingGuest) Reset() {
+	*x = BookingGuest{}
+	mi := &file_testdata_google_type_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingGuest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingGuest) ProtoMessage() {}
+
+func (x *BookingGuest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_google_type_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingGuest.ProtoReflect.Descriptor instead.
+func (*BookingGuest) Descriptor() ([]byte, []int) {
+	return file_testdata_google_type_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BookingGuest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BookingGuest) GetBirthDate() *date.Date {
+	if x != nil {
+		return x.BirthDate
+	}
+	return nil
+}
+
+type CreateBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	CheckIn       *date.Date             `protobuf:"bytes,2,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingResponse) Reset() {
+	*x = CreateBookingResponse{}
+	mi := &file_testdata_google_type_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingResponse) ProtoMessage() {}
+
+func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_google_type_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_google_type_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateBookingResponse) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CreateBookingResponse) GetCheckIn() *date.Date {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+var File_testdata_google_type_test_proto protoreflect.FileDescriptor
+
+const file_testdata_google_type_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1ftestdata/google_type_test.proto\x12\btestdata\x1a\x16google/type/date.proto\x1a\x18google/type/latlng.proto\x1a\x17google/type/money.proto\x1a\x19mcp/options/options.proto\"\x87\x02\n" +
+	"\x14CreateBookingRequest\x12,\n" +
+	"\bcheck_in\x18\x01 \x01(\v2\x11.google.type.DateR\acheckIn\x128\n" +
+	"\x0eblackout_dates\x18\x02 \x03(\v2\x11.google.type.DateR\rblackoutDates\x12(\n" +
+	"\x05price\x18\x03 \x01(\v2\x12.google.type.MoneyR\x05price\x12/\n" +
+	"\blocation\x18\x04 \x01(\v2\x13.google.type.LatLngR\blocation\x12,\n" +
+	"\x05guest\x18\x05 \x01(\v2\x16.testdata.BookingGuestR\x05guest\"T\n" +
+	"\fBookingGuest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
+	"\n" +
+	"birth_date\x18\x02 \x01(\v2\x11.google.type.DateR\tbirthDate\"d\n" +
+	"\x15CreateBookingResponse\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12,\n" +
+	"\bcheck_in\x18\x02 \x01(\v2\x11.google.type.DateR\acheckIn2\xae\x02\n" +
+	"\x0eBookingService\x12\x9b\x02\n" +
+	"\rCreateBooking\x12\x1e.testdata.CreateBookingRequest\x1a\x1f.testdata.CreateBookingResponse\"\xc8\x01\x92\xb5\x19\xc3\x01:\xc0\x01check_in { year: 2025 month: 6 day: 1 }blackout_dates { year: 2025 month: 12 day: 24 }price { currency_code: \"EUR\" units: 120 nanos: 500000000 }location { latitude: 48.8566 longitude: 2.3522 }B\xad\x01\n" +
+	"\fcom.testdataB\x13GoogleTypeTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_google_type_test_proto_rawDescOnce sync.Once
+	file_testdata_google_type_test_proto_rawDescData []byte
+)
+
+func file_testdata_google_type_test_proto_rawDescGZIP() []byte {
+	file_testdata_google_type_test_proto_rawDescOnce.Do(func() {
+		file_testdata_google_type_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_google_type_test_proto_rawDesc), len(file_testdata_google_type_test_proto_rawDesc)))
+	})
+	return file_testdata_google_type_test_proto_rawDescData
+}
+
+var file_testdata_google_type_test_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_testdata_google_type_test_proto_goTypes = []any{
+	(*CreateBookingRequest)(nil),  // 0: testdata.CreateBookingRequest
+	(*BookingGuest)(nil),          // 1: testdata.BookingGuest
+	(*CreateBookingResponse)(nil), // 2: testdata.CreateBookingResponse
+	(*date.Date)(nil),             // 3: google.type.Date
+	(*money.Money)(nil),           // 4: google.type.Money
+	(*latlng.LatLng)(nil),         // 5: google.type.LatLng
+}
+var file_testdata_google_type_test_proto_depIdxs = []int32{
+	3, // 0: testdata.CreateBookingRequest.check_in:type_name -> google.type.Date
+	3, // 1: testdata.CreateBookingRequest.blackout_dates:type_name -> google.type.Date
+	4, // 2: testdata.CreateBookingRequest.price:type_name -> google.type.Money
+	5, // 3: testdata.CreateBookingRequest.location:type_name -> google.type.LatLng
+	1, // 4: testdata.CreateBookingRequest.guest:type_name -> testdata.BookingGuest
+	3, // 5: testdata.BookingGuest.birth_date:type_name -> google.type.Date
+	3, // 6: testdata.CreateBookingResponse.check_in:type_name -> google.type.Date
+	0, // 7: testdata.BookingService.CreateBooking:input_type -> testdata.CreateBookingRequest
+	2, // 8: testdata.BookingService.CreateBooking:output_type -> testdata.CreateBookingResponse
+	8, // [8:9] is the sub-list for method output_type
+	7, // [7:8] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_testdata_google_type_test_proto_init() }
+func file_testdata_google_type_test_proto_init() {
+	if File_testdata_google_type_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_google_type_test_proto_rawDesc), len(file_testdata_google_type_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_google_type_test_proto_goTypes,
+		DependencyIndexes: file_testdata_google_type_test_proto_depIdxs,
+		MessageInfos:      file_testdata_google_type_test_proto_msgTypes,
+	}.Build()
+	File_testdata_google_type_test_proto = out.File
+	file_testdata_google_type_test_proto_goTypes = nil
+	file_testdata_google_type_test_proto_depIdxs = nil
+}
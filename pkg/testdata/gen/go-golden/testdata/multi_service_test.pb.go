@@ -0,0 +1,371 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/multi_service_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PlaceOrderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payment:
+	//
+	//	*PlaceOrderRequest_CardToken
+	//	*PlaceOrderRequest_VoucherCode
+	Payment       isPlaceOrderRequest_Payment `protobuf_oneof:"payment"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceOrderRequest) Reset() {
+	*x = PlaceOrderRequest{}
+	mi := &file_testdata_multi_service_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderRequest) ProtoMessage() {}
+
+func (x *PlaceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_multi_service_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_multi_service_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlaceOrderRequest) GetPayment() isPlaceOrderRequest_Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *PlaceOrderRequest) GetCardToken() string {
+	if x != nil {
+		if x, ok := x.Payment.(*PlaceOrderRequest_CardToken); ok {
+			return x.CardToken
+		}
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetVoucherCode() string {
+	if x != nil {
+		if x, ok := x.Payment.(*PlaceOrderRequest_VoucherCode); ok {
+			return x.VoucherCode
+		}
+	}
+	return ""
+}
+
+type isPlaceOrderRequest_Payment interface {
+	isPlaceOrderRequest_Payment()
+}
+
+type PlaceOrderRequest_CardToken struct {
+	CardToken string `protobuf:"bytes,1,opt,name=card_token,json=cardToken,proto3,oneof"`
+}
+
+type PlaceOrderRequest_VoucherCode struct {
+	VoucherCode string `protobuf:"bytes,2,opt,name=voucher_code,json=voucherCode,proto3,oneof"`
+}
+
+func (*PlaceOrderRequest_CardToken) isPlaceOrderRequest_Payment() {}
+
+func (*PlaceOrderRequest_VoucherCode) isPlaceOrderRequest_Payment() {}
+
+type PlaceOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceOrderResponse) Reset() {
+	*x = PlaceOrderResponse{}
+	mi := &file_testdata_multi_service_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderResponse) ProtoMessage() {}
+
+func (x *PlaceOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_multi_service_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderResponse.ProtoReflect.Descriptor instead.
+func (*PlaceOrderResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_multi_service_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlaceOrderResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ReserveStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sku   string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*ReserveStockRequest_Warehouse
+	//	*ReserveStockRequest_Store
+	Target        isReserveStockRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_testdata_multi_service_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_multi_service_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_multi_service_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReserveStockRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetTarget() isReserveStockRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ReserveStockRequest) GetWarehouse() string {
+	if x != nil {
+		if x, ok := x.Target.(*ReserveStockRequest_Warehouse); ok {
+			return x.Warehouse
+		}
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetStore() string {
+	if x != nil {
+		if x, ok := x.Target.(*ReserveStockRequest_Store); ok {
+			return x.Store
+		}
+	}
+	return ""
+}
+
+type isReserveStockRequest_Target interface {
+	isReserveStockRequest_Target()
+}
+
+type ReserveStockRequest_Warehouse struct {
+	Warehouse string `protobuf:"bytes,2,opt,name=warehouse,proto3,oneof"`
+}
+
+type ReserveStockRequest_Store struct {
+	Store string `protobuf:"bytes,3,opt,name=store,proto3,oneof"`
+}
+
+func (*ReserveStockRequest_Warehouse) isReserveStockRequest_Target() {}
+
+func (*ReserveStockRequest_Store) isReserveStockRequest_Target() {}
+
+type ReserveStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reserved      bool                   `protobuf:"varint,1,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockResponse) Reset() {
+	*x = ReserveStockResponse{}
+	mi := &file_testdata_multi_service_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockResponse) ProtoMessage() {}
+
+func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_multi_service_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_multi_service_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReserveStockResponse) GetReserved() bool {
+	if x != nil {
+		return x.Reserved
+	}
+	return false
+}
+
+var File_testdata_multi_service_test_proto protoreflect.FileDescriptor
+
+const file_testdata_multi_service_test_proto_rawDesc = "" +
+	"\n" +
+	"!testdata/multi_service_test.proto\x12\btestdata\"d\n" +
+	"\x11PlaceOrderRequest\x12\x1f\n" +
+	"\n" +
+	"card_token\x18\x01 \x01(\tH\x00R\tcardToken\x12#\n" +
+	"\fvoucher_code\x18\x02 \x01(\tH\x00R\vvoucherCodeB\t\n" +
+	"\apayment\"/\n" +
+	"\x12PlaceOrderResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"i\n" +
+	"\x13ReserveStockRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1e\n" +
+	"\twarehouse\x18\x02 \x01(\tH\x00R\twarehouse\x12\x16\n" +
+	"\x05store\x18\x03 \x01(\tH\x00R\x05storeB\b\n" +
+	"\x06target\"2\n" +
+	"\x14ReserveStockResponse\x12\x1a\n" +
+	"\breserved\x18\x01 \x01(\bR\breserved2W\n" +
+	"\fOrderService\x12G\n" +
+	"\n" +
+	"PlaceOrder\x12\x1b.testdata.PlaceOrderRequest\x1a\x1c.testdata.PlaceOrderResponse2a\n" +
+	"\x10InventoryService\x12M\n" +
+	"\fReserveStock\x12\x1d.testdata.ReserveStockRequest\x1a\x1e.testdata.ReserveStockResponseB\xaf\x01\n" +
+	"\fcom.testdataB\x15MultiServiceTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_multi_service_test_proto_rawDescOnce sync.Once
+	file_testdata_multi_service_test_proto_rawDescData []byte
+)
+
+func file_testdata_multi_service_test_proto_rawDescGZIP() []byte {
+	file_testdata_multi_service_test_proto_rawDescOnce.Do(func() {
+		file_testdata_multi_service_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_multi_service_test_proto_rawDesc), len(file_testdata_multi_service_test_proto_rawDesc)))
+	})
+	return file_testdata_multi_service_test_proto_rawDescData
+}
+
+var file_testdata_multi_service_test_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_testdata_multi_service_test_proto_goTypes = []any{
+	(*PlaceOrderRequest)(nil),    // 0: testdata.PlaceOrderRequest
+	(*PlaceOrderResponse)(nil),   // 1: testdata.PlaceOrderResponse
+	(*ReserveStockRequest)(nil),  // 2: testdata.ReserveStockRequest
+	(*ReserveStockResponse)(nil), // 3: testdata.ReserveStockResponse
+}
+var file_testdata_multi_service_test_proto_depIdxs = []int32{
+	0, // 0: testdata.OrderService.PlaceOrder:input_type -> testdata.PlaceOrderRequest
+	2, // 1: testdata.InventoryService.ReserveStock:input_type -> testdata.ReserveStockRequest
+	1, // 2: testdata.OrderService.PlaceOrder:output_type -> testdata.PlaceOrderResponse
+	3, // 3: testdata.InventoryService.ReserveStock:output_type -> testdata.ReserveStockResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_testdata_multi_service_test_proto_init() }
+func file_testdata_multi_service_test_proto_init() {
+	if File_testdata_multi_service_test_proto != nil {
+		return
+	}
+	file_testdata_multi_service_test_proto_msgTypes[0].OneofWrappers = []any{
+		(*PlaceOrderRequest_CardToken)(nil),
+		(*PlaceOrderRequest_VoucherCode)(nil),
+	}
+	file_testdata_multi_service_test_proto_msgTypes[2].OneofWrappers = []any{
+		(*ReserveStockRequest_Warehouse)(nil),
+		(*ReserveStockRequest_Store)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_multi_service_test_proto_rawDesc), len(file_testdata_multi_service_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_testdata_multi_service_test_proto_goTypes,
+		DependencyIndexes: file_testdata_multi_service_test_proto_depIdxs,
+		MessageInfos:      file_testdata_multi_service_test_proto_msgTypes,
+	}.Build()
+	File_testdata_multi_service_test_proto = out.File
+	file_testdata_multi_service_test_proto_goTypes = nil
+	file_testdata_multi_service_test_proto_depIdxs = nil
+}
@@ -0,0 +1,344 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/nested_wkt_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PlanTripRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Itinerary     *Itinerary             `protobuf:"bytes,1,opt,name=itinerary,proto3" json:"itinerary,omitempty"`
+	Alternatives  []*Itinerary           `protobuf:"bytes,2,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanTripRequest) Reset() {
+	*x = PlanTripRequest{}
+	mi := &file_testdata_nested_wkt_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanTripRequest) ProtoMessage() {}
+
+func (x *PlanTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_nested_wkt_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanTripRequest.ProtoReflect.Descriptor instead.
+func (*PlanTripRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_nested_wkt_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlanTripRequest) GetItinerary() *Itinerary {
+	if x != nil {
+		return x.Itinerary
+	}
+	return nil
+}
+
+func (x *PlanTripRequest) GetAlternatives() []*Itinerary {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+type Itinerary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FirstLeg      *TripLeg               `protobuf:"bytes,2,opt,name=first_leg,json=firstLeg,proto3" json:"first_leg,omitempty"`
+	LegsByCity    map[string]*TripLeg    `protobuf:"bytes,3,rep,name=legs_by_city,json=legsByCity,proto3" json:"legs_by_city,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Itinerary) Reset() {
+	*x = Itinerary{}
+	mi := &file_testdata_nested_wkt_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Itinerary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Itinerary) ProtoMessage() {}
+
+func (x *Itinerary) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_nested_wkt_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Itinerary.ProtoReflect.Descriptor instead.
+func (*Itinerary) Descriptor() ([]byte, []int) {
+	return file_testdata_nested_wkt_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Itinerary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Itinerary) GetFirstLeg() *TripLeg {
+	if x != nil {
+		return x.FirstLeg
+	}
+	return nil
+}
+
+func (x *Itinerary) GetLegsByCity() map[string]*TripLeg {
+	if x != nil {
+		return x.LegsByCity
+	}
+	return nil
+}
+
+type TripLeg struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	DepartsAt     *timestamppb.Timestamp   `protobuf:"bytes,1,opt,name=departs_at,json=departsAt,proto3" json:"departs_at,omitempty"`
+	Length        *durationpb.Duration     `protobuf:"bytes,2,opt,name=length,proto3" json:"length,omitempty"`
+	Notes         *structpb.Struct         `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	StopsAt       []*timestamppb.Timestamp `protobuf:"bytes,4,rep,name=stops_at,json=stopsAt,proto3" json:"stops_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripLeg) Reset() {
+	*x = TripLeg{}
+	mi := &file_testdata_nested_wkt_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripLeg) ProtoMessage() {}
+
+func (x *TripLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_nested_wkt_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripLeg.ProtoReflect.Descriptor instead.
+func (*TripLeg) Descriptor() ([]byte, []int) {
+	return file_testdata_nested_wkt_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TripLeg) GetDepartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartsAt
+	}
+	return nil
+}
+
+func (x *TripLeg) GetLength() *durationpb.Duration {
+	if x != nil {
+		return x.Length
+	}
+	return nil
+}
+
+func (x *TripLeg) GetNotes() *structpb.Struct {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *TripLeg) GetStopsAt() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.StopsAt
+	}
+	return nil
+}
+
+type PlanTripResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Itinerary     *Itinerary             `protobuf:"bytes,1,opt,name=itinerary,proto3" json:"itinerary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanTripResponse) Reset() {
+	*x = PlanTripResponse{}
+	mi := &file_testdata_nested_wkt_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanTripResponse) ProtoMessage() {}
+
+func (x *PlanTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_nested_wkt_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanTripResponse.ProtoReflect.Descriptor instead.
+func (*PlanTripResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_nested_wkt_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlanTripResponse) GetItinerary() *Itinerary {
+	if x != nil {
+		return x.Itinerary
+	}
+	return nil
+}
+
+var File_testdata_nested_wkt_test_proto protoreflect.FileDescriptor
+
+const file_testdata_nested_wkt_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1etestdata/nested_wkt_test.proto\x12\btestdata\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"}\n" +
+	"\x0fPlanTripRequest\x121\n" +
+	"\titinerary\x18\x01 \x01(\v2\x13.testdata.ItineraryR\titinerary\x127\n" +
+	"\falternatives\x18\x02 \x03(\v2\x13.testdata.ItineraryR\falternatives\"\xe8\x01\n" +
+	"\tItinerary\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12.\n" +
+	"\tfirst_leg\x18\x02 \x01(\v2\x11.testdata.TripLegR\bfirstLeg\x12E\n" +
+	"\flegs_by_city\x18\x03 \x03(\v2#.testdata.Itinerary.LegsByCityEntryR\n" +
+	"legsByCity\x1aP\n" +
+	"\x0fLegsByCityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
+	"\x05value\x18\x02 \x01(\v2\x11.testdata.TripLegR\x05value:\x028\x01\"\xdd\x01\n" +
+	"\aTripLeg\x129\n" +
+	"\n" +
+	"departs_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tdepartsAt\x121\n" +
+	"\x06length\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06length\x12-\n" +
+	"\x05notes\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x05notes\x125\n" +
+	"\bstops_at\x18\x04 \x03(\v2\x1a.google.protobuf.TimestampR\astopsAt\"E\n" +
+	"\x10PlanTripResponse\x121\n" +
+	"\titinerary\x18\x01 \x01(\v2\x13.testdata.ItineraryR\titinerary2P\n" +
+	"\vTripService\x12A\n" +
+	"\bPlanTrip\x12\x19.testdata.PlanTripRequest\x1a\x1a.testdata.PlanTripResponseB\xac\x01\n" +
+	"\fcom.testdataB\x12NestedWktTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_nested_wkt_test_proto_rawDescOnce sync.Once
+	file_testdata_nested_wkt_test_proto_rawDescData []byte
+)
+
+func file_testdata_nested_wkt_test_proto_rawDescGZIP() []byte {
+	file_testdata_nested_wkt_test_proto_rawDescOnce.Do(func() {
+		file_testdata_nested_wkt_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_nested_wkt_test_proto_rawDesc), len(file_testdata_nested_wkt_test_proto_rawDesc)))
+	})
+	return file_testdata_nested_wkt_test_proto_rawDescData
+}
+
+var file_testdata_nested_wkt_test_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_testdata_nested_wkt_test_proto_goTypes = []any{
+	(*PlanTripRequest)(nil),       // 0: testdata.PlanTripRequest
+	(*Itinerary)(nil),             // 1: testdata.Itinerary
+	(*TripLeg)(nil),               // 2: testdata.TripLeg
+	(*PlanTripResponse)(nil),      // 3: testdata.PlanTripResponse
+	nil,                           // 4: testdata.Itinerary.LegsByCityEntry
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 6: google.protobuf.Duration
+	(*structpb.Struct)(nil),       // 7: google.protobuf.Struct
+}
+var file_testdata_nested_wkt_test_proto_depIdxs = []int32{
+	1,  // 0: testdata.PlanTripRequest.itinerary:type_name -> testdata.Itinerary
+	1,  // 1: testdata.PlanTripRequest.alternatives:type_name -> testdata.Itinerary
+	2,  // 2: testdata.Itinerary.first_leg:type_name -> testdata.TripLeg
+	4,  // 3: testdata.Itinerary.legs_by_city:type_name -> testdata.Itinerary.LegsByCityEntry
+	5,  // 4: testdata.TripLeg.departs_at:type_name -> google.protobuf.Timestamp
+	6,  // 5: testdata.TripLeg.length:type_name -> google.protobuf.Duration
+	7,  // 6: testdata.TripLeg.notes:type_name -> google.protobuf.Struct
+	5,  // 7: testdata.TripLeg.stops_at:type_name -> google.protobuf.Timestamp
+	1,  // 8: testdata.PlanTripResponse.itinerary:type_name -> testdata.Itinerary
+	2,  // 9: testdata.Itinerary.LegsByCityEntry.value:type_name -> testdata.TripLeg
+	0,  // 10: testdata.TripService.PlanTrip:input_type -> testdata.PlanTripRequest
+	3,  // 11: testdata.TripService.PlanTrip:output_type -> testdata.PlanTripResponse
+	11, // [11:12] is the sub-list for method output_type
+	10, // [10:11] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_testdata_nested_wkt_test_proto_init() }
+func file_testdata_nested_wkt_test_proto_init() {
+	if File_testdata_nested_wkt_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_nested_wkt_test_proto_rawDesc), len(file_testdata_nested_wkt_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_nested_wkt_test_proto_goTypes,
+		DependencyIndexes: file_testdata_nested_wkt_test_proto_depIdxs,
+		MessageInfos:      file_testdata_nested_wkt_test_proto_msgTypes,
+	}.Build()
+	File_testdata_nested_wkt_test_proto = out.File
+	file_testdata_nested_wkt_test_proto_goTypes = nil
+	file_testdata_nested_wkt_test_proto_depIdxs = nil
+}
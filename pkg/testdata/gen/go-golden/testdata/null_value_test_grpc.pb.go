@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/null_value_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NicknameService_UpdateNickname_FullMethodName = "/testdata.NicknameService/UpdateNickname"
+)
+
+// NicknameServiceClient is the client API for NicknameService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NicknameService sets or clears nicknames, with NullValue fields standing
+// for an explicit null.
+type NicknameServiceClient interface {
+	UpdateNickname(ctx context.Context, in *UpdateNicknameRequest, opts ...grpc.CallOption) (*UpdateNicknameResponse, error)
+}
+
+type nicknameServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNicknameServiceClient(cc grpc.ClientConnInterface) NicknameServiceClient {
+	return &nicknameServiceClient{cc}
+}
+
+func (c *nicknameServiceClient) UpdateNickname(ctx context.Context, in *UpdateNicknameRequest, opts ...grpc.CallOption) (*UpdateNicknameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNicknameResponse)
+	err := c.cc.Invoke(ctx, NicknameService_UpdateNickname_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NicknameServiceServer is the server API for NicknameService service.
+// All implementations must embed UnimplementedNicknameServiceServer
+// for forward compatibility.
+//
+// NicknameService sets or clears nicknames, with NullValue fields standing
+// for an explicit null.
+type NicknameServiceServer interface {
+	UpdateNickname(context.Context, *UpdateNicknameRequest) (*UpdateNicknameResponse, error)
+	mustEmbedUnimplementedNicknameServiceServer()
+}
+
+// UnimplementedNicknameServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNicknameServiceServer struct{}
+
+func (UnimplementedNicknameServiceServer) UpdateNickname(context.Context, *UpdateNicknameRequest) (*UpdateNicknameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNickname not implemented")
+}
+func (UnimplementedNicknameServiceServer) mustEmbedUnimplementedNicknameServiceServer() {}
+func (UnimplementedNicknameServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeNicknameServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NicknameServiceServer will
+// result in compilation errors.
+type UnsafeNicknameServiceServer interface {
+	mustEmbedUnimplementedNicknameServiceServer()
+}
+
+func RegisterNicknameServiceServer(s grpc.ServiceRegistrar, srv NicknameServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNicknameServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NicknameService_ServiceDesc, srv)
+}
+
+func _NicknameService_UpdateNickname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNicknameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NicknameServiceServer).UpdateNickname(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NicknameService_UpdateNickname_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NicknameServiceServer).UpdateNickname(ctx, req.(*UpdateNicknameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NicknameService_ServiceDesc is the grpc.ServiceDesc for NicknameService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NicknameService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.NicknameService",
+	HandlerType: (*NicknameServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateNickname",
+			Handler:    _NicknameService_UpdateNickname_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/null_value_test.proto",
+}
@@ -0,0 +1,460 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/oneof_collection_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DefineSegmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Members:
+	//
+	//	*DefineSegmentRequest_UserIds
+	//	*DefineSegmentRequest_Filters
+	//	*DefineSegmentRequest_Query
+	Members       isDefineSegmentRequest_Members `protobuf_oneof:"members"`
+	Rules         []*SegmentRule                 `protobuf:"bytes,5,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefineSegmentRequest) Reset() {
+	*x = DefineSegmentRequest{}
+	mi := &file_testdata_oneof_collection_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefineSegmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefineSegmentRequest) ProtoMessage() {}
+
+func (x *DefineSegmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_oneof_collection_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefineSegmentRequest.ProtoReflect.Descriptor instead.
+func (*DefineSegmentRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_oneof_collection_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DefineSegmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DefineSegmentRequest) GetMembers() isDefineSegmentRequest_Members {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *DefineSegmentRequest) GetUserIds() *UserIdList {
+	if x != nil {
+		if x, ok := x.Members.(*DefineSegmentRequest_UserIds); ok {
+			return x.UserIds
+		}
+	}
+	return nil
+}
+
+func (x *DefineSegmentRequest) GetFilters() *AttributeFilters {
+	if x != nil {
+		if x, ok := x.Members.(*DefineSegmentRequest_Filters); ok {
+			return x.Filters
+		}
+	}
+	return nil
+}
+
+func (x *DefineSegmentRequest) GetQuery() string {
+	if x != nil {
+		if x, ok := x.Members.(*DefineSegmentRequest_Query); ok {
+			return x.Query
+		}
+	}
+	return ""
+}
+
+func (x *DefineSegmentRequest) GetRules() []*SegmentRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type isDefineSegmentRequest_Members interface {
+	isDefineSegmentRequest_Members()
+}
+
+type DefineSegmentRequest_UserIds struct {
+	// Explicit members.
+	UserIds *UserIdList `protobuf:"bytes,2,opt,name=user_ids,json=userIds,proto3,oneof"`
+}
+
+type DefineSegmentRequest_Filters struct {
+	// Attribute values members must have.
+	Filters *AttributeFilters `protobuf:"bytes,3,opt,name=filters,proto3,oneof"`
+}
+
+type DefineSegmentRequest_Query struct {
+	Query string `protobuf:"bytes,4,opt,name=query,proto3,oneof"`
+}
+
+func (*DefineSegmentRequest_UserIds) isDefineSegmentRequest_Members() {}
+
+func (*DefineSegmentRequest_Filters) isDefineSegmentRequest_Members() {}
+
+func (*DefineSegmentRequest_Query) isDefineSegmentRequest_Members() {}
+
+type UserIdList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserIdList) Reset() {
+	*x = UserIdList{}
+	mi := &file_testdata_oneof_collection_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserIdList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIdList) ProtoMessage() {}
+
+func (x *UserIdList) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_oneof_collection_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIdList.ProtoReflect.Descriptor instead.
+func (*UserIdList) Descriptor() ([]byte, []int) {
+	return file_testdata_oneof_collection_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserIdList) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type AttributeFilters struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ByAttribute   map[string]string      `protobuf:"bytes,1,rep,name=by_attribute,json=byAttribute,proto3" json:"by_attribute,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeFilters) Reset() {
+	*x = AttributeFilters{}
+	mi := &file_testdata_oneof_collection_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeFilters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeFilters) ProtoMessage() {}
+
+func (x *AttributeFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_oneof_collection_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeFilters.ProtoReflect.Descriptor instead.
+func (*AttributeFilters) Descriptor() ([]byte, []int) {
+	return file_testdata_oneof_collection_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AttributeFilters) GetByAttribute() map[string]string {
+	if x != nil {
+		return x.ByAttribute
+	}
+	return nil
+}
+
+type SegmentRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Match:
+	//
+	//	*SegmentRule_Exclude
+	//	*SegmentRule_Pattern
+	Match         isSegmentRule_Match `protobuf_oneof:"match"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentRule) Reset() {
+	*x = SegmentRule{}
+	mi := &file_testdata_oneof_collection_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentRule) ProtoMessage() {}
+
+func (x *SegmentRule) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_oneof_collection_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentRule.ProtoReflect.Descriptor instead.
+func (*SegmentRule) Descriptor() ([]byte, []int) {
+	return file_testdata_oneof_collection_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SegmentRule) GetMatch() isSegmentRule_Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *SegmentRule) GetExclude() *UserIdList {
+	if x != nil {
+		if x, ok := x.Match.(*SegmentRule_Exclude); ok {
+			return x.Exclude
+		}
+	}
+	return nil
+}
+
+func (x *SegmentRule) GetPattern() string {
+	if x != nil {
+		if x, ok := x.Match.(*SegmentRule_Pattern); ok {
+			return x.Pattern
+		}
+	}
+	return ""
+}
+
+type isSegmentRule_Match interface {
+	isSegmentRule_Match()
+}
+
+type SegmentRule_Exclude struct {
+	Exclude *UserIdList `protobuf:"bytes,1,opt,name=exclude,proto3,oneof"`
+}
+
+type SegmentRule_Pattern struct {
+	Pattern string `protobuf:"bytes,2,opt,name=pattern,proto3,oneof"`
+}
+
+func (*SegmentRule_Exclude) isSegmentRule_Match() {}
+
+func (*SegmentRule_Pattern) isSegmentRule_Match() {}
+
+type DefineSegmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MemberCount   int32                  `protobuf:"varint,2,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefineSegmentResponse) Reset() {
+	*x = DefineSegmentResponse{}
+	mi := &file_testdata_oneof_collection_test_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefineSegmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefineSegmentResponse) ProtoMessage() {}
+
+func (x *DefineSegmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_oneof_collection_test_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefineSegmentResponse.ProtoReflect.Descriptor instead.
+func (*DefineSegmentResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_oneof_collection_test_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DefineSegmentResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DefineSegmentResponse) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+var File_testdata_oneof_collection_test_proto protoreflect.FileDescriptor
+
+const file_testdata_oneof_collection_test_proto_rawDesc = "" +
+	"\n" +
+	"$testdata/oneof_collection_test.proto\x12\btestdata\"\xe5\x01\n" +
+	"\x14DefineSegmentRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x121\n" +
+	"\buser_ids\x18\x02 \x01(\v2\x14.testdata.UserIdListH\x00R\auserIds\x126\n" +
+	"\afilters\x18\x03 \x01(\v2\x1a.testdata.AttributeFiltersH\x00R\afilters\x12\x16\n" +
+	"\x05query\x18\x04 \x01(\tH\x00R\x05query\x12+\n" +
+	"\x05rules\x18\x05 \x03(\v2\x15.testdata.SegmentRuleR\x05rulesB\t\n" +
+	"\amembers\"'\n" +
+	"\n" +
+	"UserIdList\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"\xa2\x01\n" +
+	"\x10AttributeFilters\x12N\n" +
+	"\fby_attribute\x18\x01 \x03(\v2+.testdata.AttributeFilters.ByAttributeEntryR\vbyAttribute\x1a>\n" +
+	"\x10ByAttributeEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"d\n" +
+	"\vSegmentRule\x120\n" +
+	"\aexclude\x18\x01 \x01(\v2\x14.testdata.UserIdListH\x00R\aexclude\x12\x1a\n" +
+	"\apattern\x18\x02 \x01(\tH\x00R\apatternB\a\n" +
+	"\x05match\"N\n" +
+	"\x15DefineSegmentResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fmember_count\x18\x02 \x01(\x05R\vmemberCount2b\n" +
+	"\x0eSegmentService\x12P\n" +
+	"\rDefineSegment\x12\x1e.testdata.DefineSegmentRequest\x1a\x1f.testdata.DefineSegmentResponseB\xb2\x01\n" +
+	"\fcom.testdataB\x18OneofCollectionTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_oneof_collection_test_proto_rawDescOnce sync.Once
+	file_testdata_oneof_collection_test_proto_rawDescData []byte
+)
+
+func file_testdata_oneof_collection_test_proto_rawDescGZIP() []byte {
+	file_testdata_oneof_collection_test_proto_rawDescOnce.Do(func() {
+		file_testdata_oneof_collection_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_oneof_collection_test_proto_rawDesc), len(file_testdata_oneof_collection_test_proto_rawDesc)))
+	})
+	return file_testdata_oneof_collection_test_proto_rawDescData
+}
+
+var file_testdata_oneof_collection_test_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_testdata_oneof_collection_test_proto_goTypes = []any{
+	(*DefineSegmentRequest)(nil),  // 0: testdata.DefineSegmentRequest
+	(*UserIdList)(nil),            // 1: testdata.UserIdList
+	(*AttributeFilters)(nil),      // 2: testdata.AttributeFilters
+	(*SegmentRule)(nil),           // 3: testdata.SegmentRule
+	(*DefineSegmentResponse)(nil), // 4: testdata.DefineSegmentResponse
+	nil,                           // 5: testdata.AttributeFilters.ByAttributeEntry
+}
+var file_testdata_oneof_collection_test_proto_depIdxs = []int32{
+	1, // 0: testdata.DefineSegmentRequest.user_ids:type_name -> testdata.UserIdList
+	2, // 1: testdata.DefineSegmentRequest.filters:type_name -> testdata.AttributeFilters
+	3, // 2: testdata.DefineSegmentRequest.rules:type_name -> testdata.SegmentRule
+	5, // 3: testdata.AttributeFilters.by_attribute:type_name -> testdata.AttributeFilters.ByAttributeEntry
+	1, // 4: testdata.SegmentRule.exclude:type_name -> testdata.UserIdList
+	0, // 5: testdata.SegmentService.DefineSegment:input_type -> testdata.DefineSegmentRequest
+	4, // 6: testdata.SegmentService.DefineSegment:output_type -> testdata.DefineSegmentResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_testdata_oneof_collection_test_proto_init() }
+func file_testdata_oneof_collection_test_proto_init() {
+	if File_testdata_oneof_collection_test_proto != nil {
+		return
+	}
+	file_testdata_oneof_collection_test_proto_msgTypes[0].OneofWrappers = []any{
+		(*DefineSegmentRequest_UserIds)(nil),
+		(*DefineSegmentRequest_Filters)(nil),
+		(*DefineSegmentRequest_Query)(nil),
+	}
+	file_testdata_oneof_collection_test_proto_msgTypes[3].OneofWrappers = []any{
+		(*SegmentRule_Exclude)(nil),
+		(*SegmentRule_Pattern)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_oneof_collection_test_proto_rawDesc), len(file_testdata_oneof_collection_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_oneof_collection_test_proto_goTypes,
+		DependencyIndexes: file_testdata_oneof_collection_test_proto_depIdxs,
+		MessageInfos:      file_testdata_oneof_collection_test_proto_msgTypes,
+	}.Build()
+	File_testdata_oneof_collection_test_proto = out.File
+	file_testdata_oneof_collection_test_proto_goTypes = nil
+	file_testdata_oneof_collection_test_proto_depIdxs = nil
+}
@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/oneof_collection_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SegmentService_DefineSegment_FullMethodName = "/testdata.SegmentService/DefineSegment"
+)
+
+// SegmentServiceClient is the client API for SegmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SegmentService has a oneof whose variants are messages holding only a
+// repeated or a map field.
+type SegmentServiceClient interface {
+	DefineSegment(ctx context.Context, in *DefineSegmentRequest, opts ...grpc.CallOption) (*DefineSegmentResponse, error)
+}
+
+type segmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSegmentServiceClient(cc grpc.ClientConnInterface) SegmentServiceClient {
+	return &segmentServiceClient{cc}
+}
+
+func (c *segmentServiceClient) DefineSegment(ctx context.Context, in *DefineSegmentRequest, opts ...grpc.CallOption) (*DefineSegmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefineSegmentResponse)
+	err := c.cc.Invoke(ctx, SegmentService_DefineSegment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SegmentServiceServer is the server API for SegmentService service.
+// All implementations must embed UnimplementedSegmentServiceServer
+// for forward compatibility.
+//
+// SegmentService has a oneof whose variants are messages holding only a
+// repeated or a map field.
+type SegmentServiceServer interface {
+	DefineSegment(context.Context, *DefineSegmentRequest) (*DefineSegmentResponse, error)
+	mustEmbedUnimplementedSegmentServiceServer()
+}
+
+// UnimplementedSegmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSegmentServiceServer struct{}
+
+func (UnimplementedSegmentServiceServer) DefineSegment(context.Context, *DefineSegmentRequest) (*DefineSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefineSegment not implemented")
+}
+func (UnimplementedSegmentServiceServer) mustEmbedUnimplementedSegmentServiceServer() {}
+func (UnimplementedSegmentServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeSegmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SegmentServiceServer will
+// result in compilation errors.
+type UnsafeSegmentServiceServer interface {
+	mustEmbedUnimplementedSegmentServiceServer()
+}
+
+func RegisterSegmentServiceServer(s grpc.ServiceRegistrar, srv SegmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSegmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SegmentService_ServiceDesc, srv)
+}
+
+func _SegmentService_DefineSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefineSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServiceServer).DefineSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SegmentService_DefineSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServiceServer).DefineSegment(ctx, req.(*DefineSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SegmentService_ServiceDesc is the grpc.ServiceDesc for SegmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SegmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.SegmentService",
+	HandlerType: (*SegmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DefineSegment",
+			Handler:    _SegmentService_DefineSegment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/oneof_collection_test.proto",
+}
@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/oneof_discriminator_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AttributeService_SetAttribute_FullMethodName = "/testdata.AttributeService/SetAttribute"
+)
+
+// AttributeServiceClient is the client API for AttributeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AttributeService stores typed attributes.
+type AttributeServiceClient interface {
+	SetAttribute(ctx context.Context, in *SetAttributeRequest, opts ...grpc.CallOption) (*SetAttributeResponse, error)
+}
+
+type attributeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttributeServiceClient(cc grpc.ClientConnInterface) AttributeServiceClient {
+	return &attributeServiceClient{cc}
+}
+
+func (c *attributeServiceClient) SetAttribute(ctx context.Context, in *SetAttributeRequest, opts ...grpc.CallOption) (*SetAttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAttributeResponse)
+	err := c.cc.Invoke(ctx, AttributeService_SetAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttributeServiceServer is the server API for AttributeService service.
+// All implementations must embed UnimplementedAttributeServiceServer
+// for forward compatibility.
+//
+// AttributeService stores typed attributes.
+type AttributeServiceServer interface {
+	SetAttribute(context.Context, *SetAttributeRequest) (*SetAttributeResponse, error)
+	mustEmbedUnimplementedAttributeServiceServer()
+}
+
+// UnimplementedAttributeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttributeServiceServer struct{}
+
+func (UnimplementedAttributeServiceServer) SetAttribute(context.Context, *SetAttributeRequest) (*SetAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttribute not implemented")
+}
+func (UnimplementedAttributeServiceServer) mustEmbedUnimplementedAttributeServiceServer() {}
+func (UnimplementedAttributeServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeAttributeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttributeServiceServer will
+// result in compilation errors.
+type UnsafeAttributeServiceServer interface {
+	mustEmbedUnimplementedAttributeServiceServer()
+}
+
+func RegisterAttributeServiceServer(s grpc.ServiceRegistrar, srv AttributeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttributeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttributeService_ServiceDesc, srv)
+}
+
+func _AttributeService_SetAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServiceServer).SetAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttributeService_SetAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServiceServer).SetAttribute(ctx, req.(*SetAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttributeService_ServiceDesc is the grpc.ServiceDesc for AttributeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttributeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.AttributeService",
+	HandlerType: (*AttributeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetAttribute",
+			Handler:    _AttributeService_SetAttribute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/oneof_discriminator_test.proto",
+}
@@ -0,0 +1,322 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/repeated_rendering_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PlaceBulkOrderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lines rendered with the default of the generator.
+	Lines []*OrderLine `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	// Lines rendered inline.
+	InlineLines []*OrderLine `protobuf:"bytes,2,rep,name=inline_lines,json=inlineLines,proto3" json:"inline_lines,omitempty"`
+	// Lines rendered as a $ref.
+	RefLines []*OrderLine `protobuf:"bytes,3,rep,name=ref_lines,json=refLines,proto3" json:"ref_lines,omitempty"`
+	// Lines rendered as a summary.
+	SummaryLines  []*OrderLine `protobuf:"bytes,4,rep,name=summary_lines,json=summaryLines,proto3" json:"summary_lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceBulkOrderRequest) Reset() {
+	*x = PlaceBulkOrderRequest{}
+	mi := &file_testdata_repeated_rendering_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceBulkOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceBulkOrderRequest) ProtoMessage() {}
+
+func (x *PlaceBulkOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_repeated_rendering_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceBulkOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceBulkOrderRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_repeated_rendering_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlaceBulkOrderRequest) GetLines() []*OrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PlaceBulkOrderRequest) GetInlineLines() []*OrderLine {
+	if x != nil {
+		return x.InlineLines
+	}
+	return nil
+}
+
+func (x *PlaceBulkOrderRequest) GetRefLines() []*OrderLine {
+	if x != nil {
+		return x.RefLines
+	}
+	return nil
+}
+
+func (x *PlaceBulkOrderRequest) GetSummaryLines() []*OrderLine {
+	if x != nil {
+		return x.SummaryLines
+	}
+	return nil
+}
+
+type OrderLine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stock keeping unit.
+	Sku      string `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity int32  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Types that are valid to be assigned to Discount:
+	//
+	//	*OrderLine_PercentOff
+	//	*OrderLine_Coupon
+	Discount      isOrderLine_Discount `protobuf_oneof:"discount"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderLine) Reset() {
+	*x = OrderLine{}
+	mi := &file_testdata_repeated_rendering_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderLine) ProtoMessage() {}
+
+func (x *OrderLine) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_repeated_rendering_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderLine.ProtoReflect.Descriptor instead.
+func (*OrderLine) Descriptor() ([]byte, []int) {
+	return file_testdata_repeated_rendering_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderLine) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *OrderLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderLine) GetDiscount() isOrderLine_Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *OrderLine) GetPercentOff() int32 {
+	if x != nil {
+		if x, ok := x.Discount.(*OrderLine_PercentOff); ok {
+			return x.PercentOff
+		}
+	}
+	return 0
+}
+
+func (x *OrderLine) GetCoupon() string {
+	if x != nil {
+		if x, ok := x.Discount.(*OrderLine_Coupon); ok {
+			return x.Coupon
+		}
+	}
+	return ""
+}
+
+type isOrderLine_Discount interface {
+	isOrderLine_Discount()
+}
+
+type OrderLine_PercentOff struct {
+	PercentOff int32 `protobuf:"varint,3,opt,name=percent_off,json=percentOff,proto3,oneof"`
+}
+
+type OrderLine_Coupon struct {
+	Coupon string `protobuf:"bytes,4,opt,name=coupon,proto3,oneof"`
+}
+
+func (*OrderLine_PercentOff) isOrderLine_Discount() {}
+
+func (*OrderLine_Coupon) isOrderLine_Discount() {}
+
+type PlaceBulkOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accepted      int32                  `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceBulkOrderResponse) Reset() {
+	*x = PlaceBulkOrderResponse{}
+	mi := &file_testdata_repeated_rendering_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceBulkOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceBulkOrderResponse) ProtoMessage() {}
+
+func (x *PlaceBulkOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_repeated_rendering_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceBulkOrderResponse.ProtoReflect.Descriptor instead.
+func (*PlaceBulkOrderResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_repeated_rendering_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlaceBulkOrderResponse) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+var File_testdata_repeated_rendering_test_proto protoreflect.FileDescriptor
+
+const file_testdata_repeated_rendering_test_proto_rawDesc = "" +
+	"\n" +
+	"&testdata/repeated_rendering_test.proto\x12\btestdata\x1a\x19mcp/options/options.proto\"\xf8\x01\n" +
+	"\x15PlaceBulkOrderRequest\x12)\n" +
+	"\x05lines\x18\x01 \x03(\v2\x13.testdata.OrderLineR\x05lines\x12<\n" +
+	"\finline_lines\x18\x02 \x03(\v2\x13.testdata.OrderLineB\x04\xb0\xb2\x19\x01R\vinlineLines\x126\n" +
+	"\tref_lines\x18\x03 \x03(\v2\x13.testdata.OrderLineB\x04\xb0\xb2\x19\x02R\brefLines\x12>\n" +
+	"\rsummary_lines\x18\x04 \x03(\v2\x13.testdata.OrderLineB\x04\xb0\xb2\x19\x03R\fsummaryLines\"\x82\x01\n" +
+	"\tOrderLine\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12!\n" +
+	"\vpercent_off\x18\x03 \x01(\x05H\x00R\n" +
+	"percentOff\x12\x18\n" +
+	"\x06coupon\x18\x04 \x01(\tH\x00R\x06couponB\n" +
+	"\n" +
+	"\bdiscount\"4\n" +
+	"\x16PlaceBulkOrderResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x05R\baccepted2g\n" +
+	"\x10BulkOrderService\x12S\n" +
+	"\x0ePlaceBulkOrder\x12\x1f.testdata.PlaceBulkOrderRequest\x1a .testdata.PlaceBulkOrderResponseB\xb4\x01\n" +
+	"\fcom.testdataB\x1aRepeatedRenderingTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_repeated_rendering_test_proto_rawDescOnce sync.Once
+	file_testdata_repeated_rendering_test_proto_rawDescData []byte
+)
+
+func file_testdata_repeated_rendering_test_proto_rawDescGZIP() []byte {
+	file_testdata_repeated_rendering_test_proto_rawDescOnce.Do(func() {
+		file_testdata_repeated_rendering_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_repeated_rendering_test_proto_rawDesc), len(file_testdata_repeated_rendering_test_proto_rawDesc)))
+	})
+	return file_testdata_repeated_rendering_test_proto_rawDescData
+}
+
+var file_testdata_repeated_rendering_test_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_testdata_repeated_rendering_test_proto_goTypes = []any{
+	(*PlaceBulkOrderRequest)(nil),  // 0: testdata.PlaceBulkOrderRequest
+	(*OrderLine)(nil),              // 1: testdata.OrderLine
+	(*PlaceBulkOrderResponse)(nil), // 2: testdata.PlaceBulkOrderResponse
+}
+var file_testdata_repeated_rendering_test_proto_depIdxs = []int32{
+	1, // 0: testdata.PlaceBulkOrderRequest.lines:type_name -> testdata.OrderLine
+	1, // 1: testdata.PlaceBulkOrderRequest.inline_lines:type_name -> testdata.OrderLine
+	1, // 2: testdata.PlaceBulkOrderRequest.ref_lines:type_name -> testdata.OrderLine
+	1, // 3: testdata.PlaceBulkOrderRequest.summary_lines:type_name -> testdata.OrderLine
+	0, // 4: testdata.BulkOrderService.PlaceBulkOrder:input_type -> testdata.PlaceBulkOrderRequest
+	2, // 5: testdata.BulkOrderService.PlaceBulkOrder:output_type -> testdata.PlaceBulkOrderResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_testdata_repeated_rendering_test_proto_init() }
+func file_testdata_repeated_rendering_test_proto_init() {
+	if File_testdata_repeated_rendering_test_proto != nil {
+		return
+	}
+	file_testdata_repeated_rendering_test_proto_msgTypes[1].OneofWrappers = []any{
+		(*OrderLine_PercentOff)(nil),
+		(*OrderLine_Coupon)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_repeated_rendering_test_proto_rawDesc), len(file_testdata_repeated_rendering_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_repeated_rendering_test_proto_goTypes,
+		DependencyIndexes: file_testdata_repeated_rendering_test_proto_depIdxs,
+		MessageInfos:      file_testdata_repeated_rendering_test_proto_msgTypes,
+	}.Build()
+	File_testdata_repeated_rendering_test_proto = out.File
+	file_testdata_repeated_rendering_test_proto_goTypes = nil
+	file_testdata_repeated_rendering_test_proto_depIdxs = nil
+}
@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/repeated_rendering_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BulkOrderService_PlaceBulkOrder_FullMethodName = "/testdata.BulkOrderService/PlaceBulkOrder"
+)
+
+// BulkOrderServiceClient is the client API for BulkOrderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BulkOrderService exercises the (mcp.options.repeated_rendering) option.
+type BulkOrderServiceClient interface {
+	PlaceBulkOrder(ctx context.Context, in *PlaceBulkOrderRequest, opts ...grpc.CallOption) (*PlaceBulkOrderResponse, error)
+}
+
+type bulkOrderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBulkOrderServiceClient(cc grpc.ClientConnInterface) BulkOrderServiceClient {
+	return &bulkOrderServiceClient{cc}
+}
+
+func (c *bulkOrderServiceClient) PlaceBulkOrder(ctx context.Context, in *PlaceBulkOrderRequest, opts ...grpc.CallOption) (*PlaceBulkOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceBulkOrderResponse)
+	err := c.cc.Invoke(ctx, BulkOrderService_PlaceBulkOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BulkOrderServiceServer is the server API for BulkOrderService service.
+// All implementations must embed UnimplementedBulkOrderServiceServer
+// for forward compatibility.
+//
+// BulkOrderService exercises the (mcp.options.repeated_rendering) option.
+type BulkOrderServiceServer interface {
+	PlaceBulkOrder(context.Context, *PlaceBulkOrderRequest) (*PlaceBulkOrderResponse, error)
+	mustEmbedUnimplementedBulkOrderServiceServer()
+}
+
+// UnimplementedBulkOrderServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBulkOrderServiceServer struct{}
+
+func (UnimplementedBulkOrderServiceServer) PlaceBulkOrder(context.Context, *PlaceBulkOrderRequest) (*PlaceBulkOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBulkOrder not implemented")
+}
+func (UnimplementedBulkOrderServiceServer) mustEmbedUnimplementedBulkOrderServiceServer() {}
+func (UnimplementedBulkOrderServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeBulkOrderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BulkOrderServiceServer will
+// result in compilation errors.
+type UnsafeBulkOrderServiceServer interface {
+	mustEmbedUnimplementedBulkOrderServiceServer()
+}
+
+func RegisterBulkOrderServiceServer(s grpc.ServiceRegistrar, srv BulkOrderServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBulkOrderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BulkOrderService_ServiceDesc, srv)
+}
+
+func _BulkOrderService_PlaceBulkOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceBulkOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BulkOrderServiceServer).PlaceBulkOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BulkOrderService_PlaceBulkOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BulkOrderServiceServer).PlaceBulkOrder(ctx, req.(*PlaceBulkOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BulkOrderService_ServiceDesc is the grpc.ServiceDesc for BulkOrderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BulkOrderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.BulkOrderService",
+	HandlerType: (*BulkOrderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PlaceBulkOrder",
+			Handler:    _BulkOrderService_PlaceBulkOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/repeated_rendering_test.proto",
+}
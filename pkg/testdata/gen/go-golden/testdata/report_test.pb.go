@@ -0,0 +1,369 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/report_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	sourcecontextpb "google.golang.org/protobuf/types/known/sourcecontextpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SourceContext has no dedicated schema and is described as a plain message.
+	Origin        *sourcecontextpb.SourceContext `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	mi := &file_testdata_report_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_report_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_report_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PingRequest) GetOrigin() *sourcecontextpb.SourceContext {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+type PingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	mi := &file_testdata_report_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_report_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_report_test_proto_rawDescGZIP(), []int{1}
+}
+
+type WatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	mi := &file_testdata_report_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_report_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_report_test_proto_rawDescGZIP(), []int{2}
+}
+
+type WatchEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEvent) Reset() {
+	*x = WatchEvent{}
+	mi := &file_testdata_report_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEvent) ProtoMessage() {}
+
+func (x *WatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_report_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEvent.ProtoReflect.Descriptor instead.
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return file_testdata_report_test_proto_rawDescGZIP(), []int{3}
+}
+
+type UploadChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadChunk) Reset() {
+	*x = UploadChunk{}
+	mi := &file_testdata_report_test_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChunk) ProtoMessage() {}
+
+func (x *UploadChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_report_test_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadChunk.ProtoReflect.Descriptor instead.
+func (*UploadChunk) Descriptor() ([]byte, []int) {
+	return file_testdata_report_test_proto_rawDescGZIP(), []int{4}
+}
+
+type UploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadResponse) Reset() {
+	*x = UploadResponse{}
+	mi := &file_testdata_report_test_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadResponse) ProtoMessage() {}
+
+func (x *UploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_report_test_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadResponse.ProtoReflect.Descriptor instead.
+func (*UploadResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_report_test_proto_rawDescGZIP(), []int{5}
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_testdata_report_test_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_report_test_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_testdata_report_test_proto_rawDescGZIP(), []int{6}
+}
+
+var File_testdata_report_test_proto protoreflect.FileDescriptor
+
+const file_testdata_report_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1atestdata/report_test.proto\x12\btestdata\x1a$google/protobuf/source_context.proto\"E\n" +
+	"\vPingRequest\x126\n" +
+	"\x06origin\x18\x01 \x01(\v2\x1e.google.protobuf.SourceContextR\x06origin\"\x0e\n" +
+	"\fPingResponse\"\x0e\n" +
+	"\fWatchRequest\"\f\n" +
+	"\n" +
+	"WatchEvent\"\r\n" +
+	"\vUploadChunk\"\x10\n" +
+	"\x0eUploadResponse\"\r\n" +
+	"\vChatMessage2\xf6\x01\n" +
+	"\rReportService\x125\n" +
+	"\x04Ping\x12\x15.testdata.PingRequest\x1a\x16.testdata.PingResponse\x127\n" +
+	"\x05Watch\x12\x16.testdata.WatchRequest\x1a\x14.testdata.WatchEvent0\x01\x12;\n" +
+	"\x06Upload\x12\x15.testdata.UploadChunk\x1a\x18.testdata.UploadResponse(\x01\x128\n" +
+	"\x04Chat\x12\x15.testdata.ChatMessage\x1a\x15.testdata.ChatMessage(\x010\x01B\xa9\x01\n" +
+	"\fcom.testdataB\x0fReportTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_report_test_proto_rawDescOnce sync.Once
+	file_testdata_report_test_proto_rawDescData []byte
+)
+
+func file_testdata_report_test_proto_rawDescGZIP() []byte {
+	file_testdata_report_test_proto_rawDescOnce.Do(func() {
+		file_testdata_report_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_report_test_proto_rawDesc), len(file_testdata_report_test_proto_rawDesc)))
+	})
+	return file_testdata_report_test_proto_rawDescData
+}
+
+var file_testdata_report_test_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_testdata_report_test_proto_goTypes = []any{
+	(*PingRequest)(nil),                   // 0: testdata.PingRequest
+	(*PingResponse)(nil),                  // 1: testdata.PingResponse
+	(*WatchRequest)(nil),                  // 2: testdata.WatchRequest
+	(*WatchEvent)(nil),                    // 3: testdata.WatchEvent
+	(*UploadChunk)(nil),                   // 4: testdata.UploadChunk
+	(*UploadResponse)(nil),                // 5: testdata.UploadResponse
+	(*ChatMessage)(nil),                   // 6: testdata.ChatMessage
+	(*sourcecontextpb.SourceContext)(nil), // 7: google.protobuf.SourceContext
+}
+var file_testdata_report_test_proto_depIdxs = []int32{
+	7, // 0: testdata.PingRequest.origin:type_name -> google.protobuf.SourceContext
+	0, // 1: testdata.ReportService.Ping:input_type -> testdata.PingRequest
+	2, // 2: testdata.ReportService.Watch:input_type -> testdata.WatchRequest
+	4, // 3: testdata.ReportService.Upload:input_type -> testdata.UploadChunk
+	6, // 4: testdata.ReportService.Chat:input_type -> testdata.ChatMessage
+	1, // 5: testdata.ReportService.Ping:output_type -> testdata.PingResponse
+	3, // 6: testdata.ReportService.Watch:output_type -> testdata.WatchEvent
+	5, // 7: testdata.ReportService.Upload:output_type -> testdata.UploadResponse
+	6, // 8: testdata.ReportService.Chat:output_type -> testdata.ChatMessage
+	5, // [5:9] is the sub-list for method output_type
+	1, // [1:5] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_testdata_report_test_proto_init() }
+func file_testdata_report_test_proto_init() {
+	if File_testdata_report_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_report_test_proto_rawDesc), len(file_testdata_report_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_report_test_proto_goTypes,
+		DependencyIndexes: file_testdata_report_test_proto_depIdxs,
+		MessageInfos:      file_testdata_report_test_proto_msgTypes,
+	}.Build()
+	File_testdata_report_test_proto = out.File
+	file_testdata_report_test_proto_goTypes = nil
+	file_testdata_report_test_proto_depIdxs = nil
+}
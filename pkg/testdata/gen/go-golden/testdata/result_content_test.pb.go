@@ -0,0 +1,202 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/result_content_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleRequest) Reset() {
+	*x = GetArticleRequest{}
+	mi := &file_testdata_result_content_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleRequest) ProtoMessage() {}
+
+func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_result_content_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleRequest.ProtoReflect.Descriptor instead.
+func (*GetArticleRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_result_content_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetArticleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Article struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Article) Reset() {
+	*x = Article{}
+	mi := &file_testdata_result_content_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Article) ProtoMessage() {}
+
+func (x *Article) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_result_content_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Article.ProtoReflect.Descriptor instead.
+func (*Article) Descriptor() ([]byte, []int) {
+	return file_testdata_result_content_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Article) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Article) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+var File_testdata_result_content_test_proto protoreflect.FileDescriptor
+
+const file_testdata_result_content_test_proto_rawDesc = "" +
+	"\n" +
+	"\"testdata/result_content_test.proto\x12\btestdata\x1a\x19mcp/options/options.proto\"#\n" +
+	"\x11GetArticleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"G\n" +
+	"\aArticle\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags2\xe6\x01\n" +
+	"\x0eArticleService\x12~\n" +
+	"\rRenderArticle\x12\x1b.testdata.GetArticleRequest\x1a\x11.testdata.Article\"=\x92\xb5\x199r7# {{.title}}\n" +
+	"\n" +
+	"{{.body}}\n" +
+	"{{range .tags}}\n" +
+	"- {{.}}{{end}}\n" +
+	"\x12T\n" +
+	"\n" +
+	"GetArticle\x12\x1b.testdata.GetArticleRequest\x1a\x11.testdata.Article\"\x16\x92\xb5\x19\x12j\x10application/jsonB\xb0\x01\n" +
+	"\fcom.testdataB\x16ResultContentTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_result_content_test_proto_rawDescOnce sync.Once
+	file_testdata_result_content_test_proto_rawDescData []byte
+)
+
+func file_testdata_result_content_test_proto_rawDescGZIP() []byte {
+	file_testdata_result_content_test_proto_rawDescOnce.Do(func() {
+		file_testdata_result_content_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_result_content_test_proto_rawDesc), len(file_testdata_result_content_test_proto_rawDesc)))
+	})
+	return file_testdata_result_content_test_proto_rawDescData
+}
+
+var file_testdata_result_content_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_result_content_test_proto_goTypes = []any{
+	(*GetArticleRequest)(nil), // 0: testdata.GetArticleRequest
+	(*Article)(nil),           // 1: testdata.Article
+}
+var file_testdata_result_content_test_proto_depIdxs = []int32{
+	0, // 0: testdata.ArticleService.RenderArticle:input_type -> testdata.GetArticleRequest
+	0, // 1: testdata.ArticleService.GetArticle:input_type -> testdata.GetArticleRequest
+	1, // 2: testdata.ArticleService.RenderArticle:output_type -> testdata.Article
+	1, // 3: testdata.ArticleService.GetArticle:output_type -> testdata.Article
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_testdata_result_content_test_proto_init() }
+func file_testdata_result_content_test_proto_init() {
+	if File_testdata_result_content_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_result_content_test_proto_rawDesc), len(file_testdata_result_content_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_result_content_test_proto_goTypes,
+		DependencyIndexes: file_testdata_result_content_test_proto_depIdxs,
+		MessageInfos:      file_testdata_result_content_test_proto_msgTypes,
+	}.Build()
+	File_testdata_result_content_test_proto = out.File
+	file_testdata_result_content_test_proto_goTypes = nil
+	file_testdata_result_content_test_proto_depIdxs = nil
+}
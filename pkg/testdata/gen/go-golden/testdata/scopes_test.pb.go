@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/scopes_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PostEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	AmountCents   int64                  `protobuf:"varint,2,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostEntryRequest) Reset() {
+	*x = PostEntryRequest{}
+	mi := &file_testdata_scopes_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostEntryRequest) ProtoMessage() {}
+
+func (x *PostEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_scopes_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostEntryRequest.ProtoReflect.Descriptor instead.
+func (*PostEntryRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_scopes_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PostEntryRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *PostEntryRequest) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+type PostEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostEntryResponse) Reset() {
+	*x = PostEntryResponse{}
+	mi := &file_testdata_scopes_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostEntryResponse) ProtoMessage() {}
+
+func (x *PostEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_scopes_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostEntryResponse.ProtoReflect.Descriptor instead.
+func (*PostEntryResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_scopes_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PostEntryResponse) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+type ListEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEntriesRequest) Reset() {
+	*x = ListEntriesRequest{}
+	mi := &file_testdata_scopes_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntriesRequest) ProtoMessage() {}
+
+func (x *ListEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_scopes_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_scopes_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListEntriesRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type ListEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryIds      []string               `protobuf:"bytes,1,rep,name=entry_ids,json=entryIds,proto3" json:"entry_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEntriesResponse) Reset() {
+	*x = ListEntriesResponse{}
+	mi := &file_testdata_scopes_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntriesResponse) ProtoMessage() {}
+
+func (x *ListEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_scopes_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_scopes_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListEntriesResponse) GetEntryIds() []string {
+	if x != nil {
+		return x.EntryIds
+	}
+	return nil
+}
+
+var File_testdata_scopes_test_proto protoreflect.FileDescriptor
+
+const file_testdata_scopes_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1atestdata/scopes_test.proto\x12\btestdata\x1a\x19mcp/options/options.proto\"O\n" +
+	"\x10PostEntryRequest\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\x12!\n" +
+	"\famount_cents\x18\x02 \x01(\x03R\vamountCents\".\n" +
+	"\x11PostEntryResponse\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\".\n" +
+	"\x12ListEntriesRequest\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\"2\n" +
+	"\x13ListEntriesResponse\x12\x1b\n" +
+	"\tentry_ids\x18\x01 \x03(\tR\bentryIds2\xc2\x01\n" +
+	"\rLedgerService\x12e\n" +
+	"\tPostEntry\x12\x1a.testdata.PostEntryRequest\x1a\x1b.testdata.PostEntryResponse\"\x1f\x92\xb5\x19\x1bZ\fledger:writeZ\vledger:read\x12J\n" +
+	"\vListEntries\x12\x1c.testdata.ListEntriesRequest\x1a\x1d.testdata.ListEntriesResponseB\xa9\x01\n" +
+	"\fcom.testdataB\x0fScopesTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_scopes_test_proto_rawDescOnce sync.Once
+	file_testdata_scopes_test_proto_rawDescData []byte
+)
+
+func file_testdata_scopes_test_proto_rawDescGZIP() []byte {
+	file_testdata_scopes_test_proto_rawDescOnce.Do(func() {
+		file_testdata_scopes_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_scopes_test_proto_rawDesc), len(file_testdata_scopes_test_proto_rawDesc)))
+	})
+	return file_testdata_scopes_test_proto_rawDescData
+}
+
+var file_testdata_scopes_test_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_testdata_scopes_test_proto_goTypes = []any{
+	(*PostEntryRequest)(nil),    // 0: testdata.PostEntryRequest
+	(*PostEntryResponse)(nil),   // 1: testdata.PostEntryResponse
+	(*ListEntriesRequest)(nil),  // 2: testdata.ListEntriesRequest
+	(*ListEntriesResponse)(nil), // 3: testdata.ListEntriesResponse
+}
+var file_testdata_scopes_test_proto_depIdxs = []int32{
+	0, // 0: testdata.LedgerService.PostEntry:input_type -> testdata.PostEntryRequest
+	2, // 1: testdata.LedgerService.ListEntries:input_type -> testdata.ListEntriesRequest
+	1, // 2: testdata.LedgerService.PostEntry:output_type -> testdata.PostEntryResponse
+	3, // 3: testdata.LedgerService.ListEntries:output_type -> testdata.ListEntriesResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_testdata_scopes_test_proto_init() }
+func file_testdata_scopes_test_proto_init() {
+	if File_testdata_scopes_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_scopes_test_proto_rawDesc), len(file_testdata_scopes_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_scopes_test_proto_goTypes,
+		DependencyIndexes: file_testdata_scopes_test_proto_depIdxs,
+		MessageInfos:      file_testdata_scopes_test_proto_msgTypes,
+	}.Build()
+	File_testdata_scopes_test_proto = out.File
+	file_testdata_scopes_test_proto_goTypes = nil
+	file_testdata_scopes_test_proto_depIdxs = nil
+}
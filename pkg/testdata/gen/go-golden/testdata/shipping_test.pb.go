@@ -0,0 +1,188 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/shipping_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateShipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Destination   *SharedAddress         `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Stops         []*SharedAddress       `protobuf:"bytes,2,rep,name=stops,proto3" json:"stops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShipmentRequest) Reset() {
+	*x = CreateShipmentRequest{}
+	mi := &file_testdata_shipping_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentRequest) ProtoMessage() {}
+
+func (x *CreateShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_shipping_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_shipping_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateShipmentRequest) GetDestination() *SharedAddress {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *CreateShipmentRequest) GetStops() []*SharedAddress {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+type CreateShipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipmentId    string                 `protobuf:"bytes,1,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShipmentResponse) Reset() {
+	*x = CreateShipmentResponse{}
+	mi := &file_testdata_shipping_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentResponse) ProtoMessage() {}
+
+func (x *CreateShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_shipping_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_shipping_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateShipmentResponse) GetShipmentId() string {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return ""
+}
+
+var File_testdata_shipping_test_proto protoreflect.FileDescriptor
+
+const file_testdata_shipping_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1ctestdata/shipping_test.proto\x12\btestdata\x1a testdata/shared_types_test.proto\"\x81\x01\n" +
+	"\x15CreateShipmentRequest\x129\n" +
+	"\vdestination\x18\x01 \x01(\v2\x17.testdata.SharedAddressR\vdestination\x12-\n" +
+	"\x05stops\x18\x02 \x03(\v2\x17.testdata.SharedAddressR\x05stops\"9\n" +
+	"\x16CreateShipmentResponse\x12\x1f\n" +
+	"\vshipment_id\x18\x01 \x01(\tR\n" +
+	"shipmentId2f\n" +
+	"\x0fShippingService\x12S\n" +
+	"\x0eCreateShipment\x12\x1f.testdata.CreateShipmentRequest\x1a .testdata.CreateShipmentResponseB\xab\x01\n" +
+	"\fcom.testdataB\x11ShippingTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_shipping_test_proto_rawDescOnce sync.Once
+	file_testdata_shipping_test_proto_rawDescData []byte
+)
+
+func file_testdata_shipping_test_proto_rawDescGZIP() []byte {
+	file_testdata_shipping_test_proto_rawDescOnce.Do(func() {
+		file_testdata_shipping_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_shipping_test_proto_rawDesc), len(file_testdata_shipping_test_proto_rawDesc)))
+	})
+	return file_testdata_shipping_test_proto_rawDescData
+}
+
+var file_testdata_shipping_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_shipping_test_proto_goTypes = []any{
+	(*CreateShipmentRequest)(nil),  // 0: testdata.CreateShipmentRequest
+	(*CreateShipmentResponse)(nil), // 1: testdata.CreateShipmentResponse
+	(*SharedAddress)(nil),          // 2: testdata.SharedAddress
+}
+var file_testdata_shipping_test_proto_depIdxs = []int32{
+	2, // 0: testdata.CreateShipmentRequest.destination:type_name -> testdata.SharedAddress
+	2, // 1: testdata.CreateShipmentRequest.stops:type_name -> testdata.SharedAddress
+	0, // 2: testdata.ShippingService.CreateShipment:input_type -> testdata.CreateShipmentRequest
+	1, // 3: testdata.ShippingService.CreateShipment:output_type -> testdata.CreateShipmentResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_testdata_shipping_test_proto_init() }
+func file_testdata_shipping_test_proto_init() {
+	if File_testdata_shipping_test_proto != nil {
+		return
+	}
+	file_testdata_shared_types_test_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_shipping_test_proto_rawDesc), len(file_testdata_shipping_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_shipping_test_proto_goTypes,
+		DependencyIndexes: file_testdata_shipping_test_proto_depIdxs,
+		MessageInfos:      file_testdata_shipping_test_proto_msgTypes,
+	}.Build()
+	File_testdata_shipping_test_proto = out.File
+	file_testdata_shipping_test_proto_goTypes = nil
+	file_testdata_shipping_test_proto_depIdxs = nil
+}
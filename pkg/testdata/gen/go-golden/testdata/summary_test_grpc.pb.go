@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/summary_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DigestService_BuildDigest_FullMethodName = "/testdata.DigestService/BuildDigest"
+)
+
+// DigestServiceClient is the client API for DigestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DigestService returns a short summary next to the full digest.
+type DigestServiceClient interface {
+	BuildDigest(ctx context.Context, in *BuildDigestRequest, opts ...grpc.CallOption) (*BuildDigestResponse, error)
+}
+
+type digestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDigestServiceClient(cc grpc.ClientConnInterface) DigestServiceClient {
+	return &digestServiceClient{cc}
+}
+
+func (c *digestServiceClient) BuildDigest(ctx context.Context, in *BuildDigestRequest, opts ...grpc.CallOption) (*BuildDigestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuildDigestResponse)
+	err := c.cc.Invoke(ctx, DigestService_BuildDigest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DigestServiceServer is the server API for DigestService service.
+// All implementations must embed UnimplementedDigestServiceServer
+// for forward compatibility.
+//
+// DigestService returns a short summary next to the full digest.
+type DigestServiceServer interface {
+	BuildDigest(context.Context, *BuildDigestRequest) (*BuildDigestResponse, error)
+	mustEmbedUnimplementedDigestServiceServer()
+}
+
+// UnimplementedDigestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDigestServiceServer struct{}
+
+func (UnimplementedDigestServiceServer) BuildDigest(context.Context, *BuildDigestRequest) (*BuildDigestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildDigest not implemented")
+}
+func (UnimplementedDigestServiceServer) mustEmbedUnimplementedDigestServiceServer() {}
+func (UnimplementedDigestServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeDigestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DigestServiceServer will
+// result in compilation errors.
+type UnsafeDigestServiceServer interface {
+	mustEmbedUnimplementedDigestServiceServer()
+}
+
+func RegisterDigestServiceServer(s grpc.ServiceRegistrar, srv DigestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDigestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DigestService_ServiceDesc, srv)
+}
+
+func _DigestService_BuildDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DigestServiceServer).BuildDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DigestService_BuildDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DigestServiceServer).BuildDigest(ctx, req.(*BuildDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DigestService_ServiceDesc is the grpc.ServiceDesc for DigestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DigestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.DigestService",
+	HandlerType: (*DigestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BuildDigest",
+			Handler:    _DigestService_BuildDigest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/summary_test.proto",
+}
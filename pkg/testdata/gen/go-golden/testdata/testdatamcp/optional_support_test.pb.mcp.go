@@ -3,19 +3,25 @@
 
 package testdatamcp
 
-import (
-	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata"
-)
-
 import (
 	"context"
-	"strings"
 	"github.com/mark3labs/mcp-go/mcp"
 	mcpserver "github.com/mark3labs/mcp-go/server"
 	"encoding/json"
 	"google.golang.org/protobuf/encoding/protojson"
 	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata"
+)
+
+// Tool names as generated, and the fully-qualified method names that
+// runtime.WithToolNameOverride keys on, for references that must not drift
+// from the proto. Overrides apply at registration and are not reflected here.
+const (
+	OptionalSupportTestService_TestOptionalFieldsToolName   = "testdata_OptionalSupportTestService_TestOptionalFields"
+	OptionalSupportTestService_TestOptionalFieldsFullMethod = "testdata.OptionalSupportTestService.TestOptionalFields"
 )
 
 var (
@@ -31,171 +37,157 @@ type OptionalSupportTestServiceClient interface {
 	TestOptionalFields(ctx context.Context, req *testdata.TestOptionalFieldsRequest, opts ...grpc.CallOption) (*testdata.TestOptionalFieldsResponse, error)
 }
 
-// OptionalSupportTestServiceNormalizeTopLevelJSONStrings scans m's top level and checks if any fields
-// that should be objects according to the JSON schema are actually strings. If so, it will
-// parse string values that look like JSON and replace them with the parsed value.
-// This handles both OneOf fields and regular object fields.
-func OptionalSupportTestServiceNormalizeTopLevelJSONStrings(
-	m map[string]interface{},
-	toolSchema string,
-) (changed bool) {
-	if m == nil || toolSchema == "" {
-		return false
-	}
+// UnimplementedOptionalSupportTestServiceHandler implements OptionalSupportTestServiceClient by
+// returning codes.Unimplemented from every method. Embed it to implement only
+// the methods you need.
+type UnimplementedOptionalSupportTestServiceHandler struct{}
 
-	// Parse the tool schema
-	var schema map[string]interface{}
-	if err := json.Unmarshal([]byte(toolSchema), &schema); err != nil {
-		return false
-	}
+func (UnimplementedOptionalSupportTestServiceHandler) TestOptionalFields(context.Context, *testdata.TestOptionalFieldsRequest, ...grpc.CallOption) (*testdata.TestOptionalFieldsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TestOptionalFields not implemented")
+}
 
-	// Extract properties from the schema
-	properties, ok := schema["properties"].(map[string]interface{})
-	if !ok {
-		return false
-	}
+// MockOptionalSupportTestServiceHandler implements OptionalSupportTestServiceClient with a settable
+// func per method. A method whose func is nil returns codes.Unimplemented.
+type MockOptionalSupportTestServiceHandler struct {
+	TestOptionalFieldsFunc func(ctx context.Context, req *testdata.TestOptionalFieldsRequest) (*testdata.TestOptionalFieldsResponse, error)
+}
 
-	// Helper function to check if a schema defines an object type
-	isObjectSchema := func(propSchema map[string]interface{}) bool {
-		// Check if type is "object"
-		if typeVal, ok := propSchema["type"]; ok {
-			if typeStr, ok := typeVal.(string); ok && typeStr == "object" {
-				return true
-			}
-			// Could also be an array of types
-			if typeArr, ok := typeVal.([]interface{}); ok {
-				for _, t := range typeArr {
-					if tStr, ok := t.(string); ok && tStr == "object" {
-						return true
-					}
-				}
-			}
-		}
+func (m *MockOptionalSupportTestServiceHandler) TestOptionalFields(ctx context.Context, req *testdata.TestOptionalFieldsRequest, opts ...grpc.CallOption) (*testdata.TestOptionalFieldsResponse, error) {
+	if m.TestOptionalFieldsFunc == nil {
+		return UnimplementedOptionalSupportTestServiceHandler{}.TestOptionalFields(ctx, req, opts...)
+	}
+	return m.TestOptionalFieldsFunc(ctx, req)
+}
 
-		// Check if it has properties (inline object)
-		if _, hasProps := propSchema["properties"]; hasProps {
-			return true
-		}
+// OptionalSupportTestServiceNormalizeTopLevelJSONStrings parses top-level string values of m that
+// should be objects according to the JSON schema. See runtime.NormalizeTopLevelJSONStrings.
+func OptionalSupportTestServiceNormalizeTopLevelJSONStrings(m map[string]interface{}, toolSchema string) (changed bool) {
+	return runtime.NormalizeTopLevelJSONStrings(m, toolSchema)
+}
 
-		// Check if it has a $ref (reference to object)
-		if _, hasRef := propSchema["$ref"]; hasRef {
-			return true
-		}
+// OptionalSupportTestServiceTransformOneOfFields transforms discriminated union fields back to protobuf
+// oneOf format. See runtime.TransformOneOfFields. It knows no custom
+// (mcp.options.oneof_discriminator) values; TransformOptionalSupportTestService<Method>OneOfFields does.
+func OptionalSupportTestServiceTransformOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
 
-		// Check if it has oneOf (discriminated union - treated as object)
-		if _, hasOneOf := propSchema["oneOf"]; hasOneOf {
-			return true
-		}
+// TransformOptionalSupportTestServiceTestOptionalFieldsOneOfFields transforms the discriminated union fields
+// of the arguments of the TestOptionalFields tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformOptionalSupportTestServiceTestOptionalFieldsOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
 
-		return false
+// ParseOptionalSupportTestServiceTestOptionalFieldsArgs builds the typed request of the TestOptionalFields tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseOptionalSupportTestServiceTestOptionalFieldsArgs(args map[string]interface{}, opts ...runtime.Option) (*testdata.TestOptionalFieldsRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
 	}
+	return parseOptionalSupportTestServiceTestOptionalFieldsArgs(args, config)
+}
 
-	// Iterate through all top-level fields in the payload
-	for k, v := range m {
-		// Get the schema for this field
-		propSchema, ok := properties[k]
-		if !ok {
-			continue
-		}
+// parseOptionalSupportTestServiceTestOptionalFieldsArgs runs the arguments of the TestOptionalFields tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseOptionalSupportTestServiceTestOptionalFieldsArgs.
+func parseOptionalSupportTestServiceTestOptionalFieldsArgs(args map[string]interface{}, config *runtime.Config) (*testdata.TestOptionalFieldsRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
 
-		propSchemaMap, ok := propSchema.(map[string]interface{})
-		if !ok {
-			continue
-		}
+	var req testdata.TestOptionalFieldsRequest
 
-		// Check if this field should be an object according to the schema
-		if !isObjectSchema(propSchemaMap) {
-			continue
-		}
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(OptionalSupportTestService_TestOptionalFieldsTool, "testdata.OptionalSupportTestService.TestOptionalFields", config.ToolSchemaOverrides).JSONSchema)
 
-		// Check if the actual value is a string
-		s, ok := v.(string)
-		if !ok {
-			continue
-		}
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
 
-		// Try to parse it as JSON
-		trim := strings.TrimSpace(s)
-		if trim == "" || !(strings.HasPrefix(trim, "{") || strings.HasPrefix(trim, "[")) {
-			continue
-		}
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		var parsed any
-		if err := json.Unmarshal([]byte(trim), &parsed); err != nil {
-			continue // ignore if it's not valid JSON
-		}
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(OptionalSupportTestService_TestOptionalFieldsFullMethod, OptionalSupportTestService_TestOptionalFieldsTool.Name, config.ToolNameOverrides)])
 
-		m[k] = parsed
-		changed = true
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
 	}
-	return changed
-}
 
-// OptionalSupportTestServiceTransformOneOfFields transforms discriminated union fields back to protobuf oneOf format
-func OptionalSupportTestServiceTransformOneOfFields(m map[string]interface{}) {
-	OptionalSupportTestServiceTransformOneOfFieldsRecursive(m)
-}
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, OptionalSupportTestService_TestOptionalFieldsZeroBasedPaginationPaths)
 
-// OptionalSupportTestServiceTransformOneOfFieldsRecursive recursively transforms oneOf fields in nested objects
-func OptionalSupportTestServiceTransformOneOfFieldsRecursive(obj interface{}) {
-	switch v := obj.(type) {
-	case map[string]interface{}:
-		// Transform oneOf fields in this object
-		for key, value := range v {
-			// Check if this looks like a oneOf discriminated union (must have OneOfType postfix)
-			if strings.HasSuffix(key, "OneOfType") {
-				if unionObj, ok := value.(map[string]interface{}); ok {
-					if typeField, hasType := unionObj["object_type"]; hasType {
-						if typeStr, ok := typeField.(string); ok {
-							// First try to extract the field that matches the object_type
-							// (for message types with $ref)
-							if fieldValue, hasField := unionObj[typeStr]; hasField {
-								// Move the field value directly to the parent level
-								v[typeStr] = fieldValue
-								delete(v, key)
-							} else {
-								// Fall back to old logic: create object without object_type field
-								// (for primitive types or inline objects)
-								variantObj := make(map[string]interface{})
-								for k, val := range unionObj {
-									if k != "object_type" {
-										variantObj[k] = val
-									}
-								}
-								// Replace the union object with the variant object
-								v[typeStr] = variantObj
-								delete(v, key)
-							}
-						}
-					}
-				}
-			}
-		}
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
 
-		// Recursively process all values
-		for _, value := range v {
-			OptionalSupportTestServiceTransformOneOfFieldsRecursive(value)
-		}
-	case []interface{}:
-		// Process array elements
-		for _, item := range v {
-			OptionalSupportTestServiceTransformOneOfFieldsRecursive(item)
-		}
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
 }
 
 // ForwardToOptionalSupportTestServiceClient registers a gRPC client, to forward MCP calls to it.
+// It panics if runtime.WithToolNameOverride yields invalid or conflicting tool
+// names, if runtime.WithStartupValidation finds an invalid tool schema, if
+// runtime.WithSessionScopedClient or runtime.WithMethodClients has clients of
+// another service, or if a runtime.WithOnRegister callback renames a tool.
 func ForwardToOptionalSupportTestServiceClient(s *mcpserver.MCPServer, client OptionalSupportTestServiceClient, opts ...runtime.Option) {
 	config := runtime.NewConfig()
 	for _, opt := range opts {
 		opt(config)
 	}
-	TestOptionalFieldsToolDef := OptionalSupportTestService_TestOptionalFieldsTool
+
+	toolNames, err := runtime.ResolveToolNames(map[string]string{
+		"testdata.OptionalSupportTestService.TestOptionalFields": OptionalSupportTestService_TestOptionalFieldsTool.Name,
+	}, config.ToolNameOverrides)
+	if err != nil {
+		panic(err)
+	}
+	if err := runtime.ValidateToolDescriptionTemplate(config.DescriptionTemplate); err != nil {
+		panic(err)
+	}
+
+	// Reject a runtime.WithSessionScopedClient resolver of another client type
+	if err := runtime.CheckClientResolver[OptionalSupportTestServiceClient](config.ClientResolverType); err != nil {
+		panic(err)
+	}
+
+	// Reject runtime.WithMethodClients clients of another client type
+	if err := runtime.CheckMethodClients[OptionalSupportTestServiceClient](config.MethodClients); err != nil {
+		panic(err)
+	}
+
+	// Shared by every tool of this registration under runtime.WithConcurrencyLimit
+	limiter := runtime.NewCallLimiter(config.ConcurrencyLimit, config.ConcurrencyFailFast)
+
+	// Shared by every tool of this registration under runtime.WithRateLimit
+	rateLimiter := runtime.NewRateLimiter(config.RateLimits, config.DefaultRateLimit, config.RateLimitPerSession)
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	TestOptionalFieldsToolDef := runtime.OverrideToolSchema(OptionalSupportTestService_TestOptionalFieldsTool, "testdata.OptionalSupportTestService.TestOptionalFields", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	TestOptionalFieldsTool := mcp.Tool{
-		Name:           TestOptionalFieldsToolDef.Name,
-		Description:    TestOptionalFieldsToolDef.Description,
+		Name:           toolNames["testdata.OptionalSupportTestService.TestOptionalFields"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "testdata.OptionalSupportTestService.TestOptionalFields", TestOptionalFieldsToolDef.Description),
 		RawInputSchema: json.RawMessage(TestOptionalFieldsToolDef.JSONSchema),
 	}
 
@@ -204,19 +196,32 @@ func ForwardToOptionalSupportTestServiceClient(s *mcpserver.MCPServer, client Op
 		TestOptionalFieldsTool = runtime.AddExtraPropertiesToTool(TestOptionalFieldsTool, config.ExtraProperties)
 	}
 
-	s.AddTool(TestOptionalFieldsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req testdata.TestOptionalFieldsRequest
+	// Offer the per-call "__format" override of the response format
+	TestOptionalFieldsTool = runtime.AddFormatArgumentToTool(TestOptionalFieldsTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	TestOptionalFieldsTool, err = runtime.AddInputDefaultsToTool(TestOptionalFieldsTool, (&testdata.TestOptionalFieldsRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[TestOptionalFieldsTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = OptionalSupportTestServiceNormalizeTopLevelJSONStrings(message, TestOptionalFieldsToolDef.JSONSchema)
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&TestOptionalFieldsTool, config.OnRegister)
 
-		// Transform oneOf discriminated unions back to protobuf format
-		OptionalSupportTestServiceTransformOneOfFields(message)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(TestOptionalFieldsTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, OptionalSupportTestService_TestOptionalFieldsZeroBasedPaginationPaths)
+	TestOptionalFieldsHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
+
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -225,27 +230,79 @@ func ForwardToOptionalSupportTestServiceClient(s *mcpserver.MCPServer, client Op
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseOptionalSupportTestServiceTestOptionalFieldsArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "testdata.OptionalSupportTestService.TestOptionalFields", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(OptionalSupportTestService_TestOptionalFieldsFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, TestOptionalFieldsToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.TestOptionalFields(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, TestOptionalFieldsToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.TestOptionalFields(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["testdata.OptionalSupportTestService.TestOptionalFields"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("testdata.OptionalSupportTestService.TestOptionalFields", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -253,5 +310,42 @@ func ForwardToOptionalSupportTestServiceClient(s *mcpserver.MCPServer, client Op
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	TestOptionalFieldsHandler = runtime.RecoverPanics(TestOptionalFieldsHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	TestOptionalFieldsHandler = runtime.RecordMetrics(TestOptionalFieldsHandler, "testdata.OptionalSupportTestService.TestOptionalFields", config.Metrics)
+
+	s.AddTool(TestOptionalFieldsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, TestOptionalFieldsTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return TestOptionalFieldsHandler(ctx, request.GetArguments())
 	})
 }
+
+// OptionalSupportTestServiceInProcessServer is the server side of OptionalSupportTestService. Every grpc-go
+// OptionalSupportTestServiceServer implementation satisfies it.
+type OptionalSupportTestServiceInProcessServer interface {
+	TestOptionalFields(ctx context.Context, req *testdata.TestOptionalFieldsRequest) (*testdata.TestOptionalFieldsResponse, error)
+}
+
+// inProcessOptionalSupportTestServiceClient implements OptionalSupportTestServiceClient by calling a
+// OptionalSupportTestServiceInProcessServer directly. Call options have no effect.
+type inProcessOptionalSupportTestServiceClient struct {
+	impl OptionalSupportTestServiceInProcessServer
+}
+
+func (c inProcessOptionalSupportTestServiceClient) TestOptionalFields(ctx context.Context, req *testdata.TestOptionalFieldsRequest, _ ...grpc.CallOption) (*testdata.TestOptionalFieldsResponse, error) {
+	return c.impl.TestOptionalFields(ctx, req)
+}
+
+// RegisterInProcessOptionalSupportTestServiceServer registers impl so that MCP calls reach it
+// in-process, through the same pipeline as ForwardToOptionalSupportTestServiceClient but
+// without a gRPC connection. gRPC interceptors do not run.
+func RegisterInProcessOptionalSupportTestServiceServer(s *mcpserver.MCPServer, impl OptionalSupportTestServiceInProcessServer, opts ...runtime.Option) {
+	ForwardToOptionalSupportTestServiceClient(s, inProcessOptionalSupportTestServiceClient{impl: impl}, opts...)
+}
@@ -3,19 +3,25 @@
 
 package testdatamcp
 
-import (
-	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata"
-)
-
 import (
 	"context"
-	"strings"
 	"github.com/mark3labs/mcp-go/mcp"
 	mcpserver "github.com/mark3labs/mcp-go/server"
 	"encoding/json"
 	"google.golang.org/protobuf/encoding/protojson"
 	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata"
+)
+
+// Tool names as generated, and the fully-qualified method names that
+// runtime.WithToolNameOverride keys on, for references that must not drift
+// from the proto. Overrides apply at registration and are not reflected here.
+const (
+	PaginationService_ListItemsToolName   = "testdata_PaginationService_ListItems"
+	PaginationService_ListItemsFullMethod = "testdata.PaginationService.ListItems"
 )
 
 var (
@@ -31,171 +37,157 @@ type PaginationServiceClient interface {
 	ListItems(ctx context.Context, req *testdata.ListItemsRequest, opts ...grpc.CallOption) (*testdata.ListItemsResponse, error)
 }
 
-// PaginationServiceNormalizeTopLevelJSONStrings scans m's top level and checks if any fields
-// that should be objects according to the JSON schema are actually strings. If so, it will
-// parse string values that look like JSON and replace them with the parsed value.
-// This handles both OneOf fields and regular object fields.
-func PaginationServiceNormalizeTopLevelJSONStrings(
-	m map[string]interface{},
-	toolSchema string,
-) (changed bool) {
-	if m == nil || toolSchema == "" {
-		return false
-	}
+// UnimplementedPaginationServiceHandler implements PaginationServiceClient by
+// returning codes.Unimplemented from every method. Embed it to implement only
+// the methods you need.
+type UnimplementedPaginationServiceHandler struct{}
 
-	// Parse the tool schema
-	var schema map[string]interface{}
-	if err := json.Unmarshal([]byte(toolSchema), &schema); err != nil {
-		return false
-	}
+func (UnimplementedPaginationServiceHandler) ListItems(context.Context, *testdata.ListItemsRequest, ...grpc.CallOption) (*testdata.ListItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListItems not implemented")
+}
 
-	// Extract properties from the schema
-	properties, ok := schema["properties"].(map[string]interface{})
-	if !ok {
-		return false
-	}
+// MockPaginationServiceHandler implements PaginationServiceClient with a settable
+// func per method. A method whose func is nil returns codes.Unimplemented.
+type MockPaginationServiceHandler struct {
+	ListItemsFunc func(ctx context.Context, req *testdata.ListItemsRequest) (*testdata.ListItemsResponse, error)
+}
 
-	// Helper function to check if a schema defines an object type
-	isObjectSchema := func(propSchema map[string]interface{}) bool {
-		// Check if type is "object"
-		if typeVal, ok := propSchema["type"]; ok {
-			if typeStr, ok := typeVal.(string); ok && typeStr == "object" {
-				return true
-			}
-			// Could also be an array of types
-			if typeArr, ok := typeVal.([]interface{}); ok {
-				for _, t := range typeArr {
-					if tStr, ok := t.(string); ok && tStr == "object" {
-						return true
-					}
-				}
-			}
-		}
+func (m *MockPaginationServiceHandler) ListItems(ctx context.Context, req *testdata.ListItemsRequest, opts ...grpc.CallOption) (*testdata.ListItemsResponse, error) {
+	if m.ListItemsFunc == nil {
+		return UnimplementedPaginationServiceHandler{}.ListItems(ctx, req, opts...)
+	}
+	return m.ListItemsFunc(ctx, req)
+}
 
-		// Check if it has properties (inline object)
-		if _, hasProps := propSchema["properties"]; hasProps {
-			return true
-		}
+// PaginationServiceNormalizeTopLevelJSONStrings parses top-level string values of m that
+// should be objects according to the JSON schema. See runtime.NormalizeTopLevelJSONStrings.
+func PaginationServiceNormalizeTopLevelJSONStrings(m map[string]interface{}, toolSchema string) (changed bool) {
+	return runtime.NormalizeTopLevelJSONStrings(m, toolSchema)
+}
 
-		// Check if it has a $ref (reference to object)
-		if _, hasRef := propSchema["$ref"]; hasRef {
-			return true
-		}
+// PaginationServiceTransformOneOfFields transforms discriminated union fields back to protobuf
+// oneOf format. See runtime.TransformOneOfFields. It knows no custom
+// (mcp.options.oneof_discriminator) values; TransformPaginationService<Method>OneOfFields does.
+func PaginationServiceTransformOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
 
-		// Check if it has oneOf (discriminated union - treated as object)
-		if _, hasOneOf := propSchema["oneOf"]; hasOneOf {
-			return true
-		}
+// TransformPaginationServiceListItemsOneOfFields transforms the discriminated union fields
+// of the arguments of the ListItems tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformPaginationServiceListItemsOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
 
-		return false
+// ParsePaginationServiceListItemsArgs builds the typed request of the ListItems tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParsePaginationServiceListItemsArgs(args map[string]interface{}, opts ...runtime.Option) (*testdata.ListItemsRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
 	}
+	return parsePaginationServiceListItemsArgs(args, config)
+}
 
-	// Iterate through all top-level fields in the payload
-	for k, v := range m {
-		// Get the schema for this field
-		propSchema, ok := properties[k]
-		if !ok {
-			continue
-		}
+// parsePaginationServiceListItemsArgs runs the arguments of the ListItems tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParsePaginationServiceListItemsArgs.
+func parsePaginationServiceListItemsArgs(args map[string]interface{}, config *runtime.Config) (*testdata.ListItemsRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
 
-		propSchemaMap, ok := propSchema.(map[string]interface{})
-		if !ok {
-			continue
-		}
+	var req testdata.ListItemsRequest
 
-		// Check if this field should be an object according to the schema
-		if !isObjectSchema(propSchemaMap) {
-			continue
-		}
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(PaginationService_ListItemsTool, "testdata.PaginationService.ListItems", config.ToolSchemaOverrides).JSONSchema)
 
-		// Check if the actual value is a string
-		s, ok := v.(string)
-		if !ok {
-			continue
-		}
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
 
-		// Try to parse it as JSON
-		trim := strings.TrimSpace(s)
-		if trim == "" || !(strings.HasPrefix(trim, "{") || strings.HasPrefix(trim, "[")) {
-			continue
-		}
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		var parsed any
-		if err := json.Unmarshal([]byte(trim), &parsed); err != nil {
-			continue // ignore if it's not valid JSON
-		}
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(PaginationService_ListItemsFullMethod, PaginationService_ListItemsTool.Name, config.ToolNameOverrides)])
 
-		m[k] = parsed
-		changed = true
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
 	}
-	return changed
-}
 
-// PaginationServiceTransformOneOfFields transforms discriminated union fields back to protobuf oneOf format
-func PaginationServiceTransformOneOfFields(m map[string]interface{}) {
-	PaginationServiceTransformOneOfFieldsRecursive(m)
-}
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, PaginationService_ListItemsZeroBasedPaginationPaths)
 
-// PaginationServiceTransformOneOfFieldsRecursive recursively transforms oneOf fields in nested objects
-func PaginationServiceTransformOneOfFieldsRecursive(obj interface{}) {
-	switch v := obj.(type) {
-	case map[string]interface{}:
-		// Transform oneOf fields in this object
-		for key, value := range v {
-			// Check if this looks like a oneOf discriminated union (must have OneOfType postfix)
-			if strings.HasSuffix(key, "OneOfType") {
-				if unionObj, ok := value.(map[string]interface{}); ok {
-					if typeField, hasType := unionObj["object_type"]; hasType {
-						if typeStr, ok := typeField.(string); ok {
-							// First try to extract the field that matches the object_type
-							// (for message types with $ref)
-							if fieldValue, hasField := unionObj[typeStr]; hasField {
-								// Move the field value directly to the parent level
-								v[typeStr] = fieldValue
-								delete(v, key)
-							} else {
-								// Fall back to old logic: create object without object_type field
-								// (for primitive types or inline objects)
-								variantObj := make(map[string]interface{})
-								for k, val := range unionObj {
-									if k != "object_type" {
-										variantObj[k] = val
-									}
-								}
-								// Replace the union object with the variant object
-								v[typeStr] = variantObj
-								delete(v, key)
-							}
-						}
-					}
-				}
-			}
-		}
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
 
-		// Recursively process all values
-		for _, value := range v {
-			PaginationServiceTransformOneOfFieldsRecursive(value)
-		}
-	case []interface{}:
-		// Process array elements
-		for _, item := range v {
-			PaginationServiceTransformOneOfFieldsRecursive(item)
-		}
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
 }
 
 // ForwardToPaginationServiceClient registers a gRPC client, to forward MCP calls to it.
+// It panics if runtime.WithToolNameOverride yields invalid or conflicting tool
+// names, if runtime.WithStartupValidation finds an invalid tool schema, if
+// runtime.WithSessionScopedClient or runtime.WithMethodClients has clients of
+// another service, or if a runtime.WithOnRegister callback renames a tool.
 func ForwardToPaginationServiceClient(s *mcpserver.MCPServer, client PaginationServiceClient, opts ...runtime.Option) {
 	config := runtime.NewConfig()
 	for _, opt := range opts {
 		opt(config)
 	}
-	ListItemsToolDef := PaginationService_ListItemsTool
+
+	toolNames, err := runtime.ResolveToolNames(map[string]string{
+		"testdata.PaginationService.ListItems": PaginationService_ListItemsTool.Name,
+	}, config.ToolNameOverrides)
+	if err != nil {
+		panic(err)
+	}
+	if err := runtime.ValidateToolDescriptionTemplate(config.DescriptionTemplate); err != nil {
+		panic(err)
+	}
+
+	// Reject a runtime.WithSessionScopedClient resolver of another client type
+	if err := runtime.CheckClientResolver[PaginationServiceClient](config.ClientResolverType); err != nil {
+		panic(err)
+	}
+
+	// Reject runtime.WithMethodClients clients of another client type
+	if err := runtime.CheckMethodClients[PaginationServiceClient](config.MethodClients); err != nil {
+		panic(err)
+	}
+
+	// Shared by every tool of this registration under runtime.WithConcurrencyLimit
+	limiter := runtime.NewCallLimiter(config.ConcurrencyLimit, config.ConcurrencyFailFast)
+
+	// Shared by every tool of this registration under runtime.WithRateLimit
+	rateLimiter := runtime.NewRateLimiter(config.RateLimits, config.DefaultRateLimit, config.RateLimitPerSession)
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	ListItemsToolDef := runtime.OverrideToolSchema(PaginationService_ListItemsTool, "testdata.PaginationService.ListItems", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	ListItemsTool := mcp.Tool{
-		Name:           ListItemsToolDef.Name,
-		Description:    ListItemsToolDef.Description,
+		Name:           toolNames["testdata.PaginationService.ListItems"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "testdata.PaginationService.ListItems", ListItemsToolDef.Description),
 		RawInputSchema: json.RawMessage(ListItemsToolDef.JSONSchema),
 	}
 
@@ -204,19 +196,32 @@ func ForwardToPaginationServiceClient(s *mcpserver.MCPServer, client PaginationS
 		ListItemsTool = runtime.AddExtraPropertiesToTool(ListItemsTool, config.ExtraProperties)
 	}
 
-	s.AddTool(ListItemsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req testdata.ListItemsRequest
+	// Offer the per-call "__format" override of the response format
+	ListItemsTool = runtime.AddFormatArgumentToTool(ListItemsTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	ListItemsTool, err = runtime.AddInputDefaultsToTool(ListItemsTool, (&testdata.ListItemsRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[ListItemsTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = PaginationServiceNormalizeTopLevelJSONStrings(message, ListItemsToolDef.JSONSchema)
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&ListItemsTool, config.OnRegister)
 
-		// Transform oneOf discriminated unions back to protobuf format
-		PaginationServiceTransformOneOfFields(message)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(ListItemsTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, PaginationService_ListItemsZeroBasedPaginationPaths)
+	ListItemsHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
+
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -225,27 +230,79 @@ func ForwardToPaginationServiceClient(s *mcpserver.MCPServer, client PaginationS
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parsePaginationServiceListItemsArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "testdata.PaginationService.ListItems", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(PaginationService_ListItemsFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, ListItemsToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.ListItems(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, ListItemsToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.ListItems(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["testdata.PaginationService.ListItems"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("testdata.PaginationService.ListItems", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -253,5 +310,42 @@ func ForwardToPaginationServiceClient(s *mcpserver.MCPServer, client PaginationS
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	ListItemsHandler = runtime.RecoverPanics(ListItemsHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	ListItemsHandler = runtime.RecordMetrics(ListItemsHandler, "testdata.PaginationService.ListItems", config.Metrics)
+
+	s.AddTool(ListItemsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, ListItemsTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return ListItemsHandler(ctx, request.GetArguments())
 	})
 }
+
+// PaginationServiceInProcessServer is the server side of PaginationService. Every grpc-go
+// PaginationServiceServer implementation satisfies it.
+type PaginationServiceInProcessServer interface {
+	ListItems(ctx context.Context, req *testdata.ListItemsRequest) (*testdata.ListItemsResponse, error)
+}
+
+// inProcessPaginationServiceClient implements PaginationServiceClient by calling a
+// PaginationServiceInProcessServer directly. Call options have no effect.
+type inProcessPaginationServiceClient struct {
+	impl PaginationServiceInProcessServer
+}
+
+func (c inProcessPaginationServiceClient) ListItems(ctx context.Context, req *testdata.ListItemsRequest, _ ...grpc.CallOption) (*testdata.ListItemsResponse, error) {
+	return c.impl.ListItems(ctx, req)
+}
+
+// RegisterInProcessPaginationServiceServer registers impl so that MCP calls reach it
+// in-process, through the same pipeline as ForwardToPaginationServiceClient but
+// without a gRPC connection. gRPC interceptors do not run.
+func RegisterInProcessPaginationServiceServer(s *mcpserver.MCPServer, impl PaginationServiceInProcessServer, opts ...runtime.Option) {
+	ForwardToPaginationServiceClient(s, inProcessPaginationServiceClient{impl: impl}, opts...)
+}
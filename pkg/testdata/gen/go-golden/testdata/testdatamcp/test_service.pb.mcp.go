@@ -3,25 +3,35 @@
 
 package testdatamcp
 
-import (
-	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata"
-)
-
 import (
 	"context"
-	"strings"
 	"github.com/mark3labs/mcp-go/mcp"
 	mcpserver "github.com/mark3labs/mcp-go/server"
 	"encoding/json"
 	"google.golang.org/protobuf/encoding/protojson"
 	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata"
+)
+
+// Tool names as generated, and the fully-qualified method names that
+// runtime.WithToolNameOverride keys on, for references that must not drift
+// from the proto. Overrides apply at registration and are not reflected here.
+const (
+	TestService_CreateItemToolName              = "testdata_TestService_CreateItem"
+	TestService_CreateItemFullMethod            = "testdata.TestService.CreateItem"
+	TestService_GetItemToolName                 = "testdata_TestService_GetItem"
+	TestService_GetItemFullMethod               = "testdata.TestService.GetItem"
+	TestService_ProcessWellKnownTypesToolName   = "testdata_TestService_ProcessWellKnownTypes"
+	TestService_ProcessWellKnownTypesFullMethod = "testdata.TestService.ProcessWellKnownTypes"
 )
 
 var (
-	TestService_CreateItemTool            = runtime.Tool{Name: "testdata_TestService_CreateItem", Description: "CreateItem creates a new item\n", JSONSchema: "{\"$defs\":{\"ProductDetails\":{\"properties\":{\"price\":{\"description\":\"Product price in dollars\",\"type\":\"number\"},\"quantity\":{\"description\":\"Available quantity\",\"type\":\"integer\"}},\"required\":[],\"type\":\"object\"},\"ServiceDetails\":{\"properties\":{\"duration\":{\"description\":\"Service duration (e.g. \\\"1h\\\", \\\"30m\\\")\",\"type\":\"string\"},\"recurring\":{\"description\":\"Whether this is a recurring service\",\"type\":\"boolean\"}},\"required\":[],\"type\":\"object\"}},\"$schema\":\"https://json-schema.org/draft/2020-12/schema\",\"properties\":{\"description\":{\"description\":\"Optional field\",\"type\":\"string\"},\"item_typeOneOfType\":{\"oneOf\":[{\"properties\":{\"object_type\":{\"const\":\"product\",\"type\":\"string\"},\"product\":{\"$ref\":\"#/$defs/ProductDetails\",\"type\":\"object\"}},\"required\":[\"object_type\",\"product\"],\"title\":\"product\",\"type\":\"object\"},{\"properties\":{\"object_type\":{\"const\":\"service\",\"type\":\"string\"},\"service\":{\"$ref\":\"#/$defs/ServiceDetails\",\"type\":\"object\"}},\"required\":[\"object_type\",\"service\"],\"title\":\"service\",\"type\":\"object\"}],\"type\":\"object\"},\"labels\":{\"additionalProperties\":{\"type\":\"string\"},\"description\":\"Map field\",\"propertyNames\":{\"type\":\"string\"},\"type\":\"object\"},\"name\":{\"description\":\"Required field\",\"type\":\"string\"},\"tags\":{\"description\":\"Repeated field\",\"items\":{\"type\":\"string\"},\"type\":\"array\"},\"thumbnail\":{\"contentEncoding\":\"base64\",\"description\":\"Bytes field\",\"format\":\"byte\",\"type\":\"string\"}},\"required\":[\"name\",\"item_typeOneOfType\"],\"type\":\"object\"}"}
+	TestService_CreateItemTool            = runtime.Tool{Name: "testdata_TestService_CreateItem", Description: "CreateItem creates a new item\n", JSONSchema: "{\"$defs\":{\"ProductDetails\":{\"properties\":{\"price\":{\"description\":\"Product price in dollars\",\"type\":\"number\"},\"quantity\":{\"description\":\"Available quantity\",\"type\":\"integer\"}},\"required\":[],\"type\":\"object\"},\"ServiceDetails\":{\"properties\":{\"duration\":{\"description\":\"Service duration (e.g. \\\"1h\\\", \\\"30m\\\")\",\"type\":\"string\"},\"recurring\":{\"description\":\"Whether this is a recurring service\",\"type\":\"boolean\"}},\"required\":[],\"type\":\"object\"}},\"$schema\":\"https://json-schema.org/draft/2020-12/schema\",\"properties\":{\"description\":{\"description\":\"Optional field\",\"type\":\"string\"},\"item_typeOneOfType\":{\"description\":\"Exactly one variant of the oneof \\\"item_type\\\". Set \\\"object_type\\\" to one of \\\"product\\\", \\\"service\\\", and fill in the properties of that variant.\",\"oneOf\":[{\"properties\":{\"object_type\":{\"const\":\"product\",\"type\":\"string\"},\"product\":{\"$ref\":\"#/$defs/ProductDetails\",\"type\":\"object\"}},\"required\":[\"object_type\",\"product\"],\"title\":\"product\",\"type\":\"object\"},{\"properties\":{\"object_type\":{\"const\":\"service\",\"type\":\"string\"},\"service\":{\"$ref\":\"#/$defs/ServiceDetails\",\"type\":\"object\"}},\"required\":[\"object_type\",\"service\"],\"title\":\"service\",\"type\":\"object\"}],\"type\":\"object\"},\"labels\":{\"additionalProperties\":{\"type\":\"string\"},\"description\":\"Map field\",\"propertyNames\":{\"type\":\"string\"},\"type\":\"object\"},\"name\":{\"description\":\"Required field\",\"type\":\"string\"},\"tags\":{\"description\":\"Repeated field\",\"items\":{\"type\":\"string\"},\"type\":\"array\"},\"thumbnail\":{\"contentEncoding\":\"base64\",\"description\":\"Bytes field\",\"format\":\"byte\",\"type\":\"string\"}},\"required\":[\"name\",\"item_typeOneOfType\"],\"type\":\"object\"}"}
 	TestService_GetItemTool               = runtime.Tool{Name: "testdata_TestService_GetItem", Description: "GetItem retrieves an item by ID\n", JSONSchema: "{\"$schema\":\"https://json-schema.org/draft/2020-12/schema\",\"properties\":{\"id\":{\"type\":\"string\"}},\"required\":[],\"type\":\"object\"}"}
-	TestService_ProcessWellKnownTypesTool = runtime.Tool{Name: "testdata_TestService_ProcessWellKnownTypes", Description: "Test well-known types handling\n", JSONSchema: "{\"$schema\":\"https://json-schema.org/draft/2020-12/schema\",\"properties\":{\"config\":{\"description\":\"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object).\",\"type\":[\"object\",\"array\",\"string\",\"number\",\"boolean\",\"null\"]},\"metadata\":{\"description\":\"Well-known types that need special handling\",\"type\":\"object\"},\"payload\":{\"properties\":{\"@type\":{\"type\":\"string\"},\"value\":{\"type\":[\"object\",\"array\",\"string\",\"number\",\"boolean\",\"null\"]}},\"required\":[\"@type\"],\"type\":[\"object\",\"null\"]},\"timestamp\":{\"format\":\"date-time\",\"type\":[\"string\",\"null\"]}},\"required\":[],\"type\":\"object\"}"}
+	TestService_ProcessWellKnownTypesTool = runtime.Tool{Name: "testdata_TestService_ProcessWellKnownTypes", Description: "Test well-known types handling\n", JSONSchema: "{\"$schema\":\"https://json-schema.org/draft/2020-12/schema\",\"properties\":{\"arguments\":{\"description\":\"Positional arguments; each may be arbitrary JSON\",\"items\":{\"description\":\"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object).\",\"type\":[\"object\",\"array\",\"string\",\"number\",\"boolean\",\"null\"]},\"type\":\"array\"},\"attributes\":{\"additionalProperties\":true,\"description\":\"Free-form attributes; every value may be arbitrary JSON\",\"type\":\"object\"},\"config\":{\"description\":\"represents a google.protobuf.Value, a dynamic JSON value (string, number, boolean, array, object).\",\"type\":[\"object\",\"array\",\"string\",\"number\",\"boolean\",\"null\"]},\"metadata\":{\"description\":\"Well-known types that need special handling\",\"type\":\"object\"},\"payload\":{\"properties\":{\"@type\":{\"type\":\"string\"},\"value\":{\"type\":[\"object\",\"array\",\"string\",\"number\",\"boolean\",\"null\"]}},\"required\":[\"@type\"],\"type\":[\"object\",\"null\"]},\"timestamp\":{\"format\":\"date-time\",\"type\":[\"string\",\"null\"]}},\"required\":[],\"type\":\"object\"}"}
 )
 
 var (
@@ -37,171 +47,329 @@ type TestServiceClient interface {
 	ProcessWellKnownTypes(ctx context.Context, req *testdata.ProcessWellKnownTypesRequest, opts ...grpc.CallOption) (*testdata.ProcessWellKnownTypesResponse, error)
 }
 
-// TestServiceNormalizeTopLevelJSONStrings scans m's top level and checks if any fields
-// that should be objects according to the JSON schema are actually strings. If so, it will
-// parse string values that look like JSON and replace them with the parsed value.
-// This handles both OneOf fields and regular object fields.
-func TestServiceNormalizeTopLevelJSONStrings(
-	m map[string]interface{},
-	toolSchema string,
-) (changed bool) {
-	if m == nil || toolSchema == "" {
-		return false
+// UnimplementedTestServiceHandler implements TestServiceClient by
+// returning codes.Unimplemented from every method. Embed it to implement only
+// the methods you need.
+type UnimplementedTestServiceHandler struct{}
+
+func (UnimplementedTestServiceHandler) CreateItem(context.Context, *testdata.CreateItemRequest, ...grpc.CallOption) (*testdata.CreateItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateItem not implemented")
+}
+
+func (UnimplementedTestServiceHandler) GetItem(context.Context, *testdata.GetItemRequest, ...grpc.CallOption) (*testdata.GetItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetItem not implemented")
+}
+
+func (UnimplementedTestServiceHandler) ProcessWellKnownTypes(context.Context, *testdata.ProcessWellKnownTypesRequest, ...grpc.CallOption) (*testdata.ProcessWellKnownTypesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ProcessWellKnownTypes not implemented")
+}
+
+// MockTestServiceHandler implements TestServiceClient with a settable
+// func per method. A method whose func is nil returns codes.Unimplemented.
+type MockTestServiceHandler struct {
+	CreateItemFunc            func(ctx context.Context, req *testdata.CreateItemRequest) (*testdata.CreateItemResponse, error)
+	GetItemFunc               func(ctx context.Context, req *testdata.GetItemRequest) (*testdata.GetItemResponse, error)
+	ProcessWellKnownTypesFunc func(ctx context.Context, req *testdata.ProcessWellKnownTypesRequest) (*testdata.ProcessWellKnownTypesResponse, error)
+}
+
+func (m *MockTestServiceHandler) CreateItem(ctx context.Context, req *testdata.CreateItemRequest, opts ...grpc.CallOption) (*testdata.CreateItemResponse, error) {
+	if m.CreateItemFunc == nil {
+		return UnimplementedTestServiceHandler{}.CreateItem(ctx, req, opts...)
 	}
+	return m.CreateItemFunc(ctx, req)
+}
 
-	// Parse the tool schema
-	var schema map[string]interface{}
-	if err := json.Unmarshal([]byte(toolSchema), &schema); err != nil {
-		return false
+func (m *MockTestServiceHandler) GetItem(ctx context.Context, req *testdata.GetItemRequest, opts ...grpc.CallOption) (*testdata.GetItemResponse, error) {
+	if m.GetItemFunc == nil {
+		return UnimplementedTestServiceHandler{}.GetItem(ctx, req, opts...)
 	}
+	return m.GetItemFunc(ctx, req)
+}
 
-	// Extract properties from the schema
-	properties, ok := schema["properties"].(map[string]interface{})
-	if !ok {
-		return false
+func (m *MockTestServiceHandler) ProcessWellKnownTypes(ctx context.Context, req *testdata.ProcessWellKnownTypesRequest, opts ...grpc.CallOption) (*testdata.ProcessWellKnownTypesResponse, error) {
+	if m.ProcessWellKnownTypesFunc == nil {
+		return UnimplementedTestServiceHandler{}.ProcessWellKnownTypes(ctx, req, opts...)
 	}
+	return m.ProcessWellKnownTypesFunc(ctx, req)
+}
 
-	// Helper function to check if a schema defines an object type
-	isObjectSchema := func(propSchema map[string]interface{}) bool {
-		// Check if type is "object"
-		if typeVal, ok := propSchema["type"]; ok {
-			if typeStr, ok := typeVal.(string); ok && typeStr == "object" {
-				return true
-			}
-			// Could also be an array of types
-			if typeArr, ok := typeVal.([]interface{}); ok {
-				for _, t := range typeArr {
-					if tStr, ok := t.(string); ok && tStr == "object" {
-						return true
-					}
-				}
-			}
-		}
+// TestServiceNormalizeTopLevelJSONStrings parses top-level string values of m that
+// should be objects according to the JSON schema. See runtime.NormalizeTopLevelJSONStrings.
+func TestServiceNormalizeTopLevelJSONStrings(m map[string]interface{}, toolSchema string) (changed bool) {
+	return runtime.NormalizeTopLevelJSONStrings(m, toolSchema)
+}
 
-		// Check if it has properties (inline object)
-		if _, hasProps := propSchema["properties"]; hasProps {
-			return true
-		}
+// TestServiceTransformOneOfFields transforms discriminated union fields back to protobuf
+// oneOf format. See runtime.TransformOneOfFields. It knows no custom
+// (mcp.options.oneof_discriminator) values; TransformTestService<Method>OneOfFields does.
+func TestServiceTransformOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
 
-		// Check if it has a $ref (reference to object)
-		if _, hasRef := propSchema["$ref"]; hasRef {
-			return true
-		}
+// TransformTestServiceCreateItemOneOfFields transforms the discriminated union fields
+// of the arguments of the CreateItem tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformTestServiceCreateItemOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
 
-		// Check if it has oneOf (discriminated union - treated as object)
-		if _, hasOneOf := propSchema["oneOf"]; hasOneOf {
-			return true
-		}
+// TransformTestServiceGetItemOneOfFields transforms the discriminated union fields
+// of the arguments of the GetItem tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformTestServiceGetItemOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
+
+// TransformTestServiceProcessWellKnownTypesOneOfFields transforms the discriminated union fields
+// of the arguments of the ProcessWellKnownTypes tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformTestServiceProcessWellKnownTypesOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
 
-		return false
+// ParseTestServiceCreateItemArgs builds the typed request of the CreateItem tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseTestServiceCreateItemArgs(args map[string]interface{}, opts ...runtime.Option) (*testdata.CreateItemRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
 	}
+	return parseTestServiceCreateItemArgs(args, config)
+}
 
-	// Iterate through all top-level fields in the payload
-	for k, v := range m {
-		// Get the schema for this field
-		propSchema, ok := properties[k]
-		if !ok {
-			continue
-		}
+// parseTestServiceCreateItemArgs runs the arguments of the CreateItem tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseTestServiceCreateItemArgs.
+func parseTestServiceCreateItemArgs(args map[string]interface{}, config *runtime.Config) (*testdata.CreateItemRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
 
-		propSchemaMap, ok := propSchema.(map[string]interface{})
-		if !ok {
-			continue
-		}
+	var req testdata.CreateItemRequest
 
-		// Check if this field should be an object according to the schema
-		if !isObjectSchema(propSchemaMap) {
-			continue
-		}
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(TestService_CreateItemTool, "testdata.TestService.CreateItem", config.ToolSchemaOverrides).JSONSchema)
 
-		// Check if the actual value is a string
-		s, ok := v.(string)
-		if !ok {
-			continue
-		}
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
 
-		// Try to parse it as JSON
-		trim := strings.TrimSpace(s)
-		if trim == "" || !(strings.HasPrefix(trim, "{") || strings.HasPrefix(trim, "[")) {
-			continue
-		}
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		var parsed any
-		if err := json.Unmarshal([]byte(trim), &parsed); err != nil {
-			continue // ignore if it's not valid JSON
-		}
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(TestService_CreateItemFullMethod, TestService_CreateItemTool.Name, config.ToolNameOverrides)])
+
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
 
-		m[k] = parsed
-		changed = true
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, TestService_CreateItemZeroBasedPaginationPaths)
+
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
+
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	return changed
+	return &req, nil
 }
 
-// TestServiceTransformOneOfFields transforms discriminated union fields back to protobuf oneOf format
-func TestServiceTransformOneOfFields(m map[string]interface{}) {
-	TestServiceTransformOneOfFieldsRecursive(m)
+// ParseTestServiceGetItemArgs builds the typed request of the GetItem tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseTestServiceGetItemArgs(args map[string]interface{}, opts ...runtime.Option) (*testdata.GetItemRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return parseTestServiceGetItemArgs(args, config)
 }
 
-// TestServiceTransformOneOfFieldsRecursive recursively transforms oneOf fields in nested objects
-func TestServiceTransformOneOfFieldsRecursive(obj interface{}) {
-	switch v := obj.(type) {
-	case map[string]interface{}:
-		// Transform oneOf fields in this object
-		for key, value := range v {
-			// Check if this looks like a oneOf discriminated union (must have OneOfType postfix)
-			if strings.HasSuffix(key, "OneOfType") {
-				if unionObj, ok := value.(map[string]interface{}); ok {
-					if typeField, hasType := unionObj["object_type"]; hasType {
-						if typeStr, ok := typeField.(string); ok {
-							// First try to extract the field that matches the object_type
-							// (for message types with $ref)
-							if fieldValue, hasField := unionObj[typeStr]; hasField {
-								// Move the field value directly to the parent level
-								v[typeStr] = fieldValue
-								delete(v, key)
-							} else {
-								// Fall back to old logic: create object without object_type field
-								// (for primitive types or inline objects)
-								variantObj := make(map[string]interface{})
-								for k, val := range unionObj {
-									if k != "object_type" {
-										variantObj[k] = val
-									}
-								}
-								// Replace the union object with the variant object
-								v[typeStr] = variantObj
-								delete(v, key)
-							}
-						}
-					}
-				}
-			}
-		}
+// parseTestServiceGetItemArgs runs the arguments of the GetItem tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseTestServiceGetItemArgs.
+func parseTestServiceGetItemArgs(args map[string]interface{}, config *runtime.Config) (*testdata.GetItemRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
 
-		// Recursively process all values
-		for _, value := range v {
-			TestServiceTransformOneOfFieldsRecursive(value)
-		}
-	case []interface{}:
-		// Process array elements
-		for _, item := range v {
-			TestServiceTransformOneOfFieldsRecursive(item)
-		}
+	var req testdata.GetItemRequest
+
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(TestService_GetItemTool, "testdata.TestService.GetItem", config.ToolSchemaOverrides).JSONSchema)
+
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
 	}
+
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(TestService_GetItemFullMethod, TestService_GetItemTool.Name, config.ToolNameOverrides)])
+
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
+
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, TestService_GetItemZeroBasedPaginationPaths)
+
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
+
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
+}
+
+// ParseTestServiceProcessWellKnownTypesArgs builds the typed request of the ProcessWellKnownTypes tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseTestServiceProcessWellKnownTypesArgs(args map[string]interface{}, opts ...runtime.Option) (*testdata.ProcessWellKnownTypesRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return parseTestServiceProcessWellKnownTypesArgs(args, config)
+}
+
+// parseTestServiceProcessWellKnownTypesArgs runs the arguments of the ProcessWellKnownTypes tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseTestServiceProcessWellKnownTypesArgs.
+func parseTestServiceProcessWellKnownTypesArgs(args map[string]interface{}, config *runtime.Config) (*testdata.ProcessWellKnownTypesRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
+
+	var req testdata.ProcessWellKnownTypesRequest
+
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(TestService_ProcessWellKnownTypesTool, "testdata.TestService.ProcessWellKnownTypes", config.ToolSchemaOverrides).JSONSchema)
+
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
+
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(TestService_ProcessWellKnownTypesFullMethod, TestService_ProcessWellKnownTypesTool.Name, config.ToolNameOverrides)])
+
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
+
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, TestService_ProcessWellKnownTypesZeroBasedPaginationPaths)
+
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
+
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
 }
 
 // ForwardToTestServiceClient registers a gRPC client, to forward MCP calls to it.
+// It panics if runtime.WithToolNameOverride yields invalid or conflicting tool
+// names, if runtime.WithStartupValidation finds an invalid tool schema, if
+// runtime.WithSessionScopedClient or runtime.WithMethodClients has clients of
+// another service, or if a runtime.WithOnRegister callback renames a tool.
 func ForwardToTestServiceClient(s *mcpserver.MCPServer, client TestServiceClient, opts ...runtime.Option) {
 	config := runtime.NewConfig()
 	for _, opt := range opts {
 		opt(config)
 	}
-	CreateItemToolDef := TestService_CreateItemTool
+
+	toolNames, err := runtime.ResolveToolNames(map[string]string{
+		"testdata.TestService.CreateItem":            TestService_CreateItemTool.Name,
+		"testdata.TestService.GetItem":               TestService_GetItemTool.Name,
+		"testdata.TestService.ProcessWellKnownTypes": TestService_ProcessWellKnownTypesTool.Name,
+	}, config.ToolNameOverrides)
+	if err != nil {
+		panic(err)
+	}
+	if err := runtime.ValidateToolDescriptionTemplate(config.DescriptionTemplate); err != nil {
+		panic(err)
+	}
+
+	// Reject a runtime.WithSessionScopedClient resolver of another client type
+	if err := runtime.CheckClientResolver[TestServiceClient](config.ClientResolverType); err != nil {
+		panic(err)
+	}
+
+	// Reject runtime.WithMethodClients clients of another client type
+	if err := runtime.CheckMethodClients[TestServiceClient](config.MethodClients); err != nil {
+		panic(err)
+	}
+
+	// Shared by every tool of this registration under runtime.WithConcurrencyLimit
+	limiter := runtime.NewCallLimiter(config.ConcurrencyLimit, config.ConcurrencyFailFast)
+
+	// Shared by every tool of this registration under runtime.WithRateLimit
+	rateLimiter := runtime.NewRateLimiter(config.RateLimits, config.DefaultRateLimit, config.RateLimitPerSession)
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	CreateItemToolDef := runtime.OverrideToolSchema(TestService_CreateItemTool, "testdata.TestService.CreateItem", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	CreateItemTool := mcp.Tool{
-		Name:           CreateItemToolDef.Name,
-		Description:    CreateItemToolDef.Description,
+		Name:           toolNames["testdata.TestService.CreateItem"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "testdata.TestService.CreateItem", CreateItemToolDef.Description),
 		RawInputSchema: json.RawMessage(CreateItemToolDef.JSONSchema),
 	}
 
@@ -210,19 +378,32 @@ func ForwardToTestServiceClient(s *mcpserver.MCPServer, client TestServiceClient
 		CreateItemTool = runtime.AddExtraPropertiesToTool(CreateItemTool, config.ExtraProperties)
 	}
 
-	s.AddTool(CreateItemTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req testdata.CreateItemRequest
+	// Offer the per-call "__format" override of the response format
+	CreateItemTool = runtime.AddFormatArgumentToTool(CreateItemTool)
+
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	CreateItemTool, err = runtime.AddInputDefaultsToTool(CreateItemTool, (&testdata.CreateItemRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[CreateItemTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		message := request.GetArguments()
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&CreateItemTool, config.OnRegister)
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = TestServiceNormalizeTopLevelJSONStrings(message, CreateItemToolDef.JSONSchema)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(CreateItemTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Transform oneOf discriminated unions back to protobuf format
-		TestServiceTransformOneOfFields(message)
+	CreateItemHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, TestService_CreateItemZeroBasedPaginationPaths)
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -231,27 +412,79 @@ func ForwardToTestServiceClient(s *mcpserver.MCPServer, client TestServiceClient
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseTestServiceCreateItemArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "testdata.TestService.CreateItem", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(TestService_CreateItemFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, CreateItemToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.CreateItem(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, CreateItemToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.CreateItem(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["testdata.TestService.CreateItem"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("testdata.TestService.CreateItem", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -259,13 +492,28 @@ func ForwardToTestServiceClient(s *mcpserver.MCPServer, client TestServiceClient
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	CreateItemHandler = runtime.RecoverPanics(CreateItemHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	CreateItemHandler = runtime.RecordMetrics(CreateItemHandler, "testdata.TestService.CreateItem", config.Metrics)
+
+	s.AddTool(CreateItemTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, CreateItemTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return CreateItemHandler(ctx, request.GetArguments())
 	})
-	GetItemToolDef := TestService_GetItemTool
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	GetItemToolDef := runtime.OverrideToolSchema(TestService_GetItemTool, "testdata.TestService.GetItem", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	GetItemTool := mcp.Tool{
-		Name:           GetItemToolDef.Name,
-		Description:    GetItemToolDef.Description,
+		Name:           toolNames["testdata.TestService.GetItem"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "testdata.TestService.GetItem", GetItemToolDef.Description),
 		RawInputSchema: json.RawMessage(GetItemToolDef.JSONSchema),
 	}
 
@@ -274,19 +522,32 @@ func ForwardToTestServiceClient(s *mcpserver.MCPServer, client TestServiceClient
 		GetItemTool = runtime.AddExtraPropertiesToTool(GetItemTool, config.ExtraProperties)
 	}
 
-	s.AddTool(GetItemTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req testdata.GetItemRequest
+	// Offer the per-call "__format" override of the response format
+	GetItemTool = runtime.AddFormatArgumentToTool(GetItemTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	GetItemTool, err = runtime.AddInputDefaultsToTool(GetItemTool, (&testdata.GetItemRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[GetItemTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = TestServiceNormalizeTopLevelJSONStrings(message, GetItemToolDef.JSONSchema)
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&GetItemTool, config.OnRegister)
 
-		// Transform oneOf discriminated unions back to protobuf format
-		TestServiceTransformOneOfFields(message)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(GetItemTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, TestService_GetItemZeroBasedPaginationPaths)
+	GetItemHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
+
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -295,27 +556,79 @@ func ForwardToTestServiceClient(s *mcpserver.MCPServer, client TestServiceClient
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseTestServiceGetItemArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "testdata.TestService.GetItem", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(TestService_GetItemFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, GetItemToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.GetItem(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, GetItemToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.GetItem(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["testdata.TestService.GetItem"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("testdata.TestService.GetItem", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -323,13 +636,28 @@ func ForwardToTestServiceClient(s *mcpserver.MCPServer, client TestServiceClient
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	GetItemHandler = runtime.RecoverPanics(GetItemHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	GetItemHandler = runtime.RecordMetrics(GetItemHandler, "testdata.TestService.GetItem", config.Metrics)
+
+	s.AddTool(GetItemTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, GetItemTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return GetItemHandler(ctx, request.GetArguments())
 	})
-	ProcessWellKnownTypesToolDef := TestService_ProcessWellKnownTypesTool
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	ProcessWellKnownTypesToolDef := runtime.OverrideToolSchema(TestService_ProcessWellKnownTypesTool, "testdata.TestService.ProcessWellKnownTypes", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	ProcessWellKnownTypesTool := mcp.Tool{
-		Name:           ProcessWellKnownTypesToolDef.Name,
-		Description:    ProcessWellKnownTypesToolDef.Description,
+		Name:           toolNames["testdata.TestService.ProcessWellKnownTypes"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "testdata.TestService.ProcessWellKnownTypes", ProcessWellKnownTypesToolDef.Description),
 		RawInputSchema: json.RawMessage(ProcessWellKnownTypesToolDef.JSONSchema),
 	}
 
@@ -338,19 +666,32 @@ func ForwardToTestServiceClient(s *mcpserver.MCPServer, client TestServiceClient
 		ProcessWellKnownTypesTool = runtime.AddExtraPropertiesToTool(ProcessWellKnownTypesTool, config.ExtraProperties)
 	}
 
-	s.AddTool(ProcessWellKnownTypesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req testdata.ProcessWellKnownTypesRequest
+	// Offer the per-call "__format" override of the response format
+	ProcessWellKnownTypesTool = runtime.AddFormatArgumentToTool(ProcessWellKnownTypesTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	ProcessWellKnownTypesTool, err = runtime.AddInputDefaultsToTool(ProcessWellKnownTypesTool, (&testdata.ProcessWellKnownTypesRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[ProcessWellKnownTypesTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = TestServiceNormalizeTopLevelJSONStrings(message, ProcessWellKnownTypesToolDef.JSONSchema)
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&ProcessWellKnownTypesTool, config.OnRegister)
 
-		// Transform oneOf discriminated unions back to protobuf format
-		TestServiceTransformOneOfFields(message)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(ProcessWellKnownTypesTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
+
+	ProcessWellKnownTypesHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, TestService_ProcessWellKnownTypesZeroBasedPaginationPaths)
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -359,27 +700,79 @@ func ForwardToTestServiceClient(s *mcpserver.MCPServer, client TestServiceClient
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseTestServiceProcessWellKnownTypesArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "testdata.TestService.ProcessWellKnownTypes", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(TestService_ProcessWellKnownTypesFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, ProcessWellKnownTypesToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.ProcessWellKnownTypes(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, ProcessWellKnownTypesToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.ProcessWellKnownTypes(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["testdata.TestService.ProcessWellKnownTypes"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("testdata.TestService.ProcessWellKnownTypes", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -387,5 +780,52 @@ func ForwardToTestServiceClient(s *mcpserver.MCPServer, client TestServiceClient
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	ProcessWellKnownTypesHandler = runtime.RecoverPanics(ProcessWellKnownTypesHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	ProcessWellKnownTypesHandler = runtime.RecordMetrics(ProcessWellKnownTypesHandler, "testdata.TestService.ProcessWellKnownTypes", config.Metrics)
+
+	s.AddTool(ProcessWellKnownTypesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, ProcessWellKnownTypesTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return ProcessWellKnownTypesHandler(ctx, request.GetArguments())
 	})
 }
+
+// TestServiceInProcessServer is the server side of TestService. Every grpc-go
+// TestServiceServer implementation satisfies it.
+type TestServiceInProcessServer interface {
+	CreateItem(ctx context.Context, req *testdata.CreateItemRequest) (*testdata.CreateItemResponse, error)
+	GetItem(ctx context.Context, req *testdata.GetItemRequest) (*testdata.GetItemResponse, error)
+	ProcessWellKnownTypes(ctx context.Context, req *testdata.ProcessWellKnownTypesRequest) (*testdata.ProcessWellKnownTypesResponse, error)
+}
+
+// inProcessTestServiceClient implements TestServiceClient by calling a
+// TestServiceInProcessServer directly. Call options have no effect.
+type inProcessTestServiceClient struct {
+	impl TestServiceInProcessServer
+}
+
+func (c inProcessTestServiceClient) CreateItem(ctx context.Context, req *testdata.CreateItemRequest, _ ...grpc.CallOption) (*testdata.CreateItemResponse, error) {
+	return c.impl.CreateItem(ctx, req)
+}
+
+func (c inProcessTestServiceClient) GetItem(ctx context.Context, req *testdata.GetItemRequest, _ ...grpc.CallOption) (*testdata.GetItemResponse, error) {
+	return c.impl.GetItem(ctx, req)
+}
+
+func (c inProcessTestServiceClient) ProcessWellKnownTypes(ctx context.Context, req *testdata.ProcessWellKnownTypesRequest, _ ...grpc.CallOption) (*testdata.ProcessWellKnownTypesResponse, error) {
+	return c.impl.ProcessWellKnownTypes(ctx, req)
+}
+
+// RegisterInProcessTestServiceServer registers impl so that MCP calls reach it
+// in-process, through the same pipeline as ForwardToTestServiceClient but
+// without a gRPC connection. gRPC interceptors do not run.
+func RegisterInProcessTestServiceServer(s *mcpserver.MCPServer, impl TestServiceInProcessServer, opts ...runtime.Option) {
+	ForwardToTestServiceClient(s, inProcessTestServiceClient{impl: impl}, opts...)
+}
@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/timeout_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RunReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunReportRequest) Reset() {
+	*x = RunReportRequest{}
+	mi := &file_testdata_timeout_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunReportRequest) ProtoMessage() {}
+
+func (x *RunReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_timeout_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunReportRequest.ProtoReflect.Descriptor instead.
+func (*RunReportRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_timeout_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RunReportRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type RunReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunReportResponse) Reset() {
+	*x = RunReportResponse{}
+	mi := &file_testdata_timeout_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunReportResponse) ProtoMessage() {}
+
+func (x *RunReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_timeout_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunReportResponse.ProtoReflect.Descriptor instead.
+func (*RunReportResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_timeout_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RunReportResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+var File_testdata_timeout_test_proto protoreflect.FileDescriptor
+
+const file_testdata_timeout_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1btestdata/timeout_test.proto\x12\btestdata\x1a\x19mcp/options/options.proto\"(\n" +
+	"\x10RunReportRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"+\n" +
+	"\x11RunReportResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result2\xf9\x01\n" +
+	"\x10AnalyticsService\x12O\n" +
+	"\tRunReport\x12\x1a.testdata.RunReportRequest\x1a\x1b.testdata.RunReportResponse\"\t\x92\xb5\x19\x05R\x0330s\x12Q\n" +
+	"\n" +
+	"QuickCheck\x12\x1a.testdata.RunReportRequest\x1a\x1b.testdata.RunReportResponse\"\n" +
+	"\x92\xb5\x19\x06R\x0420ms\x12A\n" +
+	"\x06Lookup\x12\x1a.testdata.RunReportRequest\x1a\x1b.testdata.RunReportResponseB\xaa\x01\n" +
+	"\fcom.testdataB\x10TimeoutTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_timeout_test_proto_rawDescOnce sync.Once
+	file_testdata_timeout_test_proto_rawDescData []byte
+)
+
+func file_testdata_timeout_test_proto_rawDescGZIP() []byte {
+	file_testdata_timeout_test_proto_rawDescOnce.Do(func() {
+		file_testdata_timeout_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_timeout_test_proto_rawDesc), len(file_testdata_timeout_test_proto_rawDesc)))
+	})
+	return file_testdata_timeout_test_proto_rawDescData
+}
+
+var file_testdata_timeout_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_timeout_test_proto_goTypes = []any{
+	(*RunReportRequest)(nil),  // 0: testdata.RunReportRequest
+	(*RunReportResponse)(nil), // 1: testdata.RunReportResponse
+}
+var file_testdata_timeout_test_proto_depIdxs = []int32{
+	0, // 0: testdata.AnalyticsService.RunReport:input_type -> testdata.RunReportRequest
+	0, // 1: testdata.AnalyticsService.QuickCheck:input_type -> testdata.RunReportRequest
+	0, // 2: testdata.AnalyticsService.Lookup:input_type -> testdata.RunReportRequest
+	1, // 3: testdata.AnalyticsService.RunReport:output_type -> testdata.RunReportResponse
+	1, // 4: testdata.AnalyticsService.QuickCheck:output_type -> testdata.RunReportResponse
+	1, // 5: testdata.AnalyticsService.Lookup:output_type -> testdata.RunReportResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_testdata_timeout_test_proto_init() }
+func file_testdata_timeout_test_proto_init() {
+	if File_testdata_timeout_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_timeout_test_proto_rawDesc), len(file_testdata_timeout_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_timeout_test_proto_goTypes,
+		DependencyIndexes: file_testdata_timeout_test_proto_depIdxs,
+		MessageInfos:      file_testdata_timeout_test_proto_msgTypes,
+	}.Build()
+	File_testdata_timeout_test_proto = out.File
+	file_testdata_timeout_test_proto_goTypes = nil
+	file_testdata_timeout_test_proto_depIdxs = nil
+}
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/tuple_items_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddPlaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Position of the place.
+	Location []float64 `protobuf:"fixed64,2,rep,packed,name=location,proto3" json:"location,omitempty"`
+	// Any number of search keywords.
+	Keywords      []string `protobuf:"bytes,3,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPlaceRequest) Reset() {
+	*x = AddPlaceRequest{}
+	mi := &file_testdata_tuple_items_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPlaceRequest) ProtoMessage() {}
+
+func (x *AddPlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_tuple_items_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPlaceRequest.ProtoReflect.Descriptor instead.
+func (*AddPlaceRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_tuple_items_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddPlaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddPlaceRequest) GetLocation() []float64 {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *AddPlaceRequest) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+type AddPlaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Location      []float64              `protobuf:"fixed64,2,rep,packed,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPlaceResponse) Reset() {
+	*x = AddPlaceResponse{}
+	mi := &file_testdata_tuple_items_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPlaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPlaceResponse) ProtoMessage() {}
+
+func (x *AddPlaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_tuple_items_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPlaceResponse.ProtoReflect.Descriptor instead.
+func (*AddPlaceResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_tuple_items_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddPlaceResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddPlaceResponse) GetLocation() []float64 {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+var File_testdata_tuple_items_test_proto protoreflect.FileDescriptor
+
+const file_testdata_tuple_items_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1ftestdata/tuple_items_test.proto\x12\btestdata\x1a\x19mcp/options/options.proto\"\xf8\x01\n" +
+	"\x0fAddPlaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\xb4\x01\n" +
+	"\blocation\x18\x02 \x03(\x01B\x97\x01\xaa\xb2\x19F{\"description\": \"Latitude in degrees.\", \"minimum\": -90, \"maximum\": 90}\xaa\xb2\x19I{\"description\": \"Longitude in degrees.\", \"minimum\": -180, \"maximum\": 180}R\blocation\x12\x1a\n" +
+	"\bkeywords\x18\x03 \x03(\tR\bkeywords\">\n" +
+	"\x10AddPlaceResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\blocation\x18\x02 \x03(\x01R\blocation2Q\n" +
+	"\fPlaceService\x12A\n" +
+	"\bAddPlace\x12\x19.testdata.AddPlaceRequest\x1a\x1a.testdata.AddPlaceResponseB\xad\x01\n" +
+	"\fcom.testdataB\x13TupleItemsTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_tuple_items_test_proto_rawDescOnce sync.Once
+	file_testdata_tuple_items_test_proto_rawDescData []byte
+)
+
+func file_testdata_tuple_items_test_proto_rawDescGZIP() []byte {
+	file_testdata_tuple_items_test_proto_rawDescOnce.Do(func() {
+		file_testdata_tuple_items_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_tuple_items_test_proto_rawDesc), len(file_testdata_tuple_items_test_proto_rawDesc)))
+	})
+	return file_testdata_tuple_items_test_proto_rawDescData
+}
+
+var file_testdata_tuple_items_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_tuple_items_test_proto_goTypes = []any{
+	(*AddPlaceRequest)(nil),  // 0: testdata.AddPlaceRequest
+	(*AddPlaceResponse)(nil), // 1: testdata.AddPlaceResponse
+}
+var file_testdata_tuple_items_test_proto_depIdxs = []int32{
+	0, // 0: testdata.PlaceService.AddPlace:input_type -> testdata.AddPlaceRequest
+	1, // 1: testdata.PlaceService.AddPlace:output_type -> testdata.AddPlaceResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_testdata_tuple_items_test_proto_init() }
+func file_testdata_tuple_items_test_proto_init() {
+	if File_testdata_tuple_items_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_tuple_items_test_proto_rawDesc), len(file_testdata_tuple_items_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_tuple_items_test_proto_goTypes,
+		DependencyIndexes: file_testdata_tuple_items_test_proto_depIdxs,
+		MessageInfos:      file_testdata_tuple_items_test_proto_msgTypes,
+	}.Build()
+	File_testdata_tuple_items_test_proto = out.File
+	file_testdata_tuple_items_test_proto_goTypes = nil
+	file_testdata_tuple_items_test_proto_depIdxs = nil
+}
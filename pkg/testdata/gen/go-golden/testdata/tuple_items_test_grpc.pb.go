@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/tuple_items_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PlaceService_AddPlace_FullMethodName = "/testdata.PlaceService/AddPlace"
+)
+
+// PlaceServiceClient is the client API for PlaceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PlaceService exercises the (mcp.options.tuple_items) option.
+type PlaceServiceClient interface {
+	AddPlace(ctx context.Context, in *AddPlaceRequest, opts ...grpc.CallOption) (*AddPlaceResponse, error)
+}
+
+type placeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlaceServiceClient(cc grpc.ClientConnInterface) PlaceServiceClient {
+	return &placeServiceClient{cc}
+}
+
+func (c *placeServiceClient) AddPlace(ctx context.Context, in *AddPlaceRequest, opts ...grpc.CallOption) (*AddPlaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPlaceResponse)
+	err := c.cc.Invoke(ctx, PlaceService_AddPlace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlaceServiceServer is the server API for PlaceService service.
+// All implementations must embed UnimplementedPlaceServiceServer
+// for forward compatibility.
+//
+// PlaceService exercises the (mcp.options.tuple_items) option.
+type PlaceServiceServer interface {
+	AddPlace(context.Context, *AddPlaceRequest) (*AddPlaceResponse, error)
+	mustEmbedUnimplementedPlaceServiceServer()
+}
+
+// UnimplementedPlaceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPlaceServiceServer struct{}
+
+func (UnimplementedPlaceServiceServer) AddPlace(context.Context, *AddPlaceRequest) (*AddPlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPlace not implemented")
+}
+func (UnimplementedPlaceServiceServer) mustEmbedUnimplementedPlaceServiceServer() {}
+func (UnimplementedPlaceServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafePlaceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlaceServiceServer will
+// result in compilation errors.
+type UnsafePlaceServiceServer interface {
+	mustEmbedUnimplementedPlaceServiceServer()
+}
+
+func RegisterPlaceServiceServer(s grpc.ServiceRegistrar, srv PlaceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPlaceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PlaceService_ServiceDesc, srv)
+}
+
+func _PlaceService_AddPlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaceServiceServer).AddPlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaceService_AddPlace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaceServiceServer).AddPlace(ctx, req.(*AddPlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlaceService_ServiceDesc is the grpc.ServiceDesc for PlaceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlaceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.PlaceService",
+	HandlerType: (*PlaceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddPlace",
+			Handler:    _PlaceService_AddPlace_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/tuple_items_test.proto",
+}
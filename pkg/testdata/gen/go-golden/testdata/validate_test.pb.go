@@ -0,0 +1,971 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/validate_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EventKind int32
+
+const (
+	EventKind_EVENT_KIND_UNSPECIFIED EventKind = 0
+	EventKind_EVENT_KIND_CREATED     EventKind = 1
+	EventKind_EVENT_KIND_DELETED     EventKind = 2
+)
+
+// Enum value maps for EventKind.
+var (
+	EventKind_name = map[int32]string{
+		0: "EVENT_KIND_UNSPECIFIED",
+		1: "EVENT_KIND_CREATED",
+		2: "EVENT_KIND_DELETED",
+	}
+	EventKind_value = map[string]int32{
+		"EVENT_KIND_UNSPECIFIED": 0,
+		"EVENT_KIND_CREATED":     1,
+		"EVENT_KIND_DELETED":     2,
+	}
+)
+
+func (x EventKind) Enum() *EventKind {
+	p := new(EventKind)
+	*p = x
+	return p
+}
+
+func (x EventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_testdata_validate_test_proto_enumTypes[0].Descriptor()
+}
+
+func (EventKind) Type() protoreflect.EnumType {
+	return &file_testdata_validate_test_proto_enumTypes[0]
+}
+
+func (x EventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventKind.Descriptor instead.
+func (EventKind) EnumDescriptor() ([]byte, []int) {
+	return file_testdata_validate_test_proto_rawDescGZIP(), []int{0}
+}
+
+type RegisterHostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Client-generated request identifier.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Contact address for alerts.
+	OwnerEmail     string `protobuf:"bytes,2,opt,name=owner_email,json=ownerEmail,proto3" json:"owner_email,omitempty"`
+	Hostname       string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Address        string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Ipv4Address    string `protobuf:"bytes,5,opt,name=ipv4_address,json=ipv4Address,proto3" json:"ipv4_address,omitempty"`
+	Ipv6Address    string `protobuf:"bytes,6,opt,name=ipv6_address,json=ipv6Address,proto3" json:"ipv6_address,omitempty"`
+	HealthCheckUrl string `protobuf:"bytes,7,opt,name=health_check_url,json=healthCheckUrl,proto3" json:"health_check_url,omitempty"`
+	DocsPath       string `protobuf:"bytes,8,opt,name=docs_path,json=docsPath,proto3" json:"docs_path,omitempty"`
+	// Additional addresses; each item must be an IPv4 address.
+	SecondaryIpv4Addresses []string `protobuf:"bytes,9,rep,name=secondary_ipv4_addresses,json=secondaryIpv4Addresses,proto3" json:"secondary_ipv4_addresses,omitempty"`
+	// Non-format rules do not add a format.
+	DisplayName string `protobuf:"bytes,10,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Deployment environment of the host.
+	Environment string `protobuf:"bytes,11,opt,name=environment,proto3" json:"environment,omitempty"`
+	// Any region but the reserved ones.
+	Region string `protobuf:"bytes,12,opt,name=region,proto3" json:"region,omitempty"`
+	// Each item must be a known tier.
+	Tiers []string `protobuf:"bytes,13,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	// Rack position of the host.
+	RackSlot int32 `protobuf:"varint,14,opt,name=rack_slot,json=rackSlot,proto3" json:"rack_slot,omitempty"`
+	// Free-form host labels.
+	Labels []string `protobuf:"bytes,15,rep,name=labels,proto3" json:"labels,omitempty"`
+	// Host slug
+	Slug string `protobuf:"bytes,16,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Number of CPU cores.
+	Cores         uint32   `protobuf:"varint,17,opt,name=cores,proto3" json:"cores,omitempty"`
+	DnsServers    []string `protobuf:"bytes,18,rep,name=dns_servers,json=dnsServers,proto3" json:"dns_servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterHostRequest) Reset() {
+	*x = RegisterHostRequest{}
+	mi := &file_testdata_validate_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterHostRequest) ProtoMessage() {}
+
+func (x *RegisterHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_validate_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterHostRequest.ProtoReflect.Descriptor instead.
+func (*RegisterHostRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_validate_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RegisterHostRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *RegisterHostRequest) GetOwnerEmail() string {
+	if x != nil {
+		return x.OwnerEmail
+	}
+	return ""
+}
+
+func (x *RegisterHostRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *RegisterHostRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RegisterHostRequest) GetIpv4Address() string {
+	if x != nil {
+		return x.Ipv4Address
+	}
+	return ""
+}
+
+func (x *RegisterHostRequest) GetIpv6Address() string {
+	if x != nil {
+		return x.Ipv6Address
+	}
+	return ""
+}
+
+func (x *RegisterHostRequest) GetHealthCheckUrl() string {
+	if x != nil {
+		return x.HealthCheckUrl
+	}
+	return ""
+}
+
+func (x *RegisterHostRequest) GetDocsPath() string {
+	if x != nil {
+		return x.DocsPath
+	}
+	return ""
+}
+
+func (x *RegisterHostRequest) GetSecondaryIpv4Addresses() []string {
+	if x != nil {
+		return x.SecondaryIpv4Addresses
+	}
+	return nil
+}
+
+func (x *RegisterHostRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *RegisterHostRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *RegisterHostRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *RegisterHostRequest) GetTiers() []string {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *RegisterHostRequest) GetRackSlot() int32 {
+	if x != nil {
+		return x.RackSlot
+	}
+	return 0
+}
+
+func (x *RegisterHostRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *RegisterHostRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *RegisterHostRequest) GetCores() uint32 {
+	if x != nil {
+		return x.Cores
+	}
+	return 0
+}
+
+func (x *RegisterHostRequest) GetDnsServers() []string {
+	if x != nil {
+		return x.DnsServers
+	}
+	return nil
+}
+
+type RegisterHostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterHostResponse) Reset() {
+	*x = RegisterHostResponse{}
+	mi := &file_testdata_validate_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterHostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterHostResponse) ProtoMessage() {}
+
+func (x *RegisterHostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_validate_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterHostResponse.ProtoReflect.Descriptor instead.
+func (*RegisterHostResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_validate_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterHostResponse) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+type PublishEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Envelope version; only v2 is accepted.
+	ApiVersion     string       `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	SchemaRevision int32        `protobuf:"varint,2,opt,name=schema_revision,json=schemaRevision,proto3" json:"schema_revision,omitempty"`
+	Kind           EventKind    `protobuf:"varint,3,opt,name=kind,proto3,enum=testdata.EventKind" json:"kind,omitempty"`
+	Source         *EventSource `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Payload        string       `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PublishEventRequest) Reset() {
+	*x = PublishEventRequest{}
+	mi := &file_testdata_validate_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishEventRequest) ProtoMessage() {}
+
+func (x *PublishEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_validate_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishEventRequest.ProtoReflect.Descriptor instead.
+func (*PublishEventRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_validate_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PublishEventRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *PublishEventRequest) GetSchemaRevision() int32 {
+	if x != nil {
+		return x.SchemaRevision
+	}
+	return 0
+}
+
+func (x *PublishEventRequest) GetKind() EventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EventKind_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *PublishEventRequest) GetSource() *EventSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *PublishEventRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+type EventSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	System        string                 `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	Host          string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventSource) Reset() {
+	*x = EventSource{}
+	mi := &file_testdata_validate_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSource) ProtoMessage() {}
+
+func (x *EventSource) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_validate_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSource.ProtoReflect.Descriptor instead.
+func (*EventSource) Descriptor() ([]byte, []int) {
+	return file_testdata_validate_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventSource) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *EventSource) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type PublishEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishEventResponse) Reset() {
+	*x = PublishEventResponse{}
+	mi := &file_testdata_validate_test_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishEventResponse) ProtoMessage() {}
+
+func (x *PublishEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_validate_test_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishEventResponse.ProtoReflect.Descriptor instead.
+func (*PublishEventResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_validate_test_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PublishEventResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type LabelHostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Between one and four labels.
+	Labels map[string]string `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Owners by UUID; each value is an email address.
+	Owners        map[string]string          `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]*structpb.Value `protobuf:"bytes,3,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Options       *LabelHostOptions          `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LabelHostRequest) Reset() {
+	*x = LabelHostRequest{}
+	mi := &file_testdata_validate_test_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LabelHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelHostRequest) ProtoMessage() {}
+
+func (x *LabelHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_validate_test_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelHostRequest.ProtoReflect.Descriptor instead.
+func (*LabelHostRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_validate_test_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LabelHostRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *LabelHostRequest) GetOwners() map[string]string {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *LabelHostRequest) GetAnnotations() map[string]*structpb.Value {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *LabelHostRequest) GetOptions() *LabelHostOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type LabelHostOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Priorities    map[int32]string       `protobuf:"bytes,1,rep,name=priorities,proto3" json:"priorities,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LabelHostOptions) Reset() {
+	*x = LabelHostOptions{}
+	mi := &file_testdata_validate_test_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LabelHostOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelHostOptions) ProtoMessage() {}
+
+func (x *LabelHostOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_validate_test_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelHostOptions.ProtoReflect.Descriptor instead.
+func (*LabelHostOptions) Descriptor() ([]byte, []int) {
+	return file_testdata_validate_test_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LabelHostOptions) GetPriorities() map[int32]string {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+type LabelHostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LabelHostResponse) Reset() {
+	*x = LabelHostResponse{}
+	mi := &file_testdata_validate_test_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LabelHostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelHostResponse) ProtoMessage() {}
+
+func (x *LabelHostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_validate_test_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelHostResponse.ProtoReflect.Descriptor instead.
+func (*LabelHostResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_validate_test_proto_rawDescGZIP(), []int{7}
+}
+
+type ScheduleMaintenanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Pool          string                 `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	Notify        bool                   `protobuf:"varint,3,opt,name=notify,proto3" json:"notify,omitempty"`
+	ContactEmail  string                 `protobuf:"bytes,4,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	Window        *MaintenanceWindow     `protobuf:"bytes,5,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleMaintenanceRequest) Reset() {
+	*x = ScheduleMaintenanceRequest{}
+	mi := &file_testdata_validate_test_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMaintenanceRequest) ProtoMessage() {}
+
+func (x *ScheduleMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_validate_test_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_validate_test_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ScheduleMaintenanceRequest) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *ScheduleMaintenanceRequest) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *ScheduleMaintenanceRequest) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+func (x *ScheduleMaintenanceRequest) GetContactEmail() string {
+	if x != nil {
+		return x.ContactEmail
+	}
+	return ""
+}
+
+func (x *ScheduleMaintenanceRequest) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type MaintenanceWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartHour     int32                  `protobuf:"varint,1,opt,name=start_hour,json=startHour,proto3" json:"start_hour,omitempty"`
+	EndHour       int32                  `protobuf:"varint,2,opt,name=end_hour,json=endHour,proto3" json:"end_hour,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	mi := &file_testdata_validate_test_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_validate_test_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_testdata_validate_test_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MaintenanceWindow) GetStartHour() int32 {
+	if x != nil {
+		return x.StartHour
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetEndHour() int32 {
+	if x != nil {
+		return x.EndHour
+	}
+	return 0
+}
+
+type ScheduleMaintenanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleMaintenanceResponse) Reset() {
+	*x = ScheduleMaintenanceResponse{}
+	mi := &file_testdata_validate_test_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMaintenanceResponse) ProtoMessage() {}
+
+func (x *ScheduleMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_validate_test_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_validate_test_proto_rawDescGZIP(), []int{10}
+}
+
+var File_testdata_validate_test_proto protoreflect.FileDescriptor
+
+const file_testdata_validate_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1ctestdata/validate_test.proto\x12\btestdata\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xd1\x06\n" +
+	"\x13RegisterHostRequest\x12'\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\trequestId\x12(\n" +
+	"\vowner_email\x18\x02 \x01(\tB\a\xbaH\x04r\x02`\x01R\n" +
+	"ownerEmail\x12#\n" +
+	"\bhostname\x18\x03 \x01(\tB\a\xbaH\x04r\x02h\x01R\bhostname\x12!\n" +
+	"\aaddress\x18\x04 \x01(\tB\a\xbaH\x04r\x02p\x01R\aaddress\x12*\n" +
+	"\fipv4_address\x18\x05 \x01(\tB\a\xbaH\x04r\x02x\x01R\vipv4Address\x12+\n" +
+	"\fipv6_address\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x80\x01\x01R\vipv6Address\x122\n" +
+	"\x10health_check_url\x18\a \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01R\x0ehealthCheckUrl\x12%\n" +
+	"\tdocs_path\x18\b \x01(\tB\b\xbaH\x05r\x03\x90\x01\x01R\bdocsPath\x12F\n" +
+	"\x18secondary_ipv4_addresses\x18\t \x03(\tB\f\xbaH\t\x92\x01\x06\"\x04r\x02x\x01R\x16secondaryIpv4Addresses\x12*\n" +
+	"\fdisplay_name\x18\n" +
+	" \x01(\tB\a\xbaH\x04r\x02\x18@R\vdisplayName\x12;\n" +
+	"\venvironment\x18\v \x01(\tB\x19\xbaH\x16r\x14R\x03devR\astagingR\x04prodR\venvironment\x12,\n" +
+	"\x06region\x18\f \x01(\tB\x14\xbaH\x11r\x0fZ\x06globalZ\x05localR\x06region\x12.\n" +
+	"\x05tiers\x18\r \x03(\tB\x18\xbaH\x15\x92\x01\x12\"\x10r\x0eR\x04goldR\x06silverR\x05tiers\x12&\n" +
+	"\track_slot\x18\x0e \x01(\x05B\t\xbaH\x06\x1a\x04@\x01@*R\brackSlot\x12/\n" +
+	"\x06labels\x18\x0f \x03(\tB\x17\xbaH\x14\x92\x01\x11\"\x0fr\r\x92\x02\x04edge\x92\x02\x03gpuR\x06labels\x12+\n" +
+	"\x04slug\x18\x10 \x01(\tB\x17\xbaH\x14r\x12\x10\x01\x18@2\f^[a-z0-9-]+$R\x04slug\x12 \n" +
+	"\x05cores\x18\x11 \x01(\rB\n" +
+	"\xbaH\a*\x05\x18\x80\x02(\x01R\x05cores\x124\n" +
+	"\vdns_servers\x18\x12 \x03(\tB\x13\xbaH\x10\x92\x01\r\b\x01\x10\x03\x18\x01\"\x05r\x03\x18\xfd\x01R\n" +
+	"dnsServers\"/\n" +
+	"\x14RegisterHostResponse\x12\x17\n" +
+	"\ahost_id\x18\x01 \x01(\tR\x06hostId\"\xef\x01\n" +
+	"\x13PublishEventRequest\x12*\n" +
+	"\vapi_version\x18\x01 \x01(\tB\t\xbaH\x06r\x04\n" +
+	"\x02v2R\n" +
+	"apiVersion\x120\n" +
+	"\x0fschema_revision\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02\b\x03R\x0eschemaRevision\x121\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x13.testdata.EventKindB\b\xbaH\x05\x82\x01\x02\b\x02R\x04kind\x12-\n" +
+	"\x06source\x18\x04 \x01(\v2\x15.testdata.EventSourceR\x06source\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\"K\n" +
+	"\vEventSource\x12(\n" +
+	"\x06system\x18\x01 \x01(\tB\x10\xbaH\rr\v\n" +
+	"\tinventoryR\x06system\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\"1\n" +
+	"\x14PublishEventResponse\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\x90\x04\n" +
+	"\x10LabelHostRequest\x12J\n" +
+	"\x06labels\x18\x01 \x03(\v2&.testdata.LabelHostRequest.LabelsEntryB\n" +
+	"\xbaH\a\x9a\x01\x04\b\x01\x10\x04R\x06labels\x12S\n" +
+	"\x06owners\x18\x02 \x03(\v2&.testdata.LabelHostRequest.OwnersEntryB\x13\xbaH\x10\x9a\x01\r\"\x05r\x03\xb0\x01\x01*\x04r\x02`\x01R\x06owners\x12W\n" +
+	"\vannotations\x18\x03 \x03(\v2+.testdata.LabelHostRequest.AnnotationsEntryB\b\xbaH\x05\x9a\x01\x02\x10\x02R\vannotations\x124\n" +
+	"\aoptions\x18\x04 \x01(\v2\x1a.testdata.LabelHostOptionsR\aoptions\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vOwnersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aV\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\"\xa7\x01\n" +
+	"\x10LabelHostOptions\x12T\n" +
+	"\n" +
+	"priorities\x18\x01 \x03(\v2*.testdata.LabelHostOptions.PrioritiesEntryB\b\xbaH\x05\x9a\x01\x02\x10\x03R\n" +
+	"priorities\x1a=\n" +
+	"\x0fPrioritiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x13\n" +
+	"\x11LabelHostResponse\"\xec\x02\n" +
+	"\x1aScheduleMaintenanceRequest\x12\x17\n" +
+	"\ahost_id\x18\x01 \x01(\tR\x06hostId\x12\x12\n" +
+	"\x04pool\x18\x02 \x01(\tR\x04pool\x12\x16\n" +
+	"\x06notify\x18\x03 \x01(\bR\x06notify\x12#\n" +
+	"\rcontact_email\x18\x04 \x01(\tR\fcontactEmail\x123\n" +
+	"\x06window\x18\x05 \x01(\v2\x1b.testdata.MaintenanceWindowR\x06window:\xae\x01\xbaH\xaa\x01\x1aq\n" +
+	"\x17notify_requires_contact\x12,contact_email is required when notify is set\x1a(!this.notify || this.contact_email != ''\x1a5\n" +
+	"\fhost_or_pool\x1a%this.host_id != '' || this.pool != ''\"\xa9\x01\n" +
+	"\x11MaintenanceWindow\x12\x1d\n" +
+	"\n" +
+	"start_hour\x18\x01 \x01(\x05R\tstartHour\x12\x19\n" +
+	"\bend_hour\x18\x02 \x01(\x05R\aendHour:Z\xbaHW\x1aU\n" +
+	"\x0fend_after_start\x12!end_hour must be after start_hour\x1a\x1fthis.end_hour > this.start_hour\"\x1d\n" +
+	"\x1bScheduleMaintenanceResponse*W\n" +
+	"\tEventKind\x12\x1a\n" +
+	"\x16EVENT_KIND_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12EVENT_KIND_CREATED\x10\x01\x12\x16\n" +
+	"\x12EVENT_KIND_DELETED\x10\x022\xda\x02\n" +
+	"\x10ValidatedService\x12M\n" +
+	"\fRegisterHost\x12\x1d.testdata.RegisterHostRequest\x1a\x1e.testdata.RegisterHostResponse\x12M\n" +
+	"\fPublishEvent\x12\x1d.testdata.PublishEventRequest\x1a\x1e.testdata.PublishEventResponse\x12D\n" +
+	"\tLabelHost\x12\x1a.testdata.LabelHostRequest\x1a\x1b.testdata.LabelHostResponse\x12b\n" +
+	"\x13ScheduleMaintenance\x12$.testdata.ScheduleMaintenanceRequest\x1a%.testdata.ScheduleMaintenanceResponseB\xab\x01\n" +
+	"\fcom.testdataB\x11ValidateTestProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go-golden/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_validate_test_proto_rawDescOnce sync.Once
+	file_testdata_validate_test_proto_rawDescData []byte
+)
+
+func file_testdata_validate_test_proto_rawDescGZIP() []byte {
+	file_testdata_validate_test_proto_rawDescOnce.Do(func() {
+		file_testdata_validate_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_validate_test_proto_rawDesc), len(file_testdata_validate_test_proto_rawDesc)))
+	})
+	return file_testdata_validate_test_proto_rawDescData
+}
+
+var file_testdata_validate_test_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_testdata_validate_test_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_testdata_validate_test_proto_goTypes = []any{
+	(EventKind)(0),                      // 0: testdata.EventKind
+	(*RegisterHostRequest)(nil),         // 1: testdata.RegisterHostRequest
+	(*RegisterHostResponse)(nil),        // 2: testdata.RegisterHostResponse
+	(*PublishEventRequest)(nil),         // 3: testdata.PublishEventRequest
+	(*EventSource)(nil),                 // 4: testdata.EventSource
+	(*PublishEventResponse)(nil),        // 5: testdata.PublishEventResponse
+	(*LabelHostRequest)(nil),            // 6: testdata.LabelHostRequest
+	(*LabelHostOptions)(nil),            // 7: testdata.LabelHostOptions
+	(*LabelHostResponse)(nil),           // 8: testdata.LabelHostResponse
+	(*ScheduleMaintenanceRequest)(nil),  // 9: testdata.ScheduleMaintenanceRequest
+	(*MaintenanceWindow)(nil),           // 10: testdata.MaintenanceWindow
+	(*ScheduleMaintenanceResponse)(nil), // 11: testdata.ScheduleMaintenanceResponse
+	nil,                                 // 12: testdata.LabelHostRequest.LabelsEntry
+	nil,                                 // 13: testdata.LabelHostRequest.OwnersEntry
+	nil,                                 // 14: testdata.LabelHostRequest.AnnotationsEntry
+	nil,                                 // 15: testdata.LabelHostOptions.PrioritiesEntry
+	(*structpb.Value)(nil),              // 16: google.protobuf.Value
+}
+var file_testdata_validate_test_proto_depIdxs = []int32{
+	0,  // 0: testdata.PublishEventRequest.kind:type_name -> testdata.EventKind
+	4,  // 1: testdata.PublishEventRequest.source:type_name -> testdata.EventSource
+	12, // 2: testdata.LabelHostRequest.labels:type_name -> testdata.LabelHostRequest.LabelsEntry
+	13, // 3: testdata.LabelHostRequest.owners:type_name -> testdata.LabelHostRequest.OwnersEntry
+	14, // 4: testdata.LabelHostRequest.annotations:type_name -> testdata.LabelHostRequest.AnnotationsEntry
+	7,  // 5: testdata.LabelHostRequest.options:type_name -> testdata.LabelHostOptions
+	15, // 6: testdata.LabelHostOptions.priorities:type_name -> testdata.LabelHostOptions.PrioritiesEntry
+	10, // 7: testdata.ScheduleMaintenanceRequest.window:type_name -> testdata.MaintenanceWindow
+	16, // 8: testdata.LabelHostRequest.AnnotationsEntry.value:type_name -> google.protobuf.Value
+	1,  // 9: testdata.ValidatedService.RegisterHost:input_type -> testdata.RegisterHostRequest
+	3,  // 10: testdata.ValidatedService.PublishEvent:input_type -> testdata.PublishEventRequest
+	6,  // 11: testdata.ValidatedService.LabelHost:input_type -> testdata.LabelHostRequest
+	9,  // 12: testdata.ValidatedService.ScheduleMaintenance:input_type -> testdata.ScheduleMaintenanceRequest
+	2,  // 13: testdata.ValidatedService.RegisterHost:output_type -> testdata.RegisterHostResponse
+	5,  // 14: testdata.ValidatedService.PublishEvent:output_type -> testdata.PublishEventResponse
+	8,  // 15: testdata.ValidatedService.LabelHost:output_type -> testdata.LabelHostResponse
+	11, // 16: testdata.ValidatedService.ScheduleMaintenance:output_type -> testdata.ScheduleMaintenanceResponse
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_testdata_validate_test_proto_init() }
+func file_testdata_validate_test_proto_init() {
+	if File_testdata_validate_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_validate_test_proto_rawDesc), len(file_testdata_validate_test_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_validate_test_proto_goTypes,
+		DependencyIndexes: file_testdata_validate_test_proto_depIdxs,
+		EnumInfos:         file_testdata_validate_test_proto_enumTypes,
+		MessageInfos:      file_testdata_validate_test_proto_msgTypes,
+	}.Build()
+	File_testdata_validate_test_proto = out.File
+	file_testdata_validate_test_proto_goTypes = nil
+	file_testdata_validate_test_proto_depIdxs = nil
+}
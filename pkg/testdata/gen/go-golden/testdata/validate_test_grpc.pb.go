@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/validate_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ValidatedService_RegisterHost_FullMethodName        = "/testdata.ValidatedService/RegisterHost"
+	ValidatedService_PublishEvent_FullMethodName        = "/testdata.ValidatedService/PublishEvent"
+	ValidatedService_LabelHost_FullMethodName           = "/testdata.ValidatedService/LabelHost"
+	ValidatedService_ScheduleMaintenance_FullMethodName = "/testdata.ValidatedService/ScheduleMaintenance"
+)
+
+// ValidatedServiceClient is the client API for ValidatedService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ValidatedService exercises the translation of protovalidate well-known
+// string formats into JSON Schema.
+type ValidatedServiceClient interface {
+	// RegisterHost registers a host for monitoring.
+	RegisterHost(ctx context.Context, in *RegisterHostRequest, opts ...grpc.CallOption) (*RegisterHostResponse, error)
+	// PublishEvent publishes an event in the v2 envelope.
+	PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*PublishEventResponse, error)
+	// LabelHost replaces the labels of a host.
+	LabelHost(ctx context.Context, in *LabelHostRequest, opts ...grpc.CallOption) (*LabelHostResponse, error)
+	// ScheduleMaintenance schedules a maintenance window for a host.
+	ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*ScheduleMaintenanceResponse, error)
+}
+
+type validatedServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewValidatedServiceClient(cc grpc.ClientConnInterface) ValidatedServiceClient {
+	return &validatedServiceClient{cc}
+}
+
+func (c *validatedServiceClient) RegisterHost(ctx context.Context, in *RegisterHostRequest, opts ...grpc.CallOption) (*RegisterHostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterHostResponse)
+	err := c.cc.Invoke(ctx, ValidatedService_RegisterHost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatedServiceClient) PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*PublishEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishEventResponse)
+	err := c.cc.Invoke(ctx, ValidatedService_PublishEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatedServiceClient) LabelHost(ctx context.Context, in *LabelHostRequest, opts ...grpc.CallOption) (*LabelHostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LabelHostResponse)
+	err := c.cc.Invoke(ctx, ValidatedService_LabelHost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatedServiceClient) ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*ScheduleMaintenanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleMaintenanceResponse)
+	err := c.cc.Invoke(ctx, ValidatedService_ScheduleMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidatedServiceServer is the server API for ValidatedService service.
+// All implementations must embed UnimplementedValidatedServiceServer
+// for forward compatibility.
+//
+// ValidatedService exercises the translation of protovalidate well-known
+// string formats into JSON Schema.
+type ValidatedServiceServer interface {
+	// RegisterHost registers a host for monitoring.
+	RegisterHost(context.Context, *RegisterHostRequest) (*RegisterHostResponse, error)
+	// PublishEvent publishes an event in the v2 envelope.
+	PublishEvent(context.Context, *PublishEventRequest) (*PublishEventResponse, error)
+	// LabelHost replaces the labels of a host.
+	LabelHost(context.Context, *LabelHostRequest) (*LabelHostResponse, error)
+	// ScheduleMaintenance schedules a maintenance window for a host.
+	ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*ScheduleMaintenanceResponse, error)
+	mustEmbedUnimplementedValidatedServiceServer()
+}
+
+// UnimplementedValidatedServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedValidatedServiceServer struct{}
+
+func (UnimplementedValidatedServiceServer) RegisterHost(context.Context, *RegisterHostRequest) (*RegisterHostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterHost not implemented")
+}
+func (UnimplementedValidatedServiceServer) PublishEvent(context.Context, *PublishEventRequest) (*PublishEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishEvent not implemented")
+}
+func (UnimplementedValidatedServiceServer) LabelHost(context.Context, *LabelHostRequest) (*LabelHostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LabelHost not implemented")
+}
+func (UnimplementedValidatedServiceServer) ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*ScheduleMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenance not implemented")
+}
+func (UnimplementedValidatedServiceServer) mustEmbedUnimplementedValidatedServiceServer() {}
+func (UnimplementedValidatedServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeValidatedServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ValidatedServiceServer will
+// result in compilation errors.
+type UnsafeValidatedServiceServer interface {
+	mustEmbedUnimplementedValidatedServiceServer()
+}
+
+func RegisterValidatedServiceServer(s grpc.ServiceRegistrar, srv ValidatedServiceServer) {
+	// If the following call pancis, it indicates UnimplementedValidatedServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ValidatedService_ServiceDesc, srv)
+}
+
+func _ValidatedService_RegisterHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatedServiceServer).RegisterHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidatedService_RegisterHost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatedServiceServer).RegisterHost(ctx, req.(*RegisterHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatedService_PublishEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatedServiceServer).PublishEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidatedService_PublishEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatedServiceServer).PublishEvent(ctx, req.(*PublishEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatedService_LabelHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatedServiceServer).LabelHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidatedService_LabelHost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatedServiceServer).LabelHost(ctx, req.(*LabelHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatedService_ScheduleMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatedServiceServer).ScheduleMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidatedService_ScheduleMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatedServiceServer).ScheduleMaintenance(ctx, req.(*ScheduleMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ValidatedService_ServiceDesc is the grpc.ServiceDesc for ValidatedService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ValidatedService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.ValidatedService",
+	HandlerType: (*ValidatedServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterHost",
+			Handler:    _ValidatedService_RegisterHost_Handler,
+		},
+		{
+			MethodName: "PublishEvent",
+			Handler:    _ValidatedService_PublishEvent_Handler,
+		},
+		{
+			MethodName: "LabelHost",
+			Handler:    _ValidatedService_LabelHost_Handler,
+		},
+		{
+			MethodName: "ScheduleMaintenance",
+			Handler:    _ValidatedService_ScheduleMaintenance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/validate_test.proto",
+}
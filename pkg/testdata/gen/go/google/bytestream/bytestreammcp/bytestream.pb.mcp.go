@@ -3,19 +3,23 @@
 
 package bytestreammcp
 
-import (
-	bytestream "google.golang.org/genproto/googleapis/bytestream"
-)
-
 import (
 	"context"
 	"encoding/json"
 	"github.com/mark3labs/mcp-go/mcp"
 	mcpserver "github.com/mark3labs/mcp-go/server"
 	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	bytestream "google.golang.org/genproto/googleapis/bytestream"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
-	"strings"
+)
+
+// Tool names as generated, and the fully-qualified method names that
+// runtime.WithToolNameOverride keys on, for references that must not drift
+// from the proto. Overrides apply at registration and are not reflected here.
+const (
+	ByteStream_QueryWriteStatusToolName   = "google_bytestream_ByteStream_QueryWriteStatus"
+	ByteStream_QueryWriteStatusFullMethod = "google.bytestream.ByteStream.QueryWriteStatus"
 )
 
 var (
@@ -31,171 +35,135 @@ type ByteStreamClient interface {
 	QueryWriteStatus(ctx context.Context, req *bytestream.QueryWriteStatusRequest, opts ...grpc.CallOption) (*bytestream.QueryWriteStatusResponse, error)
 }
 
-// ByteStreamNormalizeTopLevelJSONStrings scans m's top level and checks if any fields
-// that should be objects according to the JSON schema are actually strings. If so, it will
-// parse string values that look like JSON and replace them with the parsed value.
-// This handles both OneOf fields and regular object fields.
-func ByteStreamNormalizeTopLevelJSONStrings(
-	m map[string]interface{},
-	toolSchema string,
-) (changed bool) {
-	if m == nil || toolSchema == "" {
-		return false
-	}
-
-	// Parse the tool schema
-	var schema map[string]interface{}
-	if err := json.Unmarshal([]byte(toolSchema), &schema); err != nil {
-		return false
-	}
-
-	// Extract properties from the schema
-	properties, ok := schema["properties"].(map[string]interface{})
-	if !ok {
-		return false
-	}
-
-	// Helper function to check if a schema defines an object type
-	isObjectSchema := func(propSchema map[string]interface{}) bool {
-		// Check if type is "object"
-		if typeVal, ok := propSchema["type"]; ok {
-			if typeStr, ok := typeVal.(string); ok && typeStr == "object" {
-				return true
-			}
-			// Could also be an array of types
-			if typeArr, ok := typeVal.([]interface{}); ok {
-				for _, t := range typeArr {
-					if tStr, ok := t.(string); ok && tStr == "object" {
-						return true
-					}
-				}
-			}
-		}
-
-		// Check if it has properties (inline object)
-		if _, hasProps := propSchema["properties"]; hasProps {
-			return true
-		}
+// ByteStreamNormalizeTopLevelJSONStrings parses top-level string values of m that
+// should be objects according to the JSON schema. See runtime.NormalizeTopLevelJSONStrings.
+func ByteStreamNormalizeTopLevelJSONStrings(m map[string]interface{}, toolSchema string) (changed bool) {
+	return runtime.NormalizeTopLevelJSONStrings(m, toolSchema)
+}
 
-		// Check if it has a $ref (reference to object)
-		if _, hasRef := propSchema["$ref"]; hasRef {
-			return true
-		}
+// ByteStreamTransformOneOfFields transforms discriminated union fields back to protobuf
+// oneOf format. See runtime.TransformOneOfFields. It knows no custom
+// (mcp.options.oneof_discriminator) values; TransformByteStream<Method>OneOfFields does.
+func ByteStreamTransformOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
 
-		// Check if it has oneOf (discriminated union - treated as object)
-		if _, hasOneOf := propSchema["oneOf"]; hasOneOf {
-			return true
-		}
+// TransformByteStreamQueryWriteStatusOneOfFields transforms the discriminated union fields
+// of the arguments of the QueryWriteStatus tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformByteStreamQueryWriteStatusOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
 
-		return false
+// ParseByteStreamQueryWriteStatusArgs builds the typed request of the QueryWriteStatus tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseByteStreamQueryWriteStatusArgs(args map[string]interface{}, opts ...runtime.Option) (*bytestream.QueryWriteStatusRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
 	}
+	return parseByteStreamQueryWriteStatusArgs(args, config)
+}
 
-	// Iterate through all top-level fields in the payload
-	for k, v := range m {
-		// Get the schema for this field
-		propSchema, ok := properties[k]
-		if !ok {
-			continue
-		}
+// parseByteStreamQueryWriteStatusArgs runs the arguments of the QueryWriteStatus tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseByteStreamQueryWriteStatusArgs.
+func parseByteStreamQueryWriteStatusArgs(args map[string]interface{}, config *runtime.Config) (*bytestream.QueryWriteStatusRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
 
-		propSchemaMap, ok := propSchema.(map[string]interface{})
-		if !ok {
-			continue
-		}
+	var req bytestream.QueryWriteStatusRequest
 
-		// Check if this field should be an object according to the schema
-		if !isObjectSchema(propSchemaMap) {
-			continue
-		}
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(ByteStream_QueryWriteStatusTool, "google.bytestream.ByteStream.QueryWriteStatus", config.ToolSchemaOverrides).JSONSchema)
 
-		// Check if the actual value is a string
-		s, ok := v.(string)
-		if !ok {
-			continue
-		}
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
 
-		// Try to parse it as JSON
-		trim := strings.TrimSpace(s)
-		if trim == "" || !(strings.HasPrefix(trim, "{") || strings.HasPrefix(trim, "[")) {
-			continue
-		}
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		var parsed any
-		if err := json.Unmarshal([]byte(trim), &parsed); err != nil {
-			continue // ignore if it's not valid JSON
-		}
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(ByteStream_QueryWriteStatusFullMethod, ByteStream_QueryWriteStatusTool.Name, config.ToolNameOverrides)])
 
-		m[k] = parsed
-		changed = true
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
 	}
-	return changed
-}
 
-// ByteStreamTransformOneOfFields transforms discriminated union fields back to protobuf oneOf format
-func ByteStreamTransformOneOfFields(m map[string]interface{}) {
-	ByteStreamTransformOneOfFieldsRecursive(m)
-}
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, ByteStream_QueryWriteStatusZeroBasedPaginationPaths)
 
-// ByteStreamTransformOneOfFieldsRecursive recursively transforms oneOf fields in nested objects
-func ByteStreamTransformOneOfFieldsRecursive(obj interface{}) {
-	switch v := obj.(type) {
-	case map[string]interface{}:
-		// Transform oneOf fields in this object
-		for key, value := range v {
-			// Check if this looks like a oneOf discriminated union (must have OneOfType postfix)
-			if strings.HasSuffix(key, "OneOfType") {
-				if unionObj, ok := value.(map[string]interface{}); ok {
-					if typeField, hasType := unionObj["object_type"]; hasType {
-						if typeStr, ok := typeField.(string); ok {
-							// First try to extract the field that matches the object_type
-							// (for message types with $ref)
-							if fieldValue, hasField := unionObj[typeStr]; hasField {
-								// Move the field value directly to the parent level
-								v[typeStr] = fieldValue
-								delete(v, key)
-							} else {
-								// Fall back to old logic: create object without object_type field
-								// (for primitive types or inline objects)
-								variantObj := make(map[string]interface{})
-								for k, val := range unionObj {
-									if k != "object_type" {
-										variantObj[k] = val
-									}
-								}
-								// Replace the union object with the variant object
-								v[typeStr] = variantObj
-								delete(v, key)
-							}
-						}
-					}
-				}
-			}
-		}
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
 
-		// Recursively process all values
-		for _, value := range v {
-			ByteStreamTransformOneOfFieldsRecursive(value)
-		}
-	case []interface{}:
-		// Process array elements
-		for _, item := range v {
-			ByteStreamTransformOneOfFieldsRecursive(item)
-		}
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
 }
 
 // ForwardToByteStreamClient registers a gRPC client, to forward MCP calls to it.
+// It panics if runtime.WithToolNameOverride yields invalid or conflicting tool
+// names, if runtime.WithStartupValidation finds an invalid tool schema, if
+// runtime.WithSessionScopedClient or runtime.WithMethodClients has clients of
+// another service, or if a runtime.WithOnRegister callback renames a tool.
 func ForwardToByteStreamClient(s *mcpserver.MCPServer, client ByteStreamClient, opts ...runtime.Option) {
 	config := runtime.NewConfig()
 	for _, opt := range opts {
 		opt(config)
 	}
-	QueryWriteStatusToolDef := ByteStream_QueryWriteStatusTool
+
+	toolNames, err := runtime.ResolveToolNames(map[string]string{
+		"google.bytestream.ByteStream.QueryWriteStatus": ByteStream_QueryWriteStatusTool.Name,
+	}, config.ToolNameOverrides)
+	if err != nil {
+		panic(err)
+	}
+	if err := runtime.ValidateToolDescriptionTemplate(config.DescriptionTemplate); err != nil {
+		panic(err)
+	}
+
+	// Reject a runtime.WithSessionScopedClient resolver of another client type
+	if err := runtime.CheckClientResolver[ByteStreamClient](config.ClientResolverType); err != nil {
+		panic(err)
+	}
+
+	// Reject runtime.WithMethodClients clients of another client type
+	if err := runtime.CheckMethodClients[ByteStreamClient](config.MethodClients); err != nil {
+		panic(err)
+	}
+
+	// Shared by every tool of this registration under runtime.WithConcurrencyLimit
+	limiter := runtime.NewCallLimiter(config.ConcurrencyLimit, config.ConcurrencyFailFast)
+
+	// Shared by every tool of this registration under runtime.WithRateLimit
+	rateLimiter := runtime.NewRateLimiter(config.RateLimits, config.DefaultRateLimit, config.RateLimitPerSession)
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	QueryWriteStatusToolDef := runtime.OverrideToolSchema(ByteStream_QueryWriteStatusTool, "google.bytestream.ByteStream.QueryWriteStatus", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	QueryWriteStatusTool := mcp.Tool{
-		Name:           QueryWriteStatusToolDef.Name,
-		Description:    QueryWriteStatusToolDef.Description,
+		Name:           toolNames["google.bytestream.ByteStream.QueryWriteStatus"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "google.bytestream.ByteStream.QueryWriteStatus", QueryWriteStatusToolDef.Description),
 		RawInputSchema: json.RawMessage(QueryWriteStatusToolDef.JSONSchema),
 	}
 
@@ -204,19 +172,32 @@ func ForwardToByteStreamClient(s *mcpserver.MCPServer, client ByteStreamClient,
 		QueryWriteStatusTool = runtime.AddExtraPropertiesToTool(QueryWriteStatusTool, config.ExtraProperties)
 	}
 
-	s.AddTool(QueryWriteStatusTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req bytestream.QueryWriteStatusRequest
+	// Offer the per-call "__format" override of the response format
+	QueryWriteStatusTool = runtime.AddFormatArgumentToTool(QueryWriteStatusTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	QueryWriteStatusTool, err = runtime.AddInputDefaultsToTool(QueryWriteStatusTool, (&bytestream.QueryWriteStatusRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[QueryWriteStatusTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = ByteStreamNormalizeTopLevelJSONStrings(message, QueryWriteStatusToolDef.JSONSchema)
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&QueryWriteStatusTool, config.OnRegister)
 
-		// Transform oneOf discriminated unions back to protobuf format
-		ByteStreamTransformOneOfFields(message)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(QueryWriteStatusTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, ByteStream_QueryWriteStatusZeroBasedPaginationPaths)
+	QueryWriteStatusHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
+
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -225,27 +206,79 @@ func ForwardToByteStreamClient(s *mcpserver.MCPServer, client ByteStreamClient,
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseByteStreamQueryWriteStatusArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "google.bytestream.ByteStream.QueryWriteStatus", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(ByteStream_QueryWriteStatusFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, QueryWriteStatusToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.QueryWriteStatus(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, QueryWriteStatusToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.QueryWriteStatus(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["google.bytestream.ByteStream.QueryWriteStatus"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("google.bytestream.ByteStream.QueryWriteStatus", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -253,5 +286,42 @@ func ForwardToByteStreamClient(s *mcpserver.MCPServer, client ByteStreamClient,
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	QueryWriteStatusHandler = runtime.RecoverPanics(QueryWriteStatusHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	QueryWriteStatusHandler = runtime.RecordMetrics(QueryWriteStatusHandler, "google.bytestream.ByteStream.QueryWriteStatus", config.Metrics)
+
+	s.AddTool(QueryWriteStatusTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, QueryWriteStatusTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return QueryWriteStatusHandler(ctx, request.GetArguments())
 	})
 }
+
+// ByteStreamInProcessServer is the server side of ByteStream. Every grpc-go
+// ByteStreamServer implementation satisfies it.
+type ByteStreamInProcessServer interface {
+	QueryWriteStatus(ctx context.Context, req *bytestream.QueryWriteStatusRequest) (*bytestream.QueryWriteStatusResponse, error)
+}
+
+// inProcessByteStreamClient implements ByteStreamClient by calling a
+// ByteStreamInProcessServer directly. Call options have no effect.
+type inProcessByteStreamClient struct {
+	impl ByteStreamInProcessServer
+}
+
+func (c inProcessByteStreamClient) QueryWriteStatus(ctx context.Context, req *bytestream.QueryWriteStatusRequest, _ ...grpc.CallOption) (*bytestream.QueryWriteStatusResponse, error) {
+	return c.impl.QueryWriteStatus(ctx, req)
+}
+
+// RegisterInProcessByteStreamServer registers impl so that MCP calls reach it
+// in-process, through the same pipeline as ForwardToByteStreamClient but
+// without a gRPC connection. gRPC interceptors do not run.
+func RegisterInProcessByteStreamServer(s *mcpserver.MCPServer, impl ByteStreamInProcessServer, opts ...runtime.Option) {
+	ForwardToByteStreamClient(s, inProcessByteStreamClient{impl: impl}, opts...)
+}
@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/bytes_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetBlobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlobRequest) Reset() {
+	*x = GetBlobRequest{}
+	mi := &file_testdata_bytes_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlobRequest) ProtoMessage() {}
+
+func (x *GetBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_bytes_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlobRequest.ProtoReflect.Descriptor instead.
+func (*GetBlobRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_bytes_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetBlobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBlobResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Content []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Small enough to stay inline under any sensible limit.
+	Checksum      []byte                 `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Parts         []*BlobPart            `protobuf:"bytes,3,rep,name=parts,proto3" json:"parts,omitempty"`
+	Attachments   map[string][]byte      `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Preview       *wrapperspb.BytesValue `protobuf:"bytes,5,opt,name=preview,proto3" json:"preview,omitempty"`
+	Chunks        [][]byte               `protobuf:"bytes,6,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlobResponse) Reset() {
+	*x = GetBlobResponse{}
+	mi := &file_testdata_bytes_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlobResponse) ProtoMessage() {}
+
+func (x *GetBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_bytes_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlobResponse.ProtoReflect.Descriptor instead.
+func (*GetBlobResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_bytes_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetBlobResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *GetBlobResponse) GetChecksum() []byte {
+	if x != nil {
+		return x.Checksum
+	}
+	return nil
+}
+
+func (x *GetBlobResponse) GetParts() []*BlobPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *GetBlobResponse) GetAttachments() map[string][]byte {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *GetBlobResponse) GetPreview() *wrapperspb.BytesValue {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+func (x *GetBlobResponse) GetChunks() [][]byte {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+type BlobPart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlobPart) Reset() {
+	*x = BlobPart{}
+	mi := &file_testdata_bytes_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlobPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobPart) ProtoMessage() {}
+
+func (x *BlobPart) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_bytes_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobPart.ProtoReflect.Descriptor instead.
+func (*BlobPart) Descriptor() ([]byte, []int) {
+	return file_testdata_bytes_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BlobPart) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BlobPart) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_testdata_bytes_test_proto protoreflect.FileDescriptor
+
+const file_testdata_bytes_test_proto_rawDesc = "" +
+	"\n" +
+	"\x19testdata/bytes_test.proto\x12\btestdata\x1a\x1egoogle/protobuf/wrappers.proto\" \n" +
+	"\x0eGetBlobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xce\x02\n" +
+	"\x0fGetBlobResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x1a\n" +
+	"\bchecksum\x18\x02 \x01(\fR\bchecksum\x12(\n" +
+	"\x05parts\x18\x03 \x03(\v2\x12.testdata.BlobPartR\x05parts\x12L\n" +
+	"\vattachments\x18\x04 \x03(\v2*.testdata.GetBlobResponse.AttachmentsEntryR\vattachments\x125\n" +
+	"\apreview\x18\x05 \x01(\v2\x1b.google.protobuf.BytesValueR\apreview\x12\x16\n" +
+	"\x06chunks\x18\x06 \x03(\fR\x06chunks\x1a>\n" +
+	"\x10AttachmentsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"2\n" +
+	"\bBlobPart\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data2M\n" +
+	"\vBlobService\x12>\n" +
+	"\aGetBlob\x12\x18.testdata.GetBlobRequest\x1a\x19.testdata.GetBlobResponseB\xa1\x01\n" +
+	"\fcom.testdataB\x0eBytesTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_bytes_test_proto_rawDescOnce sync.Once
+	file_testdata_bytes_test_proto_rawDescData []byte
+)
+
+func file_testdata_bytes_test_proto_rawDescGZIP() []byte {
+	file_testdata_bytes_test_proto_rawDescOnce.Do(func() {
+		file_testdata_bytes_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_bytes_test_proto_rawDesc), len(file_testdata_bytes_test_proto_rawDesc)))
+	})
+	return file_testdata_bytes_test_proto_rawDescData
+}
+
+var file_testdata_bytes_test_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_testdata_bytes_test_proto_goTypes = []any{
+	(*GetBlobRequest)(nil),        // 0: testdata.GetBlobRequest
+	(*GetBlobResponse)(nil),       // 1: testdata.GetBlobResponse
+	(*BlobPart)(nil),              // 2: testdata.BlobPart
+	nil,                           // 3: testdata.GetBlobResponse.AttachmentsEntry
+	(*wrapperspb.BytesValue)(nil), // 4: google.protobuf.BytesValue
+}
+var file_testdata_bytes_test_proto_depIdxs = []int32{
+	2, // 0: testdata.GetBlobResponse.parts:type_name -> testdata.BlobPart
+	3, // 1: testdata.GetBlobResponse.attachments:type_name -> testdata.GetBlobResponse.AttachmentsEntry
+	4, // 2: testdata.GetBlobResponse.preview:type_name -> google.protobuf.BytesValue
+	0, // 3: testdata.BlobService.GetBlob:input_type -> testdata.GetBlobRequest
+	1, // 4: testdata.BlobService.GetBlob:output_type -> testdata.GetBlobResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_testdata_bytes_test_proto_init() }
+func file_testdata_bytes_test_proto_init() {
+	if File_testdata_bytes_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_bytes_test_proto_rawDesc), len(file_testdata_bytes_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_bytes_test_proto_goTypes,
+		DependencyIndexes: file_testdata_bytes_test_proto_depIdxs,
+		MessageInfos:      file_testdata_bytes_test_proto_msgTypes,
+	}.Build()
+	File_testdata_bytes_test_proto = out.File
+	file_testdata_bytes_test_proto_goTypes = nil
+	file_testdata_bytes_test_proto_depIdxs = nil
+}
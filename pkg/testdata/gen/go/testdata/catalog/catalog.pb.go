@@ -0,0 +1,231 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/catalog/catalog.proto
+
+package catalog
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LookupSkuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupSkuRequest) Reset() {
+	*x = LookupSkuRequest{}
+	mi := &file_testdata_catalog_catalog_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupSkuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupSkuRequest) ProtoMessage() {}
+
+func (x *LookupSkuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_catalog_catalog_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupSkuRequest.ProtoReflect.Descriptor instead.
+func (*LookupSkuRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_catalog_catalog_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LookupSkuRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+type LookupSkuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           *Sku                   `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupSkuResponse) Reset() {
+	*x = LookupSkuResponse{}
+	mi := &file_testdata_catalog_catalog_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupSkuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupSkuResponse) ProtoMessage() {}
+
+func (x *LookupSkuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_catalog_catalog_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupSkuResponse.ProtoReflect.Descriptor instead.
+func (*LookupSkuResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_catalog_catalog_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LookupSkuResponse) GetSku() *Sku {
+	if x != nil {
+		return x.Sku
+	}
+	return nil
+}
+
+type Sku struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sku) Reset() {
+	*x = Sku{}
+	mi := &file_testdata_catalog_catalog_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sku) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sku) ProtoMessage() {}
+
+func (x *Sku) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_catalog_catalog_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sku.ProtoReflect.Descriptor instead.
+func (*Sku) Descriptor() ([]byte, []int) {
+	return file_testdata_catalog_catalog_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Sku) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Sku) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+var File_testdata_catalog_catalog_proto protoreflect.FileDescriptor
+
+const file_testdata_catalog_catalog_proto_rawDesc = "" +
+	"\n" +
+	"\x1etestdata/catalog/catalog.proto\x12\x10testdata.catalog\"$\n" +
+	"\x10LookupSkuRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\"<\n" +
+	"\x11LookupSkuResponse\x12'\n" +
+	"\x03sku\x18\x01 \x01(\v2\x15.testdata.catalog.SkuR\x03sku\"+\n" +
+	"\x03Sku\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title2f\n" +
+	"\x0eCatalogService\x12T\n" +
+	"\tLookupSku\x12\".testdata.catalog.LookupSkuRequest\x1a#.testdata.catalog.LookupSkuResponseB\xd0\x01\n" +
+	"\x14com.testdata.catalogB\fCatalogProtoP\x01ZIgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/catalog\xa2\x02\x03TCX\xaa\x02\x10Testdata.Catalog\xca\x02\x10Testdata\\Catalog\xe2\x02\x1cTestdata\\Catalog\\GPBMetadata\xea\x02\x11Testdata::Catalogb\x06proto3"
+
+var (
+	file_testdata_catalog_catalog_proto_rawDescOnce sync.Once
+	file_testdata_catalog_catalog_proto_rawDescData []byte
+)
+
+func file_testdata_catalog_catalog_proto_rawDescGZIP() []byte {
+	file_testdata_catalog_catalog_proto_rawDescOnce.Do(func() {
+		file_testdata_catalog_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_catalog_catalog_proto_rawDesc), len(file_testdata_catalog_catalog_proto_rawDesc)))
+	})
+	return file_testdata_catalog_catalog_proto_rawDescData
+}
+
+var file_testdata_catalog_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_testdata_catalog_catalog_proto_goTypes = []any{
+	(*LookupSkuRequest)(nil),  // 0: testdata.catalog.LookupSkuRequest
+	(*LookupSkuResponse)(nil), // 1: testdata.catalog.LookupSkuResponse
+	(*Sku)(nil),               // 2: testdata.catalog.Sku
+}
+var file_testdata_catalog_catalog_proto_depIdxs = []int32{
+	2, // 0: testdata.catalog.LookupSkuResponse.sku:type_name -> testdata.catalog.Sku
+	0, // 1: testdata.catalog.CatalogService.LookupSku:input_type -> testdata.catalog.LookupSkuRequest
+	1, // 2: testdata.catalog.CatalogService.LookupSku:output_type -> testdata.catalog.LookupSkuResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_testdata_catalog_catalog_proto_init() }
+func file_testdata_catalog_catalog_proto_init() {
+	if File_testdata_catalog_catalog_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_catalog_catalog_proto_rawDesc), len(file_testdata_catalog_catalog_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_catalog_catalog_proto_goTypes,
+		DependencyIndexes: file_testdata_catalog_catalog_proto_depIdxs,
+		MessageInfos:      file_testdata_catalog_catalog_proto_msgTypes,
+	}.Build()
+	File_testdata_catalog_catalog_proto = out.File
+	file_testdata_catalog_catalog_proto_goTypes = nil
+	file_testdata_catalog_catalog_proto_depIdxs = nil
+}
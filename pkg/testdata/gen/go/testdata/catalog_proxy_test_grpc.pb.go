@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/catalog_proxy_test.proto
+
+package testdata
+
+import (
+	context "context"
+	catalog "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/catalog"
+	status "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/status"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status1 "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CatalogProxyService_LookupSku_FullMethodName    = "/testdata.CatalogProxyService/LookupSku"
+	CatalogProxyService_DescribeSku_FullMethodName  = "/testdata.CatalogProxyService/DescribeSku"
+	CatalogProxyService_GetSkuStatus_FullMethodName = "/testdata.CatalogProxyService/GetSkuStatus"
+)
+
+// CatalogProxyServiceClient is the client API for CatalogProxyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CatalogProxyService serves catalog lookups with the request and response
+// messages of another package.
+type CatalogProxyServiceClient interface {
+	LookupSku(ctx context.Context, in *catalog.LookupSkuRequest, opts ...grpc.CallOption) (*catalog.LookupSkuResponse, error)
+	DescribeSku(ctx context.Context, in *DescribeSkuRequest, opts ...grpc.CallOption) (*catalog.Sku, error)
+	GetSkuStatus(ctx context.Context, in *catalog.LookupSkuRequest, opts ...grpc.CallOption) (*status.SkuStatus, error)
+}
+
+type catalogProxyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCatalogProxyServiceClient(cc grpc.ClientConnInterface) CatalogProxyServiceClient {
+	return &catalogProxyServiceClient{cc}
+}
+
+func (c *catalogProxyServiceClient) LookupSku(ctx context.Context, in *catalog.LookupSkuRequest, opts ...grpc.CallOption) (*catalog.LookupSkuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(catalog.LookupSkuResponse)
+	err := c.cc.Invoke(ctx, CatalogProxyService_LookupSku_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogProxyServiceClient) DescribeSku(ctx context.Context, in *DescribeSkuRequest, opts ...grpc.CallOption) (*catalog.Sku, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(catalog.Sku)
+	err := c.cc.Invoke(ctx, CatalogProxyService_DescribeSku_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogProxyServiceClient) GetSkuStatus(ctx context.Context, in *catalog.LookupSkuRequest, opts ...grpc.CallOption) (*status.SkuStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(status.SkuStatus)
+	err := c.cc.Invoke(ctx, CatalogProxyService_GetSkuStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CatalogProxyServiceServer is the server API for CatalogProxyService service.
+// All implementations must embed UnimplementedCatalogProxyServiceServer
+// for forward compatibility.
+//
+// CatalogProxyService serves catalog lookups with the request and response
+// messages of another package.
+type CatalogProxyServiceServer interface {
+	LookupSku(context.Context, *catalog.LookupSkuRequest) (*catalog.LookupSkuResponse, error)
+	DescribeSku(context.Context, *DescribeSkuRequest) (*catalog.Sku, error)
+	GetSkuStatus(context.Context, *catalog.LookupSkuRequest) (*status.SkuStatus, error)
+	mustEmbedUnimplementedCatalogProxyServiceServer()
+}
+
+// UnimplementedCatalogProxyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCatalogProxyServiceServer struct{}
+
+func (UnimplementedCatalogProxyServiceServer) LookupSku(context.Context, *catalog.LookupSkuRequest) (*catalog.LookupSkuResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method LookupSku not implemented")
+}
+func (UnimplementedCatalogProxyServiceServer) DescribeSku(context.Context, *DescribeSkuRequest) (*catalog.Sku, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method DescribeSku not implemented")
+}
+func (UnimplementedCatalogProxyServiceServer) GetSkuStatus(context.Context, *catalog.LookupSkuRequest) (*status.SkuStatus, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method GetSkuStatus not implemented")
+}
+func (UnimplementedCatalogProxyServiceServer) mustEmbedUnimplementedCatalogProxyServiceServer() {}
+func (UnimplementedCatalogProxyServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeCatalogProxyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CatalogProxyServiceServer will
+// result in compilation errors.
+type UnsafeCatalogProxyServiceServer interface {
+	mustEmbedUnimplementedCatalogProxyServiceServer()
+}
+
+func RegisterCatalogProxyServiceServer(s grpc.ServiceRegistrar, srv CatalogProxyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCatalogProxyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CatalogProxyService_ServiceDesc, srv)
+}
+
+func _CatalogProxyService_LookupSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(catalog.LookupSkuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogProxyServiceServer).LookupSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogProxyService_LookupSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogProxyServiceServer).LookupSku(ctx, req.(*catalog.LookupSkuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogProxyService_DescribeSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeSkuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogProxyServiceServer).DescribeSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogProxyService_DescribeSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogProxyServiceServer).DescribeSku(ctx, req.(*DescribeSkuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogProxyService_GetSkuStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(catalog.LookupSkuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogProxyServiceServer).GetSkuStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogProxyService_GetSkuStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogProxyServiceServer).GetSkuStatus(ctx, req.(*catalog.LookupSkuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CatalogProxyService_ServiceDesc is the grpc.ServiceDesc for CatalogProxyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CatalogProxyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.CatalogProxyService",
+	HandlerType: (*CatalogProxyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LookupSku",
+			Handler:    _CatalogProxyService_LookupSku_Handler,
+		},
+		{
+			MethodName: "DescribeSku",
+			Handler:    _CatalogProxyService_DescribeSku_Handler,
+		},
+		{
+			MethodName: "GetSkuStatus",
+			Handler:    _CatalogProxyService_GetSkuStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/catalog_proxy_test.proto",
+}
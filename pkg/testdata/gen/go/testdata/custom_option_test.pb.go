@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/custom_option_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeleteRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecordId      string                 `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecordRequest) Reset() {
+	*x = DeleteRecordRequest{}
+	mi := &file_testdata_custom_option_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecordRequest) ProtoMessage() {}
+
+func (x *DeleteRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_custom_option_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecordRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecordRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_custom_option_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteRecordRequest) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *DeleteRecordRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeleteRecordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecordResponse) Reset() {
+	*x = DeleteRecordResponse{}
+	mi := &file_testdata_custom_option_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecordResponse) ProtoMessage() {}
+
+func (x *DeleteRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_custom_option_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecordResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRecordResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_custom_option_test_proto_rawDescGZIP(), []int{1}
+}
+
+var File_testdata_custom_option_test_proto protoreflect.FileDescriptor
+
+const file_testdata_custom_option_test_proto_rawDesc = "" +
+	"\n" +
+	"!testdata/custom_option_test.proto\x12\btestdata\x1a&testdata/custom_option_defs_test.proto\"V\n" +
+	"\x13DeleteRecordRequest\x12'\n" +
+	"\trecord_id\x18\x01 \x01(\tB\n" +
+	"\xca\xf0\x19\x06RecordR\brecordId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x16\n" +
+	"\x14DeleteRecordResponse2p\n" +
+	"\x0eAuditedService\x12^\n" +
+	"\fDeleteRecord\x12\x1d.testdata.DeleteRecordRequest\x1a\x1e.testdata.DeleteRecordResponse\"\x0f\xc2\xf0\x19\v\n" +
+	"\arecords\x10\x01B\xa8\x01\n" +
+	"\fcom.testdataB\x15CustomOptionTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_custom_option_test_proto_rawDescOnce sync.Once
+	file_testdata_custom_option_test_proto_rawDescData []byte
+)
+
+func file_testdata_custom_option_test_proto_rawDescGZIP() []byte {
+	file_testdata_custom_option_test_proto_rawDescOnce.Do(func() {
+		file_testdata_custom_option_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_custom_option_test_proto_rawDesc), len(file_testdata_custom_option_test_proto_rawDesc)))
+	})
+	return file_testdata_custom_option_test_proto_rawDescData
+}
+
+var file_testdata_custom_option_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_custom_option_test_proto_goTypes = []any{
+	(*DeleteRecordRequest)(nil),  // 0: testdata.DeleteRecordRequest
+	(*DeleteRecordResponse)(nil), // 1: testdata.DeleteRecordResponse
+}
+var file_testdata_custom_option_test_proto_depIdxs = []int32{
+	0, // 0: testdata.AuditedService.DeleteRecord:input_type -> testdata.DeleteRecordRequest
+	1, // 1: testdata.AuditedService.DeleteRecord:output_type -> testdata.DeleteRecordResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_testdata_custom_option_test_proto_init() }
+func file_testdata_custom_option_test_proto_init() {
+	if File_testdata_custom_option_test_proto != nil {
+		return
+	}
+	file_testdata_custom_option_defs_test_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_custom_option_test_proto_rawDesc), len(file_testdata_custom_option_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_custom_option_test_proto_goTypes,
+		DependencyIndexes: file_testdata_custom_option_test_proto_depIdxs,
+		MessageInfos:      file_testdata_custom_option_test_proto_msgTypes,
+	}.Build()
+	File_testdata_custom_option_test_proto = out.File
+	file_testdata_custom_option_test_proto_goTypes = nil
+	file_testdata_custom_option_test_proto_depIdxs = nil
+}
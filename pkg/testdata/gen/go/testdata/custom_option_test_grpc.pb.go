@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/custom_option_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AuditedService_DeleteRecord_FullMethodName = "/testdata.AuditedService/DeleteRecord"
+)
+
+// AuditedServiceClient is the client API for AuditedService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AuditedService uses the custom options declared in
+// custom_option_defs_test.proto.
+type AuditedServiceClient interface {
+	DeleteRecord(ctx context.Context, in *DeleteRecordRequest, opts ...grpc.CallOption) (*DeleteRecordResponse, error)
+}
+
+type auditedServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditedServiceClient(cc grpc.ClientConnInterface) AuditedServiceClient {
+	return &auditedServiceClient{cc}
+}
+
+func (c *auditedServiceClient) DeleteRecord(ctx context.Context, in *DeleteRecordRequest, opts ...grpc.CallOption) (*DeleteRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRecordResponse)
+	err := c.cc.Invoke(ctx, AuditedService_DeleteRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditedServiceServer is the server API for AuditedService service.
+// All implementations must embed UnimplementedAuditedServiceServer
+// for forward compatibility.
+//
+// AuditedService uses the custom options declared in
+// custom_option_defs_test.proto.
+type AuditedServiceServer interface {
+	DeleteRecord(context.Context, *DeleteRecordRequest) (*DeleteRecordResponse, error)
+	mustEmbedUnimplementedAuditedServiceServer()
+}
+
+// UnimplementedAuditedServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditedServiceServer struct{}
+
+func (UnimplementedAuditedServiceServer) DeleteRecord(context.Context, *DeleteRecordRequest) (*DeleteRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
+}
+func (UnimplementedAuditedServiceServer) mustEmbedUnimplementedAuditedServiceServer() {}
+func (UnimplementedAuditedServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeAuditedServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditedServiceServer will
+// result in compilation errors.
+type UnsafeAuditedServiceServer interface {
+	mustEmbedUnimplementedAuditedServiceServer()
+}
+
+func RegisterAuditedServiceServer(s grpc.ServiceRegistrar, srv AuditedServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuditedServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuditedService_ServiceDesc, srv)
+}
+
+func _AuditedService_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditedServiceServer).DeleteRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditedService_DeleteRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditedServiceServer).DeleteRecord(ctx, req.(*DeleteRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditedService_ServiceDesc is the grpc.ServiceDesc for AuditedService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditedService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.AuditedService",
+	HandlerType: (*AuditedServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteRecord",
+			Handler:    _AuditedService_DeleteRecord_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/custom_option_test.proto",
+}
@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/deprecated_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetInvoiceRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Number string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	// Superseded by number.
+	//
+	// Deprecated: Marked as deprecated in testdata/deprecated_test.proto.
+	LegacyId      string `protobuf:"bytes,2,opt,name=legacy_id,json=legacyId,proto3" json:"legacy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceRequest) Reset() {
+	*x = GetInvoiceRequest{}
+	mi := &file_testdata_deprecated_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceRequest) ProtoMessage() {}
+
+func (x *GetInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_deprecated_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_deprecated_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetInvoiceRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in testdata/deprecated_test.proto.
+func (x *GetInvoiceRequest) GetLegacyId() string {
+	if x != nil {
+		return x.LegacyId
+	}
+	return ""
+}
+
+type GetInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	TotalCents    int32                  `protobuf:"varint,2,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceResponse) Reset() {
+	*x = GetInvoiceResponse{}
+	mi := &file_testdata_deprecated_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceResponse) ProtoMessage() {}
+
+func (x *GetInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_deprecated_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*GetInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_deprecated_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetInvoiceResponse) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *GetInvoiceResponse) GetTotalCents() int32 {
+	if x != nil {
+		return x.TotalCents
+	}
+	return 0
+}
+
+var File_testdata_deprecated_test_proto protoreflect.FileDescriptor
+
+const file_testdata_deprecated_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1etestdata/deprecated_test.proto\x12\btestdata\"L\n" +
+	"\x11GetInvoiceRequest\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\tR\x06number\x12\x1f\n" +
+	"\tlegacy_id\x18\x02 \x01(\tB\x02\x18\x01R\blegacyId\"M\n" +
+	"\x12GetInvoiceResponse\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\tR\x06number\x12\x1f\n" +
+	"\vtotal_cents\x18\x02 \x01(\x05R\n" +
+	"totalCents2\xa9\x01\n" +
+	"\x0eInvoiceService\x12N\n" +
+	"\fGetInvoiceV1\x12\x1b.testdata.GetInvoiceRequest\x1a\x1c.testdata.GetInvoiceResponse\"\x03\x88\x02\x01\x12G\n" +
+	"\n" +
+	"GetInvoice\x12\x1b.testdata.GetInvoiceRequest\x1a\x1c.testdata.GetInvoiceResponseB\xa6\x01\n" +
+	"\fcom.testdataB\x13DeprecatedTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_deprecated_test_proto_rawDescOnce sync.Once
+	file_testdata_deprecated_test_proto_rawDescData []byte
+)
+
+func file_testdata_deprecated_test_proto_rawDescGZIP() []byte {
+	file_testdata_deprecated_test_proto_rawDescOnce.Do(func() {
+		file_testdata_deprecated_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_deprecated_test_proto_rawDesc), len(file_testdata_deprecated_test_proto_rawDesc)))
+	})
+	return file_testdata_deprecated_test_proto_rawDescData
+}
+
+var file_testdata_deprecated_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_deprecated_test_proto_goTypes = []any{
+	(*GetInvoiceRequest)(nil),  // 0: testdata.GetInvoiceRequest
+	(*GetInvoiceResponse)(nil), // 1: testdata.GetInvoiceResponse
+}
+var file_testdata_deprecated_test_proto_depIdxs = []int32{
+	0, // 0: testdata.InvoiceService.GetInvoiceV1:input_type -> testdata.GetInvoiceRequest
+	0, // 1: testdata.InvoiceService.GetInvoice:input_type -> testdata.GetInvoiceRequest
+	1, // 2: testdata.InvoiceService.GetInvoiceV1:output_type -> testdata.GetInvoiceResponse
+	1, // 3: testdata.InvoiceService.GetInvoice:output_type -> testdata.GetInvoiceResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_testdata_deprecated_test_proto_init() }
+func file_testdata_deprecated_test_proto_init() {
+	if File_testdata_deprecated_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_deprecated_test_proto_rawDesc), len(file_testdata_deprecated_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_deprecated_test_proto_goTypes,
+		DependencyIndexes: file_testdata_deprecated_test_proto_depIdxs,
+		MessageInfos:      file_testdata_deprecated_test_proto_msgTypes,
+	}.Build()
+	File_testdata_deprecated_test_proto = out.File
+	file_testdata_deprecated_test_proto_goTypes = nil
+	file_testdata_deprecated_test_proto_depIdxs = nil
+}
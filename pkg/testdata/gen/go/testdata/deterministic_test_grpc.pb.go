@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/deterministic_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DeterministicService_Configure_FullMethodName = "/testdata.DeterministicService/Configure"
+)
+
+// DeterministicServiceClient is the client API for DeterministicService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DeterministicService has a request with several oneofs, nested messages and
+// enums, so any map iteration leaking into the output shows up as churn.
+type DeterministicServiceClient interface {
+	Configure(ctx context.Context, in *ConfigureRequest, opts ...grpc.CallOption) (*ConfigureResponse, error)
+}
+
+type deterministicServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeterministicServiceClient(cc grpc.ClientConnInterface) DeterministicServiceClient {
+	return &deterministicServiceClient{cc}
+}
+
+func (c *deterministicServiceClient) Configure(ctx context.Context, in *ConfigureRequest, opts ...grpc.CallOption) (*ConfigureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigureResponse)
+	err := c.cc.Invoke(ctx, DeterministicService_Configure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeterministicServiceServer is the server API for DeterministicService service.
+// All implementations must embed UnimplementedDeterministicServiceServer
+// for forward compatibility.
+//
+// DeterministicService has a request with several oneofs, nested messages and
+// enums, so any map iteration leaking into the output shows up as churn.
+type DeterministicServiceServer interface {
+	Configure(context.Context, *ConfigureRequest) (*ConfigureResponse, error)
+	mustEmbedUnimplementedDeterministicServiceServer()
+}
+
+// UnimplementedDeterministicServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDeterministicServiceServer struct{}
+
+func (UnimplementedDeterministicServiceServer) Configure(context.Context, *ConfigureRequest) (*ConfigureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Configure not implemented")
+}
+func (UnimplementedDeterministicServiceServer) mustEmbedUnimplementedDeterministicServiceServer() {}
+func (UnimplementedDeterministicServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeDeterministicServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeterministicServiceServer will
+// result in compilation errors.
+type UnsafeDeterministicServiceServer interface {
+	mustEmbedUnimplementedDeterministicServiceServer()
+}
+
+func RegisterDeterministicServiceServer(s grpc.ServiceRegistrar, srv DeterministicServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDeterministicServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DeterministicService_ServiceDesc, srv)
+}
+
+func _DeterministicService_Configure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeterministicServiceServer).Configure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeterministicService_Configure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeterministicServiceServer).Configure(ctx, req.(*ConfigureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeterministicService_ServiceDesc is the grpc.ServiceDesc for DeterministicService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeterministicService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.DeterministicService",
+	HandlerType: (*DeterministicServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Configure",
+			Handler:    _DeterministicService_Configure_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/deterministic_test.proto",
+}
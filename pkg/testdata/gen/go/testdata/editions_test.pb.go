@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/editions_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UpdateProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Explicit presence, the edition 2023 default.
+	Nickname *string `protobuf:"bytes,1,opt,name=nickname" json:"nickname,omitempty"`
+	// Implicit presence, like a proto3 field without optional.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	// Legacy required: must always be set.
+	Version *int32 `protobuf:"varint,3,req,name=version" json:"version,omitempty"`
+	// Message fields always track presence.
+	Settings *ProfileSettings `protobuf:"bytes,4,opt,name=settings" json:"settings,omitempty"`
+	// Repeated fields are never required.
+	Tags          []string `protobuf:"bytes,5,rep,name=tags" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_testdata_editions_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_editions_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_editions_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateProfileRequest) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateProfileRequest) GetSettings() *ProfileSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *UpdateProfileRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ProfileSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Explicit presence, the edition 2023 default.
+	DarkMode *bool `protobuf:"varint,1,opt,name=dark_mode,json=darkMode" json:"dark_mode,omitempty"`
+	// Implicit presence.
+	Locale        string `protobuf:"bytes,2,opt,name=locale" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileSettings) Reset() {
+	*x = ProfileSettings{}
+	mi := &file_testdata_editions_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileSettings) ProtoMessage() {}
+
+func (x *ProfileSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_editions_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileSettings.ProtoReflect.Descriptor instead.
+func (*ProfileSettings) Descriptor() ([]byte, []int) {
+	return file_testdata_editions_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProfileSettings) GetDarkMode() bool {
+	if x != nil && x.DarkMode != nil {
+		return *x.DarkMode
+	}
+	return false
+}
+
+func (x *ProfileSettings) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nickname      *string                `protobuf:"bytes,1,opt,name=nickname" json:"nickname,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_testdata_editions_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_editions_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_editions_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateProfileResponse) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+var File_testdata_editions_test_proto protoreflect.FileDescriptor
+
+const file_testdata_editions_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1ctestdata/editions_test.proto\x12\btestdata\"\xbe\x01\n" +
+	"\x14UpdateProfileRequest\x12\x1a\n" +
+	"\bnickname\x18\x01 \x01(\tR\bnickname\x12\x1e\n" +
+	"\auser_id\x18\x02 \x01(\tB\x05\xaa\x01\x02\b\x02R\x06userId\x12\x1f\n" +
+	"\aversion\x18\x03 \x01(\x05B\x05\xaa\x01\x02\b\x03R\aversion\x125\n" +
+	"\bsettings\x18\x04 \x01(\v2\x19.testdata.ProfileSettingsR\bsettings\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\"M\n" +
+	"\x0fProfileSettings\x12\x1b\n" +
+	"\tdark_mode\x18\x01 \x01(\bR\bdarkMode\x12\x1d\n" +
+	"\x06locale\x18\x02 \x01(\tB\x05\xaa\x01\x02\b\x02R\x06locale\"3\n" +
+	"\x15UpdateProfileResponse\x12\x1a\n" +
+	"\bnickname\x18\x01 \x01(\tR\bnickname2c\n" +
+	"\x0fEditionsService\x12P\n" +
+	"\rUpdateProfile\x12\x1e.testdata.UpdateProfileRequest\x1a\x1f.testdata.UpdateProfileResponseB\xa4\x01\n" +
+	"\fcom.testdataB\x11EditionsTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\beditionsp\xe8\a"
+
+var (
+	file_testdata_editions_test_proto_rawDescOnce sync.Once
+	file_testdata_editions_test_proto_rawDescData []byte
+)
+
+func file_testdata_editions_test_proto_rawDescGZIP() []byte {
+	file_testdata_editions_test_proto_rawDescOnce.Do(func() {
+		file_testdata_editions_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_editions_test_proto_rawDesc), len(file_testdata_editions_test_proto_rawDesc)))
+	})
+	return file_testdata_editions_test_proto_rawDescData
+}
+
+var file_testdata_editions_test_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_testdata_editions_test_proto_goTypes = []any{
+	(*UpdateProfileRequest)(nil),  // 0: testdata.UpdateProfileRequest
+	(*ProfileSettings)(nil),       // 1: testdata.ProfileSettings
+	(*UpdateProfileResponse)(nil), // 2: testdata.UpdateProfileResponse
+}
+var file_testdata_editions_test_proto_depIdxs = []int32{
+	1, // 0: testdata.UpdateProfileRequest.settings:type_name -> testdata.ProfileSettings
+	0, // 1: testdata.EditionsService.UpdateProfile:input_type -> testdata.UpdateProfileRequest
+	2, // 2: testdata.EditionsService.UpdateProfile:output_type -> testdata.UpdateProfileResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_testdata_editions_test_proto_init() }
+func file_testdata_editions_test_proto_init() {
+	if File_testdata_editions_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_editions_test_proto_rawDesc), len(file_testdata_editions_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_editions_test_proto_goTypes,
+		DependencyIndexes: file_testdata_editions_test_proto_depIdxs,
+		MessageInfos:      file_testdata_editions_test_proto_msgTypes,
+	}.Build()
+	File_testdata_editions_test_proto = out.File
+	file_testdata_editions_test_proto_goTypes = nil
+	file_testdata_editions_test_proto_depIdxs = nil
+}
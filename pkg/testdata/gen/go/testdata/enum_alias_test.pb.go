@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/enum_alias_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ShipmentState int32
+
+const (
+	ShipmentState_SHIPMENT_STATE_UNSPECIFIED ShipmentState = 0
+	ShipmentState_SHIPMENT_STATE_IN_TRANSIT  ShipmentState = 1
+	ShipmentState_SHIPMENT_STATE_SHIPPED     ShipmentState = 1
+	ShipmentState_SHIPMENT_STATE_DELIVERED   ShipmentState = 2
+	ShipmentState_SHIPMENT_STATE_RECEIVED    ShipmentState = 2
+	ShipmentState_SHIPMENT_STATE_DONE        ShipmentState = 2
+)
+
+// Enum value maps for ShipmentState.
+var (
+	ShipmentState_name = map[int32]string{
+		0: "SHIPMENT_STATE_UNSPECIFIED",
+		1: "SHIPMENT_STATE_IN_TRANSIT",
+		// Duplicate value: 1: "SHIPMENT_STATE_SHIPPED",
+		2: "SHIPMENT_STATE_DELIVERED",
+		// Duplicate value: 2: "SHIPMENT_STATE_RECEIVED",
+		// Duplicate value: 2: "SHIPMENT_STATE_DONE",
+	}
+	ShipmentState_value = map[string]int32{
+		"SHIPMENT_STATE_UNSPECIFIED": 0,
+		"SHIPMENT_STATE_IN_TRANSIT":  1,
+		"SHIPMENT_STATE_SHIPPED":     1,
+		"SHIPMENT_STATE_DELIVERED":   2,
+		"SHIPMENT_STATE_RECEIVED":    2,
+		"SHIPMENT_STATE_DONE":        2,
+	}
+)
+
+func (x ShipmentState) Enum() *ShipmentState {
+	p := new(ShipmentState)
+	*p = x
+	return p
+}
+
+func (x ShipmentState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShipmentState) Descriptor() protoreflect.EnumDescriptor {
+	return file_testdata_enum_alias_test_proto_enumTypes[0].Descriptor()
+}
+
+func (ShipmentState) Type() protoreflect.EnumType {
+	return &file_testdata_enum_alias_test_proto_enumTypes[0]
+}
+
+func (x ShipmentState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShipmentState.Descriptor instead.
+func (ShipmentState) EnumDescriptor() ([]byte, []int) {
+	return file_testdata_enum_alias_test_proto_rawDescGZIP(), []int{0}
+}
+
+type UpdateShipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         ShipmentState          `protobuf:"varint,1,opt,name=state,proto3,enum=testdata.ShipmentState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateShipmentRequest) Reset() {
+	*x = UpdateShipmentRequest{}
+	mi := &file_testdata_enum_alias_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShipmentRequest) ProtoMessage() {}
+
+func (x *UpdateShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_enum_alias_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShipmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_enum_alias_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateShipmentRequest) GetState() ShipmentState {
+	if x != nil {
+		return x.State
+	}
+	return ShipmentState_SHIPMENT_STATE_UNSPECIFIED
+}
+
+type UpdateShipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         ShipmentState          `protobuf:"varint,1,opt,name=state,proto3,enum=testdata.ShipmentState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateShipmentResponse) Reset() {
+	*x = UpdateShipmentResponse{}
+	mi := &file_testdata_enum_alias_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShipmentResponse) ProtoMessage() {}
+
+func (x *UpdateShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_enum_alias_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShipmentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_enum_alias_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateShipmentResponse) GetState() ShipmentState {
+	if x != nil {
+		return x.State
+	}
+	return ShipmentState_SHIPMENT_STATE_UNSPECIFIED
+}
+
+var File_testdata_enum_alias_test_proto protoreflect.FileDescriptor
+
+const file_testdata_enum_alias_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1etestdata/enum_alias_test.proto\x12\btestdata\"F\n" +
+	"\x15UpdateShipmentRequest\x12-\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x17.testdata.ShipmentStateR\x05state\"G\n" +
+	"\x16UpdateShipmentResponse\x12-\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x17.testdata.ShipmentStateR\x05state*\xc2\x01\n" +
+	"\rShipmentState\x12\x1e\n" +
+	"\x1aSHIPMENT_STATE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19SHIPMENT_STATE_IN_TRANSIT\x10\x01\x12\x1a\n" +
+	"\x16SHIPMENT_STATE_SHIPPED\x10\x01\x12\x1c\n" +
+	"\x18SHIPMENT_STATE_DELIVERED\x10\x02\x12\x1b\n" +
+	"\x17SHIPMENT_STATE_RECEIVED\x10\x02\x12\x17\n" +
+	"\x13SHIPMENT_STATE_DONE\x10\x02\x1a\x02\x10\x012f\n" +
+	"\x0fShipmentService\x12S\n" +
+	"\x0eUpdateShipment\x12\x1f.testdata.UpdateShipmentRequest\x1a .testdata.UpdateShipmentResponseB\xa5\x01\n" +
+	"\fcom.testdataB\x12EnumAliasTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_enum_alias_test_proto_rawDescOnce sync.Once
+	file_testdata_enum_alias_test_proto_rawDescData []byte
+)
+
+func file_testdata_enum_alias_test_proto_rawDescGZIP() []byte {
+	file_testdata_enum_alias_test_proto_rawDescOnce.Do(func() {
+		file_testdata_enum_alias_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_enum_alias_test_proto_rawDesc), len(file_testdata_enum_alias_test_proto_rawDesc)))
+	})
+	return file_testdata_enum_alias_test_proto_rawDescData
+}
+
+var file_testdata_enum_alias_test_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_testdata_enum_alias_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_enum_alias_test_proto_goTypes = []any{
+	(ShipmentState)(0),             // 0: testdata.ShipmentState
+	(*UpdateShipmentRequest)(nil),  // 1: testdata.UpdateShipmentRequest
+	(*UpdateShipmentResponse)(nil), // 2: testdata.UpdateShipmentResponse
+}
+var file_testdata_enum_alias_test_proto_depIdxs = []int32{
+	0, // 0: testdata.UpdateShipmentRequest.state:type_name -> testdata.ShipmentState
+	0, // 1: testdata.UpdateShipmentResponse.state:type_name -> testdata.ShipmentState
+	1, // 2: testdata.ShipmentService.UpdateShipment:input_type -> testdata.UpdateShipmentRequest
+	2, // 3: testdata.ShipmentService.UpdateShipment:output_type -> testdata.UpdateShipmentResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_testdata_enum_alias_test_proto_init() }
+func file_testdata_enum_alias_test_proto_init() {
+	if File_testdata_enum_alias_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_enum_alias_test_proto_rawDesc), len(file_testdata_enum_alias_test_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_enum_alias_test_proto_goTypes,
+		DependencyIndexes: file_testdata_enum_alias_test_proto_depIdxs,
+		EnumInfos:         file_testdata_enum_alias_test_proto_enumTypes,
+		MessageInfos:      file_testdata_enum_alias_test_proto_msgTypes,
+	}.Build()
+	File_testdata_enum_alias_test_proto = out.File
+	file_testdata_enum_alias_test_proto_goTypes = nil
+	file_testdata_enum_alias_test_proto_depIdxs = nil
+}
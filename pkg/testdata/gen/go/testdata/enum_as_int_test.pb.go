@@ -0,0 +1,270 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/enum_as_int_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AlarmLevel leaves room between its numbers, so its numbers are sparse.
+type AlarmLevel int32
+
+const (
+	AlarmLevel_ALARM_LEVEL_UNSPECIFIED AlarmLevel = 0
+	AlarmLevel_ALARM_LEVEL_INFO        AlarmLevel = 10
+	// Someone should look at it today.
+	AlarmLevel_ALARM_LEVEL_WARNING  AlarmLevel = 20
+	AlarmLevel_ALARM_LEVEL_CRITICAL AlarmLevel = 50
+)
+
+// Enum value maps for AlarmLevel.
+var (
+	AlarmLevel_name = map[int32]string{
+		0:  "ALARM_LEVEL_UNSPECIFIED",
+		10: "ALARM_LEVEL_INFO",
+		20: "ALARM_LEVEL_WARNING",
+		50: "ALARM_LEVEL_CRITICAL",
+	}
+	AlarmLevel_value = map[string]int32{
+		"ALARM_LEVEL_UNSPECIFIED": 0,
+		"ALARM_LEVEL_INFO":        10,
+		"ALARM_LEVEL_WARNING":     20,
+		"ALARM_LEVEL_CRITICAL":    50,
+	}
+)
+
+func (x AlarmLevel) Enum() *AlarmLevel {
+	p := new(AlarmLevel)
+	*p = x
+	return p
+}
+
+func (x AlarmLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlarmLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_testdata_enum_as_int_test_proto_enumTypes[0].Descriptor()
+}
+
+func (AlarmLevel) Type() protoreflect.EnumType {
+	return &file_testdata_enum_as_int_test_proto_enumTypes[0]
+}
+
+func (x AlarmLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlarmLevel.Descriptor instead.
+func (AlarmLevel) EnumDescriptor() ([]byte, []int) {
+	return file_testdata_enum_as_int_test_proto_rawDescGZIP(), []int{0}
+}
+
+type RaiseAlarmRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Level         AlarmLevel             `protobuf:"varint,2,opt,name=level,proto3,enum=testdata.AlarmLevel" json:"level,omitempty"`
+	EscalateTo    []AlarmLevel           `protobuf:"varint,3,rep,packed,name=escalate_to,json=escalateTo,proto3,enum=testdata.AlarmLevel" json:"escalate_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RaiseAlarmRequest) Reset() {
+	*x = RaiseAlarmRequest{}
+	mi := &file_testdata_enum_as_int_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RaiseAlarmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaiseAlarmRequest) ProtoMessage() {}
+
+func (x *RaiseAlarmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_enum_as_int_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaiseAlarmRequest.ProtoReflect.Descriptor instead.
+func (*RaiseAlarmRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_enum_as_int_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RaiseAlarmRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *RaiseAlarmRequest) GetLevel() AlarmLevel {
+	if x != nil {
+		return x.Level
+	}
+	return AlarmLevel_ALARM_LEVEL_UNSPECIFIED
+}
+
+func (x *RaiseAlarmRequest) GetEscalateTo() []AlarmLevel {
+	if x != nil {
+		return x.EscalateTo
+	}
+	return nil
+}
+
+type RaiseAlarmResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Level         AlarmLevel             `protobuf:"varint,2,opt,name=level,proto3,enum=testdata.AlarmLevel" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RaiseAlarmResponse) Reset() {
+	*x = RaiseAlarmResponse{}
+	mi := &file_testdata_enum_as_int_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RaiseAlarmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaiseAlarmResponse) ProtoMessage() {}
+
+func (x *RaiseAlarmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_enum_as_int_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaiseAlarmResponse.ProtoReflect.Descriptor instead.
+func (*RaiseAlarmResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_enum_as_int_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RaiseAlarmResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RaiseAlarmResponse) GetLevel() AlarmLevel {
+	if x != nil {
+		return x.Level
+	}
+	return AlarmLevel_ALARM_LEVEL_UNSPECIFIED
+}
+
+var File_testdata_enum_as_int_test_proto protoreflect.FileDescriptor
+
+const file_testdata_enum_as_int_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1ftestdata/enum_as_int_test.proto\x12\btestdata\"\x8a\x01\n" +
+	"\x11RaiseAlarmRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12*\n" +
+	"\x05level\x18\x02 \x01(\x0e2\x14.testdata.AlarmLevelR\x05level\x125\n" +
+	"\vescalate_to\x18\x03 \x03(\x0e2\x14.testdata.AlarmLevelR\n" +
+	"escalateTo\"P\n" +
+	"\x12RaiseAlarmResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x05level\x18\x02 \x01(\x0e2\x14.testdata.AlarmLevelR\x05level*r\n" +
+	"\n" +
+	"AlarmLevel\x12\x1b\n" +
+	"\x17ALARM_LEVEL_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10ALARM_LEVEL_INFO\x10\n" +
+	"\x12\x17\n" +
+	"\x13ALARM_LEVEL_WARNING\x10\x14\x12\x18\n" +
+	"\x14ALARM_LEVEL_CRITICAL\x1022W\n" +
+	"\fAlarmService\x12G\n" +
+	"\n" +
+	"RaiseAlarm\x12\x1b.testdata.RaiseAlarmRequest\x1a\x1c.testdata.RaiseAlarmResponseB\xa5\x01\n" +
+	"\fcom.testdataB\x12EnumAsIntTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_enum_as_int_test_proto_rawDescOnce sync.Once
+	file_testdata_enum_as_int_test_proto_rawDescData []byte
+)
+
+func file_testdata_enum_as_int_test_proto_rawDescGZIP() []byte {
+	file_testdata_enum_as_int_test_proto_rawDescOnce.Do(func() {
+		file_testdata_enum_as_int_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_enum_as_int_test_proto_rawDesc), len(file_testdata_enum_as_int_test_proto_rawDesc)))
+	})
+	return file_testdata_enum_as_int_test_proto_rawDescData
+}
+
+var file_testdata_enum_as_int_test_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_testdata_enum_as_int_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_enum_as_int_test_proto_goTypes = []any{
+	(AlarmLevel)(0),            // 0: testdata.AlarmLevel
+	(*RaiseAlarmRequest)(nil),  // 1: testdata.RaiseAlarmRequest
+	(*RaiseAlarmResponse)(nil), // 2: testdata.RaiseAlarmResponse
+}
+var file_testdata_enum_as_int_test_proto_depIdxs = []int32{
+	0, // 0: testdata.RaiseAlarmRequest.level:type_name -> testdata.AlarmLevel
+	0, // 1: testdata.RaiseAlarmRequest.escalate_to:type_name -> testdata.AlarmLevel
+	0, // 2: testdata.RaiseAlarmResponse.level:type_name -> testdata.AlarmLevel
+	1, // 3: testdata.AlarmService.RaiseAlarm:input_type -> testdata.RaiseAlarmRequest
+	2, // 4: testdata.AlarmService.RaiseAlarm:output_type -> testdata.RaiseAlarmResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_testdata_enum_as_int_test_proto_init() }
+func file_testdata_enum_as_int_test_proto_init() {
+	if File_testdata_enum_as_int_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_enum_as_int_test_proto_rawDesc), len(file_testdata_enum_as_int_test_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_enum_as_int_test_proto_goTypes,
+		DependencyIndexes: file_testdata_enum_as_int_test_proto_depIdxs,
+		EnumInfos:         file_testdata_enum_as_int_test_proto_enumTypes,
+		MessageInfos:      file_testdata_enum_as_int_test_proto_msgTypes,
+	}.Build()
+	File_testdata_enum_as_int_test_proto = out.File
+	file_testdata_enum_as_int_test_proto_goTypes = nil
+	file_testdata_enum_as_int_test_proto_depIdxs = nil
+}
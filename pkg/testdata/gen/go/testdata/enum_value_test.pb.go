@@ -0,0 +1,252 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/enum_value_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TicketSeverity int32
+
+const (
+	TicketSeverity_TICKET_SEVERITY_UNSPECIFIED TicketSeverity = 0
+	// Developer note: pages the on-call rotation.
+	TicketSeverity_TICKET_SEVERITY_CRITICAL TicketSeverity = 1
+	// Something is broken but there is a workaround.
+	TicketSeverity_TICKET_SEVERITY_MAJOR TicketSeverity = 2
+	TicketSeverity_TICKET_SEVERITY_MINOR TicketSeverity = 3
+)
+
+// Enum value maps for TicketSeverity.
+var (
+	TicketSeverity_name = map[int32]string{
+		0: "TICKET_SEVERITY_UNSPECIFIED",
+		1: "TICKET_SEVERITY_CRITICAL",
+		2: "TICKET_SEVERITY_MAJOR",
+		3: "TICKET_SEVERITY_MINOR",
+	}
+	TicketSeverity_value = map[string]int32{
+		"TICKET_SEVERITY_UNSPECIFIED": 0,
+		"TICKET_SEVERITY_CRITICAL":    1,
+		"TICKET_SEVERITY_MAJOR":       2,
+		"TICKET_SEVERITY_MINOR":       3,
+	}
+)
+
+func (x TicketSeverity) Enum() *TicketSeverity {
+	p := new(TicketSeverity)
+	*p = x
+	return p
+}
+
+func (x TicketSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TicketSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_testdata_enum_value_test_proto_enumTypes[0].Descriptor()
+}
+
+func (TicketSeverity) Type() protoreflect.EnumType {
+	return &file_testdata_enum_value_test_proto_enumTypes[0]
+}
+
+func (x TicketSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TicketSeverity.Descriptor instead.
+func (TicketSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_testdata_enum_value_test_proto_rawDescGZIP(), []int{0}
+}
+
+type FileTicketRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How urgent the ticket is.
+	Severity      TicketSeverity   `protobuf:"varint,1,opt,name=severity,proto3,enum=testdata.TicketSeverity" json:"severity,omitempty"`
+	AlsoAffects   []TicketSeverity `protobuf:"varint,2,rep,packed,name=also_affects,json=alsoAffects,proto3,enum=testdata.TicketSeverity" json:"also_affects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileTicketRequest) Reset() {
+	*x = FileTicketRequest{}
+	mi := &file_testdata_enum_value_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileTicketRequest) ProtoMessage() {}
+
+func (x *FileTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_enum_value_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileTicketRequest.ProtoReflect.Descriptor instead.
+func (*FileTicketRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_enum_value_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FileTicketRequest) GetSeverity() TicketSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return TicketSeverity_TICKET_SEVERITY_UNSPECIFIED
+}
+
+func (x *FileTicketRequest) GetAlsoAffects() []TicketSeverity {
+	if x != nil {
+		return x.AlsoAffects
+	}
+	return nil
+}
+
+type FileTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileTicketResponse) Reset() {
+	*x = FileTicketResponse{}
+	mi := &file_testdata_enum_value_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileTicketResponse) ProtoMessage() {}
+
+func (x *FileTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_enum_value_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileTicketResponse.ProtoReflect.Descriptor instead.
+func (*FileTicketResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_enum_value_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FileTicketResponse) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+var File_testdata_enum_value_test_proto protoreflect.FileDescriptor
+
+const file_testdata_enum_value_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1etestdata/enum_value_test.proto\x12\btestdata\x1a\x19mcp/options/options.proto\"\x86\x01\n" +
+	"\x11FileTicketRequest\x124\n" +
+	"\bseverity\x18\x01 \x01(\x0e2\x18.testdata.TicketSeverityR\bseverity\x12;\n" +
+	"\falso_affects\x18\x02 \x03(\x0e2\x18.testdata.TicketSeverityR\valsoAffects\"1\n" +
+	"\x12FileTicketResponse\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId*\xc5\x01\n" +
+	"\x0eTicketSeverity\x12\x1f\n" +
+	"\x1bTICKET_SEVERITY_UNSPECIFIED\x10\x00\x12E\n" +
+	"\x18TICKET_SEVERITY_CRITICAL\x10\x01\x1a'\xe2\xb5\x19#\n" +
+	"!Production is down for customers.\x12\x19\n" +
+	"\x15TICKET_SEVERITY_MAJOR\x10\x02\x120\n" +
+	"\x15TICKET_SEVERITY_MINOR\x10\x03\x1a\x15\xe2\xb5\x19\x11\n" +
+	"\x0fCosmetic issue.2X\n" +
+	"\rTicketService\x12G\n" +
+	"\n" +
+	"FileTicket\x12\x1b.testdata.FileTicketRequest\x1a\x1c.testdata.FileTicketResponseB\xa5\x01\n" +
+	"\fcom.testdataB\x12EnumValueTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_enum_value_test_proto_rawDescOnce sync.Once
+	file_testdata_enum_value_test_proto_rawDescData []byte
+)
+
+func file_testdata_enum_value_test_proto_rawDescGZIP() []byte {
+	file_testdata_enum_value_test_proto_rawDescOnce.Do(func() {
+		file_testdata_enum_value_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_enum_value_test_proto_rawDesc), len(file_testdata_enum_value_test_proto_rawDesc)))
+	})
+	return file_testdata_enum_value_test_proto_rawDescData
+}
+
+var file_testdata_enum_value_test_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_testdata_enum_value_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_enum_value_test_proto_goTypes = []any{
+	(TicketSeverity)(0),        // 0: testdata.TicketSeverity
+	(*FileTicketRequest)(nil),  // 1: testdata.FileTicketRequest
+	(*FileTicketResponse)(nil), // 2: testdata.FileTicketResponse
+}
+var file_testdata_enum_value_test_proto_depIdxs = []int32{
+	0, // 0: testdata.FileTicketRequest.severity:type_name -> testdata.TicketSeverity
+	0, // 1: testdata.FileTicketRequest.also_affects:type_name -> testdata.TicketSeverity
+	1, // 2: testdata.TicketService.FileTicket:input_type -> testdata.FileTicketRequest
+	2, // 3: testdata.TicketService.FileTicket:output_type -> testdata.FileTicketResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_testdata_enum_value_test_proto_init() }
+func file_testdata_enum_value_test_proto_init() {
+	if File_testdata_enum_value_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_enum_value_test_proto_rawDesc), len(file_testdata_enum_value_test_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_enum_value_test_proto_goTypes,
+		DependencyIndexes: file_testdata_enum_value_test_proto_depIdxs,
+		EnumInfos:         file_testdata_enum_value_test_proto_enumTypes,
+		MessageInfos:      file_testdata_enum_value_test_proto_msgTypes,
+	}.Build()
+	File_testdata_enum_value_test_proto = out.File
+	file_testdata_enum_value_test_proto_goTypes = nil
+	file_testdata_enum_value_test_proto_depIdxs = nil
+}
@@ -0,0 +1,129 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/field_behavior_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FieldBehaviorService_UpsertAccount_FullMethodName = "/testdata.FieldBehaviorService/UpsertAccount"
+)
+
+// FieldBehaviorServiceClient is the client API for FieldBehaviorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FieldBehaviorService uses the same message as request and response, so its
+// INPUT_ONLY and OUTPUT_ONLY fields only belong to one of the two schemas.
+type FieldBehaviorServiceClient interface {
+	// Creates or replaces an account.
+	UpsertAccount(ctx context.Context, in *Account, opts ...grpc.CallOption) (*Account, error)
+}
+
+type fieldBehaviorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFieldBehaviorServiceClient(cc grpc.ClientConnInterface) FieldBehaviorServiceClient {
+	return &fieldBehaviorServiceClient{cc}
+}
+
+func (c *fieldBehaviorServiceClient) UpsertAccount(ctx context.Context, in *Account, opts ...grpc.CallOption) (*Account, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Account)
+	err := c.cc.Invoke(ctx, FieldBehaviorService_UpsertAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FieldBehaviorServiceServer is the server API for FieldBehaviorService service.
+// All implementations must embed UnimplementedFieldBehaviorServiceServer
+// for forward compatibility.
+//
+// FieldBehaviorService uses the same message as request and response, so its
+// INPUT_ONLY and OUTPUT_ONLY fields only belong to one of the two schemas.
+type FieldBehaviorServiceServer interface {
+	// Creates or replaces an account.
+	UpsertAccount(context.Context, *Account) (*Account, error)
+	mustEmbedUnimplementedFieldBehaviorServiceServer()
+}
+
+// UnimplementedFieldBehaviorServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFieldBehaviorServiceServer struct{}
+
+func (UnimplementedFieldBehaviorServiceServer) UpsertAccount(context.Context, *Account) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertAccount not implemented")
+}
+func (UnimplementedFieldBehaviorServiceServer) mustEmbedUnimplementedFieldBehaviorServiceServer() {}
+func (UnimplementedFieldBehaviorServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeFieldBehaviorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FieldBehaviorServiceServer will
+// result in compilation errors.
+type UnsafeFieldBehaviorServiceServer interface {
+	mustEmbedUnimplementedFieldBehaviorServiceServer()
+}
+
+func RegisterFieldBehaviorServiceServer(s grpc.ServiceRegistrar, srv FieldBehaviorServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFieldBehaviorServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FieldBehaviorService_ServiceDesc, srv)
+}
+
+func _FieldBehaviorService_UpsertAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Account)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FieldBehaviorServiceServer).UpsertAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FieldBehaviorService_UpsertAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FieldBehaviorServiceServer).UpsertAccount(ctx, req.(*Account))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FieldBehaviorService_ServiceDesc is the grpc.ServiceDesc for FieldBehaviorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FieldBehaviorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.FieldBehaviorService",
+	HandlerType: (*FieldBehaviorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpsertAccount",
+			Handler:    _FieldBehaviorService_UpsertAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/field_behavior_test.proto",
+}
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/field_comments_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Title of the note.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body  string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"` // Markdown, e.g. `**bold**` or a [link](https://example.com).
+	// Tags of the note.
+	Tags          []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"` // At most 10.
+	Author        string   `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteRequest) Reset() {
+	*x = CreateNoteRequest{}
+	mi := &file_testdata_field_comments_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteRequest) ProtoMessage() {}
+
+func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_field_comments_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_field_comments_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateNoteRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type CreateNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteResponse) Reset() {
+	*x = CreateNoteResponse{}
+	mi := &file_testdata_field_comments_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteResponse) ProtoMessage() {}
+
+func (x *CreateNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_field_comments_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteResponse.ProtoReflect.Descriptor instead.
+func (*CreateNoteResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_field_comments_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateNoteResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_testdata_field_comments_test_proto protoreflect.FileDescriptor
+
+const file_testdata_field_comments_test_proto_rawDesc = "" +
+	"\n" +
+	"\"testdata/field_comments_test.proto\x12\btestdata\"i\n" +
+	"\x11CreateNoteRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\"$\n" +
+	"\x12CreateNoteResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2V\n" +
+	"\vNoteService\x12G\n" +
+	"\n" +
+	"CreateNote\x12\x1b.testdata.CreateNoteRequest\x1a\x1c.testdata.CreateNoteResponseB\xa9\x01\n" +
+	"\fcom.testdataB\x16FieldCommentsTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_field_comments_test_proto_rawDescOnce sync.Once
+	file_testdata_field_comments_test_proto_rawDescData []byte
+)
+
+func file_testdata_field_comments_test_proto_rawDescGZIP() []byte {
+	file_testdata_field_comments_test_proto_rawDescOnce.Do(func() {
+		file_testdata_field_comments_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_field_comments_test_proto_rawDesc), len(file_testdata_field_comments_test_proto_rawDesc)))
+	})
+	return file_testdata_field_comments_test_proto_rawDescData
+}
+
+var file_testdata_field_comments_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_field_comments_test_proto_goTypes = []any{
+	(*CreateNoteRequest)(nil),  // 0: testdata.CreateNoteRequest
+	(*CreateNoteResponse)(nil), // 1: testdata.CreateNoteResponse
+}
+var file_testdata_field_comments_test_proto_depIdxs = []int32{
+	0, // 0: testdata.NoteService.CreateNote:input_type -> testdata.CreateNoteRequest
+	1, // 1: testdata.NoteService.CreateNote:output_type -> testdata.CreateNoteResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_testdata_field_comments_test_proto_init() }
+func file_testdata_field_comments_test_proto_init() {
+	if File_testdata_field_comments_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_field_comments_test_proto_rawDesc), len(file_testdata_field_comments_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_field_comments_test_proto_goTypes,
+		DependencyIndexes: file_testdata_field_comments_test_proto_depIdxs,
+		MessageInfos:      file_testdata_field_comments_test_proto_msgTypes,
+	}.Build()
+	File_testdata_field_comments_test_proto = out.File
+	file_testdata_field_comments_test_proto_goTypes = nil
+	file_testdata_field_comments_test_proto_depIdxs = nil
+}
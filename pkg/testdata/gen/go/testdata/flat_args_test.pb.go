@@ -0,0 +1,427 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/flat_args_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EditProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Notify        bool                   `protobuf:"varint,2,opt,name=notify,proto3" json:"notify,omitempty"`
+	Address       *ProfileAddress        `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditProfileRequest) Reset() {
+	*x = EditProfileRequest{}
+	mi := &file_testdata_flat_args_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditProfileRequest) ProtoMessage() {}
+
+func (x *EditProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_flat_args_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditProfileRequest.ProtoReflect.Descriptor instead.
+func (*EditProfileRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_flat_args_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EditProfileRequest) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *EditProfileRequest) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+func (x *EditProfileRequest) GetAddress() *ProfileAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type Profile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name shown to other users.
+	DisplayName   string   `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio           string   `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	Interests     []string `protobuf:"bytes,3,rep,name=interests,proto3" json:"interests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_testdata_flat_args_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_flat_args_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_testdata_flat_args_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Profile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Profile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *Profile) GetInterests() []string {
+	if x != nil {
+		return x.Interests
+	}
+	return nil
+}
+
+type ProfileAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Street        string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`
+	City          *ProfileCity           `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileAddress) Reset() {
+	*x = ProfileAddress{}
+	mi := &file_testdata_flat_args_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileAddress) ProtoMessage() {}
+
+func (x *ProfileAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_flat_args_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileAddress.ProtoReflect.Descriptor instead.
+func (*ProfileAddress) Descriptor() ([]byte, []int) {
+	return file_testdata_flat_args_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProfileAddress) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *ProfileAddress) GetCity() *ProfileCity {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
+
+type ProfileCity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileCity) Reset() {
+	*x = ProfileCity{}
+	mi := &file_testdata_flat_args_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileCity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileCity) ProtoMessage() {}
+
+func (x *ProfileCity) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_flat_args_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileCity.ProtoReflect.Descriptor instead.
+func (*ProfileCity) Descriptor() ([]byte, []int) {
+	return file_testdata_flat_args_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProfileCity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MoveProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *ProfileCity           `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *ProfileCity           `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveProfileRequest) Reset() {
+	*x = MoveProfileRequest{}
+	mi := &file_testdata_flat_args_test_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveProfileRequest) ProtoMessage() {}
+
+func (x *MoveProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_flat_args_test_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveProfileRequest.ProtoReflect.Descriptor instead.
+func (*MoveProfileRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_flat_args_test_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MoveProfileRequest) GetFrom() *ProfileCity {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *MoveProfileRequest) GetTo() *ProfileCity {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type EditProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditProfileResponse) Reset() {
+	*x = EditProfileResponse{}
+	mi := &file_testdata_flat_args_test_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditProfileResponse) ProtoMessage() {}
+
+func (x *EditProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_flat_args_test_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditProfileResponse.ProtoReflect.Descriptor instead.
+func (*EditProfileResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_flat_args_test_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EditProfileResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_testdata_flat_args_test_proto protoreflect.FileDescriptor
+
+const file_testdata_flat_args_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1dtestdata/flat_args_test.proto\x12\btestdata\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19mcp/options/options.proto\"\x92\x01\n" +
+	"\x12EditProfileRequest\x120\n" +
+	"\aprofile\x18\x01 \x01(\v2\x11.testdata.ProfileB\x03\xe0A\x02R\aprofile\x12\x16\n" +
+	"\x06notify\x18\x02 \x01(\bR\x06notify\x122\n" +
+	"\aaddress\x18\x03 \x01(\v2\x18.testdata.ProfileAddressR\aaddress\"a\n" +
+	"\aProfile\x12&\n" +
+	"\fdisplay_name\x18\x01 \x01(\tB\x03\xe0A\x02R\vdisplayName\x12\x10\n" +
+	"\x03bio\x18\x02 \x01(\tR\x03bio\x12\x1c\n" +
+	"\tinterests\x18\x03 \x03(\tR\tinterests\"S\n" +
+	"\x0eProfileAddress\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12)\n" +
+	"\x04city\x18\x02 \x01(\v2\x15.testdata.ProfileCityR\x04city\"!\n" +
+	"\vProfileCity\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"f\n" +
+	"\x12MoveProfileRequest\x12)\n" +
+	"\x04from\x18\x01 \x01(\v2\x15.testdata.ProfileCityR\x04from\x12%\n" +
+	"\x02to\x18\x02 \x01(\v2\x15.testdata.ProfileCityR\x02to\"%\n" +
+	"\x13EditProfileResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2\xef\x01\n" +
+	"\x0eProfileService\x12\x90\x01\n" +
+	"\vEditProfile\x12\x1c.testdata.EditProfileRequest\x1a\x1d.testdata.EditProfileResponse\"D\x92\xb5\x19@:>profile { display_name: \"Ada\" interests: \"math\" } notify: true\x12J\n" +
+	"\vMoveProfile\x12\x1c.testdata.MoveProfileRequest\x1a\x1d.testdata.EditProfileResponseB\xa4\x01\n" +
+	"\fcom.testdataB\x11FlatArgsTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_flat_args_test_proto_rawDescOnce sync.Once
+	file_testdata_flat_args_test_proto_rawDescData []byte
+)
+
+func file_testdata_flat_args_test_proto_rawDescGZIP() []byte {
+	file_testdata_flat_args_test_proto_rawDescOnce.Do(func() {
+		file_testdata_flat_args_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_flat_args_test_proto_rawDesc), len(file_testdata_flat_args_test_proto_rawDesc)))
+	})
+	return file_testdata_flat_args_test_proto_rawDescData
+}
+
+var file_testdata_flat_args_test_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_testdata_flat_args_test_proto_goTypes = []any{
+	(*EditProfileRequest)(nil),  // 0: testdata.EditProfileRequest
+	(*Profile)(nil),             // 1: testdata.Profile
+	(*ProfileAddress)(nil),      // 2: testdata.ProfileAddress
+	(*ProfileCity)(nil),         // 3: testdata.ProfileCity
+	(*MoveProfileRequest)(nil),  // 4: testdata.MoveProfileRequest
+	(*EditProfileResponse)(nil), // 5: testdata.EditProfileResponse
+}
+var file_testdata_flat_args_test_proto_depIdxs = []int32{
+	1, // 0: testdata.EditProfileRequest.profile:type_name -> testdata.Profile
+	2, // 1: testdata.EditProfileRequest.address:type_name -> testdata.ProfileAddress
+	3, // 2: testdata.ProfileAddress.city:type_name -> testdata.ProfileCity
+	3, // 3: testdata.MoveProfileRequest.from:type_name -> testdata.ProfileCity
+	3, // 4: testdata.MoveProfileRequest.to:type_name -> testdata.ProfileCity
+	0, // 5: testdata.ProfileService.EditProfile:input_type -> testdata.EditProfileRequest
+	4, // 6: testdata.ProfileService.MoveProfile:input_type -> testdata.MoveProfileRequest
+	5, // 7: testdata.ProfileService.EditProfile:output_type -> testdata.EditProfileResponse
+	5, // 8: testdata.ProfileService.MoveProfile:output_type -> testdata.EditProfileResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_testdata_flat_args_test_proto_init() }
+func file_testdata_flat_args_test_proto_init() {
+	if File_testdata_flat_args_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_flat_args_test_proto_rawDesc), len(file_testdata_flat_args_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_flat_args_test_proto_goTypes,
+		DependencyIndexes: file_testdata_flat_args_test_proto_depIdxs,
+		MessageInfos:      file_testdata_flat_args_test_proto_msgTypes,
+	}.Build()
+	File_testdata_flat_args_test_proto = out.File
+	file_testdata_flat_args_test_proto_goTypes = nil
+	file_testdata_flat_args_test_proto_depIdxs = nil
+}
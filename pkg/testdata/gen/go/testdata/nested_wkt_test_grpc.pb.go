@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/nested_wkt_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TripService_PlanTrip_FullMethodName = "/testdata.TripService/PlanTrip"
+)
+
+// TripServiceClient is the client API for TripService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TripService takes well-known types two messages below the request.
+type TripServiceClient interface {
+	PlanTrip(ctx context.Context, in *PlanTripRequest, opts ...grpc.CallOption) (*PlanTripResponse, error)
+}
+
+type tripServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTripServiceClient(cc grpc.ClientConnInterface) TripServiceClient {
+	return &tripServiceClient{cc}
+}
+
+func (c *tripServiceClient) PlanTrip(ctx context.Context, in *PlanTripRequest, opts ...grpc.CallOption) (*PlanTripResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanTripResponse)
+	err := c.cc.Invoke(ctx, TripService_PlanTrip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TripServiceServer is the server API for TripService service.
+// All implementations must embed UnimplementedTripServiceServer
+// for forward compatibility.
+//
+// TripService takes well-known types two messages below the request.
+type TripServiceServer interface {
+	PlanTrip(context.Context, *PlanTripRequest) (*PlanTripResponse, error)
+	mustEmbedUnimplementedTripServiceServer()
+}
+
+// UnimplementedTripServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTripServiceServer struct{}
+
+func (UnimplementedTripServiceServer) PlanTrip(context.Context, *PlanTripRequest) (*PlanTripResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanTrip not implemented")
+}
+func (UnimplementedTripServiceServer) mustEmbedUnimplementedTripServiceServer() {}
+func (UnimplementedTripServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeTripServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TripServiceServer will
+// result in compilation errors.
+type UnsafeTripServiceServer interface {
+	mustEmbedUnimplementedTripServiceServer()
+}
+
+func RegisterTripServiceServer(s grpc.ServiceRegistrar, srv TripServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTripServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TripService_ServiceDesc, srv)
+}
+
+func _TripService_PlanTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).PlanTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_PlanTrip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).PlanTrip(ctx, req.(*PlanTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TripService_ServiceDesc is the grpc.ServiceDesc for TripService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TripService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.TripService",
+	HandlerType: (*TripServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PlanTrip",
+			Handler:    _TripService_PlanTrip_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/nested_wkt_test.proto",
+}
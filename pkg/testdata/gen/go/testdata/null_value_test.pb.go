@@ -0,0 +1,271 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/null_value_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UpdateNicknameRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Types that are valid to be assigned to Change:
+	//
+	//	*UpdateNicknameRequest_Nickname
+	//	*UpdateNicknameRequest_Clear
+	Change         isUpdateNicknameRequest_Change `protobuf_oneof:"change"`
+	ReservedMarker structpb.NullValue             `protobuf:"varint,4,opt,name=reserved_marker,json=reservedMarker,proto3,enum=google.protobuf.NullValue" json:"reserved_marker,omitempty"`
+	Placeholders   []structpb.NullValue           `protobuf:"varint,5,rep,packed,name=placeholders,proto3,enum=google.protobuf.NullValue" json:"placeholders,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateNicknameRequest) Reset() {
+	*x = UpdateNicknameRequest{}
+	mi := &file_testdata_null_value_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNicknameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNicknameRequest) ProtoMessage() {}
+
+func (x *UpdateNicknameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_null_value_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNicknameRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNicknameRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_null_value_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateNicknameRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateNicknameRequest) GetChange() isUpdateNicknameRequest_Change {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+func (x *UpdateNicknameRequest) GetNickname() string {
+	if x != nil {
+		if x, ok := x.Change.(*UpdateNicknameRequest_Nickname); ok {
+			return x.Nickname
+		}
+	}
+	return ""
+}
+
+func (x *UpdateNicknameRequest) GetClear() structpb.NullValue {
+	if x != nil {
+		if x, ok := x.Change.(*UpdateNicknameRequest_Clear); ok {
+			return x.Clear
+		}
+	}
+	return structpb.NullValue(0)
+}
+
+func (x *UpdateNicknameRequest) GetReservedMarker() structpb.NullValue {
+	if x != nil {
+		return x.ReservedMarker
+	}
+	return structpb.NullValue(0)
+}
+
+func (x *UpdateNicknameRequest) GetPlaceholders() []structpb.NullValue {
+	if x != nil {
+		return x.Placeholders
+	}
+	return nil
+}
+
+type isUpdateNicknameRequest_Change interface {
+	isUpdateNicknameRequest_Change()
+}
+
+type UpdateNicknameRequest_Nickname struct {
+	// New nickname.
+	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3,oneof"`
+}
+
+type UpdateNicknameRequest_Clear struct {
+	// Clears the nickname.
+	Clear structpb.NullValue `protobuf:"varint,3,opt,name=clear,proto3,enum=google.protobuf.NullValue,oneof"`
+}
+
+func (*UpdateNicknameRequest_Nickname) isUpdateNicknameRequest_Change() {}
+
+func (*UpdateNicknameRequest_Clear) isUpdateNicknameRequest_Change() {}
+
+type UpdateNicknameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cleared       bool                   `protobuf:"varint,2,opt,name=cleared,proto3" json:"cleared,omitempty"`
+	Previous      structpb.NullValue     `protobuf:"varint,3,opt,name=previous,proto3,enum=google.protobuf.NullValue" json:"previous,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNicknameResponse) Reset() {
+	*x = UpdateNicknameResponse{}
+	mi := &file_testdata_null_value_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNicknameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNicknameResponse) ProtoMessage() {}
+
+func (x *UpdateNicknameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_null_value_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNicknameResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNicknameResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_null_value_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateNicknameResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateNicknameResponse) GetCleared() bool {
+	if x != nil {
+		return x.Cleared
+	}
+	return false
+}
+
+func (x *UpdateNicknameResponse) GetPrevious() structpb.NullValue {
+	if x != nil {
+		return x.Previous
+	}
+	return structpb.NullValue(0)
+}
+
+var File_testdata_null_value_test_proto protoreflect.FileDescriptor
+
+const file_testdata_null_value_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1etestdata/null_value_test.proto\x12\btestdata\x1a\x1cgoogle/protobuf/struct.proto\"\x91\x02\n" +
+	"\x15UpdateNicknameRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\bnickname\x18\x02 \x01(\tH\x00R\bnickname\x122\n" +
+	"\x05clear\x18\x03 \x01(\x0e2\x1a.google.protobuf.NullValueH\x00R\x05clear\x12C\n" +
+	"\x0freserved_marker\x18\x04 \x01(\x0e2\x1a.google.protobuf.NullValueR\x0ereservedMarker\x12>\n" +
+	"\fplaceholders\x18\x05 \x03(\x0e2\x1a.google.protobuf.NullValueR\fplaceholdersB\b\n" +
+	"\x06change\"\x83\x01\n" +
+	"\x16UpdateNicknameResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\acleared\x18\x02 \x01(\bR\acleared\x126\n" +
+	"\bprevious\x18\x03 \x01(\x0e2\x1a.google.protobuf.NullValueR\bprevious2f\n" +
+	"\x0fNicknameService\x12S\n" +
+	"\x0eUpdateNickname\x12\x1f.testdata.UpdateNicknameRequest\x1a .testdata.UpdateNicknameResponseB\xa5\x01\n" +
+	"\fcom.testdataB\x12NullValueTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_null_value_test_proto_rawDescOnce sync.Once
+	file_testdata_null_value_test_proto_rawDescData []byte
+)
+
+func file_testdata_null_value_test_proto_rawDescGZIP() []byte {
+	file_testdata_null_value_test_proto_rawDescOnce.Do(func() {
+		file_testdata_null_value_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_null_value_test_proto_rawDesc), len(file_testdata_null_value_test_proto_rawDesc)))
+	})
+	return file_testdata_null_value_test_proto_rawDescData
+}
+
+var file_testdata_null_value_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_null_value_test_proto_goTypes = []any{
+	(*UpdateNicknameRequest)(nil),  // 0: testdata.UpdateNicknameRequest
+	(*UpdateNicknameResponse)(nil), // 1: testdata.UpdateNicknameResponse
+	(structpb.NullValue)(0),        // 2: google.protobuf.NullValue
+}
+var file_testdata_null_value_test_proto_depIdxs = []int32{
+	2, // 0: testdata.UpdateNicknameRequest.clear:type_name -> google.protobuf.NullValue
+	2, // 1: testdata.UpdateNicknameRequest.reserved_marker:type_name -> google.protobuf.NullValue
+	2, // 2: testdata.UpdateNicknameRequest.placeholders:type_name -> google.protobuf.NullValue
+	2, // 3: testdata.UpdateNicknameResponse.previous:type_name -> google.protobuf.NullValue
+	0, // 4: testdata.NicknameService.UpdateNickname:input_type -> testdata.UpdateNicknameRequest
+	1, // 5: testdata.NicknameService.UpdateNickname:output_type -> testdata.UpdateNicknameResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_testdata_null_value_test_proto_init() }
+func file_testdata_null_value_test_proto_init() {
+	if File_testdata_null_value_test_proto != nil {
+		return
+	}
+	file_testdata_null_value_test_proto_msgTypes[0].OneofWrappers = []any{
+		(*UpdateNicknameRequest_Nickname)(nil),
+		(*UpdateNicknameRequest_Clear)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_null_value_test_proto_rawDesc), len(file_testdata_null_value_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_null_value_test_proto_goTypes,
+		DependencyIndexes: file_testdata_null_value_test_proto_depIdxs,
+		MessageInfos:      file_testdata_null_value_test_proto_msgTypes,
+	}.Build()
+	File_testdata_null_value_test_proto = out.File
+	file_testdata_null_value_test_proto_goTypes = nil
+	file_testdata_null_value_test_proto_depIdxs = nil
+}
@@ -0,0 +1,296 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/oneof_discriminator_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetAttributeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*SetAttributeRequest_StringValue
+	//	*SetAttributeRequest_BoolValue
+	//	*SetAttributeRequest_ListValue
+	Value         isSetAttributeRequest_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttributeRequest) Reset() {
+	*x = SetAttributeRequest{}
+	mi := &file_testdata_oneof_discriminator_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttributeRequest) ProtoMessage() {}
+
+func (x *SetAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_oneof_discriminator_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttributeRequest.ProtoReflect.Descriptor instead.
+func (*SetAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_oneof_discriminator_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetAttributeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetAttributeRequest) GetValue() isSetAttributeRequest_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SetAttributeRequest) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*SetAttributeRequest_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *SetAttributeRequest) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*SetAttributeRequest_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *SetAttributeRequest) GetListValue() *AttributeList {
+	if x != nil {
+		if x, ok := x.Value.(*SetAttributeRequest_ListValue); ok {
+			return x.ListValue
+		}
+	}
+	return nil
+}
+
+type isSetAttributeRequest_Value interface {
+	isSetAttributeRequest_Value()
+}
+
+type SetAttributeRequest_StringValue struct {
+	StringValue string `protobuf:"bytes,2,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type SetAttributeRequest_BoolValue struct {
+	BoolValue bool `protobuf:"varint,3,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type SetAttributeRequest_ListValue struct {
+	ListValue *AttributeList `protobuf:"bytes,4,opt,name=list_value,json=listValue,proto3,oneof"`
+}
+
+func (*SetAttributeRequest_StringValue) isSetAttributeRequest_Value() {}
+
+func (*SetAttributeRequest_BoolValue) isSetAttributeRequest_Value() {}
+
+func (*SetAttributeRequest_ListValue) isSetAttributeRequest_Value() {}
+
+type AttributeList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []string               `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeList) Reset() {
+	*x = AttributeList{}
+	mi := &file_testdata_oneof_discriminator_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeList) ProtoMessage() {}
+
+func (x *AttributeList) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_oneof_discriminator_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeList.ProtoReflect.Descriptor instead.
+func (*AttributeList) Descriptor() ([]byte, []int) {
+	return file_testdata_oneof_discriminator_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttributeList) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SetAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttributeResponse) Reset() {
+	*x = SetAttributeResponse{}
+	mi := &file_testdata_oneof_discriminator_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttributeResponse) ProtoMessage() {}
+
+func (x *SetAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_oneof_discriminator_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttributeResponse.ProtoReflect.Descriptor instead.
+func (*SetAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_oneof_discriminator_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetAttributeResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+var File_testdata_oneof_discriminator_test_proto protoreflect.FileDescriptor
+
+const file_testdata_oneof_discriminator_test_proto_rawDesc = "" +
+	"\n" +
+	"'testdata/oneof_discriminator_test.proto\x12\btestdata\x1a\x19mcp/options/options.proto\"\xc4\x01\n" +
+	"\x13SetAttributeRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\fstring_value\x18\x02 \x01(\tB\b\xa2\xb2\x19\x04textH\x00R\vstringValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x03 \x01(\bH\x00R\tboolValue\x12B\n" +
+	"\n" +
+	"list_value\x18\x04 \x01(\v2\x17.testdata.AttributeListB\b\xa2\xb2\x19\x04listH\x00R\tlistValueB\a\n" +
+	"\x05value\"%\n" +
+	"\rAttributeList\x12\x14\n" +
+	"\x05items\x18\x01 \x03(\tR\x05items\"(\n" +
+	"\x14SetAttributeResponse\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key2i\n" +
+	"\x10AttributeService\x12U\n" +
+	"\fSetAttribute\x12\x1d.testdata.SetAttributeRequest\x1a\x1e.testdata.SetAttributeResponse\"\x06\x92\xb5\x19\x02H\x01B\xae\x01\n" +
+	"\fcom.testdataB\x1bOneofDiscriminatorTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_oneof_discriminator_test_proto_rawDescOnce sync.Once
+	file_testdata_oneof_discriminator_test_proto_rawDescData []byte
+)
+
+func file_testdata_oneof_discriminator_test_proto_rawDescGZIP() []byte {
+	file_testdata_oneof_discriminator_test_proto_rawDescOnce.Do(func() {
+		file_testdata_oneof_discriminator_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_oneof_discriminator_test_proto_rawDesc), len(file_testdata_oneof_discriminator_test_proto_rawDesc)))
+	})
+	return file_testdata_oneof_discriminator_test_proto_rawDescData
+}
+
+var file_testdata_oneof_discriminator_test_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_testdata_oneof_discriminator_test_proto_goTypes = []any{
+	(*SetAttributeRequest)(nil),  // 0: testdata.SetAttributeRequest
+	(*AttributeList)(nil),        // 1: testdata.AttributeList
+	(*SetAttributeResponse)(nil), // 2: testdata.SetAttributeResponse
+}
+var file_testdata_oneof_discriminator_test_proto_depIdxs = []int32{
+	1, // 0: testdata.SetAttributeRequest.list_value:type_name -> testdata.AttributeList
+	0, // 1: testdata.AttributeService.SetAttribute:input_type -> testdata.SetAttributeRequest
+	2, // 2: testdata.AttributeService.SetAttribute:output_type -> testdata.SetAttributeResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_testdata_oneof_discriminator_test_proto_init() }
+func file_testdata_oneof_discriminator_test_proto_init() {
+	if File_testdata_oneof_discriminator_test_proto != nil {
+		return
+	}
+	file_testdata_oneof_discriminator_test_proto_msgTypes[0].OneofWrappers = []any{
+		(*SetAttributeRequest_StringValue)(nil),
+		(*SetAttributeRequest_BoolValue)(nil),
+		(*SetAttributeRequest_ListValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_oneof_discriminator_test_proto_rawDesc), len(file_testdata_oneof_discriminator_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_oneof_discriminator_test_proto_goTypes,
+		DependencyIndexes: file_testdata_oneof_discriminator_test_proto_depIdxs,
+		MessageInfos:      file_testdata_oneof_discriminator_test_proto_msgTypes,
+	}.Build()
+	File_testdata_oneof_discriminator_test_proto = out.File
+	file_testdata_oneof_discriminator_test_proto_goTypes = nil
+	file_testdata_oneof_discriminator_test_proto_depIdxs = nil
+}
@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/optional_fields_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetReminderRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Title         string                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Due           *timestamppb.Timestamp   `protobuf:"bytes,2,opt,name=due,proto3" json:"due,omitempty"`
+	Snooze        *durationpb.Duration     `protobuf:"bytes,3,opt,name=snooze,proto3" json:"snooze,omitempty"`
+	Priority      *wrapperspb.Int32Value   `protobuf:"bytes,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Payload       *structpb.Value          `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	History       []*timestamppb.Timestamp `protobuf:"bytes,6,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReminderRequest) Reset() {
+	*x = SetReminderRequest{}
+	mi := &file_testdata_optional_fields_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReminderRequest) ProtoMessage() {}
+
+func (x *SetReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_optional_fields_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReminderRequest.ProtoReflect.Descriptor instead.
+func (*SetReminderRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_optional_fields_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetReminderRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SetReminderRequest) GetDue() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Due
+	}
+	return nil
+}
+
+func (x *SetReminderRequest) GetSnooze() *durationpb.Duration {
+	if x != nil {
+		return x.Snooze
+	}
+	return nil
+}
+
+func (x *SetReminderRequest) GetPriority() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Priority
+	}
+	return nil
+}
+
+func (x *SetReminderRequest) GetPayload() *structpb.Value {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SetReminderRequest) GetHistory() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type SetReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReminderId    string                 `protobuf:"bytes,1,opt,name=reminder_id,json=reminderId,proto3" json:"reminder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReminderResponse) Reset() {
+	*x = SetReminderResponse{}
+	mi := &file_testdata_optional_fields_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReminderResponse) ProtoMessage() {}
+
+func (x *SetReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_optional_fields_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReminderResponse.ProtoReflect.Descriptor instead.
+func (*SetReminderResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_optional_fields_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetReminderResponse) GetReminderId() string {
+	if x != nil {
+		return x.ReminderId
+	}
+	return ""
+}
+
+var File_testdata_optional_fields_test_proto protoreflect.FileDescriptor
+
+const file_testdata_optional_fields_test_proto_rawDesc = "" +
+	"\n" +
+	"#testdata/optional_fields_test.proto\x12\btestdata\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xac\x02\n" +
+	"\x12SetReminderRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12,\n" +
+	"\x03due\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03due\x121\n" +
+	"\x06snooze\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x06snooze\x127\n" +
+	"\bpriority\x18\x04 \x01(\v2\x1b.google.protobuf.Int32ValueR\bpriority\x120\n" +
+	"\apayload\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\apayload\x124\n" +
+	"\ahistory\x18\x06 \x03(\v2\x1a.google.protobuf.TimestampR\ahistory\"6\n" +
+	"\x13SetReminderResponse\x12\x1f\n" +
+	"\vreminder_id\x18\x01 \x01(\tR\n" +
+	"reminderId2]\n" +
+	"\x0fReminderService\x12J\n" +
+	"\vSetReminder\x12\x1c.testdata.SetReminderRequest\x1a\x1d.testdata.SetReminderResponseB\xaa\x01\n" +
+	"\fcom.testdataB\x17OptionalFieldsTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_optional_fields_test_proto_rawDescOnce sync.Once
+	file_testdata_optional_fields_test_proto_rawDescData []byte
+)
+
+func file_testdata_optional_fields_test_proto_rawDescGZIP() []byte {
+	file_testdata_optional_fields_test_proto_rawDescOnce.Do(func() {
+		file_testdata_optional_fields_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_optional_fields_test_proto_rawDesc), len(file_testdata_optional_fields_test_proto_rawDesc)))
+	})
+	return file_testdata_optional_fields_test_proto_rawDescData
+}
+
+var file_testdata_optional_fields_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_optional_fields_test_proto_goTypes = []any{
+	(*SetReminderRequest)(nil),    // 0: testdata.SetReminderRequest
+	(*SetReminderResponse)(nil),   // 1: testdata.SetReminderResponse
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 3: google.protobuf.Duration
+	(*wrapperspb.Int32Value)(nil), // 4: google.protobuf.Int32Value
+	(*structpb.Value)(nil),        // 5: google.protobuf.Value
+}
+var file_testdata_optional_fields_test_proto_depIdxs = []int32{
+	2, // 0: testdata.SetReminderRequest.due:type_name -> google.protobuf.Timestamp
+	3, // 1: testdata.SetReminderRequest.snooze:type_name -> google.protobuf.Duration
+	4, // 2: testdata.SetReminderRequest.priority:type_name -> google.protobuf.Int32Value
+	5, // 3: testdata.SetReminderRequest.payload:type_name -> google.protobuf.Value
+	2, // 4: testdata.SetReminderRequest.history:type_name -> google.protobuf.Timestamp
+	0, // 5: testdata.ReminderService.SetReminder:input_type -> testdata.SetReminderRequest
+	1, // 6: testdata.ReminderService.SetReminder:output_type -> testdata.SetReminderResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_testdata_optional_fields_test_proto_init() }
+func file_testdata_optional_fields_test_proto_init() {
+	if File_testdata_optional_fields_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_optional_fields_test_proto_rawDesc), len(file_testdata_optional_fields_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_optional_fields_test_proto_goTypes,
+		DependencyIndexes: file_testdata_optional_fields_test_proto_depIdxs,
+		MessageInfos:      file_testdata_optional_fields_test_proto_msgTypes,
+	}.Build()
+	File_testdata_optional_fields_test_proto = out.File
+	file_testdata_optional_fields_test_proto_goTypes = nil
+	file_testdata_optional_fields_test_proto_depIdxs = nil
+}
@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/optional_fields_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReminderService_SetReminder_FullMethodName = "/testdata.ReminderService/SetReminder"
+)
+
+// ReminderServiceClient is the client API for ReminderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ReminderService has singular well-known-type fields that may be unset, to
+// compare the optional_fields representations.
+type ReminderServiceClient interface {
+	SetReminder(ctx context.Context, in *SetReminderRequest, opts ...grpc.CallOption) (*SetReminderResponse, error)
+}
+
+type reminderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReminderServiceClient(cc grpc.ClientConnInterface) ReminderServiceClient {
+	return &reminderServiceClient{cc}
+}
+
+func (c *reminderServiceClient) SetReminder(ctx context.Context, in *SetReminderRequest, opts ...grpc.CallOption) (*SetReminderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetReminderResponse)
+	err := c.cc.Invoke(ctx, ReminderService_SetReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReminderServiceServer is the server API for ReminderService service.
+// All implementations must embed UnimplementedReminderServiceServer
+// for forward compatibility.
+//
+// ReminderService has singular well-known-type fields that may be unset, to
+// compare the optional_fields representations.
+type ReminderServiceServer interface {
+	SetReminder(context.Context, *SetReminderRequest) (*SetReminderResponse, error)
+	mustEmbedUnimplementedReminderServiceServer()
+}
+
+// UnimplementedReminderServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReminderServiceServer struct{}
+
+func (UnimplementedReminderServiceServer) SetReminder(context.Context, *SetReminderRequest) (*SetReminderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) mustEmbedUnimplementedReminderServiceServer() {}
+func (UnimplementedReminderServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeReminderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReminderServiceServer will
+// result in compilation errors.
+type UnsafeReminderServiceServer interface {
+	mustEmbedUnimplementedReminderServiceServer()
+}
+
+func RegisterReminderServiceServer(s grpc.ServiceRegistrar, srv ReminderServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReminderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReminderService_ServiceDesc, srv)
+}
+
+func _ReminderService_SetReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).SetReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_SetReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).SetReminder(ctx, req.(*SetReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReminderService_ServiceDesc is the grpc.ServiceDesc for ReminderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReminderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.ReminderService",
+	HandlerType: (*ReminderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetReminder",
+			Handler:    _ReminderService_SetReminder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/optional_fields_test.proto",
+}
@@ -0,0 +1,263 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/raw_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MemoAuthor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoAuthor) Reset() {
+	*x = MemoAuthor{}
+	mi := &file_testdata_raw_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoAuthor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoAuthor) ProtoMessage() {}
+
+func (x *MemoAuthor) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_raw_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoAuthor.ProtoReflect.Descriptor instead.
+func (*MemoAuthor) Descriptor() ([]byte, []int) {
+	return file_testdata_raw_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MemoAuthor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AddMemoRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Text   string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Author *MemoAuthor            `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	// Position of the memo, one-based in the tool.
+	Position      int32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMemoRequest) Reset() {
+	*x = AddMemoRequest{}
+	mi := &file_testdata_raw_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMemoRequest) ProtoMessage() {}
+
+func (x *AddMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_raw_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMemoRequest.ProtoReflect.Descriptor instead.
+func (*AddMemoRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_raw_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddMemoRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AddMemoRequest) GetAuthor() *MemoAuthor {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *AddMemoRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type Memo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Author        *MemoAuthor            `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Memo) Reset() {
+	*x = Memo{}
+	mi := &file_testdata_raw_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Memo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Memo) ProtoMessage() {}
+
+func (x *Memo) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_raw_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Memo.ProtoReflect.Descriptor instead.
+func (*Memo) Descriptor() ([]byte, []int) {
+	return file_testdata_raw_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Memo) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Memo) GetAuthor() *MemoAuthor {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *Memo) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+var File_testdata_raw_test_proto protoreflect.FileDescriptor
+
+const file_testdata_raw_test_proto_rawDesc = "" +
+	"\n" +
+	"\x17testdata/raw_test.proto\x12\btestdata\x1a\x19mcp/options/options.proto\" \n" +
+	"\n" +
+	"MemoAuthor\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"t\n" +
+	"\x0eAddMemoRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12,\n" +
+	"\x06author\x18\x02 \x01(\v2\x14.testdata.MemoAuthorR\x06author\x12 \n" +
+	"\bposition\x18\x03 \x01(\x05B\x04\x88\xb2\x19\x01R\bposition\"d\n" +
+	"\x04Memo\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12,\n" +
+	"\x06author\x18\x02 \x01(\v2\x14.testdata.MemoAuthorR\x06author\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition2T\n" +
+	"\vMemoService\x12E\n" +
+	"\aAddMemo\x12\x18.testdata.AddMemoRequest\x1a\x0e.testdata.Memo\"\x10\x92\xb5\x19\f\n" +
+	"\badd_memox\x01B\x9f\x01\n" +
+	"\fcom.testdataB\fRawTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_raw_test_proto_rawDescOnce sync.Once
+	file_testdata_raw_test_proto_rawDescData []byte
+)
+
+func file_testdata_raw_test_proto_rawDescGZIP() []byte {
+	file_testdata_raw_test_proto_rawDescOnce.Do(func() {
+		file_testdata_raw_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_raw_test_proto_rawDesc), len(file_testdata_raw_test_proto_rawDesc)))
+	})
+	return file_testdata_raw_test_proto_rawDescData
+}
+
+var file_testdata_raw_test_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_testdata_raw_test_proto_goTypes = []any{
+	(*MemoAuthor)(nil),     // 0: testdata.MemoAuthor
+	(*AddMemoRequest)(nil), // 1: testdata.AddMemoRequest
+	(*Memo)(nil),           // 2: testdata.Memo
+}
+var file_testdata_raw_test_proto_depIdxs = []int32{
+	0, // 0: testdata.AddMemoRequest.author:type_name -> testdata.MemoAuthor
+	0, // 1: testdata.Memo.author:type_name -> testdata.MemoAuthor
+	1, // 2: testdata.MemoService.AddMemo:input_type -> testdata.AddMemoRequest
+	2, // 3: testdata.MemoService.AddMemo:output_type -> testdata.Memo
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_testdata_raw_test_proto_init() }
+func file_testdata_raw_test_proto_init() {
+	if File_testdata_raw_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_raw_test_proto_rawDesc), len(file_testdata_raw_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_raw_test_proto_goTypes,
+		DependencyIndexes: file_testdata_raw_test_proto_depIdxs,
+		MessageInfos:      file_testdata_raw_test_proto_msgTypes,
+	}.Build()
+	File_testdata_raw_test_proto = out.File
+	file_testdata_raw_test_proto_goTypes = nil
+	file_testdata_raw_test_proto_depIdxs = nil
+}
@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/raw_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MemoService_AddMemo_FullMethodName = "/testdata.MemoService/AddMemo"
+)
+
+// MemoServiceClient is the client API for MemoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MemoService keeps memos, for clients that only pass string arguments.
+type MemoServiceClient interface {
+	// Adds a memo.
+	AddMemo(ctx context.Context, in *AddMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+}
+
+type memoServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMemoServiceClient(cc grpc.ClientConnInterface) MemoServiceClient {
+	return &memoServiceClient{cc}
+}
+
+func (c *memoServiceClient) AddMemo(ctx context.Context, in *AddMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_AddMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemoServiceServer is the server API for MemoService service.
+// All implementations must embed UnimplementedMemoServiceServer
+// for forward compatibility.
+//
+// MemoService keeps memos, for clients that only pass string arguments.
+type MemoServiceServer interface {
+	// Adds a memo.
+	AddMemo(context.Context, *AddMemoRequest) (*Memo, error)
+	mustEmbedUnimplementedMemoServiceServer()
+}
+
+// UnimplementedMemoServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMemoServiceServer struct{}
+
+func (UnimplementedMemoServiceServer) AddMemo(context.Context, *AddMemoRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) mustEmbedUnimplementedMemoServiceServer() {}
+func (UnimplementedMemoServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeMemoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MemoServiceServer will
+// result in compilation errors.
+type UnsafeMemoServiceServer interface {
+	mustEmbedUnimplementedMemoServiceServer()
+}
+
+func RegisterMemoServiceServer(s grpc.ServiceRegistrar, srv MemoServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMemoServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MemoService_ServiceDesc, srv)
+}
+
+func _MemoService_AddMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).AddMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_AddMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).AddMemo(ctx, req.(*AddMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemoService_ServiceDesc is the grpc.ServiceDesc for MemoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.MemoService",
+	HandlerType: (*MemoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddMemo",
+			Handler:    _MemoService_AddMemo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/raw_test.proto",
+}
@@ -0,0 +1,192 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/shared_types_test.proto
+
+package testdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SharedAddress is declared apart from the services using it, to exercise
+// schemas of messages from files that are only imported.
+type SharedAddress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Street and house number.
+	Street        string        `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`
+	City          string        `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	Region        *SharedRegion `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedAddress) Reset() {
+	*x = SharedAddress{}
+	mi := &file_testdata_shared_types_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedAddress) ProtoMessage() {}
+
+func (x *SharedAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_shared_types_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedAddress.ProtoReflect.Descriptor instead.
+func (*SharedAddress) Descriptor() ([]byte, []int) {
+	return file_testdata_shared_types_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SharedAddress) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *SharedAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *SharedAddress) GetRegion() *SharedRegion {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+type SharedRegion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedRegion) Reset() {
+	*x = SharedRegion{}
+	mi := &file_testdata_shared_types_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedRegion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedRegion) ProtoMessage() {}
+
+func (x *SharedRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_shared_types_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedRegion.ProtoReflect.Descriptor instead.
+func (*SharedRegion) Descriptor() ([]byte, []int) {
+	return file_testdata_shared_types_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SharedRegion) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+var File_testdata_shared_types_test_proto protoreflect.FileDescriptor
+
+const file_testdata_shared_types_test_proto_rawDesc = "" +
+	"\n" +
+	" testdata/shared_types_test.proto\x12\btestdata\"k\n" +
+	"\rSharedAddress\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12.\n" +
+	"\x06region\x18\x03 \x01(\v2\x16.testdata.SharedRegionR\x06region\"1\n" +
+	"\fSharedRegion\x12!\n" +
+	"\fcountry_code\x18\x01 \x01(\tR\vcountryCodeB\xa7\x01\n" +
+	"\fcom.testdataB\x14SharedTypesTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_shared_types_test_proto_rawDescOnce sync.Once
+	file_testdata_shared_types_test_proto_rawDescData []byte
+)
+
+func file_testdata_shared_types_test_proto_rawDescGZIP() []byte {
+	file_testdata_shared_types_test_proto_rawDescOnce.Do(func() {
+		file_testdata_shared_types_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_shared_types_test_proto_rawDesc), len(file_testdata_shared_types_test_proto_rawDesc)))
+	})
+	return file_testdata_shared_types_test_proto_rawDescData
+}
+
+var file_testdata_shared_types_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_shared_types_test_proto_goTypes = []any{
+	(*SharedAddress)(nil), // 0: testdata.SharedAddress
+	(*SharedRegion)(nil),  // 1: testdata.SharedRegion
+}
+var file_testdata_shared_types_test_proto_depIdxs = []int32{
+	1, // 0: testdata.SharedAddress.region:type_name -> testdata.SharedRegion
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_testdata_shared_types_test_proto_init() }
+func file_testdata_shared_types_test_proto_init() {
+	if File_testdata_shared_types_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_shared_types_test_proto_rawDesc), len(file_testdata_shared_types_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testdata_shared_types_test_proto_goTypes,
+		DependencyIndexes: file_testdata_shared_types_test_proto_depIdxs,
+		MessageInfos:      file_testdata_shared_types_test_proto_msgTypes,
+	}.Build()
+	File_testdata_shared_types_test_proto = out.File
+	file_testdata_shared_types_test_proto_goTypes = nil
+	file_testdata_shared_types_test_proto_depIdxs = nil
+}
@@ -0,0 +1,134 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/status/status.proto
+
+package status
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SkuStatus is the stock of a SKU. Its Go package is named status, like the
+// gRPC package the generated handlers import.
+type SkuStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	InStock       bool                   `protobuf:"varint,2,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuStatus) Reset() {
+	*x = SkuStatus{}
+	mi := &file_testdata_status_status_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuStatus) ProtoMessage() {}
+
+func (x *SkuStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_status_status_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuStatus.ProtoReflect.Descriptor instead.
+func (*SkuStatus) Descriptor() ([]byte, []int) {
+	return file_testdata_status_status_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SkuStatus) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SkuStatus) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+var File_testdata_status_status_proto protoreflect.FileDescriptor
+
+const file_testdata_status_status_proto_rawDesc = "" +
+	"\n" +
+	"\x1ctestdata/status/status.proto\x12\x0ftestdata.status\"8\n" +
+	"\tSkuStatus\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x19\n" +
+	"\bin_stock\x18\x02 \x01(\bR\ainStockB\xc9\x01\n" +
+	"\x13com.testdata.statusB\vStatusProtoP\x01ZHgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata/status\xa2\x02\x03TSX\xaa\x02\x0fTestdata.Status\xca\x02\x0fTestdata\\Status\xe2\x02\x1bTestdata\\Status\\GPBMetadata\xea\x02\x10Testdata::Statusb\x06proto3"
+
+var (
+	file_testdata_status_status_proto_rawDescOnce sync.Once
+	file_testdata_status_status_proto_rawDescData []byte
+)
+
+func file_testdata_status_status_proto_rawDescGZIP() []byte {
+	file_testdata_status_status_proto_rawDescOnce.Do(func() {
+		file_testdata_status_status_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_status_status_proto_rawDesc), len(file_testdata_status_status_proto_rawDesc)))
+	})
+	return file_testdata_status_status_proto_rawDescData
+}
+
+var file_testdata_status_status_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_testdata_status_status_proto_goTypes = []any{
+	(*SkuStatus)(nil), // 0: testdata.status.SkuStatus
+}
+var file_testdata_status_status_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_testdata_status_status_proto_init() }
+func file_testdata_status_status_proto_init() {
+	if File_testdata_status_status_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_status_status_proto_rawDesc), len(file_testdata_status_status_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testdata_status_status_proto_goTypes,
+		DependencyIndexes: file_testdata_status_status_proto_depIdxs,
+		MessageInfos:      file_testdata_status_status_proto_msgTypes,
+	}.Build()
+	File_testdata_status_status_proto = out.File
+	file_testdata_status_status_proto_goTypes = nil
+	file_testdata_status_status_proto_depIdxs = nil
+}
@@ -0,0 +1,188 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/stream_resource_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WatchQuotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchQuotesRequest) Reset() {
+	*x = WatchQuotesRequest{}
+	mi := &file_testdata_stream_resource_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchQuotesRequest) ProtoMessage() {}
+
+func (x *WatchQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_stream_resource_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchQuotesRequest.ProtoReflect.Descriptor instead.
+func (*WatchQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_stream_resource_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchQuotesRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type Quote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	PriceCents    int64                  `protobuf:"varint,2,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	mi := &file_testdata_stream_resource_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_stream_resource_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_testdata_stream_resource_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Quote) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Quote) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+var File_testdata_stream_resource_test_proto protoreflect.FileDescriptor
+
+const file_testdata_stream_resource_test_proto_rawDesc = "" +
+	"\n" +
+	"#testdata/stream_resource_test.proto\x12\btestdata\x1a\x19mcp/options/options.proto\",\n" +
+	"\x12WatchQuotesRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\"@\n" +
+	"\x05Quote\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1f\n" +
+	"\vprice_cents\x18\x02 \x01(\x03R\n" +
+	"priceCents2\x91\x01\n" +
+	"\fQuoteService\x12F\n" +
+	"\vWatchQuotes\x12\x1c.testdata.WatchQuotesRequest\x1a\x0f.testdata.Quote\"\x06\x92\xb5\x19\x02`\x010\x01\x129\n" +
+	"\bGetQuote\x12\x1c.testdata.WatchQuotesRequest\x1a\x0f.testdata.QuoteB\xaa\x01\n" +
+	"\fcom.testdataB\x17StreamResourceTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_stream_resource_test_proto_rawDescOnce sync.Once
+	file_testdata_stream_resource_test_proto_rawDescData []byte
+)
+
+func file_testdata_stream_resource_test_proto_rawDescGZIP() []byte {
+	file_testdata_stream_resource_test_proto_rawDescOnce.Do(func() {
+		file_testdata_stream_resource_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_stream_resource_test_proto_rawDesc), len(file_testdata_stream_resource_test_proto_rawDesc)))
+	})
+	return file_testdata_stream_resource_test_proto_rawDescData
+}
+
+var file_testdata_stream_resource_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_stream_resource_test_proto_goTypes = []any{
+	(*WatchQuotesRequest)(nil), // 0: testdata.WatchQuotesRequest
+	(*Quote)(nil),              // 1: testdata.Quote
+}
+var file_testdata_stream_resource_test_proto_depIdxs = []int32{
+	0, // 0: testdata.QuoteService.WatchQuotes:input_type -> testdata.WatchQuotesRequest
+	0, // 1: testdata.QuoteService.GetQuote:input_type -> testdata.WatchQuotesRequest
+	1, // 2: testdata.QuoteService.WatchQuotes:output_type -> testdata.Quote
+	1, // 3: testdata.QuoteService.GetQuote:output_type -> testdata.Quote
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_testdata_stream_resource_test_proto_init() }
+func file_testdata_stream_resource_test_proto_init() {
+	if File_testdata_stream_resource_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_stream_resource_test_proto_rawDesc), len(file_testdata_stream_resource_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_stream_resource_test_proto_goTypes,
+		DependencyIndexes: file_testdata_stream_resource_test_proto_depIdxs,
+		MessageInfos:      file_testdata_stream_resource_test_proto_msgTypes,
+	}.Build()
+	File_testdata_stream_resource_test_proto = out.File
+	file_testdata_stream_resource_test_proto_goTypes = nil
+	file_testdata_stream_resource_test_proto_depIdxs = nil
+}
@@ -0,0 +1,210 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/struct_value_schema_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TagResourceRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Resource string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// Tags are conventionally a string-to-string map.
+	Tags *structpb.Struct `protobuf:"bytes,2,opt,name=tags,proto3" json:"tags,omitempty"`
+	// Free-form metadata: any JSON value.
+	Metadata      *structpb.Struct   `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Limits        []*structpb.Struct `protobuf:"bytes,4,rep,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagResourceRequest) Reset() {
+	*x = TagResourceRequest{}
+	mi := &file_testdata_struct_value_schema_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagResourceRequest) ProtoMessage() {}
+
+func (x *TagResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_struct_value_schema_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagResourceRequest.ProtoReflect.Descriptor instead.
+func (*TagResourceRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_struct_value_schema_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TagResourceRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *TagResourceRequest) GetTags() *structpb.Struct {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TagResourceRequest) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *TagResourceRequest) GetLimits() []*structpb.Struct {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type TagResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          *structpb.Struct       `protobuf:"bytes,1,opt,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagResourceResponse) Reset() {
+	*x = TagResourceResponse{}
+	mi := &file_testdata_struct_value_schema_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagResourceResponse) ProtoMessage() {}
+
+func (x *TagResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_struct_value_schema_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagResourceResponse.ProtoReflect.Descriptor instead.
+func (*TagResourceResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_struct_value_schema_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TagResourceResponse) GetTags() *structpb.Struct {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+var File_testdata_struct_value_schema_test_proto protoreflect.FileDescriptor
+
+const file_testdata_struct_value_schema_test_proto_rawDesc = "" +
+	"\n" +
+	"'testdata/struct_value_schema_test.proto\x12\btestdata\x1a\x1cgoogle/protobuf/struct.proto\x1a\x19mcp/options/options.proto\"\x82\x02\n" +
+	"\x12TagResourceRequest\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12C\n" +
+	"\x04tags\x18\x02 \x01(\v2\x17.google.protobuf.StructB\x16\x92\xb2\x19\x12{\"type\": \"string\"}R\x04tags\x123\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12V\n" +
+	"\x06limits\x18\x04 \x03(\v2\x17.google.protobuf.StructB%\x92\xb2\x19!{\"type\": \"integer\", \"minimum\": 0}R\x06limits\"B\n" +
+	"\x13TagResourceResponse\x12+\n" +
+	"\x04tags\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04tags2`\n" +
+	"\x12StructValueService\x12J\n" +
+	"\vTagResource\x12\x1c.testdata.TagResourceRequest\x1a\x1d.testdata.TagResourceResponseB\xad\x01\n" +
+	"\fcom.testdataB\x1aStructValueSchemaTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_struct_value_schema_test_proto_rawDescOnce sync.Once
+	file_testdata_struct_value_schema_test_proto_rawDescData []byte
+)
+
+func file_testdata_struct_value_schema_test_proto_rawDescGZIP() []byte {
+	file_testdata_struct_value_schema_test_proto_rawDescOnce.Do(func() {
+		file_testdata_struct_value_schema_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_struct_value_schema_test_proto_rawDesc), len(file_testdata_struct_value_schema_test_proto_rawDesc)))
+	})
+	return file_testdata_struct_value_schema_test_proto_rawDescData
+}
+
+var file_testdata_struct_value_schema_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_struct_value_schema_test_proto_goTypes = []any{
+	(*TagResourceRequest)(nil),  // 0: testdata.TagResourceRequest
+	(*TagResourceResponse)(nil), // 1: testdata.TagResourceResponse
+	(*structpb.Struct)(nil),     // 2: google.protobuf.Struct
+}
+var file_testdata_struct_value_schema_test_proto_depIdxs = []int32{
+	2, // 0: testdata.TagResourceRequest.tags:type_name -> google.protobuf.Struct
+	2, // 1: testdata.TagResourceRequest.metadata:type_name -> google.protobuf.Struct
+	2, // 2: testdata.TagResourceRequest.limits:type_name -> google.protobuf.Struct
+	2, // 3: testdata.TagResourceResponse.tags:type_name -> google.protobuf.Struct
+	0, // 4: testdata.StructValueService.TagResource:input_type -> testdata.TagResourceRequest
+	1, // 5: testdata.StructValueService.TagResource:output_type -> testdata.TagResourceResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_testdata_struct_value_schema_test_proto_init() }
+func file_testdata_struct_value_schema_test_proto_init() {
+	if File_testdata_struct_value_schema_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_struct_value_schema_test_proto_rawDesc), len(file_testdata_struct_value_schema_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_struct_value_schema_test_proto_goTypes,
+		DependencyIndexes: file_testdata_struct_value_schema_test_proto_depIdxs,
+		MessageInfos:      file_testdata_struct_value_schema_test_proto_msgTypes,
+	}.Build()
+	File_testdata_struct_value_schema_test_proto = out.File
+	file_testdata_struct_value_schema_test_proto_goTypes = nil
+	file_testdata_struct_value_schema_test_proto_depIdxs = nil
+}
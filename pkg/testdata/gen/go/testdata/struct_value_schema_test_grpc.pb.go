@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/struct_value_schema_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StructValueService_TagResource_FullMethodName = "/testdata.StructValueService/TagResource"
+)
+
+// StructValueServiceClient is the client API for StructValueService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// StructValueService exercises the (mcp.options.struct_value_schema) option.
+type StructValueServiceClient interface {
+	TagResource(ctx context.Context, in *TagResourceRequest, opts ...grpc.CallOption) (*TagResourceResponse, error)
+}
+
+type structValueServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStructValueServiceClient(cc grpc.ClientConnInterface) StructValueServiceClient {
+	return &structValueServiceClient{cc}
+}
+
+func (c *structValueServiceClient) TagResource(ctx context.Context, in *TagResourceRequest, opts ...grpc.CallOption) (*TagResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagResourceResponse)
+	err := c.cc.Invoke(ctx, StructValueService_TagResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StructValueServiceServer is the server API for StructValueService service.
+// All implementations must embed UnimplementedStructValueServiceServer
+// for forward compatibility.
+//
+// StructValueService exercises the (mcp.options.struct_value_schema) option.
+type StructValueServiceServer interface {
+	TagResource(context.Context, *TagResourceRequest) (*TagResourceResponse, error)
+	mustEmbedUnimplementedStructValueServiceServer()
+}
+
+// UnimplementedStructValueServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStructValueServiceServer struct{}
+
+func (UnimplementedStructValueServiceServer) TagResource(context.Context, *TagResourceRequest) (*TagResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagResource not implemented")
+}
+func (UnimplementedStructValueServiceServer) mustEmbedUnimplementedStructValueServiceServer() {}
+func (UnimplementedStructValueServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeStructValueServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StructValueServiceServer will
+// result in compilation errors.
+type UnsafeStructValueServiceServer interface {
+	mustEmbedUnimplementedStructValueServiceServer()
+}
+
+func RegisterStructValueServiceServer(s grpc.ServiceRegistrar, srv StructValueServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStructValueServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StructValueService_ServiceDesc, srv)
+}
+
+func _StructValueService_TagResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StructValueServiceServer).TagResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StructValueService_TagResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StructValueServiceServer).TagResource(ctx, req.(*TagResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StructValueService_ServiceDesc is the grpc.ServiceDesc for StructValueService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StructValueService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.StructValueService",
+	HandlerType: (*StructValueServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TagResource",
+			Handler:    _StructValueService_TagResource_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/struct_value_schema_test.proto",
+}
@@ -0,0 +1,194 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/summary_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BuildDigestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildDigestRequest) Reset() {
+	*x = BuildDigestRequest{}
+	mi := &file_testdata_summary_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildDigestRequest) ProtoMessage() {}
+
+func (x *BuildDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_summary_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildDigestRequest.ProtoReflect.Descriptor instead.
+func (*BuildDigestRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_summary_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BuildDigestRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+type BuildDigestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One-line digest for a human reader.
+	Headline      string   `protobuf:"bytes,1,opt,name=headline,proto3" json:"headline,omitempty"`
+	Entries       []string `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total         int32    `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildDigestResponse) Reset() {
+	*x = BuildDigestResponse{}
+	mi := &file_testdata_summary_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildDigestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildDigestResponse) ProtoMessage() {}
+
+func (x *BuildDigestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_summary_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildDigestResponse.ProtoReflect.Descriptor instead.
+func (*BuildDigestResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_summary_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BuildDigestResponse) GetHeadline() string {
+	if x != nil {
+		return x.Headline
+	}
+	return ""
+}
+
+func (x *BuildDigestResponse) GetEntries() []string {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *BuildDigestResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_testdata_summary_test_proto protoreflect.FileDescriptor
+
+const file_testdata_summary_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1btestdata/summary_test.proto\x12\btestdata\x1a\x19mcp/options/options.proto\"*\n" +
+	"\x12BuildDigestRequest\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\"g\n" +
+	"\x13BuildDigestResponse\x12 \n" +
+	"\bheadline\x18\x01 \x01(\tB\x04\x98\xb2\x19\x01R\bheadline\x12\x18\n" +
+	"\aentries\x18\x02 \x03(\tR\aentries\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total2c\n" +
+	"\rDigestService\x12R\n" +
+	"\vBuildDigest\x12\x1c.testdata.BuildDigestRequest\x1a\x1d.testdata.BuildDigestResponse\"\x06\x92\xb5\x19\x02H\x01B\xa3\x01\n" +
+	"\fcom.testdataB\x10SummaryTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_summary_test_proto_rawDescOnce sync.Once
+	file_testdata_summary_test_proto_rawDescData []byte
+)
+
+func file_testdata_summary_test_proto_rawDescGZIP() []byte {
+	file_testdata_summary_test_proto_rawDescOnce.Do(func() {
+		file_testdata_summary_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_summary_test_proto_rawDesc), len(file_testdata_summary_test_proto_rawDesc)))
+	})
+	return file_testdata_summary_test_proto_rawDescData
+}
+
+var file_testdata_summary_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_summary_test_proto_goTypes = []any{
+	(*BuildDigestRequest)(nil),  // 0: testdata.BuildDigestRequest
+	(*BuildDigestResponse)(nil), // 1: testdata.BuildDigestResponse
+}
+var file_testdata_summary_test_proto_depIdxs = []int32{
+	0, // 0: testdata.DigestService.BuildDigest:input_type -> testdata.BuildDigestRequest
+	1, // 1: testdata.DigestService.BuildDigest:output_type -> testdata.BuildDigestResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_testdata_summary_test_proto_init() }
+func file_testdata_summary_test_proto_init() {
+	if File_testdata_summary_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_summary_test_proto_rawDesc), len(file_testdata_summary_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_summary_test_proto_goTypes,
+		DependencyIndexes: file_testdata_summary_test_proto_depIdxs,
+		MessageInfos:      file_testdata_summary_test_proto_msgTypes,
+	}.Build()
+	File_testdata_summary_test_proto = out.File
+	file_testdata_summary_test_proto_goTypes = nil
+	file_testdata_summary_test_proto_depIdxs = nil
+}
@@ -503,10 +503,14 @@ func (x *Item) GetUpdatedAt() *timestamppb.Timestamp {
 type ProcessWellKnownTypesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Well-known types that need special handling
-	Metadata      *structpb.Struct       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Config        *structpb.Value        `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	Payload       *anypb.Any             `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Metadata  *structpb.Struct       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Config    *structpb.Value        `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Payload   *anypb.Any             `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Free-form attributes; every value may be arbitrary JSON
+	Attributes map[string]*structpb.Value `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Positional arguments; each may be arbitrary JSON
+	Arguments     []*structpb.Value `protobuf:"bytes,6,rep,name=arguments,proto3" json:"arguments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -569,6 +573,20 @@ func (x *ProcessWellKnownTypesRequest) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ProcessWellKnownTypesRequest) GetAttributes() map[string]*structpb.Value {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *ProcessWellKnownTypesRequest) GetArguments() []*structpb.Value {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
 type ProcessWellKnownTypesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -664,12 +682,19 @@ const file_testdata_test_service_proto_rawDesc = "" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xed\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd2\x03\n" +
 	"\x1cProcessWellKnownTypesRequest\x123\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12.\n" +
 	"\x06config\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x06config\x12.\n" +
 	"\apayload\x18\x03 \x01(\v2\x14.google.protobuf.AnyR\apayload\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"S\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12V\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v26.testdata.ProcessWellKnownTypesRequest.AttributesEntryR\n" +
+	"attributes\x124\n" +
+	"\targuments\x18\x06 \x03(\v2\x16.google.protobuf.ValueR\targuments\x1aU\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\"S\n" +
 	"\x1dProcessWellKnownTypesResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage2\x80\x02\n" +
@@ -692,7 +717,7 @@ func file_testdata_test_service_proto_rawDescGZIP() []byte {
 	return file_testdata_test_service_proto_rawDescData
 }
 
-var file_testdata_test_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_testdata_test_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_testdata_test_service_proto_goTypes = []any{
 	(*CreateItemRequest)(nil),             // 0: testdata.CreateItemRequest
 	(*ProductDetails)(nil),                // 1: testdata.ProductDetails
@@ -705,35 +730,39 @@ var file_testdata_test_service_proto_goTypes = []any{
 	(*ProcessWellKnownTypesResponse)(nil), // 8: testdata.ProcessWellKnownTypesResponse
 	nil,                                   // 9: testdata.CreateItemRequest.LabelsEntry
 	nil,                                   // 10: testdata.Item.LabelsEntry
-	(*timestamppb.Timestamp)(nil),         // 11: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),               // 12: google.protobuf.Struct
-	(*structpb.Value)(nil),                // 13: google.protobuf.Value
-	(*anypb.Any)(nil),                     // 14: google.protobuf.Any
+	nil,                                   // 11: testdata.ProcessWellKnownTypesRequest.AttributesEntry
+	(*timestamppb.Timestamp)(nil),         // 12: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),               // 13: google.protobuf.Struct
+	(*structpb.Value)(nil),                // 14: google.protobuf.Value
+	(*anypb.Any)(nil),                     // 15: google.protobuf.Any
 }
 var file_testdata_test_service_proto_depIdxs = []int32{
 	9,  // 0: testdata.CreateItemRequest.labels:type_name -> testdata.CreateItemRequest.LabelsEntry
 	1,  // 1: testdata.CreateItemRequest.product:type_name -> testdata.ProductDetails
 	2,  // 2: testdata.CreateItemRequest.service:type_name -> testdata.ServiceDetails
-	11, // 3: testdata.CreateItemResponse.created_at:type_name -> google.protobuf.Timestamp
+	12, // 3: testdata.CreateItemResponse.created_at:type_name -> google.protobuf.Timestamp
 	6,  // 4: testdata.GetItemResponse.item:type_name -> testdata.Item
 	10, // 5: testdata.Item.labels:type_name -> testdata.Item.LabelsEntry
-	11, // 6: testdata.Item.created_at:type_name -> google.protobuf.Timestamp
-	11, // 7: testdata.Item.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 8: testdata.ProcessWellKnownTypesRequest.metadata:type_name -> google.protobuf.Struct
-	13, // 9: testdata.ProcessWellKnownTypesRequest.config:type_name -> google.protobuf.Value
-	14, // 10: testdata.ProcessWellKnownTypesRequest.payload:type_name -> google.protobuf.Any
-	11, // 11: testdata.ProcessWellKnownTypesRequest.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 12: testdata.TestService.CreateItem:input_type -> testdata.CreateItemRequest
-	4,  // 13: testdata.TestService.GetItem:input_type -> testdata.GetItemRequest
-	7,  // 14: testdata.TestService.ProcessWellKnownTypes:input_type -> testdata.ProcessWellKnownTypesRequest
-	3,  // 15: testdata.TestService.CreateItem:output_type -> testdata.CreateItemResponse
-	5,  // 16: testdata.TestService.GetItem:output_type -> testdata.GetItemResponse
-	8,  // 17: testdata.TestService.ProcessWellKnownTypes:output_type -> testdata.ProcessWellKnownTypesResponse
-	15, // [15:18] is the sub-list for method output_type
-	12, // [12:15] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	12, // 6: testdata.Item.created_at:type_name -> google.protobuf.Timestamp
+	12, // 7: testdata.Item.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 8: testdata.ProcessWellKnownTypesRequest.metadata:type_name -> google.protobuf.Struct
+	14, // 9: testdata.ProcessWellKnownTypesRequest.config:type_name -> google.protobuf.Value
+	15, // 10: testdata.ProcessWellKnownTypesRequest.payload:type_name -> google.protobuf.Any
+	12, // 11: testdata.ProcessWellKnownTypesRequest.timestamp:type_name -> google.protobuf.Timestamp
+	11, // 12: testdata.ProcessWellKnownTypesRequest.attributes:type_name -> testdata.ProcessWellKnownTypesRequest.AttributesEntry
+	14, // 13: testdata.ProcessWellKnownTypesRequest.arguments:type_name -> google.protobuf.Value
+	14, // 14: testdata.ProcessWellKnownTypesRequest.AttributesEntry.value:type_name -> google.protobuf.Value
+	0,  // 15: testdata.TestService.CreateItem:input_type -> testdata.CreateItemRequest
+	4,  // 16: testdata.TestService.GetItem:input_type -> testdata.GetItemRequest
+	7,  // 17: testdata.TestService.ProcessWellKnownTypes:input_type -> testdata.ProcessWellKnownTypesRequest
+	3,  // 18: testdata.TestService.CreateItem:output_type -> testdata.CreateItemResponse
+	5,  // 19: testdata.TestService.GetItem:output_type -> testdata.GetItemResponse
+	8,  // 20: testdata.TestService.ProcessWellKnownTypes:output_type -> testdata.ProcessWellKnownTypesResponse
+	18, // [18:21] is the sub-list for method output_type
+	15, // [15:18] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_testdata_test_service_proto_init() }
@@ -751,7 +780,7 @@ func file_testdata_test_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_test_service_proto_rawDesc), len(file_testdata_test_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -3,23 +3,29 @@
 
 package testdatamcp
 
-import (
-	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
-)
-
 import (
 	"context"
-	"strings"
 	"github.com/mark3labs/mcp-go/mcp"
 	mcpserver "github.com/mark3labs/mcp-go/server"
 	"encoding/json"
 	"google.golang.org/protobuf/encoding/protojson"
 	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// Tool names as generated, and the fully-qualified method names that
+// runtime.WithToolNameOverride keys on, for references that must not drift
+// from the proto. Overrides apply at registration and are not reflected here.
+const (
+	OneOfNestedTestService_GrantDeviceDataModificationRightOnApplicationToolName   = "phpt1g_TestService_GrantDeviceDataModificationRightOnApplication"
+	OneOfNestedTestService_GrantDeviceDataModificationRightOnApplicationFullMethod = "testdata.OneOfNestedTestService.GrantDeviceDataModificationRightOnApplication"
 )
 
 var (
-	OneOfNestedTestService_GrantDeviceDataModificationRightOnApplicationTool = runtime.Tool{Name: "phpt1g_TestService_GrantDeviceDataModificationRightOnApplication", Description: "", JSONSchema: "{\"$defs\":{\"DeviceDataApplications\":{\"properties\":{\"application_code\":{\"type\":\"string\"}},\"required\":[],\"type\":\"object\"}},\"$schema\":\"https://json-schema.org/draft/2020-12/schema\",\"properties\":{\"kindOneOfType\":{\"oneOf\":[{\"properties\":{\"device_data_applications\":{\"$ref\":\"#/$defs/DeviceDataApplications\",\"type\":\"object\"},\"object_type\":{\"const\":\"device_data_applications\",\"type\":\"string\"}},\"required\":[\"object_type\",\"device_data_applications\"],\"title\":\"device_data_applications\",\"type\":\"object\"}],\"type\":\"object\"}},\"required\":[\"kindOneOfType\"],\"type\":\"object\"}"}
+	OneOfNestedTestService_GrantDeviceDataModificationRightOnApplicationTool = runtime.Tool{Name: "phpt1g_TestService_GrantDeviceDataModificationRightOnApplication", Description: "", JSONSchema: "{\"$defs\":{\"DeviceDataApplications\":{\"properties\":{\"application_code\":{\"type\":\"string\"}},\"required\":[],\"type\":\"object\"}},\"$schema\":\"https://json-schema.org/draft/2020-12/schema\",\"properties\":{\"kindOneOfType\":{\"description\":\"Exactly one variant of the oneof \\\"kind\\\". Set \\\"object_type\\\" to one of \\\"device_data_applications\\\", and fill in the properties of that variant.\",\"oneOf\":[{\"properties\":{\"device_data_applications\":{\"$ref\":\"#/$defs/DeviceDataApplications\",\"type\":\"object\"},\"object_type\":{\"const\":\"device_data_applications\",\"type\":\"string\"}},\"required\":[\"object_type\",\"device_data_applications\"],\"title\":\"device_data_applications\",\"type\":\"object\"}],\"type\":\"object\"}},\"required\":[\"kindOneOfType\"],\"type\":\"object\"}"}
 )
 
 var (
@@ -31,171 +37,157 @@ type OneOfNestedTestServiceClient interface {
 	GrantDeviceDataModificationRightOnApplication(ctx context.Context, req *testdata.GrantDeviceDataModificationRightOnApplicationRequest, opts ...grpc.CallOption) (*testdata.GrantDeviceDataModificationRightOnApplicationResponse, error)
 }
 
-// OneOfNestedTestServiceNormalizeTopLevelJSONStrings scans m's top level and checks if any fields
-// that should be objects according to the JSON schema are actually strings. If so, it will
-// parse string values that look like JSON and replace them with the parsed value.
-// This handles both OneOf fields and regular object fields.
-func OneOfNestedTestServiceNormalizeTopLevelJSONStrings(
-	m map[string]interface{},
-	toolSchema string,
-) (changed bool) {
-	if m == nil || toolSchema == "" {
-		return false
-	}
+// UnimplementedOneOfNestedTestServiceHandler implements OneOfNestedTestServiceClient by
+// returning codes.Unimplemented from every method. Embed it to implement only
+// the methods you need.
+type UnimplementedOneOfNestedTestServiceHandler struct{}
 
-	// Parse the tool schema
-	var schema map[string]interface{}
-	if err := json.Unmarshal([]byte(toolSchema), &schema); err != nil {
-		return false
-	}
+func (UnimplementedOneOfNestedTestServiceHandler) GrantDeviceDataModificationRightOnApplication(context.Context, *testdata.GrantDeviceDataModificationRightOnApplicationRequest, ...grpc.CallOption) (*testdata.GrantDeviceDataModificationRightOnApplicationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantDeviceDataModificationRightOnApplication not implemented")
+}
 
-	// Extract properties from the schema
-	properties, ok := schema["properties"].(map[string]interface{})
-	if !ok {
-		return false
-	}
+// MockOneOfNestedTestServiceHandler implements OneOfNestedTestServiceClient with a settable
+// func per method. A method whose func is nil returns codes.Unimplemented.
+type MockOneOfNestedTestServiceHandler struct {
+	GrantDeviceDataModificationRightOnApplicationFunc func(ctx context.Context, req *testdata.GrantDeviceDataModificationRightOnApplicationRequest) (*testdata.GrantDeviceDataModificationRightOnApplicationResponse, error)
+}
 
-	// Helper function to check if a schema defines an object type
-	isObjectSchema := func(propSchema map[string]interface{}) bool {
-		// Check if type is "object"
-		if typeVal, ok := propSchema["type"]; ok {
-			if typeStr, ok := typeVal.(string); ok && typeStr == "object" {
-				return true
-			}
-			// Could also be an array of types
-			if typeArr, ok := typeVal.([]interface{}); ok {
-				for _, t := range typeArr {
-					if tStr, ok := t.(string); ok && tStr == "object" {
-						return true
-					}
-				}
-			}
-		}
+func (m *MockOneOfNestedTestServiceHandler) GrantDeviceDataModificationRightOnApplication(ctx context.Context, req *testdata.GrantDeviceDataModificationRightOnApplicationRequest, opts ...grpc.CallOption) (*testdata.GrantDeviceDataModificationRightOnApplicationResponse, error) {
+	if m.GrantDeviceDataModificationRightOnApplicationFunc == nil {
+		return UnimplementedOneOfNestedTestServiceHandler{}.GrantDeviceDataModificationRightOnApplication(ctx, req, opts...)
+	}
+	return m.GrantDeviceDataModificationRightOnApplicationFunc(ctx, req)
+}
 
-		// Check if it has properties (inline object)
-		if _, hasProps := propSchema["properties"]; hasProps {
-			return true
-		}
+// OneOfNestedTestServiceNormalizeTopLevelJSONStrings parses top-level string values of m that
+// should be objects according to the JSON schema. See runtime.NormalizeTopLevelJSONStrings.
+func OneOfNestedTestServiceNormalizeTopLevelJSONStrings(m map[string]interface{}, toolSchema string) (changed bool) {
+	return runtime.NormalizeTopLevelJSONStrings(m, toolSchema)
+}
 
-		// Check if it has a $ref (reference to object)
-		if _, hasRef := propSchema["$ref"]; hasRef {
-			return true
-		}
+// OneOfNestedTestServiceTransformOneOfFields transforms discriminated union fields back to protobuf
+// oneOf format. See runtime.TransformOneOfFields. It knows no custom
+// (mcp.options.oneof_discriminator) values; TransformOneOfNestedTestService<Method>OneOfFields does.
+func OneOfNestedTestServiceTransformOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
 
-		// Check if it has oneOf (discriminated union - treated as object)
-		if _, hasOneOf := propSchema["oneOf"]; hasOneOf {
-			return true
-		}
+// TransformOneOfNestedTestServiceGrantDeviceDataModificationRightOnApplicationOneOfFields transforms the discriminated union fields
+// of the arguments of the GrantDeviceDataModificationRightOnApplication tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformOneOfNestedTestServiceGrantDeviceDataModificationRightOnApplicationOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
 
-		return false
+// ParseOneOfNestedTestServiceGrantDeviceDataModificationRightOnApplicationArgs builds the typed request of the GrantDeviceDataModificationRightOnApplication tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseOneOfNestedTestServiceGrantDeviceDataModificationRightOnApplicationArgs(args map[string]interface{}, opts ...runtime.Option) (*testdata.GrantDeviceDataModificationRightOnApplicationRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
 	}
+	return parseOneOfNestedTestServiceGrantDeviceDataModificationRightOnApplicationArgs(args, config)
+}
 
-	// Iterate through all top-level fields in the payload
-	for k, v := range m {
-		// Get the schema for this field
-		propSchema, ok := properties[k]
-		if !ok {
-			continue
-		}
+// parseOneOfNestedTestServiceGrantDeviceDataModificationRightOnApplicationArgs runs the arguments of the GrantDeviceDataModificationRightOnApplication tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseOneOfNestedTestServiceGrantDeviceDataModificationRightOnApplicationArgs.
+func parseOneOfNestedTestServiceGrantDeviceDataModificationRightOnApplicationArgs(args map[string]interface{}, config *runtime.Config) (*testdata.GrantDeviceDataModificationRightOnApplicationRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
 
-		propSchemaMap, ok := propSchema.(map[string]interface{})
-		if !ok {
-			continue
-		}
+	var req testdata.GrantDeviceDataModificationRightOnApplicationRequest
 
-		// Check if this field should be an object according to the schema
-		if !isObjectSchema(propSchemaMap) {
-			continue
-		}
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(OneOfNestedTestService_GrantDeviceDataModificationRightOnApplicationTool, "testdata.OneOfNestedTestService.GrantDeviceDataModificationRightOnApplication", config.ToolSchemaOverrides).JSONSchema)
 
-		// Check if the actual value is a string
-		s, ok := v.(string)
-		if !ok {
-			continue
-		}
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
 
-		// Try to parse it as JSON
-		trim := strings.TrimSpace(s)
-		if trim == "" || !(strings.HasPrefix(trim, "{") || strings.HasPrefix(trim, "[")) {
-			continue
-		}
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		var parsed any
-		if err := json.Unmarshal([]byte(trim), &parsed); err != nil {
-			continue // ignore if it's not valid JSON
-		}
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(OneOfNestedTestService_GrantDeviceDataModificationRightOnApplicationFullMethod, OneOfNestedTestService_GrantDeviceDataModificationRightOnApplicationTool.Name, config.ToolNameOverrides)])
 
-		m[k] = parsed
-		changed = true
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
 	}
-	return changed
-}
 
-// OneOfNestedTestServiceTransformOneOfFields transforms discriminated union fields back to protobuf oneOf format
-func OneOfNestedTestServiceTransformOneOfFields(m map[string]interface{}) {
-	OneOfNestedTestServiceTransformOneOfFieldsRecursive(m)
-}
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, OneOfNestedTestService_GrantDeviceDataModificationRightOnApplicationZeroBasedPaginationPaths)
 
-// OneOfNestedTestServiceTransformOneOfFieldsRecursive recursively transforms oneOf fields in nested objects
-func OneOfNestedTestServiceTransformOneOfFieldsRecursive(obj interface{}) {
-	switch v := obj.(type) {
-	case map[string]interface{}:
-		// Transform oneOf fields in this object
-		for key, value := range v {
-			// Check if this looks like a oneOf discriminated union (must have OneOfType postfix)
-			if strings.HasSuffix(key, "OneOfType") {
-				if unionObj, ok := value.(map[string]interface{}); ok {
-					if typeField, hasType := unionObj["object_type"]; hasType {
-						if typeStr, ok := typeField.(string); ok {
-							// First try to extract the field that matches the object_type
-							// (for message types with $ref)
-							if fieldValue, hasField := unionObj[typeStr]; hasField {
-								// Move the field value directly to the parent level
-								v[typeStr] = fieldValue
-								delete(v, key)
-							} else {
-								// Fall back to old logic: create object without object_type field
-								// (for primitive types or inline objects)
-								variantObj := make(map[string]interface{})
-								for k, val := range unionObj {
-									if k != "object_type" {
-										variantObj[k] = val
-									}
-								}
-								// Replace the union object with the variant object
-								v[typeStr] = variantObj
-								delete(v, key)
-							}
-						}
-					}
-				}
-			}
-		}
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
 
-		// Recursively process all values
-		for _, value := range v {
-			OneOfNestedTestServiceTransformOneOfFieldsRecursive(value)
-		}
-	case []interface{}:
-		// Process array elements
-		for _, item := range v {
-			OneOfNestedTestServiceTransformOneOfFieldsRecursive(item)
-		}
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
 }
 
 // ForwardToOneOfNestedTestServiceClient registers a gRPC client, to forward MCP calls to it.
+// It panics if runtime.WithToolNameOverride yields invalid or conflicting tool
+// names, if runtime.WithStartupValidation finds an invalid tool schema, if
+// runtime.WithSessionScopedClient or runtime.WithMethodClients has clients of
+// another service, or if a runtime.WithOnRegister callback renames a tool.
 func ForwardToOneOfNestedTestServiceClient(s *mcpserver.MCPServer, client OneOfNestedTestServiceClient, opts ...runtime.Option) {
 	config := runtime.NewConfig()
 	for _, opt := range opts {
 		opt(config)
 	}
-	GrantDeviceDataModificationRightOnApplicationToolDef := OneOfNestedTestService_GrantDeviceDataModificationRightOnApplicationTool
+
+	toolNames, err := runtime.ResolveToolNames(map[string]string{
+		"testdata.OneOfNestedTestService.GrantDeviceDataModificationRightOnApplication": OneOfNestedTestService_GrantDeviceDataModificationRightOnApplicationTool.Name,
+	}, config.ToolNameOverrides)
+	if err != nil {
+		panic(err)
+	}
+	if err := runtime.ValidateToolDescriptionTemplate(config.DescriptionTemplate); err != nil {
+		panic(err)
+	}
+
+	// Reject a runtime.WithSessionScopedClient resolver of another client type
+	if err := runtime.CheckClientResolver[OneOfNestedTestServiceClient](config.ClientResolverType); err != nil {
+		panic(err)
+	}
+
+	// Reject runtime.WithMethodClients clients of another client type
+	if err := runtime.CheckMethodClients[OneOfNestedTestServiceClient](config.MethodClients); err != nil {
+		panic(err)
+	}
+
+	// Shared by every tool of this registration under runtime.WithConcurrencyLimit
+	limiter := runtime.NewCallLimiter(config.ConcurrencyLimit, config.ConcurrencyFailFast)
+
+	// Shared by every tool of this registration under runtime.WithRateLimit
+	rateLimiter := runtime.NewRateLimiter(config.RateLimits, config.DefaultRateLimit, config.RateLimitPerSession)
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	GrantDeviceDataModificationRightOnApplicationToolDef := runtime.OverrideToolSchema(OneOfNestedTestService_GrantDeviceDataModificationRightOnApplicationTool, "testdata.OneOfNestedTestService.GrantDeviceDataModificationRightOnApplication", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	GrantDeviceDataModificationRightOnApplicationTool := mcp.Tool{
-		Name:           GrantDeviceDataModificationRightOnApplicationToolDef.Name,
-		Description:    GrantDeviceDataModificationRightOnApplicationToolDef.Description,
+		Name:           toolNames["testdata.OneOfNestedTestService.GrantDeviceDataModificationRightOnApplication"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "testdata.OneOfNestedTestService.GrantDeviceDataModificationRightOnApplication", GrantDeviceDataModificationRightOnApplicationToolDef.Description),
 		RawInputSchema: json.RawMessage(GrantDeviceDataModificationRightOnApplicationToolDef.JSONSchema),
 	}
 
@@ -204,19 +196,32 @@ func ForwardToOneOfNestedTestServiceClient(s *mcpserver.MCPServer, client OneOfN
 		GrantDeviceDataModificationRightOnApplicationTool = runtime.AddExtraPropertiesToTool(GrantDeviceDataModificationRightOnApplicationTool, config.ExtraProperties)
 	}
 
-	s.AddTool(GrantDeviceDataModificationRightOnApplicationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req testdata.GrantDeviceDataModificationRightOnApplicationRequest
+	// Offer the per-call "__format" override of the response format
+	GrantDeviceDataModificationRightOnApplicationTool = runtime.AddFormatArgumentToTool(GrantDeviceDataModificationRightOnApplicationTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	GrantDeviceDataModificationRightOnApplicationTool, err = runtime.AddInputDefaultsToTool(GrantDeviceDataModificationRightOnApplicationTool, (&testdata.GrantDeviceDataModificationRightOnApplicationRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[GrantDeviceDataModificationRightOnApplicationTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = OneOfNestedTestServiceNormalizeTopLevelJSONStrings(message, GrantDeviceDataModificationRightOnApplicationToolDef.JSONSchema)
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&GrantDeviceDataModificationRightOnApplicationTool, config.OnRegister)
 
-		// Transform oneOf discriminated unions back to protobuf format
-		OneOfNestedTestServiceTransformOneOfFields(message)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(GrantDeviceDataModificationRightOnApplicationTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, OneOfNestedTestService_GrantDeviceDataModificationRightOnApplicationZeroBasedPaginationPaths)
+	GrantDeviceDataModificationRightOnApplicationHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
+
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -225,27 +230,79 @@ func ForwardToOneOfNestedTestServiceClient(s *mcpserver.MCPServer, client OneOfN
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseOneOfNestedTestServiceGrantDeviceDataModificationRightOnApplicationArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "testdata.OneOfNestedTestService.GrantDeviceDataModificationRightOnApplication", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(OneOfNestedTestService_GrantDeviceDataModificationRightOnApplicationFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, GrantDeviceDataModificationRightOnApplicationToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.GrantDeviceDataModificationRightOnApplication(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, GrantDeviceDataModificationRightOnApplicationToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.GrantDeviceDataModificationRightOnApplication(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["testdata.OneOfNestedTestService.GrantDeviceDataModificationRightOnApplication"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("testdata.OneOfNestedTestService.GrantDeviceDataModificationRightOnApplication", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -253,5 +310,42 @@ func ForwardToOneOfNestedTestServiceClient(s *mcpserver.MCPServer, client OneOfN
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	GrantDeviceDataModificationRightOnApplicationHandler = runtime.RecoverPanics(GrantDeviceDataModificationRightOnApplicationHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	GrantDeviceDataModificationRightOnApplicationHandler = runtime.RecordMetrics(GrantDeviceDataModificationRightOnApplicationHandler, "testdata.OneOfNestedTestService.GrantDeviceDataModificationRightOnApplication", config.Metrics)
+
+	s.AddTool(GrantDeviceDataModificationRightOnApplicationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, GrantDeviceDataModificationRightOnApplicationTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return GrantDeviceDataModificationRightOnApplicationHandler(ctx, request.GetArguments())
 	})
 }
+
+// OneOfNestedTestServiceInProcessServer is the server side of OneOfNestedTestService. Every grpc-go
+// OneOfNestedTestServiceServer implementation satisfies it.
+type OneOfNestedTestServiceInProcessServer interface {
+	GrantDeviceDataModificationRightOnApplication(ctx context.Context, req *testdata.GrantDeviceDataModificationRightOnApplicationRequest) (*testdata.GrantDeviceDataModificationRightOnApplicationResponse, error)
+}
+
+// inProcessOneOfNestedTestServiceClient implements OneOfNestedTestServiceClient by calling a
+// OneOfNestedTestServiceInProcessServer directly. Call options have no effect.
+type inProcessOneOfNestedTestServiceClient struct {
+	impl OneOfNestedTestServiceInProcessServer
+}
+
+func (c inProcessOneOfNestedTestServiceClient) GrantDeviceDataModificationRightOnApplication(ctx context.Context, req *testdata.GrantDeviceDataModificationRightOnApplicationRequest, _ ...grpc.CallOption) (*testdata.GrantDeviceDataModificationRightOnApplicationResponse, error) {
+	return c.impl.GrantDeviceDataModificationRightOnApplication(ctx, req)
+}
+
+// RegisterInProcessOneOfNestedTestServiceServer registers impl so that MCP calls reach it
+// in-process, through the same pipeline as ForwardToOneOfNestedTestServiceClient but
+// without a gRPC connection. gRPC interceptors do not run.
+func RegisterInProcessOneOfNestedTestServiceServer(s *mcpserver.MCPServer, impl OneOfNestedTestServiceInProcessServer, opts ...runtime.Option) {
+	ForwardToOneOfNestedTestServiceClient(s, inProcessOneOfNestedTestServiceClient{impl: impl}, opts...)
+}
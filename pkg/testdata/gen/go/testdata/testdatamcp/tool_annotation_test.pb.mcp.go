@@ -3,31 +3,45 @@
 
 package testdatamcp
 
-import (
-	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
-)
-
 import (
 	"context"
-	"strings"
 	"github.com/mark3labs/mcp-go/mcp"
 	mcpserver "github.com/mark3labs/mcp-go/server"
 	"encoding/json"
 	"google.golang.org/protobuf/encoding/protojson"
 	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"github.com/shaders/protoc-gen-go-mcp/pkg/runtime"
+	testdata "github.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata"
+)
+
+// Tool names as generated, and the fully-qualified method names that
+// runtime.WithToolNameOverride keys on, for references that must not drift
+// from the proto. Overrides apply at registration and are not reflected here.
+const (
+	AnnotatedService_DeleteWidgetToolName   = "delete_widget"
+	AnnotatedService_DeleteWidgetFullMethod = "testdata.AnnotatedService.DeleteWidget"
+	AnnotatedService_GetWidgetToolName      = "get_widget"
+	AnnotatedService_GetWidgetFullMethod    = "testdata.AnnotatedService.GetWidget"
+	AnnotatedService_ListLegacyToolName     = "testdata_AnnotatedService_ListLegacy"
+	AnnotatedService_ListLegacyFullMethod   = "testdata.AnnotatedService.ListLegacy"
+	AnnotatedService_ListWidgetsToolName    = "list_widgets"
+	AnnotatedService_ListWidgetsFullMethod  = "testdata.AnnotatedService.ListWidgets"
 )
 
 var (
 	AnnotatedService_DeleteWidgetTool = runtime.Tool{Name: "delete_widget", Description: "Permanently deletes a widget. Only destructive is set; the other hints\nstay unset and must be omitted from the generated tool so MCP clients\napply the spec defaults.\n", JSONSchema: "{\"$schema\":\"https://json-schema.org/draft/2020-12/schema\",\"properties\":{\"id\":{\"description\":\"Widget identifier.\",\"type\":\"string\"}},\"required\":[],\"type\":\"object\"}", Title: "Delete widget", Destructive: runtime.BoolPtr(true)}
 	AnnotatedService_GetWidgetTool    = runtime.Tool{Name: "get_widget", Description: "Fetches a widget by id.\n", JSONSchema: "{\"$schema\":\"https://json-schema.org/draft/2020-12/schema\",\"properties\":{\"id\":{\"description\":\"Widget identifier.\",\"type\":\"string\"}},\"required\":[],\"type\":\"object\"}", Title: "Get widget", ReadOnly: runtime.BoolPtr(true), Idempotent: runtime.BoolPtr(true), OpenWorld: runtime.BoolPtr(false)}
 	AnnotatedService_ListLegacyTool   = runtime.Tool{Name: "testdata_AnnotatedService_ListLegacy", Description: "Unannotated method: keeps the legacy autogenerated tool name and emits\nno ToolAnnotation.\n", JSONSchema: "{\"$schema\":\"https://json-schema.org/draft/2020-12/schema\",\"properties\":{\"filter\":{\"description\":\"Free-form filter.\",\"type\":\"string\"}},\"required\":[],\"type\":\"object\"}"}
+	AnnotatedService_ListWidgetsTool  = runtime.Tool{Name: "list_widgets", Description: "Lists every widget visible to the caller. Use get_widget to fetch one widget's details.", JSONSchema: "{\"$schema\":\"https://json-schema.org/draft/2020-12/schema\",\"properties\":{\"limit\":{\"description\":\"Maximum number of widgets to return.\",\"type\":\"integer\"}},\"required\":[],\"type\":\"object\"}"}
 )
 
 var (
 	AnnotatedService_DeleteWidgetZeroBasedPaginationPaths = [][]string{}
 	AnnotatedService_GetWidgetZeroBasedPaginationPaths    = [][]string{}
 	AnnotatedService_ListLegacyZeroBasedPaginationPaths   = [][]string{}
+	AnnotatedService_ListWidgetsZeroBasedPaginationPaths  = [][]string{}
 )
 
 // AnnotatedServiceClient is compatible with the grpc-go client interface.
@@ -35,173 +49,418 @@ type AnnotatedServiceClient interface {
 	DeleteWidget(ctx context.Context, req *testdata.DeleteWidgetRequest, opts ...grpc.CallOption) (*testdata.DeleteWidgetResponse, error)
 	GetWidget(ctx context.Context, req *testdata.GetWidgetRequest, opts ...grpc.CallOption) (*testdata.GetWidgetResponse, error)
 	ListLegacy(ctx context.Context, req *testdata.ListLegacyRequest, opts ...grpc.CallOption) (*testdata.ListLegacyResponse, error)
+	ListWidgets(ctx context.Context, req *testdata.ListWidgetsRequest, opts ...grpc.CallOption) (*testdata.ListWidgetsResponse, error)
+}
+
+// UnimplementedAnnotatedServiceHandler implements AnnotatedServiceClient by
+// returning codes.Unimplemented from every method. Embed it to implement only
+// the methods you need.
+type UnimplementedAnnotatedServiceHandler struct{}
+
+func (UnimplementedAnnotatedServiceHandler) DeleteWidget(context.Context, *testdata.DeleteWidgetRequest, ...grpc.CallOption) (*testdata.DeleteWidgetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWidget not implemented")
+}
+
+func (UnimplementedAnnotatedServiceHandler) GetWidget(context.Context, *testdata.GetWidgetRequest, ...grpc.CallOption) (*testdata.GetWidgetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWidget not implemented")
 }
 
-// AnnotatedServiceNormalizeTopLevelJSONStrings scans m's top level and checks if any fields
-// that should be objects according to the JSON schema are actually strings. If so, it will
-// parse string values that look like JSON and replace them with the parsed value.
-// This handles both OneOf fields and regular object fields.
-func AnnotatedServiceNormalizeTopLevelJSONStrings(
-	m map[string]interface{},
-	toolSchema string,
-) (changed bool) {
-	if m == nil || toolSchema == "" {
-		return false
+func (UnimplementedAnnotatedServiceHandler) ListLegacy(context.Context, *testdata.ListLegacyRequest, ...grpc.CallOption) (*testdata.ListLegacyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLegacy not implemented")
+}
+
+func (UnimplementedAnnotatedServiceHandler) ListWidgets(context.Context, *testdata.ListWidgetsRequest, ...grpc.CallOption) (*testdata.ListWidgetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWidgets not implemented")
+}
+
+// MockAnnotatedServiceHandler implements AnnotatedServiceClient with a settable
+// func per method. A method whose func is nil returns codes.Unimplemented.
+type MockAnnotatedServiceHandler struct {
+	DeleteWidgetFunc func(ctx context.Context, req *testdata.DeleteWidgetRequest) (*testdata.DeleteWidgetResponse, error)
+	GetWidgetFunc    func(ctx context.Context, req *testdata.GetWidgetRequest) (*testdata.GetWidgetResponse, error)
+	ListLegacyFunc   func(ctx context.Context, req *testdata.ListLegacyRequest) (*testdata.ListLegacyResponse, error)
+	ListWidgetsFunc  func(ctx context.Context, req *testdata.ListWidgetsRequest) (*testdata.ListWidgetsResponse, error)
+}
+
+func (m *MockAnnotatedServiceHandler) DeleteWidget(ctx context.Context, req *testdata.DeleteWidgetRequest, opts ...grpc.CallOption) (*testdata.DeleteWidgetResponse, error) {
+	if m.DeleteWidgetFunc == nil {
+		return UnimplementedAnnotatedServiceHandler{}.DeleteWidget(ctx, req, opts...)
 	}
+	return m.DeleteWidgetFunc(ctx, req)
+}
 
-	// Parse the tool schema
-	var schema map[string]interface{}
-	if err := json.Unmarshal([]byte(toolSchema), &schema); err != nil {
-		return false
+func (m *MockAnnotatedServiceHandler) GetWidget(ctx context.Context, req *testdata.GetWidgetRequest, opts ...grpc.CallOption) (*testdata.GetWidgetResponse, error) {
+	if m.GetWidgetFunc == nil {
+		return UnimplementedAnnotatedServiceHandler{}.GetWidget(ctx, req, opts...)
 	}
+	return m.GetWidgetFunc(ctx, req)
+}
 
-	// Extract properties from the schema
-	properties, ok := schema["properties"].(map[string]interface{})
-	if !ok {
-		return false
+func (m *MockAnnotatedServiceHandler) ListLegacy(ctx context.Context, req *testdata.ListLegacyRequest, opts ...grpc.CallOption) (*testdata.ListLegacyResponse, error) {
+	if m.ListLegacyFunc == nil {
+		return UnimplementedAnnotatedServiceHandler{}.ListLegacy(ctx, req, opts...)
 	}
+	return m.ListLegacyFunc(ctx, req)
+}
 
-	// Helper function to check if a schema defines an object type
-	isObjectSchema := func(propSchema map[string]interface{}) bool {
-		// Check if type is "object"
-		if typeVal, ok := propSchema["type"]; ok {
-			if typeStr, ok := typeVal.(string); ok && typeStr == "object" {
-				return true
-			}
-			// Could also be an array of types
-			if typeArr, ok := typeVal.([]interface{}); ok {
-				for _, t := range typeArr {
-					if tStr, ok := t.(string); ok && tStr == "object" {
-						return true
-					}
-				}
-			}
-		}
+func (m *MockAnnotatedServiceHandler) ListWidgets(ctx context.Context, req *testdata.ListWidgetsRequest, opts ...grpc.CallOption) (*testdata.ListWidgetsResponse, error) {
+	if m.ListWidgetsFunc == nil {
+		return UnimplementedAnnotatedServiceHandler{}.ListWidgets(ctx, req, opts...)
+	}
+	return m.ListWidgetsFunc(ctx, req)
+}
 
-		// Check if it has properties (inline object)
-		if _, hasProps := propSchema["properties"]; hasProps {
-			return true
-		}
+// AnnotatedServiceNormalizeTopLevelJSONStrings parses top-level string values of m that
+// should be objects according to the JSON schema. See runtime.NormalizeTopLevelJSONStrings.
+func AnnotatedServiceNormalizeTopLevelJSONStrings(m map[string]interface{}, toolSchema string) (changed bool) {
+	return runtime.NormalizeTopLevelJSONStrings(m, toolSchema)
+}
 
-		// Check if it has a $ref (reference to object)
-		if _, hasRef := propSchema["$ref"]; hasRef {
-			return true
-		}
+// AnnotatedServiceTransformOneOfFields transforms discriminated union fields back to protobuf
+// oneOf format. See runtime.TransformOneOfFields. It knows no custom
+// (mcp.options.oneof_discriminator) values; TransformAnnotatedService<Method>OneOfFields does.
+func AnnotatedServiceTransformOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
 
-		// Check if it has oneOf (discriminated union - treated as object)
-		if _, hasOneOf := propSchema["oneOf"]; hasOneOf {
-			return true
-		}
+// TransformAnnotatedServiceDeleteWidgetOneOfFields transforms the discriminated union fields
+// of the arguments of the DeleteWidget tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformAnnotatedServiceDeleteWidgetOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
+
+// TransformAnnotatedServiceGetWidgetOneOfFields transforms the discriminated union fields
+// of the arguments of the GetWidget tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformAnnotatedServiceGetWidgetOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
 
-		return false
+// TransformAnnotatedServiceListLegacyOneOfFields transforms the discriminated union fields
+// of the arguments of the ListLegacy tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformAnnotatedServiceListLegacyOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
+
+// TransformAnnotatedServiceListWidgetsOneOfFields transforms the discriminated union fields
+// of the arguments of the ListWidgets tool back to protobuf oneOf format, as
+// its handler does. See runtime.TransformOneOfFields.
+func TransformAnnotatedServiceListWidgetsOneOfFields(m map[string]interface{}, maxDepth int) error {
+	return runtime.TransformOneOfFields(m, maxDepth)
+}
+
+// ParseAnnotatedServiceDeleteWidgetArgs builds the typed request of the DeleteWidget tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseAnnotatedServiceDeleteWidgetArgs(args map[string]interface{}, opts ...runtime.Option) (*testdata.DeleteWidgetRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
 	}
+	return parseAnnotatedServiceDeleteWidgetArgs(args, config)
+}
 
-	// Iterate through all top-level fields in the payload
-	for k, v := range m {
-		// Get the schema for this field
-		propSchema, ok := properties[k]
-		if !ok {
-			continue
-		}
+// parseAnnotatedServiceDeleteWidgetArgs runs the arguments of the DeleteWidget tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseAnnotatedServiceDeleteWidgetArgs.
+func parseAnnotatedServiceDeleteWidgetArgs(args map[string]interface{}, config *runtime.Config) (*testdata.DeleteWidgetRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
 
-		propSchemaMap, ok := propSchema.(map[string]interface{})
-		if !ok {
-			continue
-		}
+	var req testdata.DeleteWidgetRequest
 
-		// Check if this field should be an object according to the schema
-		if !isObjectSchema(propSchemaMap) {
-			continue
-		}
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(AnnotatedService_DeleteWidgetTool, "testdata.AnnotatedService.DeleteWidget", config.ToolSchemaOverrides).JSONSchema)
 
-		// Check if the actual value is a string
-		s, ok := v.(string)
-		if !ok {
-			continue
-		}
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
 
-		// Try to parse it as JSON
-		trim := strings.TrimSpace(s)
-		if trim == "" || !(strings.HasPrefix(trim, "{") || strings.HasPrefix(trim, "[")) {
-			continue
-		}
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		var parsed any
-		if err := json.Unmarshal([]byte(trim), &parsed); err != nil {
-			continue // ignore if it's not valid JSON
-		}
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(AnnotatedService_DeleteWidgetFullMethod, AnnotatedService_DeleteWidgetTool.Name, config.ToolNameOverrides)])
+
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
+
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, AnnotatedService_DeleteWidgetZeroBasedPaginationPaths)
+
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
 
-		m[k] = parsed
-		changed = true
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	return changed
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
 }
 
-// AnnotatedServiceTransformOneOfFields transforms discriminated union fields back to protobuf oneOf format
-func AnnotatedServiceTransformOneOfFields(m map[string]interface{}) {
-	AnnotatedServiceTransformOneOfFieldsRecursive(m)
+// ParseAnnotatedServiceGetWidgetArgs builds the typed request of the GetWidget tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseAnnotatedServiceGetWidgetArgs(args map[string]interface{}, opts ...runtime.Option) (*testdata.GetWidgetRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return parseAnnotatedServiceGetWidgetArgs(args, config)
 }
 
-// AnnotatedServiceTransformOneOfFieldsRecursive recursively transforms oneOf fields in nested objects
-func AnnotatedServiceTransformOneOfFieldsRecursive(obj interface{}) {
-	switch v := obj.(type) {
-	case map[string]interface{}:
-		// Transform oneOf fields in this object
-		for key, value := range v {
-			// Check if this looks like a oneOf discriminated union (must have OneOfType postfix)
-			if strings.HasSuffix(key, "OneOfType") {
-				if unionObj, ok := value.(map[string]interface{}); ok {
-					if typeField, hasType := unionObj["object_type"]; hasType {
-						if typeStr, ok := typeField.(string); ok {
-							// First try to extract the field that matches the object_type
-							// (for message types with $ref)
-							if fieldValue, hasField := unionObj[typeStr]; hasField {
-								// Move the field value directly to the parent level
-								v[typeStr] = fieldValue
-								delete(v, key)
-							} else {
-								// Fall back to old logic: create object without object_type field
-								// (for primitive types or inline objects)
-								variantObj := make(map[string]interface{})
-								for k, val := range unionObj {
-									if k != "object_type" {
-										variantObj[k] = val
-									}
-								}
-								// Replace the union object with the variant object
-								v[typeStr] = variantObj
-								delete(v, key)
-							}
-						}
-					}
-				}
-			}
-		}
+// parseAnnotatedServiceGetWidgetArgs runs the arguments of the GetWidget tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseAnnotatedServiceGetWidgetArgs.
+func parseAnnotatedServiceGetWidgetArgs(args map[string]interface{}, config *runtime.Config) (*testdata.GetWidgetRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
 
-		// Recursively process all values
-		for _, value := range v {
-			AnnotatedServiceTransformOneOfFieldsRecursive(value)
-		}
-	case []interface{}:
-		// Process array elements
-		for _, item := range v {
-			AnnotatedServiceTransformOneOfFieldsRecursive(item)
-		}
+	var req testdata.GetWidgetRequest
+
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(AnnotatedService_GetWidgetTool, "testdata.AnnotatedService.GetWidget", config.ToolSchemaOverrides).JSONSchema)
+
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
+
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(AnnotatedService_GetWidgetFullMethod, AnnotatedService_GetWidgetTool.Name, config.ToolNameOverrides)])
+
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
+
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, AnnotatedService_GetWidgetZeroBasedPaginationPaths)
+
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
+
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
+}
+
+// ParseAnnotatedServiceListLegacyArgs builds the typed request of the ListLegacy tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseAnnotatedServiceListLegacyArgs(args map[string]interface{}, opts ...runtime.Option) (*testdata.ListLegacyRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return parseAnnotatedServiceListLegacyArgs(args, config)
+}
+
+// parseAnnotatedServiceListLegacyArgs runs the arguments of the ListLegacy tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseAnnotatedServiceListLegacyArgs.
+func parseAnnotatedServiceListLegacyArgs(args map[string]interface{}, config *runtime.Config) (*testdata.ListLegacyRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
+
+	var req testdata.ListLegacyRequest
+
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(AnnotatedService_ListLegacyTool, "testdata.AnnotatedService.ListLegacy", config.ToolSchemaOverrides).JSONSchema)
+
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
+
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(AnnotatedService_ListLegacyFullMethod, AnnotatedService_ListLegacyTool.Name, config.ToolNameOverrides)])
+
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
+
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, AnnotatedService_ListLegacyZeroBasedPaginationPaths)
+
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
 	}
+
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
+}
+
+// ParseAnnotatedServiceListWidgetsArgs builds the typed request of the ListWidgets tool
+// from its arguments, with the same transformations and checks as the
+// generated handler, for custom handlers and middleware that work on typed
+// requests. opts are the runtime options of the registration. args is
+// modified in place. Invalid arguments are an InvalidArgument status error.
+func ParseAnnotatedServiceListWidgetsArgs(args map[string]interface{}, opts ...runtime.Option) (*testdata.ListWidgetsRequest, error) {
+	config := runtime.NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	return parseAnnotatedServiceListWidgetsArgs(args, config)
+}
+
+// parseAnnotatedServiceListWidgetsArgs runs the arguments of the ListWidgets tool
+// through the transformations and checks of config and builds the typed
+// request. It is the argument pipeline of both the generated handler and
+// ParseAnnotatedServiceListWidgetsArgs.
+func parseAnnotatedServiceListWidgetsArgs(args map[string]interface{}, config *runtime.Config) (*testdata.ListWidgetsRequest, error) {
+	// Reject oversized arguments under runtime.WithMaxRequestBytes
+	if err := runtime.CheckRequestSize(args, config.MaxRequestBytes); err != nil {
+		return nil, err
+	}
+
+	var req testdata.ListWidgetsRequest
+
+	// Normalize JSON strings for object fields (including oneOf's), by the
+	// schema of runtime.WithToolSchemaOverride if any
+	_ = runtime.NormalizeTopLevelJSONStrings(args, runtime.OverrideToolSchema(AnnotatedService_ListWidgetsTool, "testdata.AnnotatedService.ListWidgets", config.ToolSchemaOverrides).JSONSchema)
+
+	// Transform oneOf discriminated unions back to protobuf format, rejecting
+	// arguments nested deeper than runtime.WithMaxNestingDepth allows
+	if err := runtime.TransformOneOfFields(args, config.MaxNestingDepth); err != nil {
+		return nil, err
+	}
+
+	// Drop a per-call "__format" override, which only shapes the result
+	if _, err := runtime.UseToonForCall(args, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Fill in omitted fields from runtime.WithInputDefaults
+	runtime.ApplyInputDefaults(args, req.ProtoReflect().Descriptor(), config.InputDefaults[runtime.ToolName(AnnotatedService_ListWidgetsFullMethod, AnnotatedService_ListWidgetsTool.Name, config.ToolNameOverrides)])
+
+	// Turn integral floats such as 5.0 sent for integer fields into integers
+	if err := runtime.NormalizeIntegerFields(args, req.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
+
+	// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
+	runtime.AdjustZeroBasedPaginationFields(args, AnnotatedService_ListWidgetsZeroBasedPaginationPaths)
+
+	// Coerce argument values under runtime.WithFieldCoercer
+	if err := runtime.CoerceFields(args, req.ProtoReflect().Descriptor(), config.FieldCoercers); err != nil {
+		return nil, err
+	}
+
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &req, nil
 }
 
 // ForwardToAnnotatedServiceClient registers a gRPC client, to forward MCP calls to it.
+// It panics if runtime.WithToolNameOverride yields invalid or conflicting tool
+// names, if runtime.WithStartupValidation finds an invalid tool schema, if
+// runtime.WithSessionScopedClient or runtime.WithMethodClients has clients of
+// another service, or if a runtime.WithOnRegister callback renames a tool.
 func ForwardToAnnotatedServiceClient(s *mcpserver.MCPServer, client AnnotatedServiceClient, opts ...runtime.Option) {
 	config := runtime.NewConfig()
 	for _, opt := range opts {
 		opt(config)
 	}
-	DeleteWidgetToolDef := AnnotatedService_DeleteWidgetTool
+
+	toolNames, err := runtime.ResolveToolNames(map[string]string{
+		"testdata.AnnotatedService.DeleteWidget": AnnotatedService_DeleteWidgetTool.Name,
+		"testdata.AnnotatedService.GetWidget":    AnnotatedService_GetWidgetTool.Name,
+		"testdata.AnnotatedService.ListLegacy":   AnnotatedService_ListLegacyTool.Name,
+		"testdata.AnnotatedService.ListWidgets":  AnnotatedService_ListWidgetsTool.Name,
+	}, config.ToolNameOverrides)
+	if err != nil {
+		panic(err)
+	}
+	if err := runtime.ValidateToolDescriptionTemplate(config.DescriptionTemplate); err != nil {
+		panic(err)
+	}
+
+	// Reject a runtime.WithSessionScopedClient resolver of another client type
+	if err := runtime.CheckClientResolver[AnnotatedServiceClient](config.ClientResolverType); err != nil {
+		panic(err)
+	}
+
+	// Reject runtime.WithMethodClients clients of another client type
+	if err := runtime.CheckMethodClients[AnnotatedServiceClient](config.MethodClients); err != nil {
+		panic(err)
+	}
+
+	// Shared by every tool of this registration under runtime.WithConcurrencyLimit
+	limiter := runtime.NewCallLimiter(config.ConcurrencyLimit, config.ConcurrencyFailFast)
+
+	// Shared by every tool of this registration under runtime.WithRateLimit
+	rateLimiter := runtime.NewRateLimiter(config.RateLimits, config.DefaultRateLimit, config.RateLimitPerSession)
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	DeleteWidgetToolDef := runtime.OverrideToolSchema(AnnotatedService_DeleteWidgetTool, "testdata.AnnotatedService.DeleteWidget", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	DeleteWidgetTool := mcp.Tool{
-		Name:           DeleteWidgetToolDef.Name,
-		Description:    DeleteWidgetToolDef.Description,
+		Name:           toolNames["testdata.AnnotatedService.DeleteWidget"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "testdata.AnnotatedService.DeleteWidget", DeleteWidgetToolDef.Description),
 		RawInputSchema: json.RawMessage(DeleteWidgetToolDef.JSONSchema),
 		Annotations: mcp.ToolAnnotation{
 			Title:           DeleteWidgetToolDef.Title,
@@ -217,19 +476,32 @@ func ForwardToAnnotatedServiceClient(s *mcpserver.MCPServer, client AnnotatedSer
 		DeleteWidgetTool = runtime.AddExtraPropertiesToTool(DeleteWidgetTool, config.ExtraProperties)
 	}
 
-	s.AddTool(DeleteWidgetTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req testdata.DeleteWidgetRequest
+	// Offer the per-call "__format" override of the response format
+	DeleteWidgetTool = runtime.AddFormatArgumentToTool(DeleteWidgetTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	DeleteWidgetTool, err = runtime.AddInputDefaultsToTool(DeleteWidgetTool, (&testdata.DeleteWidgetRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[DeleteWidgetTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = AnnotatedServiceNormalizeTopLevelJSONStrings(message, DeleteWidgetToolDef.JSONSchema)
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&DeleteWidgetTool, config.OnRegister)
 
-		// Transform oneOf discriminated unions back to protobuf format
-		AnnotatedServiceTransformOneOfFields(message)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(DeleteWidgetTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, AnnotatedService_DeleteWidgetZeroBasedPaginationPaths)
+	DeleteWidgetHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
+
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -238,27 +510,79 @@ func ForwardToAnnotatedServiceClient(s *mcpserver.MCPServer, client AnnotatedSer
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseAnnotatedServiceDeleteWidgetArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "testdata.AnnotatedService.DeleteWidget", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(AnnotatedService_DeleteWidgetFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, DeleteWidgetToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.DeleteWidget(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, DeleteWidgetToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.DeleteWidget(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["testdata.AnnotatedService.DeleteWidget"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("testdata.AnnotatedService.DeleteWidget", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -266,13 +590,28 @@ func ForwardToAnnotatedServiceClient(s *mcpserver.MCPServer, client AnnotatedSer
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	DeleteWidgetHandler = runtime.RecoverPanics(DeleteWidgetHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	DeleteWidgetHandler = runtime.RecordMetrics(DeleteWidgetHandler, "testdata.AnnotatedService.DeleteWidget", config.Metrics)
+
+	s.AddTool(DeleteWidgetTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, DeleteWidgetTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return DeleteWidgetHandler(ctx, request.GetArguments())
 	})
-	GetWidgetToolDef := AnnotatedService_GetWidgetTool
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	GetWidgetToolDef := runtime.OverrideToolSchema(AnnotatedService_GetWidgetTool, "testdata.AnnotatedService.GetWidget", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	GetWidgetTool := mcp.Tool{
-		Name:           GetWidgetToolDef.Name,
-		Description:    GetWidgetToolDef.Description,
+		Name:           toolNames["testdata.AnnotatedService.GetWidget"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "testdata.AnnotatedService.GetWidget", GetWidgetToolDef.Description),
 		RawInputSchema: json.RawMessage(GetWidgetToolDef.JSONSchema),
 		Annotations: mcp.ToolAnnotation{
 			Title:           GetWidgetToolDef.Title,
@@ -288,19 +627,32 @@ func ForwardToAnnotatedServiceClient(s *mcpserver.MCPServer, client AnnotatedSer
 		GetWidgetTool = runtime.AddExtraPropertiesToTool(GetWidgetTool, config.ExtraProperties)
 	}
 
-	s.AddTool(GetWidgetTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req testdata.GetWidgetRequest
+	// Offer the per-call "__format" override of the response format
+	GetWidgetTool = runtime.AddFormatArgumentToTool(GetWidgetTool)
+
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	GetWidgetTool, err = runtime.AddInputDefaultsToTool(GetWidgetTool, (&testdata.GetWidgetRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[GetWidgetTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		message := request.GetArguments()
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&GetWidgetTool, config.OnRegister)
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = AnnotatedServiceNormalizeTopLevelJSONStrings(message, GetWidgetToolDef.JSONSchema)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(GetWidgetTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Transform oneOf discriminated unions back to protobuf format
-		AnnotatedServiceTransformOneOfFields(message)
+	GetWidgetHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, AnnotatedService_GetWidgetZeroBasedPaginationPaths)
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -309,27 +661,79 @@ func ForwardToAnnotatedServiceClient(s *mcpserver.MCPServer, client AnnotatedSer
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseAnnotatedServiceGetWidgetArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "testdata.AnnotatedService.GetWidget", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(AnnotatedService_GetWidgetFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, GetWidgetToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.GetWidget(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, GetWidgetToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.GetWidget(ctx, &req)
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["testdata.AnnotatedService.GetWidget"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
 		if err != nil {
-			return runtime.HandleError(err)
+			return nil, err
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("testdata.AnnotatedService.GetWidget", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -337,13 +741,28 @@ func ForwardToAnnotatedServiceClient(s *mcpserver.MCPServer, client AnnotatedSer
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	GetWidgetHandler = runtime.RecoverPanics(GetWidgetHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	GetWidgetHandler = runtime.RecordMetrics(GetWidgetHandler, "testdata.AnnotatedService.GetWidget", config.Metrics)
+
+	s.AddTool(GetWidgetTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, GetWidgetTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return GetWidgetHandler(ctx, request.GetArguments())
 	})
-	ListLegacyToolDef := AnnotatedService_ListLegacyTool
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	ListLegacyToolDef := runtime.OverrideToolSchema(AnnotatedService_ListLegacyTool, "testdata.AnnotatedService.ListLegacy", config.ToolSchemaOverrides)
 
 	// Convert simple Tool to mcp.Tool
 	ListLegacyTool := mcp.Tool{
-		Name:           ListLegacyToolDef.Name,
-		Description:    ListLegacyToolDef.Description,
+		Name:           toolNames["testdata.AnnotatedService.ListLegacy"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "testdata.AnnotatedService.ListLegacy", ListLegacyToolDef.Description),
 		RawInputSchema: json.RawMessage(ListLegacyToolDef.JSONSchema),
 	}
 
@@ -352,19 +771,32 @@ func ForwardToAnnotatedServiceClient(s *mcpserver.MCPServer, client AnnotatedSer
 		ListLegacyTool = runtime.AddExtraPropertiesToTool(ListLegacyTool, config.ExtraProperties)
 	}
 
-	s.AddTool(ListLegacyTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var req testdata.ListLegacyRequest
+	// Offer the per-call "__format" override of the response format
+	ListLegacyTool = runtime.AddFormatArgumentToTool(ListLegacyTool)
 
-		message := request.GetArguments()
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	ListLegacyTool, err = runtime.AddInputDefaultsToTool(ListLegacyTool, (&testdata.ListLegacyRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[ListLegacyTool.Name])
+	if err != nil {
+		panic(err)
+	}
 
-		// Normalize JSON strings for object fields (including oneOf's).
-		_ = AnnotatedServiceNormalizeTopLevelJSONStrings(message, ListLegacyToolDef.JSONSchema)
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&ListLegacyTool, config.OnRegister)
 
-		// Transform oneOf discriminated unions back to protobuf format
-		AnnotatedServiceTransformOneOfFields(message)
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(ListLegacyTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
 
-		// Decrement values for fields annotated with (mcp.options.zero_based_pagination)
-		runtime.AdjustZeroBasedPaginationFields(message, AnnotatedService_ListLegacyZeroBasedPaginationPaths)
+	ListLegacyHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
+
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
 
 		// Extract extra properties if configured
 		for _, prop := range config.ExtraProperties {
@@ -373,27 +805,223 @@ func ForwardToAnnotatedServiceClient(s *mcpserver.MCPServer, client AnnotatedSer
 			}
 		}
 
-		marshaled, err := json.Marshal(message)
+		// Build the typed request from the arguments
+		req, err := parseAnnotatedServiceListLegacyArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "testdata.AnnotatedService.ListLegacy", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(AnnotatedService_ListLegacyFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, ListLegacyToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.ListLegacy(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, ListLegacyToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
+		if err != nil {
+			return nil, err
+		}
+
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(marshaled, &req); err != nil {
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["testdata.AnnotatedService.ListLegacy"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
+		if err != nil {
 			return nil, err
 		}
 
-		resp, err := client.ListLegacy(ctx, &req)
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("testdata.AnnotatedService.ListLegacy", marshaled, config.ResultPostProcessors)
+		if err != nil {
+			return nil, err
+		}
+
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
+			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
+				return mcp.NewToolResultText(toonData), nil
+			}
+			// Fall back to JSON if TOON compression fails
+		}
+
+		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	ListLegacyHandler = runtime.RecoverPanics(ListLegacyHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	ListLegacyHandler = runtime.RecordMetrics(ListLegacyHandler, "testdata.AnnotatedService.ListLegacy", config.Metrics)
+
+	s.AddTool(ListLegacyTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, ListLegacyTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return ListLegacyHandler(ctx, request.GetArguments())
+	})
+	// Swap in the input schema of runtime.WithToolSchemaOverride, if any
+	ListWidgetsToolDef := runtime.OverrideToolSchema(AnnotatedService_ListWidgetsTool, "testdata.AnnotatedService.ListWidgets", config.ToolSchemaOverrides)
+
+	// Convert simple Tool to mcp.Tool
+	ListWidgetsTool := mcp.Tool{
+		Name:           toolNames["testdata.AnnotatedService.ListWidgets"],
+		Description:    runtime.ApplyToolDescriptionTemplate(config.DescriptionTemplate, "testdata.AnnotatedService.ListWidgets", ListWidgetsToolDef.Description),
+		RawInputSchema: json.RawMessage(ListWidgetsToolDef.JSONSchema),
+	}
+
+	// Add extra properties to schema if configured
+	if len(config.ExtraProperties) > 0 {
+		ListWidgetsTool = runtime.AddExtraPropertiesToTool(ListWidgetsTool, config.ExtraProperties)
+	}
+
+	// Offer the per-call "__format" override of the response format
+	ListWidgetsTool = runtime.AddFormatArgumentToTool(ListWidgetsTool)
+
+	// Show the defaults of runtime.WithInputDefaults in the schema
+	ListWidgetsTool, err = runtime.AddInputDefaultsToTool(ListWidgetsTool, (&testdata.ListWidgetsRequest{}).ProtoReflect().Descriptor(), config.InputDefaults[ListWidgetsTool.Name])
+	if err != nil {
+		panic(err)
+	}
+
+	// Let the callbacks of runtime.WithOnRegister observe or adjust the tool
+	runtime.NotifyRegister(&ListWidgetsTool, config.OnRegister)
+
+	// Fail fast on a broken schema under runtime.WithStartupValidation
+	if err := runtime.ValidateToolSchema(ListWidgetsTool, config.StartupValidation); err != nil {
+		panic(err)
+	}
+
+	ListWidgetsHandler := func(ctx context.Context, message map[string]interface{}) (*mcp.CallToolResult, error) {
+		// See the values of runtime.WithBaseContext behind those of the request
+		ctx = runtime.MergeBaseContext(ctx, config.BaseContext)
+
+		// Honor a per-call "__format" override of the response format
+		useToon, err := runtime.UseToonForCall(message, config.UseToonCompression)
+		if err != nil {
+			return runtime.HandleError(status.Error(codes.InvalidArgument, err.Error()))
+		}
+
+		// Extract extra properties if configured
+		for _, prop := range config.ExtraProperties {
+			if propVal, ok := message[prop.Name]; ok {
+				ctx = context.WithValue(ctx, prop.ContextKey, propVal)
+			}
+		}
+
+		// Build the typed request from the arguments
+		req, err := parseAnnotatedServiceListWidgetsArgs(message, config)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Let request interceptors inspect, amend or reject the typed request
+		if err := runtime.InterceptRequest(ctx, "testdata.AnnotatedService.ListWidgets", req, config.RequestInterceptors); err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Pick the client of this method under runtime.WithMethodClients
+		methodClient, err := runtime.MethodClient(AnnotatedService_ListWidgetsFullMethod, client, config.MethodClients)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Resolve the client of this call under runtime.WithSessionScopedClient
+		callClient, err := runtime.ResolveClient(ctx, methodClient, config.ClientResolver)
+		if err != nil {
+			return runtime.HandleError(err)
+		}
+
+		// Wait for a slot under runtime.WithConcurrencyLimit; nothing was forwarded yet
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return runtime.HandleCallError(ctx, err, true)
+		}
+		defer release()
+
+		// Bound the call by the method timeout, or else runtime.WithCallTimeout
+		ctx, cancel := runtime.CallContext(ctx, ListWidgetsToolDef.Timeout, config.CallTimeout)
+		defer cancel()
+
+		resp, err := callClient.ListWidgets(ctx, req)
+		if err != nil {
+			// Report an interruption by the MCP client apart from backend errors,
+			// and backend failures under runtime.WithProtocolErrorCodes as protocol errors
+			return runtime.HandleCallErrorWithProtocolCodes(ctx, err, ListWidgetsToolDef.RetrySafe(), config.ProtocolErrorCodes)
+		}
+
+		// Apply response transformers (redaction, enrichment) if configured
+		transformed, err := runtime.TransformResponse(ctx, resp, config.ResponseTransformers)
 		if err != nil {
 			return runtime.HandleError(err)
 		}
 
-		marshaled, err = (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(resp)
+		marshaled, err := (protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}).Marshal(transformed)
+		if err != nil {
+			return nil, err
+		}
+
+		// Summarize bytes values over the configured inline limit
+		marshaled, err = runtime.SummarizeLargeBytes(marshaled, transformed, config.BytesInlineLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		// Keep only the allowlisted fields under runtime.WithResponseFieldAllowlist
+		allowedFields := config.ResponseFieldAllowlist["testdata.AnnotatedService.ListWidgets"]
+		marshaled, err = runtime.PruneResponseFields(marshaled, allowedFields)
+		if err != nil {
+			return nil, err
+		}
+
+		// Reshape the result under runtime.WithToolResultPostProcessor
+		marshaled, err = runtime.PostProcessResult("testdata.AnnotatedService.ListWidgets", marshaled, config.ResultPostProcessors)
 		if err != nil {
 			return nil, err
 		}
 
-		// Optionally compress to TOON format if configured
-		if config.UseToonCompression {
+		// Optionally compress to TOON format if configured or requested
+		if useToon {
 			if toonData, toonErr := runtime.CompressToToon(marshaled); toonErr == nil {
 				return mcp.NewToolResultText(toonData), nil
 			}
@@ -401,5 +1029,57 @@ func ForwardToAnnotatedServiceClient(s *mcpserver.MCPServer, client AnnotatedSer
 		}
 
 		return mcp.NewToolResultText(string(marshaled)), nil
+	}
+
+	// Report panics as tool errors unless disabled with runtime.WithPanicRecovery
+	ListWidgetsHandler = runtime.RecoverPanics(ListWidgetsHandler, config.PanicRecovery, config.PanicStackTrace)
+
+	// Report the sizes, duration and error of every call under runtime.WithMetrics
+	ListWidgetsHandler = runtime.RecordMetrics(ListWidgetsHandler, "testdata.AnnotatedService.ListWidgets", config.Metrics)
+
+	s.AddTool(ListWidgetsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Reject calls over the rate of runtime.WithRateLimit
+		if err := rateLimiter.Allow(ctx, ListWidgetsTool.Name); err != nil {
+			return runtime.HandleError(err)
+		}
+		return ListWidgetsHandler(ctx, request.GetArguments())
 	})
 }
+
+// AnnotatedServiceInProcessServer is the server side of AnnotatedService. Every grpc-go
+// AnnotatedServiceServer implementation satisfies it.
+type AnnotatedServiceInProcessServer interface {
+	DeleteWidget(ctx context.Context, req *testdata.DeleteWidgetRequest) (*testdata.DeleteWidgetResponse, error)
+	GetWidget(ctx context.Context, req *testdata.GetWidgetRequest) (*testdata.GetWidgetResponse, error)
+	ListLegacy(ctx context.Context, req *testdata.ListLegacyRequest) (*testdata.ListLegacyResponse, error)
+	ListWidgets(ctx context.Context, req *testdata.ListWidgetsRequest) (*testdata.ListWidgetsResponse, error)
+}
+
+// inProcessAnnotatedServiceClient implements AnnotatedServiceClient by calling a
+// AnnotatedServiceInProcessServer directly. Call options have no effect.
+type inProcessAnnotatedServiceClient struct {
+	impl AnnotatedServiceInProcessServer
+}
+
+func (c inProcessAnnotatedServiceClient) DeleteWidget(ctx context.Context, req *testdata.DeleteWidgetRequest, _ ...grpc.CallOption) (*testdata.DeleteWidgetResponse, error) {
+	return c.impl.DeleteWidget(ctx, req)
+}
+
+func (c inProcessAnnotatedServiceClient) GetWidget(ctx context.Context, req *testdata.GetWidgetRequest, _ ...grpc.CallOption) (*testdata.GetWidgetResponse, error) {
+	return c.impl.GetWidget(ctx, req)
+}
+
+func (c inProcessAnnotatedServiceClient) ListLegacy(ctx context.Context, req *testdata.ListLegacyRequest, _ ...grpc.CallOption) (*testdata.ListLegacyResponse, error) {
+	return c.impl.ListLegacy(ctx, req)
+}
+
+func (c inProcessAnnotatedServiceClient) ListWidgets(ctx context.Context, req *testdata.ListWidgetsRequest, _ ...grpc.CallOption) (*testdata.ListWidgetsResponse, error) {
+	return c.impl.ListWidgets(ctx, req)
+}
+
+// RegisterInProcessAnnotatedServiceServer registers impl so that MCP calls reach it
+// in-process, through the same pipeline as ForwardToAnnotatedServiceClient but
+// without a gRPC connection. gRPC interceptors do not run.
+func RegisterInProcessAnnotatedServiceServer(s *mcpserver.MCPServer, impl AnnotatedServiceInProcessServer, opts ...runtime.Option) {
+	ForwardToAnnotatedServiceClient(s, inProcessAnnotatedServiceClient{impl: impl}, opts...)
+}
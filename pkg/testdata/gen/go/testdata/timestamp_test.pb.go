@@ -0,0 +1,328 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/timestamp_test.proto
+
+package testdata
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ScheduleJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When the job first runs.
+	StartTime     *timestamppb.Timestamp            `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp            `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	BlackoutTimes []*timestamppb.Timestamp          `protobuf:"bytes,3,rep,name=blackout_times,json=blackoutTimes,proto3" json:"blackout_times,omitempty"`
+	Deadlines     map[string]*timestamppb.Timestamp `protobuf:"bytes,4,rep,name=deadlines,proto3" json:"deadlines,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Window        *JobWindow                        `protobuf:"bytes,5,opt,name=window,proto3" json:"window,omitempty"`
+	// Types that are valid to be assigned to Trigger:
+	//
+	//	*ScheduleJobRequest_RunAt
+	//	*ScheduleJobRequest_Cron
+	Trigger       isScheduleJobRequest_Trigger `protobuf_oneof:"trigger"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleJobRequest) Reset() {
+	*x = ScheduleJobRequest{}
+	mi := &file_testdata_timestamp_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleJobRequest) ProtoMessage() {}
+
+func (x *ScheduleJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_timestamp_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleJobRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleJobRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_timestamp_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScheduleJobRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ScheduleJobRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ScheduleJobRequest) GetBlackoutTimes() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.BlackoutTimes
+	}
+	return nil
+}
+
+func (x *ScheduleJobRequest) GetDeadlines() map[string]*timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadlines
+	}
+	return nil
+}
+
+func (x *ScheduleJobRequest) GetWindow() *JobWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *ScheduleJobRequest) GetTrigger() isScheduleJobRequest_Trigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return nil
+}
+
+func (x *ScheduleJobRequest) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Trigger.(*ScheduleJobRequest_RunAt); ok {
+			return x.RunAt
+		}
+	}
+	return nil
+}
+
+func (x *ScheduleJobRequest) GetCron() string {
+	if x != nil {
+		if x, ok := x.Trigger.(*ScheduleJobRequest_Cron); ok {
+			return x.Cron
+		}
+	}
+	return ""
+}
+
+type isScheduleJobRequest_Trigger interface {
+	isScheduleJobRequest_Trigger()
+}
+
+type ScheduleJobRequest_RunAt struct {
+	RunAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=run_at,json=runAt,proto3,oneof"`
+}
+
+type ScheduleJobRequest_Cron struct {
+	Cron string `protobuf:"bytes,7,opt,name=cron,proto3,oneof"`
+}
+
+func (*ScheduleJobRequest_RunAt) isScheduleJobRequest_Trigger() {}
+
+func (*ScheduleJobRequest_Cron) isScheduleJobRequest_Trigger() {}
+
+type JobWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpensAt       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobWindow) Reset() {
+	*x = JobWindow{}
+	mi := &file_testdata_timestamp_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobWindow) ProtoMessage() {}
+
+func (x *JobWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_timestamp_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobWindow.ProtoReflect.Descriptor instead.
+func (*JobWindow) Descriptor() ([]byte, []int) {
+	return file_testdata_timestamp_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JobWindow) GetOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpensAt
+	}
+	return nil
+}
+
+type ScheduleJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NextRunTime   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleJobResponse) Reset() {
+	*x = ScheduleJobResponse{}
+	mi := &file_testdata_timestamp_test_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleJobResponse) ProtoMessage() {}
+
+func (x *ScheduleJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_timestamp_test_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleJobResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleJobResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_timestamp_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScheduleJobResponse) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
+var File_testdata_timestamp_test_proto protoreflect.FileDescriptor
+
+const file_testdata_timestamp_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1dtestdata/timestamp_test.proto\x12\btestdata\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf6\x03\n" +
+	"\x12ScheduleJobRequest\x12>\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12A\n" +
+	"\x0eblackout_times\x18\x03 \x03(\v2\x1a.google.protobuf.TimestampR\rblackoutTimes\x12I\n" +
+	"\tdeadlines\x18\x04 \x03(\v2+.testdata.ScheduleJobRequest.DeadlinesEntryR\tdeadlines\x12+\n" +
+	"\x06window\x18\x05 \x01(\v2\x13.testdata.JobWindowR\x06window\x123\n" +
+	"\x06run_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x05runAt\x12\x14\n" +
+	"\x04cron\x18\a \x01(\tH\x00R\x04cron\x1aX\n" +
+	"\x0eDeadlinesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05value:\x028\x01B\t\n" +
+	"\atrigger\"B\n" +
+	"\tJobWindow\x125\n" +
+	"\bopens_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\aopensAt\"U\n" +
+	"\x13ScheduleJobResponse\x12>\n" +
+	"\rnext_run_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vnextRunTime2^\n" +
+	"\x10TimestampService\x12J\n" +
+	"\vScheduleJob\x12\x1c.testdata.ScheduleJobRequest\x1a\x1d.testdata.ScheduleJobResponseB\xa5\x01\n" +
+	"\fcom.testdataB\x12TimestampTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_timestamp_test_proto_rawDescOnce sync.Once
+	file_testdata_timestamp_test_proto_rawDescData []byte
+)
+
+func file_testdata_timestamp_test_proto_rawDescGZIP() []byte {
+	file_testdata_timestamp_test_proto_rawDescOnce.Do(func() {
+		file_testdata_timestamp_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_timestamp_test_proto_rawDesc), len(file_testdata_timestamp_test_proto_rawDesc)))
+	})
+	return file_testdata_timestamp_test_proto_rawDescData
+}
+
+var file_testdata_timestamp_test_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_testdata_timestamp_test_proto_goTypes = []any{
+	(*ScheduleJobRequest)(nil),    // 0: testdata.ScheduleJobRequest
+	(*JobWindow)(nil),             // 1: testdata.JobWindow
+	(*ScheduleJobResponse)(nil),   // 2: testdata.ScheduleJobResponse
+	nil,                           // 3: testdata.ScheduleJobRequest.DeadlinesEntry
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_testdata_timestamp_test_proto_depIdxs = []int32{
+	4,  // 0: testdata.ScheduleJobRequest.start_time:type_name -> google.protobuf.Timestamp
+	4,  // 1: testdata.ScheduleJobRequest.end_time:type_name -> google.protobuf.Timestamp
+	4,  // 2: testdata.ScheduleJobRequest.blackout_times:type_name -> google.protobuf.Timestamp
+	3,  // 3: testdata.ScheduleJobRequest.deadlines:type_name -> testdata.ScheduleJobRequest.DeadlinesEntry
+	1,  // 4: testdata.ScheduleJobRequest.window:type_name -> testdata.JobWindow
+	4,  // 5: testdata.ScheduleJobRequest.run_at:type_name -> google.protobuf.Timestamp
+	4,  // 6: testdata.JobWindow.opens_at:type_name -> google.protobuf.Timestamp
+	4,  // 7: testdata.ScheduleJobResponse.next_run_time:type_name -> google.protobuf.Timestamp
+	4,  // 8: testdata.ScheduleJobRequest.DeadlinesEntry.value:type_name -> google.protobuf.Timestamp
+	0,  // 9: testdata.TimestampService.ScheduleJob:input_type -> testdata.ScheduleJobRequest
+	2,  // 10: testdata.TimestampService.ScheduleJob:output_type -> testdata.ScheduleJobResponse
+	10, // [10:11] is the sub-list for method output_type
+	9,  // [9:10] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_testdata_timestamp_test_proto_init() }
+func file_testdata_timestamp_test_proto_init() {
+	if File_testdata_timestamp_test_proto != nil {
+		return
+	}
+	file_testdata_timestamp_test_proto_msgTypes[0].OneofWrappers = []any{
+		(*ScheduleJobRequest_RunAt)(nil),
+		(*ScheduleJobRequest_Cron)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_timestamp_test_proto_rawDesc), len(file_testdata_timestamp_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_timestamp_test_proto_goTypes,
+		DependencyIndexes: file_testdata_timestamp_test_proto_depIdxs,
+		MessageInfos:      file_testdata_timestamp_test_proto_msgTypes,
+	}.Build()
+	File_testdata_timestamp_test_proto = out.File
+	file_testdata_timestamp_test_proto_goTypes = nil
+	file_testdata_timestamp_test_proto_depIdxs = nil
+}
@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: testdata/timestamp_test.proto
+
+package testdata
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TimestampService_ScheduleJob_FullMethodName = "/testdata.TimestampService/ScheduleJob"
+)
+
+// TimestampServiceClient is the client API for TimestampService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TimestampService exercises google.protobuf.Timestamp nullability and the
+// timestamp_format option.
+type TimestampServiceClient interface {
+	ScheduleJob(ctx context.Context, in *ScheduleJobRequest, opts ...grpc.CallOption) (*ScheduleJobResponse, error)
+}
+
+type timestampServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimestampServiceClient(cc grpc.ClientConnInterface) TimestampServiceClient {
+	return &timestampServiceClient{cc}
+}
+
+func (c *timestampServiceClient) ScheduleJob(ctx context.Context, in *ScheduleJobRequest, opts ...grpc.CallOption) (*ScheduleJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleJobResponse)
+	err := c.cc.Invoke(ctx, TimestampService_ScheduleJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimestampServiceServer is the server API for TimestampService service.
+// All implementations must embed UnimplementedTimestampServiceServer
+// for forward compatibility.
+//
+// TimestampService exercises google.protobuf.Timestamp nullability and the
+// timestamp_format option.
+type TimestampServiceServer interface {
+	ScheduleJob(context.Context, *ScheduleJobRequest) (*ScheduleJobResponse, error)
+	mustEmbedUnimplementedTimestampServiceServer()
+}
+
+// UnimplementedTimestampServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTimestampServiceServer struct{}
+
+func (UnimplementedTimestampServiceServer) ScheduleJob(context.Context, *ScheduleJobRequest) (*ScheduleJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleJob not implemented")
+}
+func (UnimplementedTimestampServiceServer) mustEmbedUnimplementedTimestampServiceServer() {}
+func (UnimplementedTimestampServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeTimestampServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimestampServiceServer will
+// result in compilation errors.
+type UnsafeTimestampServiceServer interface {
+	mustEmbedUnimplementedTimestampServiceServer()
+}
+
+func RegisterTimestampServiceServer(s grpc.ServiceRegistrar, srv TimestampServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTimestampServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TimestampService_ServiceDesc, srv)
+}
+
+func _TimestampService_ScheduleJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimestampServiceServer).ScheduleJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimestampService_ScheduleJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimestampServiceServer).ScheduleJob(ctx, req.(*ScheduleJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TimestampService_ServiceDesc is the grpc.ServiceDesc for TimestampService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimestampService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.TimestampService",
+	HandlerType: (*TimestampServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleJob",
+			Handler:    _TimestampService_ScheduleJob_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testdata/timestamp_test.proto",
+}
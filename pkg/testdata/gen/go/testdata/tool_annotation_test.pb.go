@@ -281,6 +281,95 @@ func (x *ListLegacyResponse) GetNames() []string {
 	return nil
 }
 
+type ListWidgetsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of widgets to return.
+	Limit         int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWidgetsRequest) Reset() {
+	*x = ListWidgetsRequest{}
+	mi := &file_testdata_tool_annotation_test_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWidgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWidgetsRequest) ProtoMessage() {}
+
+func (x *ListWidgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_tool_annotation_test_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWidgetsRequest.ProtoReflect.Descriptor instead.
+func (*ListWidgetsRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_tool_annotation_test_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListWidgetsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListWidgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWidgetsResponse) Reset() {
+	*x = ListWidgetsResponse{}
+	mi := &file_testdata_tool_annotation_test_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWidgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWidgetsResponse) ProtoMessage() {}
+
+func (x *ListWidgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_tool_annotation_test_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWidgetsResponse.ProtoReflect.Descriptor instead.
+func (*ListWidgetsResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_tool_annotation_test_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListWidgetsResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
 var File_testdata_tool_annotation_test_proto protoreflect.FileDescriptor
 
 const file_testdata_tool_annotation_test_proto_rawDesc = "" +
@@ -296,7 +385,11 @@ const file_testdata_tool_annotation_test_proto_rawDesc = "" +
 	"\x11ListLegacyRequest\x12\x16\n" +
 	"\x06filter\x18\x01 \x01(\tR\x06filter\"*\n" +
 	"\x12ListLegacyResponse\x12\x14\n" +
-	"\x05names\x18\x01 \x03(\tR\x05names2\xba\x02\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"*\n" +
+	"\x12ListWidgetsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"'\n" +
+	"\x13ListWidgetsResponse\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids2\xf4\x03\n" +
 	"\x10AnnotatedService\x12h\n" +
 	"\tGetWidget\x12\x1a.testdata.GetWidgetRequest\x1a\x1b.testdata.GetWidgetResponse\"\"\x92\xb5\x19\x1e\n" +
 	"\n" +
@@ -305,7 +398,9 @@ const file_testdata_tool_annotation_test_proto_rawDesc = "" +
 	"\fDeleteWidget\x12\x1d.testdata.DeleteWidgetRequest\x1a\x1e.testdata.DeleteWidgetResponse\"$\x92\xb5\x19 \n" +
 	"\rdelete_widget\x12\rDelete widget \x01\x12G\n" +
 	"\n" +
-	"ListLegacy\x12\x1b.testdata.ListLegacyRequest\x1a\x1c.testdata.ListLegacyResponseB\xaa\x01\n" +
+	"ListLegacy\x12\x1b.testdata.ListLegacyRequest\x1a\x1c.testdata.ListLegacyResponse\x12\xb7\x01\n" +
+	"\vListWidgets\x12\x1c.testdata.ListWidgetsRequest\x1a\x1d.testdata.ListWidgetsResponse\"k\x92\xb5\x19g\n" +
+	"\flist_widgetsBWLists every widget visible to the caller. Use get_widget to fetch one widget's details.B\xaa\x01\n" +
 	"\fcom.testdataB\x17ToolAnnotationTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
 
 var (
@@ -320,7 +415,7 @@ func file_testdata_tool_annotation_test_proto_rawDescGZIP() []byte {
 	return file_testdata_tool_annotation_test_proto_rawDescData
 }
 
-var file_testdata_tool_annotation_test_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_testdata_tool_annotation_test_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_testdata_tool_annotation_test_proto_goTypes = []any{
 	(*GetWidgetRequest)(nil),     // 0: testdata.GetWidgetRequest
 	(*GetWidgetResponse)(nil),    // 1: testdata.GetWidgetResponse
@@ -328,16 +423,20 @@ var file_testdata_tool_annotation_test_proto_goTypes = []any{
 	(*DeleteWidgetResponse)(nil), // 3: testdata.DeleteWidgetResponse
 	(*ListLegacyRequest)(nil),    // 4: testdata.ListLegacyRequest
 	(*ListLegacyResponse)(nil),   // 5: testdata.ListLegacyResponse
+	(*ListWidgetsRequest)(nil),   // 6: testdata.ListWidgetsRequest
+	(*ListWidgetsResponse)(nil),  // 7: testdata.ListWidgetsResponse
 }
 var file_testdata_tool_annotation_test_proto_depIdxs = []int32{
 	0, // 0: testdata.AnnotatedService.GetWidget:input_type -> testdata.GetWidgetRequest
 	2, // 1: testdata.AnnotatedService.DeleteWidget:input_type -> testdata.DeleteWidgetRequest
 	4, // 2: testdata.AnnotatedService.ListLegacy:input_type -> testdata.ListLegacyRequest
-	1, // 3: testdata.AnnotatedService.GetWidget:output_type -> testdata.GetWidgetResponse
-	3, // 4: testdata.AnnotatedService.DeleteWidget:output_type -> testdata.DeleteWidgetResponse
-	5, // 5: testdata.AnnotatedService.ListLegacy:output_type -> testdata.ListLegacyResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
+	6, // 3: testdata.AnnotatedService.ListWidgets:input_type -> testdata.ListWidgetsRequest
+	1, // 4: testdata.AnnotatedService.GetWidget:output_type -> testdata.GetWidgetResponse
+	3, // 5: testdata.AnnotatedService.DeleteWidget:output_type -> testdata.DeleteWidgetResponse
+	5, // 6: testdata.AnnotatedService.ListLegacy:output_type -> testdata.ListLegacyResponse
+	7, // 7: testdata.AnnotatedService.ListWidgets:output_type -> testdata.ListWidgetsResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -354,7 +453,7 @@ func file_testdata_tool_annotation_test_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_tool_annotation_test_proto_rawDesc), len(file_testdata_tool_annotation_test_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,7 @@ const (
 	AnnotatedService_GetWidget_FullMethodName    = "/testdata.AnnotatedService/GetWidget"
 	AnnotatedService_DeleteWidget_FullMethodName = "/testdata.AnnotatedService/DeleteWidget"
 	AnnotatedService_ListLegacy_FullMethodName   = "/testdata.AnnotatedService/ListLegacy"
+	AnnotatedService_ListWidgets_FullMethodName  = "/testdata.AnnotatedService/ListWidgets"
 )
 
 // AnnotatedServiceClient is the client API for AnnotatedService service.
@@ -41,6 +42,9 @@ type AnnotatedServiceClient interface {
 	// Unannotated method: keeps the legacy autogenerated tool name and emits
 	// no ToolAnnotation.
 	ListLegacy(ctx context.Context, in *ListLegacyRequest, opts ...grpc.CallOption) (*ListLegacyResponse, error)
+	// Lists widgets. Developer note: reads from the widgets replica; the
+	// annotation's description is what the model sees instead.
+	ListWidgets(ctx context.Context, in *ListWidgetsRequest, opts ...grpc.CallOption) (*ListWidgetsResponse, error)
 }
 
 type annotatedServiceClient struct {
@@ -81,6 +85,16 @@ func (c *annotatedServiceClient) ListLegacy(ctx context.Context, in *ListLegacyR
 	return out, nil
 }
 
+func (c *annotatedServiceClient) ListWidgets(ctx context.Context, in *ListWidgetsRequest, opts ...grpc.CallOption) (*ListWidgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWidgetsResponse)
+	err := c.cc.Invoke(ctx, AnnotatedService_ListWidgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AnnotatedServiceServer is the server API for AnnotatedService service.
 // All implementations must embed UnimplementedAnnotatedServiceServer
 // for forward compatibility.
@@ -98,6 +112,9 @@ type AnnotatedServiceServer interface {
 	// Unannotated method: keeps the legacy autogenerated tool name and emits
 	// no ToolAnnotation.
 	ListLegacy(context.Context, *ListLegacyRequest) (*ListLegacyResponse, error)
+	// Lists widgets. Developer note: reads from the widgets replica; the
+	// annotation's description is what the model sees instead.
+	ListWidgets(context.Context, *ListWidgetsRequest) (*ListWidgetsResponse, error)
 	mustEmbedUnimplementedAnnotatedServiceServer()
 }
 
@@ -117,6 +134,9 @@ func (UnimplementedAnnotatedServiceServer) DeleteWidget(context.Context, *Delete
 func (UnimplementedAnnotatedServiceServer) ListLegacy(context.Context, *ListLegacyRequest) (*ListLegacyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLegacy not implemented")
 }
+func (UnimplementedAnnotatedServiceServer) ListWidgets(context.Context, *ListWidgetsRequest) (*ListWidgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWidgets not implemented")
+}
 func (UnimplementedAnnotatedServiceServer) mustEmbedUnimplementedAnnotatedServiceServer() {}
 func (UnimplementedAnnotatedServiceServer) testEmbeddedByValue()                          {}
 
@@ -192,6 +212,24 @@ func _AnnotatedService_ListLegacy_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnnotatedService_ListWidgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWidgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotatedServiceServer).ListWidgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnotatedService_ListWidgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotatedServiceServer).ListWidgets(ctx, req.(*ListWidgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AnnotatedService_ServiceDesc is the grpc.ServiceDesc for AnnotatedService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -211,6 +249,10 @@ var AnnotatedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLegacy",
 			Handler:    _AnnotatedService_ListLegacy_Handler,
 		},
+		{
+			MethodName: "ListWidgets",
+			Handler:    _AnnotatedService_ListWidgets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "testdata/tool_annotation_test.proto",
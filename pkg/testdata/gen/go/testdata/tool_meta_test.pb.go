@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: testdata/tool_meta_test.proto
+
+package testdata
+
+import (
+	_ "github.com/shaders/protoc-gen-go-mcp/pkg/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RecordTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	AmountCents   int32                  `protobuf:"varint,2,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordTransferRequest) Reset() {
+	*x = RecordTransferRequest{}
+	mi := &file_testdata_tool_meta_test_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordTransferRequest) ProtoMessage() {}
+
+func (x *RecordTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_tool_meta_test_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordTransferRequest.ProtoReflect.Descriptor instead.
+func (*RecordTransferRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_tool_meta_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecordTransferRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *RecordTransferRequest) GetAmountCents() int32 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+type RecordTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordTransferResponse) Reset() {
+	*x = RecordTransferResponse{}
+	mi := &file_testdata_tool_meta_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordTransferResponse) ProtoMessage() {}
+
+func (x *RecordTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_tool_meta_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordTransferResponse.ProtoReflect.Descriptor instead.
+func (*RecordTransferResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_tool_meta_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecordTransferResponse) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+var File_testdata_tool_meta_test_proto protoreflect.FileDescriptor
+
+const file_testdata_tool_meta_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1dtestdata/tool_meta_test.proto\x12\btestdata\x1a\x19mcp/options/options.proto\"T\n" +
+	"\x15RecordTransferRequest\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\x12!\n" +
+	"\famount_cents\x18\x02 \x01(\x05R\vamountCents\"9\n" +
+	"\x16RecordTransferResponse\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId2\x7f\n" +
+	"\x0fTransferService\x12l\n" +
+	"\x0eRecordTransfer\x12\x1f.testdata.RecordTransferRequest\x1a .testdata.RecordTransferResponse\"\x17\x92\xb5\x19\x13\n" +
+	"\x0frecord_transferH\x01B\xaf\x01\x82\xb7\x19\a\n" +
+	"\x051.4.0\n" +
+	"\fcom.testdataB\x11ToolMetaTestProtoP\x01ZAgithub.com/shaders/protoc-gen-go-mcp/pkg/testdata/gen/go/testdata\xa2\x02\x03TXX\xaa\x02\bTestdata\xca\x02\bTestdata\xe2\x02\x14Testdata\\GPBMetadata\xea\x02\bTestdatab\x06proto3"
+
+var (
+	file_testdata_tool_meta_test_proto_rawDescOnce sync.Once
+	file_testdata_tool_meta_test_proto_rawDescData []byte
+)
+
+func file_testdata_tool_meta_test_proto_rawDescGZIP() []byte {
+	file_testdata_tool_meta_test_proto_rawDescOnce.Do(func() {
+		file_testdata_tool_meta_test_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_testdata_tool_meta_test_proto_rawDesc), len(file_testdata_tool_meta_test_proto_rawDesc)))
+	})
+	return file_testdata_tool_meta_test_proto_rawDescData
+}
+
+var file_testdata_tool_meta_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_testdata_tool_meta_test_proto_goTypes = []any{
+	(*RecordTransferRequest)(nil),  // 0: testdata.RecordTransferRequest
+	(*RecordTransferResponse)(nil), // 1: testdata.RecordTransferResponse
+}
+var file_testdata_tool_meta_test_proto_depIdxs = []int32{
+	0, // 0: testdata.TransferService.RecordTransfer:input_type -> testdata.RecordTransferRequest
+	1, // 1: testdata.TransferService.RecordTransfer:output_type -> testdata.RecordTransferResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_testdata_tool_meta_test_proto_init() }
+func file_testdata_tool_meta_test_proto_init() {
+	if File_testdata_tool_meta_test_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_testdata_tool_meta_test_proto_rawDesc), len(file_testdata_tool_meta_test_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_testdata_tool_meta_test_proto_goTypes,
+		DependencyIndexes: file_testdata_tool_meta_test_proto_depIdxs,
+		MessageInfos:      file_testdata_tool_meta_test_proto_msgTypes,
+	}.Build()
+	File_testdata_tool_meta_test_proto = out.File
+	file_testdata_tool_meta_test_proto_goTypes = nil
+	file_testdata_tool_meta_test_proto_depIdxs = nil
+}